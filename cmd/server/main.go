@@ -2,6 +2,9 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
 	"errors"
 	"flag"
 	"fmt"
@@ -29,9 +32,18 @@ import (
 )
 
 const (
-	defaultListenAddr = "127.0.0.1:6767"
-	gracefulTimeout   = 10 * time.Second
-	defaultPIDFile    = "k0rdent-mcp.pid"
+	defaultListenAddr      = "127.0.0.1:6767"
+	defaultShutdownTimeout = 10 * time.Second
+	killGracePeriod        = 5 * time.Second
+	defaultPIDFile         = "k0rdent-mcp.pid"
+	envShutdownTimeout     = "SHUTDOWN_TIMEOUT"
+
+	summaryFormatText = "text"
+	summaryFormatJSON = "json"
+
+	// Exit codes of the status command when the server is not running.
+	statusExitNotRunning = 3
+	statusExitStalePID   = 4
 )
 
 func main() {
@@ -54,6 +66,15 @@ func main() {
 			fmt.Fprintf(os.Stderr, "error: %v\n", err)
 			os.Exit(1)
 		}
+	case "status":
+		code, err := runStatus(args, os.Stdout)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "error: %v\n", err)
+			os.Exit(1)
+		}
+		os.Exit(code)
+	case "version", "--version", "-v":
+		printVersion(os.Stdout)
 	case "help", "--help", "-h":
 		printUsage()
 	default:
@@ -63,16 +84,26 @@ func main() {
 	}
 }
 
+func printVersion(w io.Writer) {
+	info := version.Get()
+	fmt.Fprintf(w, "k0rdent-mcp %s\ncommit: %s\nbuilt: %s\n", info.Version, info.GitCommit, info.BuildDate)
+}
+
 func printUsage() {
 	fmt.Fprintf(os.Stderr, `k0rdent MCP Server
 
 Usage:
   k0rdent-mcp start [flags]
   k0rdent-mcp stop [flags]
+  k0rdent-mcp status [flags]
+  k0rdent-mcp version
 
 Commands:
   start   Launch the MCP server and write a PID file for lifecycle management (use --debug to turn on debug logging).
-  stop    Send a graceful termination signal to the running server referenced by the PID file.
+  stop    Send a graceful termination signal to the running server referenced by the PID file,
+          escalating to SIGKILL if it does not exit in time (use --force to kill immediately).
+  status  Report whether the server referenced by the PID file is running (use --json for scripting).
+  version Print the version, git commit, and build date (also available as --version).
 
 Use "k0rdent-mcp <command> --help" for more information about a command.
 `)
@@ -93,22 +124,30 @@ func (e *envOverrides) Set(value string) error {
 }
 
 type startFlagValues struct {
-	pidFile    *string
-	logLevel   *string
-	listen     *string
-	envs       envOverrides
-	debug      *bool
-	debugAlias *bool
+	configFile      *string
+	pidFile         *string
+	logLevel        *string
+	listen          *string
+	shutdownTimeout *time.Duration
+	envs            envOverrides
+	debug           *bool
+	debugAlias      *bool
+	force           *bool
+	summaryFormat   *string
 }
 
 func registerStartFlags(fs *flag.FlagSet) startFlagValues {
 	values := startFlagValues{}
+	values.configFile = fs.String("config", "", "Load settings from a YAML or TOML config file (environment variables and flags take precedence)")
 	values.pidFile = fs.String("pid-file", defaultPIDFile, "Path to the PID file written by the running server")
 	values.logLevel = fs.String("log-level", "", "Override LOG_LEVEL (debug, info, warn, error)")
 	values.listen = fs.String("listen", "", "Override LISTEN_ADDR used by the HTTP server")
+	values.shutdownTimeout = fs.Duration("shutdown-timeout", 0, "Time allowed for draining connections and flushing logs on shutdown (overrides SHUTDOWN_TIMEOUT; default 10s)")
 	fs.Var(&values.envs, "env", "Set additional environment variables (KEY=VALUE). May be specified multiple times.")
 	values.debug = fs.Bool("debug", false, "Enable debug logging (overrides --log-level/LOG_LEVEL)")
 	values.debugAlias = fs.Bool("d", false, "Alias for --debug")
+	values.force = fs.Bool("force", false, "Start even if the PID file references a running process")
+	values.summaryFormat = fs.String("summary-format", summaryFormatText, "Format of the startup summary printed to stdout (text or json)")
 	return values
 }
 
@@ -131,6 +170,19 @@ Flags:
 		}
 		return err
 	}
+	if *values.summaryFormat != summaryFormatText && *values.summaryFormat != summaryFormatJSON {
+		return fmt.Errorf("invalid --summary-format %q (expected %s or %s)", *values.summaryFormat, summaryFormatText, summaryFormatJSON)
+	}
+
+	if *values.configFile != "" {
+		source, err := config.LoadFile(*values.configFile)
+		if err != nil {
+			return err
+		}
+		if err := source.ApplyEnv(os.LookupEnv, os.Setenv); err != nil {
+			return err
+		}
+	}
 
 	if err := cli.ApplyEnvOverrides([]string(values.envs)); err != nil {
 		return err
@@ -153,9 +205,17 @@ Flags:
 		return err
 	}
 
+	gracefulTimeout, err := resolveShutdownTimeout(*values.shutdownTimeout, os.Getenv(envShutdownTimeout))
+	if err != nil {
+		return err
+	}
+
 	if err := ensurePIDDir(*values.pidFile); err != nil {
 		return err
 	}
+	if err := checkExistingPID(*values.pidFile, *values.force, os.Stderr); err != nil {
+		return err
+	}
 
 	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer stop()
@@ -176,7 +236,13 @@ Flags:
 
 	// Print startup banner even if ping failed, to show the configuration being used
 	if settings != nil {
-		printStartupSummary(os.Stdout, settings, addr, *values.pidFile)
+		if *values.summaryFormat == summaryFormatJSON {
+			if err := printStartupSummaryJSON(os.Stdout, settings, addr, *values.pidFile); err != nil {
+				return err
+			}
+		} else {
+			printStartupSummary(os.Stdout, settings, addr, *values.pidFile)
+		}
 	}
 
 	// Now fail if config loading had errors (after showing banner)
@@ -217,7 +283,7 @@ Flags:
 
 	logStartupConfiguration(logger, setup.settings, setup.httpServer.Addr, *values.pidFile)
 
-	logger.Info("http server listening", "addr", setup.httpServer.Addr, "auth_mode", setup.authMode)
+	logger.Info("http server listening", "addr", setup.httpServer.Addr, "auth_mode", setup.authMode, "tls", tlsMode(setup.settings.TLS), "shutdown_timeout", gracefulTimeout)
 
 	go func() {
 		<-ctx.Done()
@@ -228,7 +294,7 @@ Flags:
 		}
 	}()
 
-	if err := setup.httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+	if err := listenAndServe(setup.httpServer, setup.settings.TLS); err != nil && !errors.Is(err, http.ErrServerClosed) {
 		return err
 	}
 
@@ -249,7 +315,8 @@ Flags:
 	}
 
 	pidFile := fs.String("pid-file", defaultPIDFile, "Path to the PID file created by the running server")
-	timeout := fs.Duration("timeout", 10*time.Second, "Maximum time to wait for the server to stop")
+	timeout := fs.Duration("timeout", 10*time.Second, "Maximum time to wait for the server to stop before sending SIGKILL")
+	force := fs.Bool("force", false, "Send SIGKILL immediately instead of waiting for a graceful shutdown")
 
 	if err := fs.Parse(args); err != nil {
 		if errors.Is(err, flag.ErrHelp) {
@@ -263,21 +330,124 @@ Flags:
 		return err
 	}
 
-	if err := cli.SignalProcess(pid, syscall.SIGTERM); err != nil {
-		if errors.Is(err, os.ErrProcessDone) {
-			_ = cli.RemovePID(*pidFile)
-			return nil
-		}
+	killed, err := cli.StopProcess(pid, cli.StopOptions{
+		Timeout:         *timeout,
+		KillGracePeriod: killGracePeriod,
+		Force:           *force,
+	})
+	if err != nil {
 		return err
 	}
-
-	if err := cli.WaitForExit(pid, *timeout); err != nil {
-		return err
+	if killed && !*force {
+		fmt.Fprintf(os.Stderr, "warning: server (pid %d) did not exit within %s; sent SIGKILL\n", pid, *timeout)
 	}
 
 	return cli.RemovePID(*pidFile)
 }
 
+// serverStatus is the state reported by the status command.
+type serverStatus struct {
+	Running bool   `json:"running"`
+	Stale   bool   `json:"stale,omitempty"`
+	PID     int    `json:"pid,omitempty"`
+	PIDFile string `json:"pidFile"`
+}
+
+// runStatus prints whether the server referenced by the PID file is alive and returns the
+// process exit code: 0 when running, statusExitNotRunning when the PID file is missing, and
+// statusExitStalePID when the PID file references a process that is gone or holds no valid
+// pid. A PID file that cannot be read is returned as an error.
+func runStatus(args []string, w io.Writer) (int, error) {
+	fs := flag.NewFlagSet("status", flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), `Usage: k0rdent-mcp status [flags]
+
+Reports whether the MCP server referenced by the PID file is running.
+Exits 0 when running, 3 when the PID file is missing, and 4 when the PID file is stale.
+
+Flags:
+`)
+		fs.PrintDefaults()
+	}
+
+	pidFile := fs.String("pid-file", defaultPIDFile, "Path to the PID file created by the running server")
+	jsonOutput := fs.Bool("json", false, "Print the status as JSON")
+
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0, nil
+		}
+		return 1, err
+	}
+
+	status, err := checkServerStatus(*pidFile)
+	if err != nil {
+		return 1, err
+	}
+
+	if *jsonOutput {
+		if err := json.NewEncoder(w).Encode(status); err != nil {
+			return 1, fmt.Errorf("encode status: %w", err)
+		}
+	} else {
+		switch {
+		case status.Running:
+			fmt.Fprintf(w, "running (pid %d, pid file %s)\n", status.PID, status.PIDFile)
+		case status.Stale && status.PID > 0:
+			fmt.Fprintf(w, "stale pid file %s (pid %d is not running)\n", status.PIDFile, status.PID)
+		case status.Stale:
+			fmt.Fprintf(w, "stale pid file %s (contents are not a valid pid)\n", status.PIDFile)
+		default:
+			fmt.Fprintf(w, "not running (no pid file at %s)\n", status.PIDFile)
+		}
+	}
+
+	switch {
+	case status.Running:
+		return 0, nil
+	case status.Stale:
+		return statusExitStalePID, nil
+	default:
+		return statusExitNotRunning, nil
+	}
+}
+
+// checkServerStatus reports the process recorded in the PID file. A process that exists
+// but cannot be signalled (EPERM) is reported as running; a PID file that cannot be read
+// is an error rather than stale.
+func checkServerStatus(pidFile string) (serverStatus, error) {
+	pidStatus, err := cli.InspectPIDFile(pidFile)
+	if err != nil {
+		return serverStatus{PIDFile: pidFile}, err
+	}
+	return serverStatus{
+		Running: pidStatus.Running,
+		Stale:   pidStatus.Stale(),
+		PID:     pidStatus.PID,
+		PIDFile: pidFile,
+	}, nil
+}
+
+// checkExistingPID refuses to start when the PID file points at a live process unless
+// force is set. Stale PID files are reported and left for WritePID to overwrite.
+func checkExistingPID(path string, force bool, w io.Writer) error {
+	stale, err := cli.CheckPIDFile(path)
+	if err != nil {
+		if !errors.Is(err, cli.ErrAlreadyRunning) {
+			return err
+		}
+		if !force {
+			return fmt.Errorf("%w; stop it with 'k0rdent-mcp stop' or pass --force to take over the PID file", err)
+		}
+		fmt.Fprintf(w, "warning: %v; continuing because --force was set\n", err)
+		return nil
+	}
+	if stale {
+		fmt.Fprintf(w, "warning: replacing stale PID file %s\n", path)
+	}
+	return nil
+}
+
 type serverSetup struct {
 	httpServer *http.Server
 	logger     *slog.Logger
@@ -308,12 +478,25 @@ func initializeServerWithSettings(ctx context.Context, settings *config.Settings
 		return nil, err
 	}
 
-	catalogManager, err := catalog.NewManager(catalog.LoadConfig())
+	catalogOpts := catalog.LoadConfig()
+	if settings.Cluster.CatalogCacheTTL > 0 {
+		catalogOpts.CacheTTL = settings.Cluster.CatalogCacheTTL
+	}
+	catalogManager, err := catalog.NewManager(catalogOpts)
 	if err != nil {
 		_ = logManager.Close(context.Background())
 		return nil, fmt.Errorf("init catalog manager: %w", err)
 	}
 	logger.Info("catalog manager initialized")
+	if settings.Cluster.CatalogWarmOnStart {
+		go warmCatalog(ctx, catalogManager, logger)
+	}
+
+	var clusterWatchHub *core.ClusterWatchHub
+	if settings.Cluster.MonitorSharedWatches {
+		clusterWatchHub = core.NewClusterWatchHub()
+		logger.Info("cluster monitor shared watches enabled")
+	}
 
 	sessionOptions := func(ctx *mcpserver.SessionContext) (*mcp.ServerOptions, error) {
 		if ctx.Values == nil {
@@ -324,14 +507,23 @@ func initializeServerWithSettings(ctx context.Context, settings *config.Settings
 		eventManager := core.NewEventManager()
 		podLogManager := core.NewPodLogManager()
 		clusterMonitorManager := core.NewClusterMonitorManager()
+		if clusterWatchHub != nil {
+			clusterMonitorManager.UseSharedWatches(clusterWatchHub)
+		}
+		clusterLogManager := core.NewClusterLogManager()
+		clusterWatchManager := core.NewClusterDeploymentWatchManager()
 
 		router.Register("events", eventManager)
 		router.Register("podlogs", podLogManager)
 		router.Register("cluster-monitor", clusterMonitorManager)
+		router.Register("cluster-logs", clusterLogManager)
+		router.Register("cluster-deployments", clusterWatchManager)
 
 		ctx.Values[core.ContextKeyEventManager] = eventManager
 		ctx.Values[core.ContextKeyPodLogManager] = podLogManager
 		ctx.Values[core.ContextKeyClusterMonitorManager] = clusterMonitorManager
+		ctx.Values[core.ContextKeyClusterLogManager] = clusterLogManager
+		ctx.Values[core.ContextKeyClusterWatchManager] = clusterWatchManager
 
 		return &mcp.ServerOptions{
 			HasTools:           true,
@@ -350,6 +542,8 @@ func initializeServerWithSettings(ctx context.Context, settings *config.Settings
 			eventManager          *core.EventManager
 			podLogManager         *core.PodLogManager
 			clusterMonitorManager *core.ClusterMonitorManager
+			clusterLogManager     *core.ClusterLogManager
+			clusterWatchManager   *core.ClusterDeploymentWatchManager
 		)
 		if ctx != nil && ctx.Values != nil {
 			if mgr, ok := ctx.Values[core.ContextKeyEventManager].(*core.EventManager); ok {
@@ -361,11 +555,19 @@ func initializeServerWithSettings(ctx context.Context, settings *config.Settings
 			if mgr, ok := ctx.Values[core.ContextKeyClusterMonitorManager].(*core.ClusterMonitorManager); ok {
 				clusterMonitorManager = mgr
 			}
+			if mgr, ok := ctx.Values[core.ContextKeyClusterLogManager].(*core.ClusterLogManager); ok {
+				clusterLogManager = mgr
+			}
+			if mgr, ok := ctx.Values[core.ContextKeyClusterWatchManager].(*core.ClusterDeploymentWatchManager); ok {
+				clusterWatchManager = mgr
+			}
 		}
 		return core.Register(s, session, core.Options{
 			EventManager:          eventManager,
 			PodLogManager:         podLogManager,
 			ClusterMonitorManager: clusterMonitorManager,
+			ClusterLogManager:     clusterLogManager,
+			ClusterWatchManager:   clusterWatchManager,
 			CatalogManager:        catalogManager,
 		})
 	}
@@ -396,9 +598,16 @@ func initializeServerWithSettings(ctx context.Context, settings *config.Settings
 		addr = defaultListenAddr
 	}
 
+	tlsConfig, err := serverTLSConfig(settings.TLS, os.ReadFile)
+	if err != nil {
+		_ = logManager.Close(context.Background())
+		return nil, err
+	}
+
 	httpServer := &http.Server{
-		Addr:    addr,
-		Handler: app.Router(),
+		Addr:      addr,
+		Handler:   app.Router(),
+		TLSConfig: tlsConfig,
 	}
 
 	return &serverSetup{
@@ -410,6 +619,61 @@ func initializeServerWithSettings(ctx context.Context, settings *config.Settings
 	}, nil
 }
 
+// warmCatalog loads the catalog index so the first catalog tool call is served from the cache.
+// Failures are only logged; catalog tools retry the download on their next call.
+func warmCatalog(ctx context.Context, manager *catalog.Manager, logger *slog.Logger) {
+	start := time.Now()
+	entries, err := manager.List(ctx, "", false)
+	if err != nil {
+		logger.Warn("catalog warm-up failed", "error", err, "duration_ms", time.Since(start).Milliseconds())
+		return
+	}
+	logger.Info("catalog cache warmed", "app_count", len(entries), "duration_ms", time.Since(start).Milliseconds())
+}
+
+// serverTLSConfig builds the HTTPS configuration for the configured TLS settings. It returns nil
+// when TLS is disabled; the certificate and key are loaded by ListenAndServeTLS.
+func serverTLSConfig(settings config.TLSSettings, readFile func(string) ([]byte, error)) (*tls.Config, error) {
+	if !settings.Enabled() {
+		return nil, nil
+	}
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if settings.ClientCAFile == "" {
+		return tlsConfig, nil
+	}
+	data, err := readFile(settings.ClientCAFile)
+	if err != nil {
+		return nil, fmt.Errorf("read TLS client CA: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, fmt.Errorf("TLS client CA %s contains no PEM certificates", settings.ClientCAFile)
+	}
+	tlsConfig.ClientCAs = pool
+	tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	return tlsConfig, nil
+}
+
+// listenAndServe serves HTTPS when TLS is configured and plain HTTP otherwise.
+func listenAndServe(httpServer *http.Server, settings config.TLSSettings) error {
+	if settings.Enabled() {
+		return httpServer.ListenAndServeTLS(settings.CertFile, settings.KeyFile)
+	}
+	return httpServer.ListenAndServe()
+}
+
+// tlsMode describes the TLS configuration for the startup summary.
+func tlsMode(settings config.TLSSettings) string {
+	switch {
+	case settings.MutualTLS():
+		return "enabled (client certificates required)"
+	case settings.Enabled():
+		return "enabled"
+	default:
+		return "disabled"
+	}
+}
+
 func ensurePIDDir(pidFile string) error {
 	dir := filepath.Dir(pidFile)
 	if dir == "." {
@@ -418,6 +682,29 @@ func ensurePIDDir(pidFile string) error {
 	return os.MkdirAll(dir, 0o755)
 }
 
+// resolveShutdownTimeout picks the shutdown timeout from the --shutdown-timeout flag,
+// falling back to SHUTDOWN_TIMEOUT and then the 10s default.
+func resolveShutdownTimeout(flagValue time.Duration, envValue string) (time.Duration, error) {
+	if flagValue < 0 {
+		return 0, fmt.Errorf("--shutdown-timeout must not be negative")
+	}
+	if flagValue > 0 {
+		return flagValue, nil
+	}
+	envValue = strings.TrimSpace(envValue)
+	if envValue == "" {
+		return defaultShutdownTimeout, nil
+	}
+	parsed, err := time.ParseDuration(envValue)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q: %w", envShutdownTimeout, envValue, err)
+	}
+	if parsed <= 0 {
+		return 0, fmt.Errorf("%s must be positive, got %q", envShutdownTimeout, envValue)
+	}
+	return parsed, nil
+}
+
 func applyLogLevelFlags(debug bool, logLevelFlag string, stderr io.Writer) error {
 	if debug {
 		if logLevelFlag != "" && stderr != nil {
@@ -447,6 +734,7 @@ func printStartupSummary(w io.Writer, settings *config.Settings, listenAddr, pid
 	fmt.Fprintln(w, "K0rdent MCP Server Startup Summary")
 	fmt.Fprintf(w, "  Listen Address:       %s\n", listenAddr)
 	fmt.Fprintf(w, "  Auth Mode:            %s\n", settings.AuthMode)
+	fmt.Fprintf(w, "  TLS:                  %s\n", tlsMode(settings.TLS))
 	fmt.Fprintf(w, "  Kubeconfig Source:    %s\n", settings.Source)
 	fmt.Fprintf(w, "  Kubeconfig Context:   %s\n", settings.ContextName)
 	fmt.Fprintf(w, "  Namespace Filter:     %s\n", namespaceFilter)
@@ -456,6 +744,23 @@ func printStartupSummary(w io.Writer, settings *config.Settings, listenAddr, pid
 	fmt.Fprintln(w, "========================================")
 }
 
+// printStartupSummaryJSON writes the startup summary as a single JSON object on one line,
+// using the same fields as the startup configuration log entry.
+func printStartupSummaryJSON(w io.Writer, settings *config.Settings, listenAddr, pidFile string) error {
+	if w == nil || settings == nil {
+		return nil
+	}
+	attrs := startupSummaryAttributes(settings, listenAddr, pidFile)
+	summary := make(map[string]any, len(attrs)/2)
+	for i := 0; i+1 < len(attrs); i += 2 {
+		summary[attrs[i].(string)] = attrs[i+1]
+	}
+	if err := json.NewEncoder(w).Encode(summary); err != nil {
+		return fmt.Errorf("encode startup summary: %w", err)
+	}
+	return nil
+}
+
 func logStartupConfiguration(logger *slog.Logger, settings *config.Settings, listenAddr, pidFile string) {
 	if logger == nil || settings == nil {
 		return
@@ -474,6 +779,7 @@ func startupSummaryAttributes(settings *config.Settings, listenAddr, pidFile str
 	return []any{
 		"listen_addr", listenAddr,
 		"auth_mode", settings.AuthMode,
+		"tls", tlsMode(settings.TLS),
 		"kubeconfig_source", settings.Source,
 		"kubeconfig_context", settings.ContextName,
 		"namespace_filter", namespaceFilter,
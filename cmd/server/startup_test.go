@@ -3,14 +3,25 @@ package main
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
+	"encoding/json"
 	"flag"
+	"io"
 	"log/slog"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"os/exec"
+	"path/filepath"
 	"regexp"
+	"strconv"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/k0rdent/mcp-k0rdent-server/internal/catalog"
 	"github.com/k0rdent/mcp-k0rdent-server/internal/config"
+	"github.com/k0rdent/mcp-k0rdent-server/internal/version"
 )
 
 type recordingHandler struct {
@@ -48,6 +59,7 @@ func TestPrintStartupSummary(t *testing.T) {
 		"K0rdent MCP Server Startup Summary",
 		"Listen Address:       127.0.0.1:6767",
 		"Auth Mode:            DEV_ALLOW_ANY",
+		"TLS:                  disabled",
 		"Kubeconfig Source:    path",
 		"Namespace Filter:     ^team-",
 		"Log Level:            DEBUG",
@@ -62,6 +74,43 @@ func TestPrintStartupSummary(t *testing.T) {
 	}
 }
 
+func TestPrintStartupSummaryJSON(t *testing.T) {
+	buf := &bytes.Buffer{}
+	settings := &config.Settings{
+		AuthMode:        config.AuthModeDevAllowAny,
+		Source:          config.SourcePath,
+		ContextName:     "dev",
+		NamespaceFilter: regexp.MustCompile(`^team-`),
+		Logging:         config.LoggingSettings{Level: slog.LevelDebug},
+	}
+
+	if err := printStartupSummaryJSON(buf, settings, "127.0.0.1:6767", "/tmp/pid"); err != nil {
+		t.Fatalf("printStartupSummaryJSON returned error: %v", err)
+	}
+	if strings.Count(buf.String(), "\n") != 1 {
+		t.Fatalf("expected a single JSON line, got %q", buf.String())
+	}
+
+	var summary map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &summary); err != nil {
+		t.Fatalf("failed to decode summary %q: %v", buf.String(), err)
+	}
+	want := map[string]any{
+		"listen_addr":        "127.0.0.1:6767",
+		"auth_mode":          "DEV_ALLOW_ANY",
+		"kubeconfig_source":  "path",
+		"kubeconfig_context": "dev",
+		"namespace_filter":   "^team-",
+		"log_level":          "DEBUG",
+		"pid_file":           "/tmp/pid",
+	}
+	for key, value := range want {
+		if summary[key] != value {
+			t.Fatalf("summary %s mismatch: got %v want %v", key, summary[key], value)
+		}
+	}
+}
+
 func TestStartupSummaryAttributes(t *testing.T) {
 	settings := &config.Settings{
 		AuthMode:    config.AuthModeOIDCRequired,
@@ -71,6 +120,7 @@ func TestStartupSummaryAttributes(t *testing.T) {
 			Level:               slog.LevelWarn,
 			ExternalSinkEnabled: false,
 		},
+		TLS: config.TLSSettings{CertFile: "tls.crt", KeyFile: "tls.key", ClientCAFile: "ca.crt"},
 	}
 
 	attrs := startupSummaryAttributes(settings, ":8443", "pidfile")
@@ -90,6 +140,7 @@ func TestStartupSummaryAttributes(t *testing.T) {
 	cases := map[string]any{
 		"listen_addr":           ":8443",
 		"auth_mode":             config.AuthModeOIDCRequired,
+		"tls":                   "enabled (client certificates required)",
 		"kubeconfig_source":     config.SourcePath,
 		"kubeconfig_context":    "prod",
 		"namespace_filter":      "",
@@ -105,6 +156,29 @@ func TestStartupSummaryAttributes(t *testing.T) {
 	}
 }
 
+func TestServerTLSConfig(t *testing.T) {
+	cfg, err := serverTLSConfig(config.TLSSettings{}, nil)
+	if err != nil || cfg != nil {
+		t.Fatalf("expected no TLS config when disabled, got %v, %v", cfg, err)
+	}
+
+	cfg, err = serverTLSConfig(config.TLSSettings{CertFile: "tls.crt", KeyFile: "tls.key"}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.ClientAuth != tls.NoClientCert {
+		t.Fatalf("expected no client auth without a client CA, got %v", cfg.ClientAuth)
+	}
+
+	mtls := config.TLSSettings{CertFile: "tls.crt", KeyFile: "tls.key", ClientCAFile: "ca.crt"}
+	if _, err := serverTLSConfig(mtls, func(string) ([]byte, error) { return []byte("not a pem"), nil }); err == nil {
+		t.Fatal("expected error for client CA without certificates")
+	}
+	if _, err := serverTLSConfig(mtls, func(string) ([]byte, error) { return nil, os.ErrNotExist }); err == nil {
+		t.Fatal("expected error for unreadable client CA")
+	}
+}
+
 func TestLogStartupConfiguration(t *testing.T) {
 	settings := &config.Settings{
 		AuthMode: config.AuthModeDevAllowAny,
@@ -126,6 +200,51 @@ func TestLogStartupConfiguration(t *testing.T) {
 	}
 }
 
+func TestWarmCatalog(t *testing.T) {
+	available := true
+	requests := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if !available {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"metadata":{"generated":"2025-11-06T15:02:01","version":"1.0.0"},"addons":[{"name":"minio","charts":[{"name":"minio","versions":["14.1.2"]}]}]}`))
+	}))
+	defer ts.Close()
+
+	newManager := func() *catalog.Manager {
+		manager, err := catalog.NewManager(catalog.Options{
+			ArchiveURL: ts.URL,
+			CacheDir:   t.TempDir(),
+			CacheTTL:   time.Hour,
+			Logger:     slog.New(slog.NewTextHandler(io.Discard, nil)),
+		})
+		if err != nil {
+			t.Fatalf("NewManager failed: %v", err)
+		}
+		return manager
+	}
+
+	handler := &recordingHandler{}
+	manager := newManager()
+	warmCatalog(context.Background(), manager, slog.New(handler))
+	if len(handler.records) != 1 || handler.records[0].Message != "catalog cache warmed" {
+		t.Fatalf("expected a single warm-up success record, got %+v", handler.records)
+	}
+	if _, err := manager.List(context.Background(), "", false); err != nil || requests != 1 {
+		t.Fatalf("expected list to be served from the warmed cache, got err=%v after %d requests", err, requests)
+	}
+
+	available = false
+	handler = &recordingHandler{}
+	warmCatalog(context.Background(), newManager(), slog.New(handler))
+	if len(handler.records) != 1 || handler.records[0].Level != slog.LevelWarn {
+		t.Fatalf("expected a single warm-up failure warning, got %+v", handler.records)
+	}
+}
+
 func TestRegisterStartFlagsDebugAlias(t *testing.T) {
 	fs := flag.NewFlagSet("start", flag.ContinueOnError)
 	values := registerStartFlags(fs)
@@ -181,3 +300,126 @@ func TestRegisterStartFlagsHelpIncludesDebug(t *testing.T) {
 		t.Fatalf("expected help output to mention --debug, got %q", output)
 	}
 }
+
+func TestResolveShutdownTimeout(t *testing.T) {
+	tests := []struct {
+		name    string
+		flag    time.Duration
+		env     string
+		want    time.Duration
+		wantErr bool
+	}{
+		{name: "default", want: defaultShutdownTimeout},
+		{name: "env", env: "45s", want: 45 * time.Second},
+		{name: "flag overrides env", flag: time.Minute, env: "45s", want: time.Minute},
+		{name: "invalid env", env: "soon", wantErr: true},
+		{name: "non-positive env", env: "0s", wantErr: true},
+		{name: "negative flag", flag: -time.Second, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolveShutdownTimeout(tt.flag, tt.env)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got timeout %s", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("resolveShutdownTimeout returned error: %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestPrintVersion(t *testing.T) {
+	var buf bytes.Buffer
+	printVersion(&buf)
+
+	info := version.Get()
+	out := buf.String()
+	for _, want := range []string{info.Version, info.GitCommit, info.BuildDate} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("expected version output to contain %q, got %q", want, out)
+		}
+	}
+}
+
+func TestRunStatus(t *testing.T) {
+	dir := t.TempDir()
+
+	exited := exec.Command(os.Args[0], "-test.run=^$")
+	if err := exited.Run(); err != nil {
+		t.Fatalf("failed to run helper process: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		contents string
+		wantCode int
+		wantOut  string
+	}{
+		{name: "missing", wantCode: statusExitNotRunning, wantOut: "not running"},
+		{name: "running", contents: strconv.Itoa(os.Getpid()), wantCode: 0, wantOut: "running (pid"},
+		{name: "exited", contents: strconv.Itoa(exited.Process.Pid), wantCode: statusExitStalePID, wantOut: "stale pid file"},
+		{name: "garbage", contents: "not-a-number", wantCode: statusExitStalePID, wantOut: "stale pid file"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pidFile := filepath.Join(dir, tt.name+".pid")
+			if tt.contents != "" {
+				if err := os.WriteFile(pidFile, []byte(tt.contents), 0o644); err != nil {
+					t.Fatalf("failed to write pid file: %v", err)
+				}
+			}
+
+			var buf bytes.Buffer
+			code, err := runStatus([]string{"--pid-file", pidFile}, &buf)
+			if err != nil {
+				t.Fatalf("runStatus returned error: %v", err)
+			}
+			if code != tt.wantCode {
+				t.Fatalf("expected exit code %d, got %d", tt.wantCode, code)
+			}
+			if !strings.HasPrefix(buf.String(), tt.wantOut) {
+				t.Fatalf("expected output to start with %q, got %q", tt.wantOut, buf.String())
+			}
+		})
+	}
+
+	// A PID file that cannot be read is an error, not a stale file
+	unreadable := filepath.Join(dir, "unreadable.pid")
+	if err := os.Mkdir(unreadable, 0o755); err != nil {
+		t.Fatalf("failed to create directory: %v", err)
+	}
+	var buf bytes.Buffer
+	if code, err := runStatus([]string{"--pid-file", unreadable}, &buf); err == nil || code == statusExitStalePID {
+		t.Fatalf("expected read error, got code=%d err=%v output=%q", code, err, buf.String())
+	}
+}
+
+func TestRunStatusJSON(t *testing.T) {
+	pidFile := filepath.Join(t.TempDir(), "server.pid")
+	if err := os.WriteFile(pidFile, []byte(strconv.Itoa(os.Getpid())), 0o644); err != nil {
+		t.Fatalf("failed to write pid file: %v", err)
+	}
+
+	var buf bytes.Buffer
+	code, err := runStatus([]string{"--json", "--pid-file", pidFile}, &buf)
+	if err != nil || code != 0 {
+		t.Fatalf("expected running status, got code=%d err=%v", code, err)
+	}
+
+	var status serverStatus
+	if err := json.Unmarshal(buf.Bytes(), &status); err != nil {
+		t.Fatalf("failed to decode status JSON %q: %v", buf.String(), err)
+	}
+	if !status.Running || status.PID != os.Getpid() || status.PIDFile != pidFile {
+		t.Fatalf("unexpected status: %+v", status)
+	}
+}
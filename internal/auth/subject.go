@@ -0,0 +1,28 @@
+package auth
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+)
+
+// TokenSubject returns the "sub" claim of a JWT bearer token, or an empty string when the
+// token is not a JWT. The signature is not verified; the API server remains responsible for
+// authenticating the token, so the subject must only be used for attribution.
+func TokenSubject(token string) string {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return ""
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return ""
+	}
+	var claims struct {
+		Subject string `json:"sub"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return ""
+	}
+	return strings.TrimSpace(claims.Subject)
+}
@@ -0,0 +1,30 @@
+package auth
+
+import (
+	"encoding/base64"
+	"testing"
+)
+
+func TestTokenSubject(t *testing.T) {
+	payload := base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"system:serviceaccount:kcm-system:agent"}`))
+
+	tests := []struct {
+		name  string
+		token string
+		want  string
+	}{
+		{name: "jwt", token: "eyJhbGciOiJub25lIn0." + payload + ".sig", want: "system:serviceaccount:kcm-system:agent"},
+		{name: "opaque", token: "opaque-token", want: ""},
+		{name: "bad payload", token: "a.!!!.c", want: ""},
+		{name: "no subject", token: "a." + base64.RawURLEncoding.EncodeToString([]byte(`{"iss":"x"}`)) + ".c", want: ""},
+		{name: "empty", token: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TokenSubject(tt.token); got != tt.want {
+				t.Fatalf("expected subject %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
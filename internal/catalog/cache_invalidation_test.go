@@ -2,7 +2,10 @@ package catalog
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"log/slog"
 	"net/http"
 	"net/http/httptest"
@@ -375,3 +378,59 @@ func TestCacheInvalidation_ForceRefresh(t *testing.T) {
 		t.Errorf("expected HTTP call with refresh=true, but call count stayed at %d", callCount)
 	}
 }
+
+func TestCacheInvalidation_ChecksumMismatchKeepsIndex(t *testing.T) {
+	fixtureData, err := os.ReadFile(filepath.Join("testdata", "valid-index.json"))
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+	sum := sha256.Sum256(fixtureData)
+	fixtureSHA := hex.EncodeToString(sum[:])
+
+	tampered := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if tampered {
+			w.Write([]byte(`{"metadata":{"generated":"2099-01-01T00:00:00Z"},"addons":[]}`))
+			return
+		}
+		w.Write(fixtureData)
+	}))
+	defer server.Close()
+
+	mgr, err := NewManager(Options{
+		CacheDir:    t.TempDir(),
+		ArchiveURL:  server.URL,
+		CacheTTL:    1 * time.Hour,
+		ExpectedSHA: fixtureSHA,
+		Logger:      slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError})),
+	})
+	if err != nil {
+		t.Fatalf("failed to create manager: %v", err)
+	}
+	defer mgr.db.Close()
+
+	ctx := context.Background()
+	if err := mgr.loadOrRefreshIndex(ctx, false); err != nil {
+		t.Fatalf("initial load with matching checksum failed: %v", err)
+	}
+	before, err := mgr.db.GetMetadata("index_timestamp")
+	if err != nil {
+		t.Fatalf("failed to read index timestamp: %v", err)
+	}
+
+	// A tampered index must be rejected without replacing the verified one
+	tampered = true
+	err = mgr.loadOrRefreshIndex(ctx, true)
+	if !errors.Is(err, ErrChecksumMismatch) {
+		t.Fatalf("expected ErrChecksumMismatch, got %v", err)
+	}
+	after, err := mgr.db.GetMetadata("index_timestamp")
+	if err != nil {
+		t.Fatalf("failed to read index timestamp: %v", err)
+	}
+	if after != before {
+		t.Errorf("index was rebuilt from a mismatching download: %q -> %q", before, after)
+	}
+}
@@ -2,6 +2,8 @@ package catalog
 
 import (
 	"os"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -18,9 +20,33 @@ const (
 	// EnvCacheTTL overrides the default cache time-to-live
 	EnvCacheTTL = "CATALOG_CACHE_TTL"
 
+	// EnvMaxIndexBytes overrides the maximum accepted size of the catalog index download
+	EnvMaxIndexBytes = "CATALOG_MAX_INDEX_BYTES"
+
+	// EnvMaxIdleConnsPerHost overrides how many idle connections are kept per catalog host
+	EnvMaxIdleConnsPerHost = "CATALOG_MAX_IDLE_CONNS_PER_HOST"
+
+	// EnvIdleConnTimeout overrides how long idle catalog connections are kept open
+	EnvIdleConnTimeout = "CATALOG_IDLE_CONN_TIMEOUT"
+
+	// EnvDisableHTTP2 turns off HTTP/2 negotiation for catalog requests
+	EnvDisableHTTP2 = "CATALOG_DISABLE_HTTP2"
+
+	// EnvExpectedSHA pins the SHA256 (hex) the downloaded catalog index must match
+	EnvExpectedSHA = "CATALOG_SHA"
+
+	// EnvLocalManifestRoot points manifest reads at a local mirror of the catalog repository
+	EnvLocalManifestRoot = "CATALOG_LOCAL_MANIFEST_ROOT"
+
+	// EnvManifestRef pins manifest downloads to a catalog repository branch or tag ref
+	EnvManifestRef = "CATALOG_MANIFEST_REF"
+
 	// DefaultArchiveURL points to the latest JSON index of the k0rdent catalog
 	DefaultArchiveURL = "https://catalog.k0rdent.io/latest/index.json"
 
+	// DefaultManifestRef is the catalog repository ref manifests are fetched from
+	DefaultManifestRef = "refs/heads/main"
+
 	// DefaultCacheDir is the filesystem location for storing catalog data
 	DefaultCacheDir = "/var/lib/k0rdent-mcp/catalog"
 
@@ -29,6 +55,15 @@ const (
 
 	// DefaultCacheTTL is how long cached catalog data remains valid
 	DefaultCacheTTL = 6 * time.Hour
+
+	// DefaultMaxIndexBytes caps the catalog index download to guard against oversized responses
+	DefaultMaxIndexBytes int64 = 32 << 20
+
+	// DefaultMaxIdleConnsPerHost keeps enough idle connections for back-to-back manifest fetches
+	DefaultMaxIdleConnsPerHost = 10
+
+	// DefaultIdleConnTimeout is how long an idle catalog connection is kept for reuse
+	DefaultIdleConnTimeout = 90 * time.Second
 )
 
 // LoadConfig reads configuration from environment variables and returns
@@ -39,6 +74,11 @@ func LoadConfig() Options {
 		CacheDir:        DefaultCacheDir,
 		DownloadTimeout: DefaultDownloadTimeout,
 		CacheTTL:        DefaultCacheTTL,
+		MaxIndexBytes:   DefaultMaxIndexBytes,
+		ManifestRef:     DefaultManifestRef,
+
+		MaxIdleConnsPerHost: DefaultMaxIdleConnsPerHost,
+		IdleConnTimeout:     DefaultIdleConnTimeout,
 	}
 
 	if url := os.Getenv(EnvArchiveURL); url != "" {
@@ -61,5 +101,41 @@ func LoadConfig() Options {
 		}
 	}
 
+	if maxBytes := os.Getenv(EnvMaxIndexBytes); maxBytes != "" {
+		if n, err := strconv.ParseInt(maxBytes, 10, 64); err == nil && n > 0 {
+			opts.MaxIndexBytes = n
+		}
+	}
+
+	if conns := os.Getenv(EnvMaxIdleConnsPerHost); conns != "" {
+		if n, err := strconv.Atoi(conns); err == nil && n > 0 {
+			opts.MaxIdleConnsPerHost = n
+		}
+	}
+
+	if idle := os.Getenv(EnvIdleConnTimeout); idle != "" {
+		if d, err := time.ParseDuration(idle); err == nil {
+			opts.IdleConnTimeout = d
+		}
+	}
+
+	if disable := os.Getenv(EnvDisableHTTP2); disable != "" {
+		if b, err := strconv.ParseBool(disable); err == nil {
+			opts.DisableHTTP2 = b
+		}
+	}
+
+	if sha := os.Getenv(EnvExpectedSHA); sha != "" {
+		opts.ExpectedSHA = strings.ToLower(strings.TrimSpace(sha))
+	}
+
+	if root := os.Getenv(EnvLocalManifestRoot); root != "" {
+		opts.LocalManifestRoot = root
+	}
+
+	if ref := os.Getenv(EnvManifestRef); ref != "" {
+		opts.ManifestRef = strings.Trim(strings.TrimSpace(ref), "/")
+	}
+
 	return opts
 }
@@ -115,11 +115,20 @@ func (db *DB) SetMetadata(key, value string) error {
 	return nil
 }
 
+// execer is satisfied by both *sql.DB and *sql.Tx.
+type execer interface {
+	Exec(query string, args ...any) (sql.Result, error)
+}
+
 // UpsertApp inserts or updates an application entry.
 func (db *DB) UpsertApp(app AppRow) error {
 	db.mu.Lock()
 	defer db.mu.Unlock()
 
+	return upsertApp(db.db, app)
+}
+
+func upsertApp(exec execer, app AppRow) error {
 	// Marshal string slices to JSON
 	tagsJSON, err := json.Marshal(app.Tags)
 	if err != nil {
@@ -135,7 +144,7 @@ func (db *DB) UpsertApp(app AppRow) error {
 		INSERT OR REPLACE INTO apps (slug, title, summary, tags, validated_platforms)
 		VALUES (?, ?, ?, ?, ?)
 	`
-	_, err = db.db.Exec(query, app.Slug, app.Title, app.Summary, string(tagsJSON), string(platformsJSON))
+	_, err = exec.Exec(query, app.Slug, app.Title, app.Summary, string(tagsJSON), string(platformsJSON))
 	if err != nil {
 		return fmt.Errorf("upsert app: %w", err)
 	}
@@ -148,12 +157,16 @@ func (db *DB) UpsertServiceTemplate(st ServiceTemplateRow) error {
 	db.mu.Lock()
 	defer db.mu.Unlock()
 
+	return upsertServiceTemplate(db.db, st)
+}
+
+func upsertServiceTemplate(exec execer, st ServiceTemplateRow) error {
 	query := `
 		INSERT OR REPLACE INTO service_templates
 		(app_slug, chart_name, version, service_template_path, helm_repository_path)
 		VALUES (?, ?, ?, ?, ?)
 	`
-	result, err := db.db.Exec(query, st.AppSlug, st.ChartName, st.Version, st.ServiceTemplatePath, st.HelmRepositoryPath)
+	result, err := exec.Exec(query, st.AppSlug, st.ChartName, st.Version, st.ServiceTemplatePath, st.HelmRepositoryPath)
 	if err != nil {
 		return fmt.Errorf("upsert service template: %w", err)
 	}
@@ -293,6 +306,20 @@ func (db *DB) GetServiceTemplate(appSlug, chartName, version string) (*ServiceTe
 	return &st, nil
 }
 
+// CountEntries returns the number of apps and ServiceTemplate versions in the index.
+func (db *DB) CountEntries() (apps, templates int, err error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	if err := db.db.QueryRow("SELECT COUNT(*) FROM apps").Scan(&apps); err != nil {
+		return 0, 0, fmt.Errorf("count apps: %w", err)
+	}
+	if err := db.db.QueryRow("SELECT COUNT(*) FROM service_templates").Scan(&templates); err != nil {
+		return 0, 0, fmt.Errorf("count service templates: %w", err)
+	}
+	return apps, templates, nil
+}
+
 // ClearAll removes all data from apps and service_templates tables.
 // This is used for cache invalidation when rebuilding the catalog index.
 func (db *DB) ClearAll() error {
@@ -314,6 +341,54 @@ func (db *DB) ClearAll() error {
 	return nil
 }
 
+// ReplaceIndex atomically replaces all apps and service templates and stores the
+// given metadata entries. Everything happens in a single transaction, so a failure
+// or crash part-way through leaves the previously committed index intact.
+func (db *DB) ReplaceIndex(apps []AppRow, templates []ServiceTemplateRow, metadata map[string]string) (err error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	tx, err := db.db.Begin()
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	// Delete in correct order due to foreign key constraints
+	for _, query := range []string{"DELETE FROM service_templates", "DELETE FROM apps"} {
+		if _, err = tx.Exec(query); err != nil {
+			return fmt.Errorf("clear tables: %w", err)
+		}
+	}
+
+	for _, app := range apps {
+		if err = upsertApp(tx, app); err != nil {
+			return fmt.Errorf("insert app %s: %w", app.Slug, err)
+		}
+	}
+
+	for _, st := range templates {
+		if err = upsertServiceTemplate(tx, st); err != nil {
+			return fmt.Errorf("insert template %s/%s/%s: %w", st.AppSlug, st.ChartName, st.Version, err)
+		}
+	}
+
+	for key, value := range metadata {
+		if _, err = tx.Exec("INSERT OR REPLACE INTO metadata (key, value) VALUES (?, ?)", key, value); err != nil {
+			return fmt.Errorf("set metadata %s: %w", key, err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+	return nil
+}
+
 // Close closes the database connection.
 func (db *DB) Close() error {
 	db.mu.Lock()
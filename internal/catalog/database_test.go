@@ -0,0 +1,48 @@
+package catalog
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+// TestReplaceIndex_RollbackOnFailure verifies a failed rebuild leaves the prior index intact
+func TestReplaceIndex_RollbackOnFailure(t *testing.T) {
+	db, err := OpenDB(filepath.Join(t.TempDir(), "catalog.db"))
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	defer db.Close()
+
+	initialApps := []AppRow{{Slug: "minio", Title: "MinIO"}}
+	initialTemplates := []ServiceTemplateRow{{AppSlug: "minio", ChartName: "minio", Version: "1.0.0", ServiceTemplatePath: "apps/minio/st.yaml"}}
+	if err := db.ReplaceIndex(initialApps, initialTemplates, map[string]string{"index_timestamp": "t1"}); err != nil {
+		t.Fatalf("initial ReplaceIndex failed: %v", err)
+	}
+
+	// Force the second rebuild to fail part-way through the inserts
+	if _, err := db.db.Exec(`CREATE TRIGGER reject_bad BEFORE INSERT ON apps WHEN NEW.slug = 'bad'
+		BEGIN SELECT RAISE(ABORT, 'rejected'); END`); err != nil {
+		t.Fatalf("failed to create trigger: %v", err)
+	}
+
+	newApps := []AppRow{{Slug: "redis", Title: "Redis"}, {Slug: "bad", Title: "Bad"}}
+	if err := db.ReplaceIndex(newApps, nil, map[string]string{"index_timestamp": "t2"}); err == nil {
+		t.Fatal("expected ReplaceIndex to fail")
+	}
+
+	apps, err := db.ListApps("")
+	if err != nil {
+		t.Fatalf("ListApps failed: %v", err)
+	}
+	if len(apps) != 1 || apps[0].App.Slug != "minio" || len(apps[0].Templates) != 1 {
+		t.Fatalf("expected prior index to be intact, got %+v", apps)
+	}
+
+	timestamp, err := db.GetMetadata("index_timestamp")
+	if err != nil {
+		t.Fatalf("GetMetadata failed: %v", err)
+	}
+	if timestamp != "t1" {
+		t.Errorf("expected index_timestamp t1, got %q", timestamp)
+	}
+}
@@ -0,0 +1,111 @@
+package catalog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/k0rdent/mcp-k0rdent-server/internal/logging"
+	"sigs.k8s.io/yaml"
+)
+
+// ErrNotFound is returned when an app or version is not in the catalog index.
+var ErrNotFound = errors.New("not found in catalog")
+
+// Get returns a single catalog app. When version is set, each ServiceTemplate published at
+// that version is resolved to its manifest locations and the chart its manifest references,
+// which requires fetching the ServiceTemplate manifests.
+func (m *Manager) Get(ctx context.Context, app, version string) (EntryDetail, error) {
+	logger := logging.WithContext(ctx, m.logger)
+	logger.Debug("get catalog entry", "app", app, "version", version)
+
+	if err := m.loadOrRefreshIndex(ctx, false); err != nil {
+		logger.Error("failed to load catalog index", "error", err)
+		return EntryDetail{}, err
+	}
+
+	appsWithTemplates, err := m.db.ListApps(app)
+	if err != nil {
+		logger.Error("failed to query apps from database", "error", err)
+		return EntryDetail{}, fmt.Errorf("query apps: %w", err)
+	}
+	if len(appsWithTemplates) == 0 {
+		return EntryDetail{}, fmt.Errorf("app %q %w", app, ErrNotFound)
+	}
+
+	detail := EntryDetail{Entry: catalogEntryFromApp(appsWithTemplates[0])}
+	if version == "" {
+		return detail, nil
+	}
+
+	var available []string
+	for _, v := range detail.Entry.Versions {
+		available = append(available, v.Version)
+		if v.Version != version {
+			continue
+		}
+
+		manifests, err := m.GetManifests(ctx, app, v.Name, version)
+		if err != nil {
+			return EntryDetail{}, fmt.Errorf("get manifests for %s %s: %w", v.Name, version, err)
+		}
+		chart, err := parseChartMetadata(manifests[0])
+		if err != nil {
+			return EntryDetail{}, fmt.Errorf("parse service template %s %s: %w", v.Name, version, err)
+		}
+		detail.Templates = append(detail.Templates, TemplateDetail{
+			Name:               v.Name,
+			Version:            version,
+			ServiceTemplateURL: m.manifestSource(manifestPath(app, v.Name, version)),
+			HelmRepositoryURL:  m.manifestSource(helmRepoPath()),
+			Chart:              chart,
+		})
+	}
+	if len(detail.Templates) == 0 {
+		return EntryDetail{}, fmt.Errorf("app %q version %q %w (available: %s)", app, version, ErrNotFound, strings.Join(available, ", "))
+	}
+	detail.Version = version
+
+	logger.Info("catalog entry retrieved", "app", app, "version", version, "template_count", len(detail.Templates))
+	return detail, nil
+}
+
+// parseChartMetadata reads the chart reference from a ServiceTemplate manifest. Both the
+// chartSpec form and the older chartName/chartVersion fields are understood.
+func parseChartMetadata(manifest []byte) (ChartMetadata, error) {
+	var st struct {
+		Spec struct {
+			Helm struct {
+				ChartName    string `json:"chartName"`
+				ChartVersion string `json:"chartVersion"`
+				ChartSpec    struct {
+					Chart     string `json:"chart"`
+					Version   string `json:"version"`
+					SourceRef struct {
+						Kind string `json:"kind"`
+						Name string `json:"name"`
+					} `json:"sourceRef"`
+				} `json:"chartSpec"`
+			} `json:"helm"`
+		} `json:"spec"`
+	}
+	if err := yaml.Unmarshal(manifest, &st); err != nil {
+		return ChartMetadata{}, err
+	}
+
+	helm := st.Spec.Helm
+	chart := ChartMetadata{
+		Name:       helm.ChartSpec.Chart,
+		Version:    helm.ChartSpec.Version,
+		SourceKind: helm.ChartSpec.SourceRef.Kind,
+		SourceName: helm.ChartSpec.SourceRef.Name,
+	}
+	if chart.Name == "" {
+		chart.Name = helm.ChartName
+	}
+	if chart.Version == "" {
+		chart.Version = helm.ChartVersion
+	}
+	return chart, nil
+}
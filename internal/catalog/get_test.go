@@ -0,0 +1,84 @@
+package catalog
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testChartSpecServiceTemplate = `apiVersion: k0rdent.mirantis.com/v1beta1
+kind: ServiceTemplate
+metadata:
+  name: minio-14-1-2
+spec:
+  helm:
+    chartSpec:
+      chart: minio
+      version: 14.1.2
+      sourceRef:
+        kind: HelmRepository
+        name: k0rdent-catalog
+`
+
+func TestGet(t *testing.T) {
+	root := t.TempDir()
+	writeLocalManifest(t, root, manifestPath("minio", "minio", "14.1.2"), testChartSpecServiceTemplate)
+	manager := newLocalRootManager(t, root)
+
+	detail, err := manager.Get(context.Background(), "minio", "")
+	if err != nil {
+		t.Fatalf("Get without version failed: %v", err)
+	}
+	if detail.Entry.Slug != "minio" || len(detail.Templates) != 0 {
+		t.Fatalf("expected only the minio entry, got %+v", detail)
+	}
+
+	detail, err = manager.Get(context.Background(), "minio", "14.1.2")
+	if err != nil {
+		t.Fatalf("Get with version failed: %v", err)
+	}
+	if detail.Version != "14.1.2" || len(detail.Templates) != 1 {
+		t.Fatalf("expected one resolved template, got %+v", detail)
+	}
+	tmpl := detail.Templates[0]
+	want := ChartMetadata{Name: "minio", Version: "14.1.2", SourceKind: "HelmRepository", SourceName: "k0rdent-catalog"}
+	if tmpl.Chart != want {
+		t.Errorf("expected chart %+v, got %+v", want, tmpl.Chart)
+	}
+	if tmpl.ServiceTemplateURL != filepath.Join(root, filepath.FromSlash(manifestPath("minio", "minio", "14.1.2"))) {
+		t.Errorf("unexpected service template location %q", tmpl.ServiceTemplateURL)
+	}
+}
+
+func TestGetNotFound(t *testing.T) {
+	manager := newLocalRootManager(t, t.TempDir())
+
+	if _, err := manager.Get(context.Background(), "kafka", ""); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound for unknown app, got %v", err)
+	}
+
+	_, err := manager.Get(context.Background(), "minio", "9.9.9")
+	if !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound for unknown version, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "14.1.2") {
+		t.Errorf("expected error to list available versions, got %v", err)
+	}
+}
+
+func TestParseChartMetadataLegacyFields(t *testing.T) {
+	chart, err := parseChartMetadata([]byte(`kind: ServiceTemplate
+spec:
+  helm:
+    chartName: postgresql
+    chartVersion: 1.0.0
+`))
+	if err != nil {
+		t.Fatalf("parseChartMetadata failed: %v", err)
+	}
+	if chart.Name != "postgresql" || chart.Version != "1.0.0" {
+		t.Errorf("unexpected chart metadata %+v", chart)
+	}
+}
@@ -2,12 +2,16 @@ package catalog
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"log/slog"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -429,3 +433,108 @@ func TestParseJSONIndexFromFixture(t *testing.T) {
 		t.Error("redis addon not found in parsed apps")
 	}
 }
+
+// TestFetchJSONIndexMaxBytes verifies that oversized index responses are rejected before parsing
+func TestFetchJSONIndexMaxBytes(t *testing.T) {
+	fixtureData, err := os.ReadFile(filepath.Join("testdata", "valid-index.json"))
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(fixtureData)
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name        string
+		maxBytes    int64
+		expectError bool
+	}{
+		{name: "limit below index size", maxBytes: int64(len(fixtureData)) - 1, expectError: true},
+		{name: "limit equal to index size", maxBytes: int64(len(fixtureData)), expectError: false},
+		{name: "default limit", maxBytes: 0, expectError: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mgr, err := NewManager(Options{
+				CacheDir:      t.TempDir(),
+				ArchiveURL:    server.URL,
+				MaxIndexBytes: tt.maxBytes,
+				Logger:        slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError})),
+			})
+			if err != nil {
+				t.Fatalf("failed to create manager: %v", err)
+			}
+
+			_, _, err = mgr.fetchJSONIndex(context.Background())
+			if tt.expectError {
+				if err == nil || !strings.Contains(err.Error(), "exceeds maximum size") {
+					t.Errorf("expected size limit error, got %v", err)
+				}
+			} else if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestFetchJSONIndexExpectedSHA(t *testing.T) {
+	fixtureData, err := os.ReadFile(filepath.Join("testdata", "valid-index.json"))
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+	sum := sha256.Sum256(fixtureData)
+	fixtureSHA := hex.EncodeToString(sum[:])
+	wrongSHA := strings.Repeat("0", 64)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(fixtureData)
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name        string
+		expectedSHA string
+		expectError bool
+	}{
+		{name: "no pin", expectedSHA: "", expectError: false},
+		{name: "matching pin", expectedSHA: fixtureSHA, expectError: false},
+		{name: "matching pin in upper case", expectedSHA: strings.ToUpper(fixtureSHA), expectError: false},
+		{name: "mismatching pin", expectedSHA: wrongSHA, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mgr, err := NewManager(Options{
+				CacheDir:    t.TempDir(),
+				ArchiveURL:  server.URL,
+				ExpectedSHA: tt.expectedSHA,
+				Logger:      slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError})),
+			})
+			if err != nil {
+				t.Fatalf("failed to create manager: %v", err)
+			}
+
+			_, sha, err := mgr.fetchJSONIndex(context.Background())
+			if tt.expectError {
+				if !errors.Is(err, ErrChecksumMismatch) {
+					t.Fatalf("expected ErrChecksumMismatch, got %v", err)
+				}
+				if !strings.Contains(err.Error(), wrongSHA) || !strings.Contains(err.Error(), fixtureSHA) {
+					t.Errorf("expected error to name both SHAs, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if sha != fixtureSHA {
+				t.Errorf("expected sha %s, got %s", fixtureSHA, sha)
+			}
+		})
+	}
+}
@@ -3,28 +3,45 @@ package catalog
 import (
 	"context"
 	"crypto/sha256"
+	"crypto/tls"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log/slog"
 	"net/http"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"time"
 
+	"golang.org/x/sync/singleflight"
+
 	"github.com/k0rdent/mcp-k0rdent-server/internal/logging"
+	"github.com/k0rdent/mcp-k0rdent-server/internal/metrics"
 )
 
+// ErrChecksumMismatch is returned when the downloaded catalog index does not match the pinned SHA.
+var ErrChecksumMismatch = errors.New("catalog index checksum mismatch")
+
 // Manager handles downloading, caching, and indexing the k0rdent catalog.
 type Manager struct {
-	db         *DB
-	httpClient *http.Client
-	cacheDir   string
-	cacheTTL   time.Duration
-	archiveURL string
-	logger     *slog.Logger
+	db          *DB
+	httpClient  *http.Client
+	cacheDir    string
+	cacheTTL    time.Duration
+	archiveURL  string
+	logger      *slog.Logger
+	metrics     *metrics.CatalogMetrics
+	maxIndex    int64
+	expectedSHA string
+	localRoot   string
+	manifestRef string
+
+	// loads shares one in-flight index download between concurrent callers
+	loads singleflight.Group
 }
 
 // NewManager constructs a Manager with the provided options. If options are incomplete,
@@ -45,12 +62,25 @@ func NewManager(opts Options) (*Manager, error) {
 	if opts.Logger == nil {
 		opts.Logger = slog.Default()
 	}
+	if opts.Metrics == nil {
+		opts.Metrics = metrics.NewCatalogMetrics()
+	}
+	if opts.MaxIndexBytes <= 0 {
+		opts.MaxIndexBytes = DefaultMaxIndexBytes
+	}
+	if opts.MaxIdleConnsPerHost <= 0 {
+		opts.MaxIdleConnsPerHost = DefaultMaxIdleConnsPerHost
+	}
+	if opts.IdleConnTimeout == 0 {
+		opts.IdleConnTimeout = DefaultIdleConnTimeout
+	}
 
 	// Create HTTP client with timeout if not provided
 	client := opts.HTTPClient
 	if client == nil {
 		client = &http.Client{
-			Timeout: opts.DownloadTimeout,
+			Timeout:   opts.DownloadTimeout,
+			Transport: newTransport(opts),
 		}
 	}
 
@@ -67,12 +97,17 @@ func NewManager(opts Options) (*Manager, error) {
 	}
 
 	m := &Manager{
-		db:         db,
-		httpClient: client,
-		cacheDir:   opts.CacheDir,
-		cacheTTL:   opts.CacheTTL,
-		archiveURL: opts.ArchiveURL,
-		logger:     logging.WithComponent(opts.Logger, "catalog.manager"),
+		db:          db,
+		httpClient:  client,
+		cacheDir:    opts.CacheDir,
+		cacheTTL:    opts.CacheTTL,
+		archiveURL:  opts.ArchiveURL,
+		logger:      logging.WithComponent(opts.Logger, "catalog.manager"),
+		metrics:     opts.Metrics,
+		maxIndex:    opts.MaxIndexBytes,
+		expectedSHA: strings.ToLower(strings.TrimSpace(opts.ExpectedSHA)),
+		localRoot:   opts.LocalManifestRoot,
+		manifestRef: strings.Trim(strings.TrimSpace(opts.ManifestRef), "/"),
 	}
 
 	return m, nil
@@ -100,30 +135,37 @@ func (m *Manager) List(ctx context.Context, appFilter string, refresh bool) ([]C
 	// Convert to CatalogEntry (keep existing type for compatibility)
 	results := make([]CatalogEntry, 0, len(appsWithTemplates))
 	for _, awt := range appsWithTemplates {
-		versions := make([]ServiceTemplateVersion, 0, len(awt.Templates))
-		for _, tmpl := range awt.Templates {
-			versions = append(versions, ServiceTemplateVersion{
-				Name:                tmpl.ChartName,
-				Version:             tmpl.Version,
-				Repository:          "", // Will be populated from manifest if needed
-				ServiceTemplatePath: tmpl.ServiceTemplatePath,
-				HelmRepositoryPath:  tmpl.HelmRepositoryPath,
-			})
-		}
-		results = append(results, CatalogEntry{
-			Slug:               awt.App.Slug,
-			Title:              awt.App.Title,
-			Summary:            awt.App.Summary,
-			Tags:               awt.App.Tags,
-			ValidatedPlatforms: awt.App.ValidatedPlatforms,
-			Versions:           versions,
-		})
+		results = append(results, catalogEntryFromApp(awt))
 	}
 
 	logger.Info("catalog entries listed", "count", len(results))
 	return results, nil
 }
 
+// catalogEntryFromApp converts a database row and its templates to a CatalogEntry with
+// versions sorted newest first.
+func catalogEntryFromApp(awt AppWithTemplates) CatalogEntry {
+	versions := make([]ServiceTemplateVersion, 0, len(awt.Templates))
+	for _, tmpl := range awt.Templates {
+		versions = append(versions, ServiceTemplateVersion{
+			Name:                tmpl.ChartName,
+			Version:             tmpl.Version,
+			Repository:          "", // Will be populated from manifest if needed
+			ServiceTemplatePath: tmpl.ServiceTemplatePath,
+			HelmRepositoryPath:  tmpl.HelmRepositoryPath,
+		})
+	}
+	sortVersionsNewestFirst(versions)
+	return CatalogEntry{
+		Slug:               awt.App.Slug,
+		Title:              awt.App.Title,
+		Summary:            awt.App.Summary,
+		Tags:               awt.App.Tags,
+		ValidatedPlatforms: awt.App.ValidatedPlatforms,
+		Versions:           versions,
+	}
+}
+
 // GetManifests retrieves the ServiceTemplate and optional HelmRepository manifests
 // for a specific app, template name, and version. Returns the manifests as byte slices.
 func (m *Manager) GetManifests(ctx context.Context, app, template, version string) ([][]byte, error) {
@@ -144,25 +186,26 @@ func (m *Manager) GetManifests(ctx context.Context, app, template, version strin
 
 	manifests := [][]byte{}
 
-	// Fetch ServiceTemplate manifest from GitHub (required)
-	stURL := m.constructManifestURL(app, template, version)
-	logger.Debug("fetching service template manifest", "url", stURL)
+	// Fetch ServiceTemplate manifest from the local root or GitHub (required)
+	stPath := manifestPath(app, template, version)
+	stSource := m.manifestSource(stPath)
+	logger.Debug("fetching service template manifest", "source", stSource)
 
-	stData, err := m.fetchManifestWithRetry(ctx, stURL)
+	stData, err := m.loadManifest(ctx, stPath)
 	if err != nil {
-		logger.Error("failed to fetch service template manifest", "url", stURL, "error", err)
+		logger.Error("failed to fetch service template manifest", "source", stSource, "error", err)
 		return nil, fmt.Errorf("fetch service template manifest: %w", err)
 	}
 	manifests = append(manifests, stData)
 
 	// Fetch HelmRepository manifest (optional)
 	// Note: HelmRepository is shared across all templates
-	hrURL := m.constructHelmRepoURL()
-	logger.Debug("fetching helm repository manifest", "url", hrURL)
+	hrSource := m.manifestSource(helmRepoPath())
+	logger.Debug("fetching helm repository manifest", "source", hrSource)
 
-	hrData, err := m.fetchManifestWithRetry(ctx, hrURL)
+	hrData, err := m.loadManifest(ctx, helmRepoPath())
 	if err != nil {
-		logger.Warn("failed to fetch helm repository manifest", "url", hrURL, "error", err)
+		logger.Warn("failed to fetch helm repository manifest", "source", hrSource, "error", err)
 	} else {
 		manifests = append(manifests, hrData)
 	}
@@ -171,8 +214,257 @@ func (m *Manager) GetManifests(ctx context.Context, app, template, version strin
 	return manifests, nil
 }
 
+// CheckAvailability probes the ServiceTemplate and HelmRepository manifest URLs for a
+// catalog template without installing anything. It reports reachability and sizes so
+// installs can be pre-flighted in restricted-network environments.
+func (m *Manager) CheckAvailability(ctx context.Context, app, template, version string) (AvailabilityReport, error) {
+	logger := logging.WithContext(ctx, m.logger)
+	logger.Debug("check manifest availability", "app", app, "template", template, "version", version)
+
+	if err := m.loadOrRefreshIndex(ctx, false); err != nil {
+		logger.Error("failed to load catalog index", "error", err)
+		return AvailabilityReport{}, err
+	}
+
+	if _, err := m.db.GetServiceTemplate(app, template, version); err != nil {
+		logger.Error("service template not found", "app", app, "template", template, "version", version, "error", err)
+		return AvailabilityReport{}, fmt.Errorf("app %q template %q version %q not found", app, template, version)
+	}
+
+	report := AvailabilityReport{
+		App:      app,
+		Template: template,
+		Version:  version,
+	}
+	if m.localRoot != "" {
+		report.Manifests = []ManifestAvailability{
+			m.probeLocalManifest("ServiceTemplate", manifestPath(app, template, version), true),
+			m.probeLocalManifest("HelmRepository", helmRepoPath(), false),
+		}
+	} else {
+		report.Manifests = []ManifestAvailability{
+			m.probeManifest(ctx, "ServiceTemplate", m.constructManifestURL(app, template, version), true),
+			m.probeManifest(ctx, "HelmRepository", m.constructHelmRepoURL(), false),
+		}
+	}
+
+	report.Available = true
+	for _, manifest := range report.Manifests {
+		if manifest.Required && !manifest.Reachable {
+			report.Available = false
+		}
+	}
+
+	logger.Info("manifest availability checked", "app", app, "template", template, "version", version, "available", report.Available)
+	return report, nil
+}
+
+// probeManifest issues a HEAD request for url, falling back to GET when the server
+// rejects HEAD or omits Content-Length, so the manifest size can be reported.
+func (m *Manager) probeManifest(ctx context.Context, kind, url string, required bool) ManifestAvailability {
+	result := ManifestAvailability{Kind: kind, URL: url, Required: required}
+
+	resp, err := m.doRequest(ctx, http.MethodHead, url)
+	if err == nil && (resp.StatusCode == http.StatusMethodNotAllowed || (resp.StatusCode == http.StatusOK && resp.ContentLength < 0)) {
+		resp.Body.Close()
+		resp, err = m.doRequest(ctx, http.MethodGet, url)
+	}
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	defer resp.Body.Close()
+
+	result.StatusCode = resp.StatusCode
+	if resp.StatusCode != http.StatusOK {
+		result.Error = fmt.Sprintf("unexpected status code %d", resp.StatusCode)
+		return result
+	}
+	result.Reachable = true
+
+	if resp.ContentLength >= 0 {
+		result.SizeBytes = resp.ContentLength
+	} else if resp.Request != nil && resp.Request.Method == http.MethodGet {
+		n, err := io.Copy(io.Discard, resp.Body)
+		if err != nil {
+			result.Reachable = false
+			result.Error = fmt.Sprintf("read response body: %v", err)
+			return result
+		}
+		result.SizeBytes = n
+	}
+	return result
+}
+
+func (m *Manager) doRequest(ctx context.Context, method, url string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+	resp, err := m.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("http request: %w", err)
+	}
+	return resp, nil
+}
+
+// Status reports the freshness of the local catalog index without contacting the
+// catalog host. It also updates the catalog freshness metrics.
+func (m *Manager) Status(ctx context.Context) (Status, error) {
+	logger := logging.WithContext(ctx, m.logger)
+
+	indexTimestamp, err := m.db.GetMetadata("index_timestamp")
+	if err != nil {
+		return Status{}, fmt.Errorf("get index timestamp: %w", err)
+	}
+	indexedAtRaw, err := m.db.GetMetadata("indexed_at")
+	if err != nil {
+		return Status{}, fmt.Errorf("get indexed_at: %w", err)
+	}
+	apps, templates, err := m.db.CountEntries()
+	if err != nil {
+		return Status{}, err
+	}
+
+	status := Status{
+		IndexTimestamp: indexTimestamp,
+		AppCount:       apps,
+		TemplateCount:  templates,
+		CacheTTL:       m.cacheTTL.String(),
+		URL:            m.archiveURL,
+		Stale:          true,
+	}
+
+	var indexedAt time.Time
+	if indexedAtRaw != "" {
+		if parsed, err := time.Parse(time.RFC3339, indexedAtRaw); err == nil {
+			indexedAt = parsed
+			status.IndexedAt = &indexedAt
+		} else {
+			logger.Warn("invalid indexed_at metadata", "value", indexedAtRaw, "error", err)
+		}
+	}
+
+	if metadata, err := m.readCacheMetadata(); err != nil {
+		logger.Warn("failed to read cache metadata", "error", err)
+	} else if metadata != nil && !metadata.Timestamp.IsZero() {
+		lastRefresh := metadata.Timestamp
+		status.LastRefresh = &lastRefresh
+		status.Stale = time.Since(lastRefresh) >= m.cacheTTL
+	}
+
+	m.metrics.RecordIndex(indexTimestamp, indexedAt, apps, templates)
+	return status, nil
+}
+
+// newTransport clones the default transport and tunes connection reuse so that
+// manifest fetches issued in quick succession share connections to the catalog host.
+func newTransport(opts Options) *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.MaxIdleConnsPerHost = opts.MaxIdleConnsPerHost
+	transport.IdleConnTimeout = opts.IdleConnTimeout
+	transport.ForceAttemptHTTP2 = !opts.DisableHTTP2
+	if opts.DisableHTTP2 {
+		// A non-nil empty map disables the transport's automatic HTTP/2 upgrade.
+		transport.TLSNextProto = map[string]func(string, *tls.Conn) http.RoundTripper{}
+	}
+	return transport
+}
+
+// Summary aggregates app, template version, and tag counts over the cached catalog index.
+// The index is only downloaded when the cache is missing or stale.
+func (m *Manager) Summary(ctx context.Context) (Summary, error) {
+	entries, err := m.List(ctx, "", false)
+	if err != nil {
+		return Summary{}, err
+	}
+	return summarizeEntries(entries), nil
+}
+
+// Export returns every app and template version in the cached catalog index, with the index
+// timestamp and SHA identifying the snapshot, for seeding an offline mirror. The index is only
+// downloaded when the cache is missing or stale.
+func (m *Manager) Export(ctx context.Context) (Export, error) {
+	logger := logging.WithContext(ctx, m.logger)
+
+	entries, err := m.List(ctx, "", false)
+	if err != nil {
+		return Export{}, err
+	}
+	indexTimestamp, err := m.db.GetMetadata("index_timestamp")
+	if err != nil {
+		return Export{}, fmt.Errorf("get index timestamp: %w", err)
+	}
+	sha, err := m.db.GetMetadata("catalog_sha")
+	if err != nil {
+		return Export{}, fmt.Errorf("get catalog sha: %w", err)
+	}
+
+	export := Export{
+		IndexTimestamp: indexTimestamp,
+		SHA:            sha,
+		URL:            m.archiveURL,
+		AppCount:       len(entries),
+		Entries:        entries,
+	}
+	if indexedAtRaw, err := m.db.GetMetadata("indexed_at"); err == nil && indexedAtRaw != "" {
+		if indexedAt, err := time.Parse(time.RFC3339, indexedAtRaw); err == nil {
+			export.IndexedAt = &indexedAt
+		} else {
+			logger.Warn("invalid indexed_at metadata", "value", indexedAtRaw, "error", err)
+		}
+	}
+	for _, entry := range entries {
+		export.TemplateCount += len(entry.Versions)
+	}
+
+	logger.Info("catalog index exported",
+		"index_timestamp", indexTimestamp,
+		"app_count", export.AppCount,
+		"template_count", export.TemplateCount,
+	)
+	return export, nil
+}
+
+// summarizeEntries counts apps per tag, ignoring blank and duplicate tags on one app.
+func summarizeEntries(entries []CatalogEntry) Summary {
+	summary := Summary{AppCount: len(entries), Tags: []TagCount{}}
+	counts := make(map[string]int)
+	for _, entry := range entries {
+		summary.TemplateCount += len(entry.Versions)
+		seen := make(map[string]struct{}, len(entry.Tags))
+		for _, tag := range entry.Tags {
+			tag = strings.TrimSpace(tag)
+			if tag == "" {
+				continue
+			}
+			if _, dup := seen[tag]; dup {
+				continue
+			}
+			seen[tag] = struct{}{}
+			counts[tag]++
+		}
+		if len(seen) == 0 {
+			summary.Untagged++
+		}
+	}
+
+	for tag, count := range counts {
+		summary.Tags = append(summary.Tags, TagCount{Tag: tag, Count: count})
+	}
+	sort.Slice(summary.Tags, func(i, j int) bool {
+		if summary.Tags[i].Count != summary.Tags[j].Count {
+			return summary.Tags[i].Count > summary.Tags[j].Count
+		}
+		return summary.Tags[i].Tag < summary.Tags[j].Tag
+	})
+	return summary
+}
+
 // loadOrRefreshIndex ensures the database index is populated. If refresh is true,
-// or the cache is stale, a new download and indexing pass occurs.
+// or the cache is stale, a new download and indexing pass occurs. Concurrent callers
+// share one in-flight download; a caller whose context ends stops waiting without
+// cancelling the download for the others.
 func (m *Manager) loadOrRefreshIndex(ctx context.Context, refresh bool) error {
 	logger := logging.WithContext(ctx, m.logger)
 
@@ -184,13 +476,43 @@ func (m *Manager) loadOrRefreshIndex(ctx context.Context, refresh bool) error {
 	}
 
 	// Check if cache is still valid (TTL not expired) and we don't need to refresh
-	if currentIndexTimestamp != "" && !refresh {
+	if currentIndexTimestamp != "" && !refresh && m.cachedSHAMatches() {
 		if valid, err := m.isCacheValid(); err == nil && valid {
 			logger.Debug("using existing catalog index (cache TTL valid)", "timestamp", currentIndexTimestamp)
 			return nil
 		}
 	}
 
+	key := "load"
+	if refresh {
+		key = "refresh"
+	}
+	loadCtx := context.WithoutCancel(ctx)
+	result := m.loads.DoChan(key, func() (any, error) {
+		return nil, m.fetchAndIndex(loadCtx, refresh)
+	})
+	select {
+	case res := <-result:
+		if res.Shared {
+			logger.Debug("shared in-flight catalog index load", "refresh_requested", refresh)
+		}
+		return res.Err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// fetchAndIndex downloads the JSON catalog index and rebuilds the database when the index
+// changed, is missing, or refresh is true.
+func (m *Manager) fetchAndIndex(ctx context.Context, refresh bool) error {
+	logger := logging.WithContext(ctx, m.logger)
+
+	currentIndexTimestamp, err := m.db.GetMetadata("index_timestamp")
+	if err != nil {
+		logger.Error("failed to get index timestamp from database", "error", err)
+		return fmt.Errorf("get index timestamp: %w", err)
+	}
+
 	// Fetch JSON catalog index to check if it has changed
 	logger.Debug("fetching JSON index to check for updates", "url", m.archiveURL)
 	start := time.Now()
@@ -212,65 +534,37 @@ func (m *Manager) loadOrRefreshIndex(ctx context.Context, refresh bool) error {
 			"refresh_requested", refresh)
 		indexStart := time.Now()
 
-		if err := m.db.ClearAll(); err != nil {
-			logger.Error("failed to clear database", "error", err)
-			return fmt.Errorf("clear database: %w", err)
-		}
-
-		// Parse JSON index into database rows
+		// Parse JSON index into database rows before touching the existing index
 		apps, templates, err := m.parseJSONIndex(index)
 		if err != nil {
 			logger.Error("failed to parse JSON index", "error", err)
 			return fmt.Errorf("parse JSON index: %w", err)
 		}
 
-		// Insert apps and templates into database
-		for _, app := range apps {
-			if err := m.db.UpsertApp(app); err != nil {
-				logger.Error("failed to insert app", "slug", app.Slug, "error", err)
-				return fmt.Errorf("insert app %s: %w", app.Slug, err)
-			}
-		}
-
-		for _, tmpl := range templates {
-			if err := m.db.UpsertServiceTemplate(tmpl); err != nil {
-				logger.Error("failed to insert template", "app", tmpl.AppSlug, "chart", tmpl.ChartName, "version", tmpl.Version, "error", err)
-				return fmt.Errorf("insert template %s/%s/%s: %w", tmpl.AppSlug, tmpl.ChartName, tmpl.Version, err)
-			}
-		}
-
-		// Store the index timestamp from metadata.generated
-		if err := m.db.SetMetadata("index_timestamp", newIndexTimestamp); err != nil {
-			logger.Error("failed to set index timestamp", "error", err)
-			return fmt.Errorf("set index timestamp: %w", err)
-		}
-
-		// Store SHA for reference (keeping for backward compatibility)
-		if err := m.db.SetMetadata("catalog_sha", actualSHA); err != nil {
-			logger.Error("failed to set catalog SHA", "error", err)
-			return fmt.Errorf("set catalog SHA: %w", err)
+		// Replace the index and its metadata in a single transaction so a failed
+		// rebuild leaves the previous index readable.
+		// catalog_sha is kept for backward compatibility.
+		indexedAt := time.Now()
+		if err := m.db.ReplaceIndex(apps, templates, map[string]string{
+			"index_timestamp": newIndexTimestamp,
+			"catalog_sha":     actualSHA,
+			"indexed_at":      indexedAt.Format(time.RFC3339),
+		}); err != nil {
+			logger.Error("failed to rebuild catalog index", "error", err)
+			return fmt.Errorf("rebuild catalog index: %w", err)
 		}
+		m.metrics.RecordIndex(newIndexTimestamp, indexedAt, len(apps), len(templates))
 
-		if err := m.db.SetMetadata("indexed_at", time.Now().Format(time.RFC3339)); err != nil {
-			logger.Error("failed to set indexed_at", "error", err)
-			return fmt.Errorf("set indexed_at: %w", err)
-		}
-
-		// Write cache metadata with index timestamp
+		// Write cache metadata only after the index is committed. The file is
+		// written to a temp path and renamed so readers never see a partial file.
 		metadata := CacheMetadata{
 			SHA:            actualSHA,
 			Timestamp:      time.Now(),
 			URL:            m.archiveURL,
 			IndexTimestamp: newIndexTimestamp,
 		}
-		metadataPath := filepath.Join(m.cacheDir, "metadata.json")
-		metadataData, err := json.Marshal(metadata)
-		if err != nil {
-			logger.Warn("failed to marshal cache metadata", "error", err)
-		} else {
-			if err := os.WriteFile(metadataPath, metadataData, 0644); err != nil {
-				logger.Warn("failed to write cache metadata", "error", err)
-			}
+		if err := m.writeCacheMetadata(metadata); err != nil {
+			logger.Warn("failed to write cache metadata", "error", err)
 		}
 
 		logger.Info("catalog index rebuilt successfully",
@@ -280,33 +574,97 @@ func (m *Manager) loadOrRefreshIndex(ctx context.Context, refresh bool) error {
 			"duration_ms", time.Since(indexStart).Milliseconds())
 	} else {
 		logger.Debug("catalog index timestamp unchanged, skipping rebuild", "timestamp", currentIndexTimestamp)
+
+		// Record the successful check so freshness reflects the last time the
+		// catalog host was reachable, not only the last rebuild.
+		if err := m.writeCacheMetadata(CacheMetadata{
+			SHA:            actualSHA,
+			Timestamp:      time.Now(),
+			URL:            m.archiveURL,
+			IndexTimestamp: newIndexTimestamp,
+		}); err != nil {
+			logger.Warn("failed to write cache metadata", "error", err)
+		}
+	}
+
+	return nil
+}
+
+// cachedSHAMatches reports whether the indexed catalog was built from the pinned checksum.
+// It is always true when no checksum is pinned, so a changed pin forces a re-download.
+func (m *Manager) cachedSHAMatches() bool {
+	if m.expectedSHA == "" {
+		return true
+	}
+	sha, err := m.db.GetMetadata("catalog_sha")
+	return err == nil && sha == m.expectedSHA
+}
+
+// writeCacheMetadata atomically replaces metadata.json in the cache directory.
+func (m *Manager) writeCacheMetadata(metadata CacheMetadata) error {
+	data, err := json.Marshal(metadata)
+	if err != nil {
+		return fmt.Errorf("marshal cache metadata: %w", err)
+	}
+
+	metadataPath := filepath.Join(m.cacheDir, "metadata.json")
+	tmp, err := os.CreateTemp(m.cacheDir, "metadata-*.json.tmp")
+	if err != nil {
+		return fmt.Errorf("create temp metadata file: %w", err)
 	}
+	tmpPath := tmp.Name()
+	defer os.Remove(tmpPath)
 
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("write temp metadata file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("close temp metadata file: %w", err)
+	}
+	if err := os.Chmod(tmpPath, 0644); err != nil {
+		return fmt.Errorf("chmod temp metadata file: %w", err)
+	}
+	if err := os.Rename(tmpPath, metadataPath); err != nil {
+		return fmt.Errorf("rename metadata file: %w", err)
+	}
 	return nil
 }
 
 // isCacheValid checks if the current cache is still within its TTL period.
 func (m *Manager) isCacheValid() (bool, error) {
+	metadata, err := m.readCacheMetadata()
+	if err != nil || metadata == nil {
+		return false, err
+	}
+
+	age := time.Since(metadata.Timestamp)
+	return age < m.cacheTTL, nil
+}
+
+// readCacheMetadata loads metadata.json from the cache directory. It returns nil
+// without error when the file does not exist.
+func (m *Manager) readCacheMetadata() (*CacheMetadata, error) {
 	metadataPath := filepath.Join(m.cacheDir, "metadata.json")
 	data, err := os.ReadFile(metadataPath)
 	if err != nil {
 		if os.IsNotExist(err) {
-			return false, nil
+			return nil, nil
 		}
-		return false, fmt.Errorf("read cache metadata: %w", err)
+		return nil, fmt.Errorf("read cache metadata: %w", err)
 	}
 
 	var metadata CacheMetadata
 	if err := json.Unmarshal(data, &metadata); err != nil {
-		return false, fmt.Errorf("unmarshal cache metadata: %w", err)
+		return nil, fmt.Errorf("unmarshal cache metadata: %w", err)
 	}
-
-	age := time.Since(metadata.Timestamp)
-	return age < m.cacheTTL, nil
+	return &metadata, nil
 }
 
 // fetchJSONIndex downloads the JSON catalog index from the configured URL,
 // computes its SHA256 hash, and returns both the parsed index and the hash.
+// When a checksum is pinned, an index with a different hash is rejected with
+// ErrChecksumMismatch before it is parsed.
 func (m *Manager) fetchJSONIndex(ctx context.Context) (*JSONIndex, string, error) {
 	logger := logging.WithContext(ctx, m.logger)
 	logger.Debug("fetching JSON index", "url", m.archiveURL)
@@ -327,15 +685,23 @@ func (m *Manager) fetchJSONIndex(ctx context.Context) (*JSONIndex, string, error
 		return nil, "", fmt.Errorf("download failed with status %d", resp.StatusCode)
 	}
 
-	// Read response into memory and compute SHA
-	data, err := io.ReadAll(resp.Body)
+	// Read response into memory, bounded by the configured limit, and compute SHA.
+	// One extra byte is read so an index of exactly the limit is still accepted.
+	data, err := io.ReadAll(io.LimitReader(resp.Body, m.maxIndex+1))
 	if err != nil {
 		return nil, "", fmt.Errorf("read index data: %w", err)
 	}
+	if int64(len(data)) > m.maxIndex {
+		return nil, "", fmt.Errorf("catalog index exceeds maximum size of %d bytes (set %s to raise the limit)", m.maxIndex, EnvMaxIndexBytes)
+	}
 
 	hash := sha256.Sum256(data)
 	sha := hex.EncodeToString(hash[:])
 	logger.Debug("JSON index downloaded", "sha", sha, "size_bytes", len(data))
+	if m.expectedSHA != "" && sha != m.expectedSHA {
+		logger.Error("catalog index checksum mismatch", "expected_sha", m.expectedSHA, "actual_sha", sha)
+		return nil, "", fmt.Errorf("%w: expected sha256 %s, got %s (check %s)", ErrChecksumMismatch, m.expectedSHA, sha, EnvExpectedSHA)
+	}
 
 	// Parse JSON
 	var index JSONIndex
@@ -390,19 +756,85 @@ func (m *Manager) parseJSONIndex(index *JSONIndex) ([]AppRow, []ServiceTemplateR
 	return apps, templates, nil
 }
 
+// catalogRawRepoURL is the GitHub raw URL of the catalog repository; it is followed by the
+// manifest ref and a catalog-relative manifest path.
+const catalogRawRepoURL = "https://raw.githubusercontent.com/k0rdent/catalog/"
+
+// rawBaseURL is the GitHub raw URL of the catalog repository root at the configured ref;
+// manifest paths below are relative to it, and to LocalManifestRoot in offline mode.
+func (m *Manager) rawBaseURL() string {
+	ref := m.manifestRef
+	if ref == "" {
+		ref = DefaultManifestRef
+	}
+	return catalogRawRepoURL + ref + "/"
+}
+
+// manifestPath is the catalog-relative path of a ServiceTemplate manifest.
+// Pattern: apps/{slug}/charts/{name}-service-template-{version}/templates/service-template.yaml
+func manifestPath(slug, name, version string) string {
+	return fmt.Sprintf("apps/%s/charts/%s-service-template-%s/templates/service-template.yaml", slug, name, version)
+}
+
+// helmRepoPath is the catalog-relative path of the HelmRepository manifest.
+// This is a constant path as the HelmRepository is shared across all templates.
+func helmRepoPath() string {
+	return "apps/k0rdent-utils/charts/k0rdent-catalog-1.0.0/templates/helm-repository.yaml"
+}
+
 // constructManifestURL builds the GitHub raw URL for a ServiceTemplate manifest.
-// Pattern: https://raw.githubusercontent.com/k0rdent/catalog/refs/heads/main/apps/{slug}/charts/{name}-service-template-{version}/templates/service-template.yaml
 func (m *Manager) constructManifestURL(slug, name, version string) string {
-	return fmt.Sprintf(
-		"https://raw.githubusercontent.com/k0rdent/catalog/refs/heads/main/apps/%s/charts/%s-service-template-%s/templates/service-template.yaml",
-		slug, name, version,
-	)
+	return m.rawBaseURL() + manifestPath(slug, name, version)
 }
 
 // constructHelmRepoURL builds the GitHub raw URL for the HelmRepository manifest.
-// This is a constant path as the HelmRepository is shared across all templates.
 func (m *Manager) constructHelmRepoURL() string {
-	return "https://raw.githubusercontent.com/k0rdent/catalog/refs/heads/main/apps/k0rdent-utils/charts/k0rdent-catalog-1.0.0/templates/helm-repository.yaml"
+	return m.rawBaseURL() + helmRepoPath()
+}
+
+// manifestSource returns where a catalog-relative manifest is read from: a file under the
+// local manifest root when one is configured, otherwise its GitHub raw URL.
+func (m *Manager) manifestSource(relPath string) string {
+	if m.localRoot != "" {
+		return filepath.Join(m.localRoot, filepath.FromSlash(relPath))
+	}
+	return m.rawBaseURL() + relPath
+}
+
+// loadManifest reads a catalog-relative manifest from the local manifest root when one is
+// configured and fetches it over HTTP otherwise. The HTTP catalog is never consulted in
+// offline mode, so a missing local file is reported as such.
+func (m *Manager) loadManifest(ctx context.Context, relPath string) ([]byte, error) {
+	if m.localRoot == "" {
+		return m.fetchManifestWithRetry(ctx, m.rawBaseURL()+relPath)
+	}
+	path := m.manifestSource(relPath)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("manifest %s not found in local manifest root %s (%s)", relPath, m.localRoot, EnvLocalManifestRoot)
+		}
+		return nil, fmt.Errorf("read local manifest %s: %w", path, err)
+	}
+	return data, nil
+}
+
+// probeLocalManifest reports whether a manifest exists under the local manifest root.
+func (m *Manager) probeLocalManifest(kind, relPath string, required bool) ManifestAvailability {
+	path := m.manifestSource(relPath)
+	result := ManifestAvailability{Kind: kind, URL: path, Required: required}
+	info, err := os.Stat(path)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	if info.IsDir() {
+		result.Error = "is a directory"
+		return result
+	}
+	result.Reachable = true
+	result.SizeBytes = info.Size()
+	return result
 }
 
 // fetchManifestWithRetry fetches a manifest from a URL with retry logic and timeout.
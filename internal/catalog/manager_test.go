@@ -10,9 +10,13 @@ import (
 	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
+
+	"github.com/k0rdent/mcp-k0rdent-server/internal/metrics"
 )
 
 func TestNewManager(t *testing.T) {
@@ -261,6 +265,65 @@ func TestListWithAppFilter(t *testing.T) {
 	}
 }
 
+func TestExport(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("testdata", "valid-index.json"))
+	if err != nil {
+		t.Fatalf("read test JSON index: %v", err)
+	}
+	var index JSONIndex
+	if err := json.Unmarshal(data, &index); err != nil {
+		t.Fatalf("parse test JSON index: %v", err)
+	}
+	requestCount := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestCount++
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(data)
+	}))
+	defer ts.Close()
+
+	manager, err := NewManager(Options{
+		ArchiveURL: ts.URL,
+		CacheDir:   t.TempDir(),
+		CacheTTL:   time.Hour,
+		Logger:     slog.New(slog.NewTextHandler(io.Discard, nil)),
+	})
+	if err != nil {
+		t.Fatalf("NewManager failed: %v", err)
+	}
+
+	export, err := manager.Export(context.Background())
+	if err != nil {
+		t.Fatalf("Export failed: %v", err)
+	}
+	if export.IndexTimestamp != index.Metadata.Generated {
+		t.Errorf("expected index timestamp %q, got %q", index.Metadata.Generated, export.IndexTimestamp)
+	}
+	if len(export.SHA) != 64 || export.IndexedAt == nil || export.URL != ts.URL {
+		t.Errorf("expected sha, indexed_at, and url to be set, got %+v", export)
+	}
+	if export.AppCount != len(index.Addons) || len(export.Entries) != len(index.Addons) {
+		t.Errorf("expected %d apps, got %d (%d entries)", len(index.Addons), export.AppCount, len(export.Entries))
+	}
+	templates := 0
+	for _, addon := range index.Addons {
+		for _, chart := range addon.Charts {
+			templates += len(chart.Versions)
+		}
+	}
+	if export.TemplateCount != templates {
+		t.Errorf("expected %d template versions, got %d", templates, export.TemplateCount)
+	}
+
+	// A second export is served from the cached index
+	if _, err := manager.Export(context.Background()); err != nil {
+		t.Fatalf("second Export failed: %v", err)
+	}
+	if requestCount != 1 {
+		t.Errorf("expected 1 index download, got %d", requestCount)
+	}
+}
+
 func TestGetManifests(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		data, err := os.ReadFile(filepath.Join("testdata", "valid-index.json"))
@@ -319,6 +382,89 @@ func TestGetManifests(t *testing.T) {
 	}
 }
 
+// newLocalRootManager serves only the catalog index over HTTP and reads manifests from root.
+func newLocalRootManager(t *testing.T, root string) *Manager {
+	t.Helper()
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, err := os.ReadFile(filepath.Join("testdata", "valid-index.json"))
+		if err != nil {
+			http.Error(w, "internal error", http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(data)
+	}))
+	t.Cleanup(ts.Close)
+
+	manager, err := NewManager(Options{
+		ArchiveURL:        ts.URL,
+		CacheDir:          t.TempDir(),
+		CacheTTL:          time.Hour,
+		LocalManifestRoot: root,
+		HTTPClient: &http.Client{Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			if r.URL.Host != strings.TrimPrefix(ts.URL, "http://") {
+				t.Errorf("unexpected HTTP request in offline mode: %s", r.URL)
+			}
+			return http.DefaultTransport.RoundTrip(r)
+		})},
+		Logger: slog.New(slog.NewTextHandler(io.Discard, nil)),
+	})
+	if err != nil {
+		t.Fatalf("NewManager failed: %v", err)
+	}
+	return manager
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) { return f(r) }
+
+func writeLocalManifest(t *testing.T, root, relPath, content string) {
+	t.Helper()
+	path := filepath.Join(root, filepath.FromSlash(relPath))
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatalf("create manifest dir: %v", err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("write manifest: %v", err)
+	}
+}
+
+func TestGetManifestsLocalManifestRoot(t *testing.T) {
+	root := t.TempDir()
+	writeLocalManifest(t, root, "apps/minio/charts/minio-service-template-14.1.2/templates/service-template.yaml", "kind: ServiceTemplate\nmetadata:\n  name: minio-14-1-2\n")
+	writeLocalManifest(t, root, "apps/k0rdent-utils/charts/k0rdent-catalog-1.0.0/templates/helm-repository.yaml", "kind: HelmRepository\n")
+	manager := newLocalRootManager(t, root)
+
+	manifests, err := manager.GetManifests(context.Background(), "minio", "minio", "14.1.2")
+	if err != nil {
+		t.Fatalf("GetManifests failed: %v", err)
+	}
+	if len(manifests) != 2 {
+		t.Fatalf("expected ServiceTemplate and HelmRepository manifests, got %d", len(manifests))
+	}
+	if !strings.Contains(string(manifests[0]), "name: minio-14-1-2") {
+		t.Errorf("expected local ServiceTemplate manifest, got %q", manifests[0])
+	}
+	if string(manifests[1]) != "kind: HelmRepository\n" {
+		t.Errorf("expected local HelmRepository manifest, got %q", manifests[1])
+	}
+}
+
+func TestGetManifestsLocalManifestRootMissingFile(t *testing.T) {
+	root := t.TempDir()
+	manager := newLocalRootManager(t, root)
+
+	_, err := manager.GetManifests(context.Background(), "minio", "minio", "14.1.2")
+	if err == nil {
+		t.Fatal("expected error for missing local manifest")
+	}
+	want := "manifest apps/minio/charts/minio-service-template-14.1.2/templates/service-template.yaml not found in local manifest root " + root
+	if !strings.Contains(err.Error(), want) {
+		t.Errorf("expected error %q, got: %v", want, err)
+	}
+}
+
 func TestGetManifestsNotFound(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		data, err := os.ReadFile(filepath.Join("testdata", "valid-index.json"))
@@ -613,10 +759,19 @@ func TestContextCancellation(t *testing.T) {
 	if !strings.Contains(err.Error(), "context") && !strings.Contains(err.Error(), "timeout") {
 		t.Logf("error: %v", err)
 	}
+
+	// The abandoned download keeps running for other callers; join it before cleanup
+	if err := manager.loadOrRefreshIndex(context.Background(), false); err != nil {
+		t.Fatalf("joining in-flight load failed: %v", err)
+	}
 }
 
 func TestConcurrentAccess(t *testing.T) {
+	var calls atomic.Int32
+	release := make(chan struct{})
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		<-release
 		data, err := os.ReadFile(filepath.Join("testdata", "valid-index.json"))
 		if err != nil {
 			http.Error(w, "internal error", http.StatusInternalServerError)
@@ -649,12 +804,19 @@ func TestConcurrentAccess(t *testing.T) {
 		}()
 	}
 
+	// Hold the download so the callers overlap
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+
 	// Wait for all goroutines to complete
 	for i := 0; i < numGoroutines; i++ {
 		if err := <-errChan; err != nil {
 			t.Errorf("concurrent List call failed: %v", err)
 		}
 	}
+	if got := calls.Load(); got != 1 {
+		t.Errorf("expected concurrent callers to share one index download, got %d", got)
+	}
 }
 
 // TestConstructManifestURL verifies the URL construction for ServiceTemplate manifests.
@@ -717,6 +879,99 @@ func TestConstructHelmRepoURL(t *testing.T) {
 	}
 }
 
+// TestConstructManifestURLWithRef verifies the manifest ref is interpolated into both URLs
+// and that an empty ref falls back to main.
+func TestConstructManifestURLWithRef(t *testing.T) {
+	tests := []struct {
+		name        string
+		ref         string
+		manifestURL string
+		helmRepoURL string
+	}{
+		{
+			name:        "release tag",
+			ref:         "refs/tags/v1.2.0",
+			manifestURL: "https://raw.githubusercontent.com/k0rdent/catalog/refs/tags/v1.2.0/apps/minio/charts/minio-service-template-14.1.2/templates/service-template.yaml",
+			helmRepoURL: "https://raw.githubusercontent.com/k0rdent/catalog/refs/tags/v1.2.0/apps/k0rdent-utils/charts/k0rdent-catalog-1.0.0/templates/helm-repository.yaml",
+		},
+		{
+			name:        "empty ref falls back to main",
+			ref:         "",
+			manifestURL: "https://raw.githubusercontent.com/k0rdent/catalog/refs/heads/main/apps/minio/charts/minio-service-template-14.1.2/templates/service-template.yaml",
+			helmRepoURL: "https://raw.githubusercontent.com/k0rdent/catalog/refs/heads/main/apps/k0rdent-utils/charts/k0rdent-catalog-1.0.0/templates/helm-repository.yaml",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manager, err := NewManager(Options{
+				CacheDir:    t.TempDir(),
+				ManifestRef: tt.ref,
+				Logger:      slog.New(slog.NewTextHandler(io.Discard, nil)),
+			})
+			if err != nil {
+				t.Fatalf("NewManager failed: %v", err)
+			}
+			if url := manager.constructManifestURL("minio", "minio", "14.1.2"); url != tt.manifestURL {
+				t.Errorf("expected manifest URL %q, got %q", tt.manifestURL, url)
+			}
+			if url := manager.constructHelmRepoURL(); url != tt.helmRepoURL {
+				t.Errorf("expected HelmRepository URL %q, got %q", tt.helmRepoURL, url)
+			}
+		})
+	}
+}
+
+// TestGetManifestsUsesManifestRef verifies manifest fetches request the configured ref.
+func TestGetManifestsUsesManifestRef(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, err := os.ReadFile(filepath.Join("testdata", "valid-index.json"))
+		if err != nil {
+			http.Error(w, "internal error", http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(data)
+	}))
+	defer ts.Close()
+
+	var requested []string
+	manager, err := NewManager(Options{
+		ArchiveURL:  ts.URL,
+		CacheDir:    t.TempDir(),
+		CacheTTL:    time.Hour,
+		ManifestRef: "refs/tags/v1.2.0",
+		HTTPClient: &http.Client{Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			if r.URL.Host != "raw.githubusercontent.com" {
+				return http.DefaultTransport.RoundTrip(r)
+			}
+			requested = append(requested, r.URL.Path)
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader("kind: ServiceTemplate\n")),
+				Header:     make(http.Header),
+				Request:    r,
+			}, nil
+		})},
+		Logger: slog.New(slog.NewTextHandler(io.Discard, nil)),
+	})
+	if err != nil {
+		t.Fatalf("NewManager failed: %v", err)
+	}
+
+	if _, err := manager.GetManifests(context.Background(), "minio", "minio", "14.1.2"); err != nil {
+		t.Fatalf("GetManifests failed: %v", err)
+	}
+	if len(requested) == 0 {
+		t.Fatal("expected manifest requests to GitHub")
+	}
+	for _, path := range requested {
+		if !strings.HasPrefix(path, "/k0rdent/catalog/refs/tags/v1.2.0/") {
+			t.Errorf("expected request under refs/tags/v1.2.0, got %s", path)
+		}
+	}
+}
+
 // TestFetchManifestSuccess tests successful manifest fetch.
 func TestFetchManifestSuccess(t *testing.T) {
 	expectedContent := "apiVersion: v1\nkind: ServiceTemplate\nmetadata:\n  name: test"
@@ -1043,3 +1298,174 @@ func TestShouldRetry(t *testing.T) {
 		})
 	}
 }
+
+func TestStatus(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, err := os.ReadFile(filepath.Join("testdata", "valid-index.json"))
+		if err != nil {
+			http.Error(w, "internal error", http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(data)
+	}))
+	defer ts.Close()
+
+	catalogMetrics := metrics.NewCatalogMetrics()
+	manager, err := NewManager(Options{
+		ArchiveURL: ts.URL,
+		CacheDir:   t.TempDir(),
+		CacheTTL:   time.Hour,
+		Logger:     slog.New(slog.NewTextHandler(io.Discard, nil)),
+		Metrics:    catalogMetrics,
+	})
+	if err != nil {
+		t.Fatalf("NewManager failed: %v", err)
+	}
+
+	status, err := manager.Status(context.Background())
+	if err != nil {
+		t.Fatalf("Status failed: %v", err)
+	}
+	if !status.Stale || status.LastRefresh != nil || status.AppCount != 0 {
+		t.Errorf("expected empty stale status before first load, got %+v", status)
+	}
+
+	entries, err := manager.List(context.Background(), "", false)
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+
+	status, err = manager.Status(context.Background())
+	if err != nil {
+		t.Fatalf("Status failed: %v", err)
+	}
+	if status.Stale {
+		t.Error("expected fresh status after load")
+	}
+	if status.IndexTimestamp == "" || status.IndexedAt == nil || status.LastRefresh == nil {
+		t.Errorf("expected timestamps to be populated, got %+v", status)
+	}
+	if status.AppCount != len(entries) {
+		t.Errorf("expected app count %d, got %d", len(entries), status.AppCount)
+	}
+
+	apps, _ := catalogMetrics.EntryCounts()
+	if apps != len(entries) || catalogMetrics.IndexTimestamp() != status.IndexTimestamp {
+		t.Errorf("metrics not updated: apps=%d timestamp=%q", apps, catalogMetrics.IndexTimestamp())
+	}
+}
+
+// roundTripFunc lets tests answer requests to fixed URLs such as raw.githubusercontent.com
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestCheckAvailability(t *testing.T) {
+	indexData, err := os.ReadFile(filepath.Join("testdata", "valid-index.json"))
+	if err != nil {
+		t.Fatalf("failed to read test JSON index: %v", err)
+	}
+
+	var methods []string
+	transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		respond := func(status int, body string, length int64) *http.Response {
+			return &http.Response{
+				StatusCode:    status,
+				Body:          io.NopCloser(strings.NewReader(body)),
+				ContentLength: length,
+				Header:        http.Header{},
+				Request:       req,
+			}
+		}
+		switch {
+		case req.URL.Host == "catalog.test":
+			return respond(http.StatusOK, string(indexData), int64(len(indexData))), nil
+		case strings.HasSuffix(req.URL.Path, "service-template.yaml"):
+			methods = append(methods, req.Method)
+			// Simulate a server that does not report Content-Length for HEAD
+			if req.Method == http.MethodHead {
+				return respond(http.StatusOK, "", -1), nil
+			}
+			return respond(http.StatusOK, "kind: ServiceTemplate\n", -1), nil
+		default:
+			return respond(http.StatusNotFound, "", 0), nil
+		}
+	})
+
+	manager, err := NewManager(Options{
+		ArchiveURL: "http://catalog.test/index.json",
+		CacheDir:   t.TempDir(),
+		CacheTTL:   time.Hour,
+		HTTPClient: &http.Client{Transport: transport},
+		Logger:     slog.New(slog.NewTextHandler(io.Discard, nil)),
+	})
+	if err != nil {
+		t.Fatalf("NewManager failed: %v", err)
+	}
+
+	entries, err := manager.List(context.Background(), "minio", false)
+	if err != nil || len(entries) == 0 || len(entries[0].Versions) == 0 {
+		t.Fatalf("expected minio entry, got %v (err=%v)", entries, err)
+	}
+	version := entries[0].Versions[0]
+
+	report, err := manager.CheckAvailability(context.Background(), "minio", version.Name, version.Version)
+	if err != nil {
+		t.Fatalf("CheckAvailability failed: %v", err)
+	}
+	if !report.Available {
+		t.Errorf("expected report to be available: %+v", report)
+	}
+	if len(report.Manifests) != 2 {
+		t.Fatalf("expected 2 manifest probes, got %d", len(report.Manifests))
+	}
+	st, hr := report.Manifests[0], report.Manifests[1]
+	if !st.Reachable || st.SizeBytes != int64(len("kind: ServiceTemplate\n")) {
+		t.Errorf("unexpected ServiceTemplate probe: %+v", st)
+	}
+	if strings.Join(methods, ",") != "HEAD,GET" {
+		t.Errorf("expected HEAD then GET fallback, got %v", methods)
+	}
+	if hr.Reachable || hr.Required || hr.StatusCode != http.StatusNotFound {
+		t.Errorf("unexpected HelmRepository probe: %+v", hr)
+	}
+
+	if _, err := manager.CheckAvailability(context.Background(), "minio", version.Name, "0.0.0"); err == nil {
+		t.Error("expected error for unknown version")
+	}
+}
+
+func TestSummarizeEntries(t *testing.T) {
+	entries := []CatalogEntry{
+		{Slug: "postgres", Tags: []string{"Database", "SQL"}, Versions: []ServiceTemplateVersion{{Version: "1.0.0"}, {Version: "1.1.0"}}},
+		{Slug: "redis", Tags: []string{"Database", "Cache", "Database"}, Versions: []ServiceTemplateVersion{{Version: "7.0.0"}}},
+		{Slug: "plain", Tags: []string{" "}},
+	}
+
+	summary := summarizeEntries(entries)
+	if summary.AppCount != 3 || summary.TemplateCount != 3 || summary.Untagged != 1 {
+		t.Fatalf("unexpected totals: %+v", summary)
+	}
+	want := []TagCount{{Tag: "Database", Count: 2}, {Tag: "Cache", Count: 1}, {Tag: "SQL", Count: 1}}
+	if !reflect.DeepEqual(summary.Tags, want) {
+		t.Errorf("expected tags %+v, got %+v", want, summary.Tags)
+	}
+}
+
+func TestNewTransport(t *testing.T) {
+	transport := newTransport(Options{MaxIdleConnsPerHost: 20, IdleConnTimeout: time.Minute})
+	if transport.MaxIdleConnsPerHost != 20 || transport.IdleConnTimeout != time.Minute {
+		t.Errorf("unexpected connection tuning: max idle per host %d, idle timeout %s", transport.MaxIdleConnsPerHost, transport.IdleConnTimeout)
+	}
+	if !transport.ForceAttemptHTTP2 || transport.TLSNextProto != nil {
+		t.Errorf("expected HTTP/2 to be attempted by default")
+	}
+
+	transport = newTransport(Options{DisableHTTP2: true})
+	if transport.ForceAttemptHTTP2 || transport.TLSNextProto == nil {
+		t.Errorf("expected HTTP/2 to be disabled")
+	}
+}
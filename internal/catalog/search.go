@@ -0,0 +1,126 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/k0rdent/mcp-k0rdent-server/internal/logging"
+)
+
+// SearchOptions narrows a catalog search. Empty fields match every entry; all matching is
+// case-insensitive.
+type SearchOptions struct {
+	// App limits results to the app with this exact slug
+	App string
+	// Query is a substring matched against the slug, title, and summary
+	Query string
+	// Tags must all be present on an entry
+	Tags []string
+	// Platform must be one of the entry's validated platforms
+	Platform string
+}
+
+// Search returns the catalog entries matching opts. When a query is given, entries are
+// ordered by relevance: an exact slug match first, then slug, title, and summary matches;
+// ties and query-less searches are ordered by slug.
+func (m *Manager) Search(ctx context.Context, opts SearchOptions, refresh bool) ([]CatalogEntry, error) {
+	logger := logging.WithContext(ctx, m.logger)
+	logger.Debug("search catalog entries",
+		"app_filter", opts.App,
+		"query", opts.Query,
+		"tags", opts.Tags,
+		"platform", opts.Platform,
+		"refresh", refresh,
+	)
+
+	if err := m.loadOrRefreshIndex(ctx, refresh); err != nil {
+		logger.Error("failed to load or refresh catalog index", "error", err)
+		return nil, err
+	}
+
+	appsWithTemplates, err := m.db.ListApps(opts.App)
+	if err != nil {
+		logger.Error("failed to query apps from database", "error", err)
+		return nil, fmt.Errorf("query apps: %w", err)
+	}
+
+	query := strings.ToLower(strings.TrimSpace(opts.Query))
+	type scored struct {
+		entry CatalogEntry
+		rank  int
+	}
+	var matches []scored
+	for _, awt := range appsWithTemplates {
+		if !hasAllTags(awt.App.Tags, opts.Tags) || !hasPlatform(awt.App.ValidatedPlatforms, opts.Platform) {
+			continue
+		}
+		rank := searchRank(awt.App, query)
+		if rank < 0 {
+			continue
+		}
+		matches = append(matches, scored{entry: catalogEntryFromApp(awt), rank: rank})
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].rank != matches[j].rank {
+			return matches[i].rank < matches[j].rank
+		}
+		return matches[i].entry.Slug < matches[j].entry.Slug
+	})
+
+	results := make([]CatalogEntry, 0, len(matches))
+	for _, match := range matches {
+		results = append(results, match.entry)
+	}
+
+	logger.Info("catalog entries searched", "query", opts.Query, "count", len(results))
+	return results, nil
+}
+
+// searchRank scores how well an app matches a lowercase query; lower is better and -1 means
+// no match. An empty query matches everything equally.
+func searchRank(app AppRow, query string) int {
+	switch {
+	case query == "":
+		return 0
+	case strings.ToLower(app.Slug) == query:
+		return 0
+	case strings.Contains(strings.ToLower(app.Slug), query):
+		return 1
+	case strings.Contains(strings.ToLower(app.Title), query):
+		return 2
+	case strings.Contains(strings.ToLower(app.Summary), query):
+		return 3
+	default:
+		return -1
+	}
+}
+
+func hasAllTags(tags, required []string) bool {
+	for _, want := range required {
+		want = strings.TrimSpace(want)
+		if want == "" {
+			continue
+		}
+		if !containsFold(tags, want) {
+			return false
+		}
+	}
+	return true
+}
+
+func hasPlatform(platforms []string, platform string) bool {
+	platform = strings.TrimSpace(platform)
+	return platform == "" || containsFold(platforms, platform)
+}
+
+func containsFold(values []string, target string) bool {
+	for _, value := range values {
+		if strings.EqualFold(value, target) {
+			return true
+		}
+	}
+	return false
+}
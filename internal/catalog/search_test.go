@@ -0,0 +1,79 @@
+package catalog
+
+import (
+	"context"
+	"testing"
+)
+
+func searchSlugs(t *testing.T, manager *Manager, opts SearchOptions) []string {
+	t.Helper()
+	entries, err := manager.Search(context.Background(), opts, false)
+	if err != nil {
+		t.Fatalf("Search(%+v) failed: %v", opts, err)
+	}
+	slugs := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		slugs = append(slugs, entry.Slug)
+	}
+	return slugs
+}
+
+func TestSearch(t *testing.T) {
+	manager := newLocalRootManager(t, "")
+
+	tests := []struct {
+		name string
+		opts SearchOptions
+		want []string
+	}{
+		{name: "no filters", opts: SearchOptions{}, want: []string{"minio", "postgresql", "redis"}},
+		{name: "slug substring", opts: SearchOptions{Query: "SQL"}, want: []string{"postgresql"}},
+		{name: "summary match", opts: SearchOptions{Query: "data structure"}, want: []string{"redis"}},
+		{name: "tags case-insensitive", opts: SearchOptions{Tags: []string{"database"}}, want: []string{"postgresql", "redis"}},
+		{name: "all tags required", opts: SearchOptions{Tags: []string{"Database", "Cache"}}, want: []string{"redis"}},
+		{name: "query and tags", opts: SearchOptions{Query: "postgres", Tags: []string{"Storage"}}, want: []string{}},
+		{name: "app filter", opts: SearchOptions{App: "minio", Query: "minio"}, want: []string{"minio"}},
+		{name: "no match", opts: SearchOptions{Query: "kafka"}, want: []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := searchSlugs(t, manager, tt.opts)
+			if len(got) != len(tt.want) {
+				t.Fatalf("expected %v, got %v", tt.want, got)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Fatalf("expected %v, got %v", tt.want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestSearchRank(t *testing.T) {
+	apps := []AppRow{
+		{Slug: "ingress-nginx", Title: "Ingress NGINX", Summary: "Ingress controller using nginx"},
+		{Slug: "nginx", Title: "NGINX", Summary: "Web server"},
+		{Slug: "traefik", Title: "Traefik", Summary: "Cloud native proxy, an nginx alternative"},
+		{Slug: "cert-manager", Title: "cert-manager", Summary: "Certificates"},
+	}
+	want := []int{1, 0, 3, -1}
+	for i, app := range apps {
+		if got := searchRank(app, "nginx"); got != want[i] {
+			t.Errorf("searchRank(%s) = %d, want %d", app.Slug, got, want[i])
+		}
+	}
+	if got := searchRank(AppRow{Slug: "x", Title: "Dashboard"}, "dash"); got != 2 {
+		t.Errorf("expected title match rank 2, got %d", got)
+	}
+}
+
+func TestHasPlatform(t *testing.T) {
+	platforms := []string{"AWS", "azure"}
+	if !hasPlatform(platforms, "aws") || !hasPlatform(platforms, "Azure") || !hasPlatform(platforms, "") {
+		t.Error("expected case-insensitive platform match")
+	}
+	if hasPlatform(platforms, "gcp") || hasPlatform(nil, "aws") {
+		t.Error("expected unlisted platform to be rejected")
+	}
+}
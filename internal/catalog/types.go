@@ -4,6 +4,8 @@ import (
 	"log/slog"
 	"net/http"
 	"time"
+
+	"github.com/k0rdent/mcp-k0rdent-server/internal/metrics"
 )
 
 // CatalogEntry represents a single application in the catalog with its metadata
@@ -62,9 +64,47 @@ type CacheMetadata struct {
 	IndexTimestamp string `json:"index_timestamp,omitempty"`
 }
 
+// AvailabilityReport describes whether the manifests for a catalog template can be fetched.
+type AvailabilityReport struct {
+	App      string `json:"app"`
+	Template string `json:"template"`
+	Version  string `json:"version"`
+
+	// Available is true when every required manifest is reachable
+	Available bool `json:"available"`
+
+	// Manifests lists the probe result for each manifest URL
+	Manifests []ManifestAvailability `json:"manifests"`
+}
+
+// ManifestAvailability is the probe result for a single manifest URL.
+type ManifestAvailability struct {
+	// Kind is the resource kind the manifest defines (ServiceTemplate or HelmRepository)
+	Kind string `json:"kind"`
+
+	// URL is the manifest location
+	URL string `json:"url"`
+
+	// Required is false for manifests whose absence does not block install
+	Required bool `json:"required"`
+
+	// Reachable is true when the URL answered with HTTP 200
+	Reachable bool `json:"reachable"`
+
+	// StatusCode is the HTTP status returned, if a response was received
+	StatusCode int `json:"status_code,omitempty"`
+
+	// SizeBytes is the manifest size, when known
+	SizeBytes int64 `json:"size_bytes,omitempty"`
+
+	// Error describes why the URL could not be reached
+	Error string `json:"error,omitempty"`
+}
+
 // Options configure the catalog Manager.
 type Options struct {
-	// HTTPClient is used for downloading the catalog archive (optional, defaults to http.DefaultClient with timeout)
+	// HTTPClient is used for downloading the catalog archive (optional, defaults to a client with a
+	// connection-reusing transport tuned by the settings below; those settings are ignored when set)
 	HTTPClient *http.Client
 
 	// CacheDir is the directory where catalog archives are stored (required)
@@ -79,8 +119,108 @@ type Options struct {
 	// DownloadTimeout is the HTTP request timeout for archive downloads
 	DownloadTimeout time.Duration
 
+	// MaxIdleConnsPerHost bounds idle connections kept per host for reuse (optional, defaults to DefaultMaxIdleConnsPerHost)
+	MaxIdleConnsPerHost int
+
+	// IdleConnTimeout is how long idle connections are kept (optional, defaults to DefaultIdleConnTimeout)
+	IdleConnTimeout time.Duration
+
+	// DisableHTTP2 stops the default transport from negotiating HTTP/2
+	DisableHTTP2 bool
+
+	// MaxIndexBytes limits how much of the catalog index response is read (optional, defaults to DefaultMaxIndexBytes)
+	MaxIndexBytes int64
+
+	// ExpectedSHA is the hex SHA256 the catalog index must match; a mismatching index is
+	// rejected and the existing index is kept (optional, empty disables the check)
+	ExpectedSHA string
+
+	// LocalManifestRoot is a directory mirroring the catalog repository layout
+	// (apps/{slug}/charts/...). When set, manifests are read from it instead of GitHub
+	// (optional, empty fetches manifests over HTTP)
+	LocalManifestRoot string
+
+	// ManifestRef is the catalog repository ref manifests are fetched from, e.g.
+	// "refs/tags/v1.2.0" (optional, defaults to DefaultManifestRef)
+	ManifestRef string
+
 	// Logger is used for structured logging (optional, defaults to slog.Default())
 	Logger *slog.Logger
+
+	// Metrics records catalog freshness (optional, a private tracker is created if nil)
+	Metrics *metrics.CatalogMetrics
+}
+
+// Status summarizes the freshness of the local catalog index.
+type Status struct {
+	// IndexTimestamp is the metadata.generated timestamp of the indexed catalog
+	IndexTimestamp string `json:"index_timestamp,omitempty"`
+
+	// IndexedAt is when the local index was last rebuilt
+	IndexedAt *time.Time `json:"indexed_at,omitempty"`
+
+	// LastRefresh is when the catalog host was last successfully checked for changes
+	LastRefresh *time.Time `json:"last_refresh,omitempty"`
+
+	// AppCount is the number of apps in the index
+	AppCount int `json:"app_count"`
+
+	// TemplateCount is the number of ServiceTemplate versions in the index
+	TemplateCount int `json:"template_count"`
+
+	// CacheTTL is the configured cache time-to-live
+	CacheTTL string `json:"cache_ttl"`
+
+	// Stale is true when the last refresh is older than the cache TTL or never happened
+	Stale bool `json:"stale"`
+
+	// URL is the catalog index URL
+	URL string `json:"url"`
+}
+
+// Summary is an aggregate overview of the catalog index.
+type Summary struct {
+	// AppCount is the number of apps in the catalog
+	AppCount int `json:"app_count"`
+
+	// TemplateCount is the number of ServiceTemplate versions across all apps
+	TemplateCount int `json:"template_count"`
+
+	// Tags counts apps per tag, most common first
+	Tags []TagCount `json:"tags"`
+
+	// Untagged is the number of apps without any tag
+	Untagged int `json:"untagged"`
+}
+
+// Export is a snapshot of the cached catalog index, used to seed an offline mirror.
+type Export struct {
+	// IndexTimestamp is the metadata.generated timestamp of the exported index
+	IndexTimestamp string `json:"index_timestamp"`
+
+	// SHA is the SHA256 of the catalog index the snapshot was built from
+	SHA string `json:"sha"`
+
+	// IndexedAt is when the local index was last rebuilt
+	IndexedAt *time.Time `json:"indexed_at,omitempty"`
+
+	// URL is the catalog index URL the snapshot was downloaded from
+	URL string `json:"url"`
+
+	// AppCount is the number of apps in the snapshot
+	AppCount int `json:"app_count"`
+
+	// TemplateCount is the number of ServiceTemplate versions across all apps
+	TemplateCount int `json:"template_count"`
+
+	// Entries lists every app with all of its ServiceTemplate versions
+	Entries []CatalogEntry `json:"entries"`
+}
+
+// TagCount is the number of catalog apps carrying a tag.
+type TagCount struct {
+	Tag   string `json:"tag"`
+	Count int    `json:"count"`
 }
 
 // JSONIndex represents the structure of the JSON catalog index downloaded from the catalog repository.
@@ -139,3 +279,39 @@ type JSONAddonMetadata struct {
 	// Owner identifies the team or organization maintaining this addon
 	Owner string `json:"owner"`
 }
+
+// EntryDetail is a single catalog app, optionally resolved to one version.
+type EntryDetail struct {
+	Entry CatalogEntry `json:"entry"`
+
+	// Version is the requested version; empty when only the entry was requested
+	Version string `json:"version,omitempty"`
+
+	// Templates describes each ServiceTemplate published at Version (one per chart name)
+	Templates []TemplateDetail `json:"templates,omitempty"`
+}
+
+// TemplateDetail describes the manifests of one ServiceTemplate version.
+type TemplateDetail struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+
+	// ServiceTemplateURL and HelmRepositoryURL are the manifest locations (file paths when
+	// LocalManifestRoot is set)
+	ServiceTemplateURL string `json:"serviceTemplateURL"`
+	HelmRepositoryURL  string `json:"helmRepositoryURL"`
+
+	// Chart is the Helm chart the ServiceTemplate manifest points at
+	Chart ChartMetadata `json:"chart"`
+}
+
+// ChartMetadata is the Helm chart reference from a ServiceTemplate's spec.helm.
+type ChartMetadata struct {
+	Name    string `json:"name,omitempty"`
+	Version string `json:"version,omitempty"`
+
+	// SourceKind and SourceName identify the Flux source holding the chart, e.g.
+	// HelmRepository/k0rdent-catalog
+	SourceKind string `json:"sourceKind,omitempty"`
+	SourceName string `json:"sourceName,omitempty"`
+}
@@ -0,0 +1,86 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+)
+
+// VersionLatest is the version sentinel install tools resolve to the newest stable release.
+const VersionLatest = "latest"
+
+// compareVersionStrings orders versions by semantic version. Versions that do not parse as
+// semver sort below every valid version and are compared lexically among themselves.
+func compareVersionStrings(a, b string) int {
+	va, errA := semver.NewVersion(a)
+	vb, errB := semver.NewVersion(b)
+	switch {
+	case errA == nil && errB == nil:
+		return va.Compare(vb)
+	case errA == nil:
+		return 1
+	case errB == nil:
+		return -1
+	default:
+		return strings.Compare(a, b)
+	}
+}
+
+// sortVersionsNewestFirst orders versions by chart name and, within a chart, newest first.
+func sortVersionsNewestFirst(versions []ServiceTemplateVersion) {
+	sort.SliceStable(versions, func(i, j int) bool {
+		if versions[i].Name != versions[j].Name {
+			return versions[i].Name < versions[j].Name
+		}
+		return compareVersionStrings(versions[i].Version, versions[j].Version) > 0
+	})
+}
+
+// highestVersion returns the highest semver of the template among versions. Pre-releases
+// are skipped unless includePrerelease is set, and versions that are not semver are ignored.
+func highestVersion(versions []ServiceTemplateVersion, template string, includePrerelease bool) (string, bool) {
+	var best *semver.Version
+	var bestRaw string
+	for _, v := range versions {
+		if v.Name != template {
+			continue
+		}
+		parsed, err := semver.NewVersion(v.Version)
+		if err != nil {
+			continue
+		}
+		if parsed.Prerelease() != "" && !includePrerelease {
+			continue
+		}
+		if best == nil || parsed.GreaterThan(best) {
+			best, bestRaw = parsed, v.Version
+		}
+	}
+	return bestRaw, best != nil
+}
+
+// LatestVersion returns the highest stable semver of an app's template, as spelled in the
+// catalog index. Pre-release versions are only considered when includePrerelease is set.
+func (m *Manager) LatestVersion(ctx context.Context, app, template string, includePrerelease bool) (string, error) {
+	entries, err := m.List(ctx, app, false)
+	if err != nil {
+		return "", err
+	}
+	for _, entry := range entries {
+		if entry.Slug != app {
+			continue
+		}
+		if version, ok := highestVersion(entry.Versions, template, includePrerelease); ok {
+			return version, nil
+		}
+		kind := "stable"
+		if includePrerelease {
+			kind = "semver"
+		}
+		return "", fmt.Errorf("no %s version of %s/%s found in catalog", kind, app, template)
+	}
+	return "", fmt.Errorf("app %s not found in catalog", app)
+}
@@ -0,0 +1,126 @@
+package catalog
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCompareVersionStrings(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"1.10.0", "1.9.0", 1},
+		{"1.2.3", "1.2.3", 0},
+		{"v1.2.3", "1.2.3", 0},
+		{"1.0.0-rc.1", "1.0.0", -1},
+		{"2.0.0", "not-a-version", 1},
+		{"alpha", "beta", -1},
+	}
+	for _, tt := range tests {
+		if got := compareVersionStrings(tt.a, tt.b); got != tt.want {
+			t.Errorf("compareVersionStrings(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestSortVersionsNewestFirst(t *testing.T) {
+	versions := []ServiceTemplateVersion{
+		{Name: "postgresql", Version: "12.5.8"},
+		{Name: "pgadmin", Version: "1.0.0"},
+		{Name: "postgresql", Version: "12.10.0"},
+		{Name: "postgresql", Version: "13.0.0-beta.1"},
+		{Name: "postgresql", Version: "9.0.0"},
+	}
+	sortVersionsNewestFirst(versions)
+
+	var got []string
+	for _, v := range versions {
+		got = append(got, v.Name+":"+v.Version)
+	}
+	want := "pgadmin:1.0.0,postgresql:13.0.0-beta.1,postgresql:12.10.0,postgresql:12.5.8,postgresql:9.0.0"
+	if strings.Join(got, ",") != want {
+		t.Fatalf("unexpected order %v, want %s", got, want)
+	}
+}
+
+func TestHighestVersion(t *testing.T) {
+	versions := []ServiceTemplateVersion{
+		{Name: "postgresql", Version: "12.5.8"},
+		{Name: "postgresql", Version: "12.10.0"},
+		{Name: "postgresql", Version: "13.0.0-beta.1"},
+		{Name: "postgresql", Version: "nightly"},
+		{Name: "pgadmin", Version: "99.0.0"},
+	}
+
+	if got, ok := highestVersion(versions, "postgresql", false); !ok || got != "12.10.0" {
+		t.Errorf("stable: got %q (ok=%v), want 12.10.0", got, ok)
+	}
+	if got, ok := highestVersion(versions, "postgresql", true); !ok || got != "13.0.0-beta.1" {
+		t.Errorf("prerelease: got %q (ok=%v), want 13.0.0-beta.1", got, ok)
+	}
+	if _, ok := highestVersion(versions, "missing", true); ok {
+		t.Error("expected no version for unknown template")
+	}
+}
+
+func TestManagerLatestVersion(t *testing.T) {
+	index := `{
+  "metadata": {"generated": "2025-11-06T15:02:01", "version": "1.0.0"},
+  "addons": [
+    {
+      "name": "postgresql",
+      "description": "PostgreSQL Database",
+      "charts": [{"name": "postgresql", "versions": ["12.5.8", "12.10.0", "13.0.0-rc.1"]}]
+    },
+    {
+      "name": "edge",
+      "description": "Pre-release only",
+      "charts": [{"name": "edge", "versions": ["0.1.0-alpha.1"]}]
+    }
+  ]
+}`
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(index))
+	}))
+	defer ts.Close()
+
+	manager, err := NewManager(Options{
+		ArchiveURL: ts.URL,
+		CacheDir:   t.TempDir(),
+		CacheTTL:   time.Hour,
+		Logger:     slog.New(slog.NewTextHandler(io.Discard, nil)),
+	})
+	if err != nil {
+		t.Fatalf("NewManager failed: %v", err)
+	}
+	ctx := context.Background()
+
+	entries, err := manager.List(ctx, "postgresql", false)
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if len(entries) != 1 || len(entries[0].Versions) != 3 || entries[0].Versions[0].Version != "13.0.0-rc.1" || entries[0].Versions[1].Version != "12.10.0" {
+		t.Fatalf("expected newest-first versions, got %+v", entries)
+	}
+
+	if got, err := manager.LatestVersion(ctx, "postgresql", "postgresql", false); err != nil || got != "12.10.0" {
+		t.Errorf("LatestVersion stable = %q, %v; want 12.10.0", got, err)
+	}
+	if got, err := manager.LatestVersion(ctx, "postgresql", "postgresql", true); err != nil || got != "13.0.0-rc.1" {
+		t.Errorf("LatestVersion prerelease = %q, %v; want 13.0.0-rc.1", got, err)
+	}
+	if _, err := manager.LatestVersion(ctx, "edge", "edge", false); err == nil || !strings.Contains(err.Error(), "no stable version") {
+		t.Errorf("expected no stable version error, got %v", err)
+	}
+	if _, err := manager.LatestVersion(ctx, "missing", "missing", false); err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Errorf("expected app not found error, got %v", err)
+	}
+}
@@ -10,6 +10,9 @@ import (
 	"time"
 )
 
+// ErrAlreadyRunning indicates that the PID file references a live process.
+var ErrAlreadyRunning = errors.New("server already running")
+
 // ApplyEnvOverrides sets environment variables based on KEY=VALUE pairs.
 func ApplyEnvOverrides(overrides []string) error {
 	for _, override := range overrides {
@@ -42,6 +45,62 @@ func ReadPID(path string) (int, error) {
 	return pid, nil
 }
 
+// PIDFileStatus describes the process recorded in a PID file.
+type PIDFileStatus struct {
+	// Exists is true when the PID file is present, even if its contents are not a valid pid.
+	Exists bool
+	// PID is the recorded pid, or 0 when the file is missing or its contents do not parse.
+	PID int
+	// Running is true when the recorded process exists, including one we may not signal.
+	Running bool
+}
+
+// Stale reports whether the PID file exists but does not point at a live process.
+func (s PIDFileStatus) Stale() bool {
+	return s.Exists && !s.Running
+}
+
+// InspectPIDFile reads a PID file and probes the recorded process. A missing file or
+// contents that are not a valid pid are not errors; a file that exists but cannot be read
+// (e.g. EACCES) is.
+func InspectPIDFile(path string) (PIDFileStatus, error) {
+	var status PIDFileStatus
+	pid, err := ReadPID(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return status, nil
+		}
+		var numErr *strconv.NumError
+		if !errors.As(err, &numErr) {
+			return status, err
+		}
+		status.Exists = true
+		return status, nil
+	}
+	status.Exists = true
+	if pid <= 0 {
+		return status, nil
+	}
+	status.PID = pid
+	status.Running = processExists(pid)
+	return status, nil
+}
+
+// CheckPIDFile inspects an existing PID file before a new server writes its own.
+// It returns ErrAlreadyRunning when the recorded process is still alive, and reports
+// stale=true when the file exists but its process is gone or its contents are not a valid
+// pid. A missing PID file is neither stale nor an error; an unreadable one is an error.
+func CheckPIDFile(path string) (stale bool, err error) {
+	status, err := InspectPIDFile(path)
+	if err != nil {
+		return false, err
+	}
+	if status.Running && status.PID != os.Getpid() {
+		return false, fmt.Errorf("%w (pid %d, pid file %s)", ErrAlreadyRunning, status.PID, path)
+	}
+	return status.Exists, nil
+}
+
 // RemovePID removes a PID file, ignoring errors.
 func RemovePID(path string) error {
 	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
@@ -82,6 +141,44 @@ func WaitForExit(pid int, timeout time.Duration) error {
 	}
 }
 
+// StopOptions control how StopProcess terminates a running server.
+type StopOptions struct {
+	// Timeout is how long to wait for a graceful exit after SIGTERM.
+	Timeout time.Duration
+	// KillGracePeriod is how long to wait for the process to disappear after SIGKILL.
+	KillGracePeriod time.Duration
+	// Force skips SIGTERM and sends SIGKILL immediately.
+	Force bool
+}
+
+// StopProcess sends SIGTERM to the PID and waits for it to exit, escalating to SIGKILL
+// when the timeout elapses (or immediately when Force is set). It reports whether
+// SIGKILL was required. A process that is already gone is treated as stopped.
+func StopProcess(pid int, opts StopOptions) (bool, error) {
+	if !opts.Force {
+		if err := SignalProcess(pid, syscall.SIGTERM); err != nil {
+			if errors.Is(err, os.ErrProcessDone) {
+				return false, nil
+			}
+			return false, err
+		}
+		if err := WaitForExit(pid, opts.Timeout); err == nil {
+			return false, nil
+		}
+	}
+
+	if err := SignalProcess(pid, syscall.SIGKILL); err != nil {
+		if errors.Is(err, os.ErrProcessDone) {
+			return false, nil
+		}
+		return false, err
+	}
+	if err := WaitForExit(pid, opts.KillGracePeriod); err != nil {
+		return true, fmt.Errorf("process %d still running after SIGKILL: %w", pid, err)
+	}
+	return true, nil
+}
+
 func processExists(pid int) bool {
 	err := syscall.Kill(pid, syscall.Signal(0))
 	return err == nil || err == syscall.EPERM
@@ -2,9 +2,11 @@ package cli
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
+	"os/signal"
 	"path/filepath"
 	"runtime"
 	"strings"
@@ -121,6 +123,61 @@ func TestApplyEnvOverridesInvalid(t *testing.T) {
 	}
 }
 
+func TestCheckPIDFile(t *testing.T) {
+	dir := t.TempDir()
+
+	missing := filepath.Join(dir, "missing.pid")
+	stale, err := CheckPIDFile(missing)
+	if err != nil || stale {
+		t.Fatalf("expected missing pid file to be ignored, got stale=%v err=%v", stale, err)
+	}
+
+	garbage := filepath.Join(dir, "garbage.pid")
+	if err := os.WriteFile(garbage, []byte("not-a-number"), 0o644); err != nil {
+		t.Fatalf("failed to write pid file: %v", err)
+	}
+	stale, err = CheckPIDFile(garbage)
+	if err != nil || !stale {
+		t.Fatalf("expected invalid pid file to be stale, got stale=%v err=%v", stale, err)
+	}
+
+	// A PID file that exists but cannot be read is an error, not stale
+	if _, err := CheckPIDFile(dir); err == nil {
+		t.Fatalf("expected read error for unreadable pid file")
+	}
+
+	if runtime.GOOS == "windows" {
+		t.Skip("signals not supported on Windows")
+	}
+
+	cmd := helperCommand("sleep", "5s")
+	if err := cmd.Start(); err != nil {
+		t.Fatalf("failed to start helper process: %v", err)
+	}
+	done := make(chan struct{})
+	go func() {
+		_ = cmd.Wait()
+		close(done)
+	}()
+	defer cmd.Process.Kill()
+
+	live := filepath.Join(dir, "live.pid")
+	if err := WritePID(live, cmd.Process.Pid); err != nil {
+		t.Fatalf("WritePID returned error: %v", err)
+	}
+	if _, err := CheckPIDFile(live); !errors.Is(err, ErrAlreadyRunning) {
+		t.Fatalf("expected ErrAlreadyRunning for live process, got %v", err)
+	}
+
+	_ = cmd.Process.Kill()
+	<-done
+
+	stale, err = CheckPIDFile(live)
+	if err != nil || !stale {
+		t.Fatalf("expected exited process to be stale, got stale=%v err=%v", stale, err)
+	}
+}
+
 func TestReadPIDInvalid(t *testing.T) {
 	dir := t.TempDir()
 	path := filepath.Join(dir, "pid")
@@ -156,6 +213,83 @@ func TestWaitForExitTimeout(t *testing.T) {
 	_ = cmd.Wait()
 }
 
+func TestStopProcessEscalatesToKill(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("signals not supported on Windows")
+	}
+	cmd := helperCommand("ignore-term", "10s")
+	if err := cmd.Start(); err != nil {
+		t.Fatalf("failed to start helper process: %v", err)
+	}
+	go func() { _ = cmd.Wait() }()
+	defer cmd.Process.Kill()
+
+	// Give the helper a moment to install its SIGTERM handler.
+	time.Sleep(200 * time.Millisecond)
+
+	killed, err := StopProcess(cmd.Process.Pid, StopOptions{
+		Timeout:         250 * time.Millisecond,
+		KillGracePeriod: 2 * time.Second,
+	})
+	if err != nil {
+		t.Fatalf("StopProcess returned error: %v", err)
+	}
+	if !killed {
+		t.Fatalf("expected StopProcess to escalate to SIGKILL")
+	}
+}
+
+func TestStopProcessGraceful(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("signals not supported on Windows")
+	}
+	cmd := helperCommand("sleep", "10s")
+	if err := cmd.Start(); err != nil {
+		t.Fatalf("failed to start helper process: %v", err)
+	}
+	go func() { _ = cmd.Wait() }()
+	defer cmd.Process.Kill()
+
+	killed, err := StopProcess(cmd.Process.Pid, StopOptions{
+		Timeout:         2 * time.Second,
+		KillGracePeriod: time.Second,
+	})
+	if err != nil {
+		t.Fatalf("StopProcess returned error: %v", err)
+	}
+	if killed {
+		t.Fatalf("expected SIGTERM to be sufficient")
+	}
+}
+
+func TestStopProcessForce(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("signals not supported on Windows")
+	}
+	cmd := helperCommand("ignore-term", "10s")
+	if err := cmd.Start(); err != nil {
+		t.Fatalf("failed to start helper process: %v", err)
+	}
+	go func() { _ = cmd.Wait() }()
+	defer cmd.Process.Kill()
+
+	start := time.Now()
+	killed, err := StopProcess(cmd.Process.Pid, StopOptions{
+		Timeout:         10 * time.Second,
+		KillGracePeriod: 2 * time.Second,
+		Force:           true,
+	})
+	if err != nil {
+		t.Fatalf("StopProcess returned error: %v", err)
+	}
+	if !killed {
+		t.Fatalf("expected forced stop to use SIGKILL")
+	}
+	if time.Since(start) > 5*time.Second {
+		t.Fatalf("forced stop should not wait for the graceful timeout")
+	}
+}
+
 func helperCommand(action, value string) *exec.Cmd {
 	if runtime.GOOS == "windows" {
 		panic("helperCommand should not be called on Windows")
@@ -197,6 +331,13 @@ func TestHelperProcess(t *testing.T) {
 			os.Exit(2)
 		}
 		time.Sleep(duration)
+	case "ignore-term":
+		duration, err := time.ParseDuration(value)
+		if err != nil {
+			os.Exit(2)
+		}
+		signal.Ignore(syscall.SIGTERM)
+		time.Sleep(duration)
 	default:
 		fmt.Fprintln(os.Stderr, "unknown helper action")
 		os.Exit(3)
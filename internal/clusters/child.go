@@ -0,0 +1,107 @@
+package clusters
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+var (
+	// SecretsGVR is the GroupVersionResource for core Secrets
+	SecretsGVR = schema.GroupVersionResource{
+		Group:    "",
+		Version:  "v1",
+		Resource: "secrets",
+	}
+)
+
+const (
+	// kubeconfigSecretSuffix is the CAPI naming convention for child cluster kubeconfig secrets
+	kubeconfigSecretSuffix = "-kubeconfig"
+	// kubeconfigSecretKey is the data key holding the kubeconfig in CAPI secrets
+	kubeconfigSecretKey = "value"
+	// kubeconfigSecretAltKey is the data key used by secrets written outside of CAPI
+	kubeconfigSecretAltKey = "kubeconfig"
+)
+
+// ChildClientFactory builds a dynamic client for a child cluster from raw kubeconfig bytes.
+type ChildClientFactory func(kubeconfig []byte) (dynamic.Interface, error)
+
+// NewChildDynamicClient is the default ChildClientFactory.
+func NewChildDynamicClient(kubeconfig []byte) (dynamic.Interface, error) {
+	cfg, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("parse child kubeconfig: %w", err)
+	}
+	client, err := dynamic.NewForConfig(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("create child dynamic client: %w", err)
+	}
+	return client, nil
+}
+
+// childClusterClient resolves the kubeconfig secret for a ClusterDeployment and returns a
+// dynamic client connected to the child cluster.
+func (m *Manager) childClusterClient(ctx context.Context, namespace, name string) (dynamic.Interface, error) {
+	kubeconfig, _, err := m.childKubeconfig(ctx, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	return m.childClients(kubeconfig)
+}
+
+// childKubeconfig fetches and decodes the kubeconfig secret of a ClusterDeployment, returning
+// the raw kubeconfig and the secret it was read from. A missing secret wraps both
+// ErrKubeconfigNotReady and ErrResourceNotFound.
+func (m *Manager) childKubeconfig(ctx context.Context, namespace, name string) ([]byte, ResourceReference, error) {
+	cdObj, err := m.dynamicClient.Resource(ClusterDeploymentsGVR).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, ResourceReference{}, fmt.Errorf("%w: cluster deployment %s not found in namespace %s", ErrResourceNotFound, name, namespace)
+		}
+		return nil, ResourceReference{}, fmt.Errorf("fetch cluster deployment: %w", err)
+	}
+
+	secretRef := buildReferenceFromPath(cdObj, namespace, "status", "kubeconfigSecret")
+	if secretRef.Name == "" {
+		secretRef = ResourceReference{Name: name + kubeconfigSecretSuffix, Namespace: namespace}
+	}
+
+	secret, err := m.dynamicClient.Resource(SecretsGVR).Namespace(secretRef.Namespace).Get(ctx, secretRef.Name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, secretRef, fmt.Errorf("%w: %w: kubeconfig secret %s/%s not found (cluster may not be provisioned yet)", ErrKubeconfigNotReady, ErrResourceNotFound, secretRef.Namespace, secretRef.Name)
+		}
+		return nil, secretRef, fmt.Errorf("fetch kubeconfig secret: %w", err)
+	}
+
+	kubeconfig, err := kubeconfigFromSecret(secret)
+	if err != nil {
+		return nil, secretRef, fmt.Errorf("kubeconfig secret %s/%s: %w", secretRef.Namespace, secretRef.Name, err)
+	}
+	return kubeconfig, secretRef, nil
+}
+
+// kubeconfigFromSecret decodes the kubeconfig stored in an unstructured Secret under
+// data.value, falling back to data.kubeconfig.
+func kubeconfigFromSecret(secret *unstructured.Unstructured) ([]byte, error) {
+	for _, key := range []string{kubeconfigSecretKey, kubeconfigSecretAltKey} {
+		encoded, found, err := unstructured.NestedString(secret.Object, "data", key)
+		if err != nil || !found || encoded == "" {
+			continue
+		}
+		decoded, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("decode data.%s: %w", key, err)
+		}
+		return decoded, nil
+	}
+	return nil, fmt.Errorf("missing data.%s or data.%s", kubeconfigSecretKey, kubeconfigSecretAltKey)
+}
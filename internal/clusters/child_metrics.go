@@ -0,0 +1,139 @@
+package clusters
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/k0rdent/mcp-k0rdent-server/internal/logging"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var (
+	// NodeMetricsGVR is the GroupVersionResource for metrics-server node metrics
+	NodeMetricsGVR = schema.GroupVersionResource{
+		Group:    "metrics.k8s.io",
+		Version:  "v1beta1",
+		Resource: "nodes",
+	}
+
+	// PodMetricsGVR is the GroupVersionResource for metrics-server pod metrics
+	PodMetricsGVR = schema.GroupVersionResource{
+		Group:    "metrics.k8s.io",
+		Version:  "v1beta1",
+		Resource: "pods",
+	}
+)
+
+// GetClusterMetrics connects to the child cluster behind a ClusterDeployment and summarizes
+// node and per-namespace CPU/memory usage from the metrics API. ErrMetricsUnavailable is
+// returned when metrics-server is not installed or not serving.
+func (m *Manager) GetClusterMetrics(ctx context.Context, namespace, name string) (ClusterMetricsSummary, error) {
+	logger := logging.WithContext(ctx, m.logger)
+	logger.Debug("getting child cluster metrics",
+		"name", name,
+		"namespace", namespace,
+	)
+
+	child, err := m.childClusterClient(ctx, namespace, name)
+	if err != nil {
+		logger.Warn("failed to connect to child cluster", "name", name, "namespace", namespace, "error", err)
+		return ClusterMetricsSummary{}, err
+	}
+
+	nodeList, err := child.Resource(NodeMetricsGVR).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return ClusterMetricsSummary{}, metricsListError("node", err)
+	}
+	podList, err := child.Resource(PodMetricsGVR).Namespace(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return ClusterMetricsSummary{}, metricsListError("pod", err)
+	}
+
+	summary := ClusterMetricsSummary{
+		Name:        name,
+		Namespace:   namespace,
+		CollectedAt: time.Now().UTC(),
+		Nodes:       make([]NodeUsage, 0, len(nodeList.Items)),
+		Namespaces:  []NamespaceUsage{},
+	}
+
+	for i := range nodeList.Items {
+		item := &nodeList.Items[i]
+		usage := parseUsage(item.Object, "usage")
+		summary.Nodes = append(summary.Nodes, NodeUsage{Name: item.GetName(), Usage: usage})
+		summary.Total.CPUMillicores += usage.CPUMillicores
+		summary.Total.MemoryBytes += usage.MemoryBytes
+	}
+	sort.Slice(summary.Nodes, func(i, j int) bool {
+		return summary.Nodes[i].Name < summary.Nodes[j].Name
+	})
+
+	byNamespace := make(map[string]*NamespaceUsage)
+	for i := range podList.Items {
+		item := &podList.Items[i]
+		ns := item.GetNamespace()
+		entry, ok := byNamespace[ns]
+		if !ok {
+			entry = &NamespaceUsage{Namespace: ns}
+			byNamespace[ns] = entry
+		}
+		entry.PodCount++
+
+		containers, _, _ := unstructured.NestedSlice(item.Object, "containers")
+		for _, c := range containers {
+			container, ok := c.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			usage := parseUsage(container, "usage")
+			entry.Usage.CPUMillicores += usage.CPUMillicores
+			entry.Usage.MemoryBytes += usage.MemoryBytes
+		}
+	}
+	for _, entry := range byNamespace {
+		summary.Namespaces = append(summary.Namespaces, *entry)
+	}
+	sort.Slice(summary.Namespaces, func(i, j int) bool {
+		return summary.Namespaces[i].Namespace < summary.Namespaces[j].Namespace
+	})
+
+	logger.Info("child cluster metrics collected",
+		"name", name,
+		"namespace", namespace,
+		"node_count", len(summary.Nodes),
+		"namespace_count", len(summary.Namespaces),
+	)
+
+	return summary, nil
+}
+
+// metricsListError maps "API not served" responses to ErrMetricsUnavailable.
+func metricsListError(kind string, err error) error {
+	if apierrors.IsNotFound(err) || apierrors.IsServiceUnavailable(err) {
+		return fmt.Errorf("%w: %v", ErrMetricsUnavailable, err)
+	}
+	return fmt.Errorf("list %s metrics: %w", kind, err)
+}
+
+// parseUsage reads cpu and memory quantities from the usage map at the given field.
+// Unparseable quantities are treated as zero.
+func parseUsage(obj map[string]interface{}, field string) ResourceUsage {
+	var usage ResourceUsage
+	values, found, err := unstructured.NestedStringMap(obj, field)
+	if err != nil || !found {
+		return usage
+	}
+	if cpu, err := resource.ParseQuantity(values["cpu"]); err == nil {
+		usage.CPUMillicores = cpu.MilliValue()
+	}
+	if memory, err := resource.ParseQuantity(values["memory"]); err == nil {
+		usage.MemoryBytes = memory.Value()
+	}
+	return usage
+}
@@ -0,0 +1,167 @@
+package clusters
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"log/slog"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/fake"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+func newMetricsObject(kind, namespace, name string, fields map[string]interface{}) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "metrics.k8s.io/v1beta1",
+		"kind":       kind,
+		"metadata": map[string]interface{}{
+			"name": name,
+		},
+	}}
+	if namespace != "" {
+		obj.SetNamespace(namespace)
+	}
+	for k, v := range fields {
+		obj.Object[k] = v
+	}
+	return obj
+}
+
+func newKubeconfigSecret(namespace, name string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Secret",
+		"metadata": map[string]interface{}{
+			"name":      name,
+			"namespace": namespace,
+		},
+		"data": map[string]interface{}{
+			"value": base64.StdEncoding.EncodeToString([]byte("apiVersion: v1\nkind: Config\n")),
+		},
+	}}
+}
+
+// newChildMetricsClient registers metrics objects under their metrics.k8s.io resources;
+// the fake tracker cannot infer "nodes"/"pods" from the NodeMetrics/PodMetrics kinds.
+func newChildMetricsClient(t *testing.T, objects ...*unstructured.Unstructured) *fake.FakeDynamicClient {
+	t.Helper()
+	client := fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		NodeMetricsGVR: "NodeMetricsList",
+		PodMetricsGVR:  "PodMetricsList",
+	})
+	for _, obj := range objects {
+		gvr := NodeMetricsGVR
+		if obj.GetKind() == "PodMetrics" {
+			gvr = PodMetricsGVR
+		}
+		if err := client.Tracker().Create(gvr, obj, obj.GetNamespace()); err != nil {
+			t.Fatalf("failed to seed %s: %v", obj.GetName(), err)
+		}
+	}
+	return client
+}
+
+func TestGetClusterMetrics_Summarizes(t *testing.T) {
+	cd := createTestClusterDeployment("child", "team-a", nil)
+	mgmt := fake.NewSimpleDynamicClient(runtime.NewScheme(), cd, newKubeconfigSecret("team-a", "child-kubeconfig"))
+
+	child := newChildMetricsClient(t,
+		newMetricsObject("NodeMetrics", "", "node-b", map[string]interface{}{
+			"usage": map[string]interface{}{"cpu": "500m", "memory": "1Gi"},
+		}),
+		newMetricsObject("NodeMetrics", "", "node-a", map[string]interface{}{
+			"usage": map[string]interface{}{"cpu": "1", "memory": "512Mi"},
+		}),
+		newMetricsObject("PodMetrics", "kube-system", "coredns", map[string]interface{}{
+			"containers": []interface{}{
+				map[string]interface{}{"name": "coredns", "usage": map[string]interface{}{"cpu": "10m", "memory": "20Mi"}},
+			},
+		}),
+		newMetricsObject("PodMetrics", "kube-system", "proxy", map[string]interface{}{
+			"containers": []interface{}{
+				map[string]interface{}{"name": "a", "usage": map[string]interface{}{"cpu": "5m", "memory": "10Mi"}},
+				map[string]interface{}{"name": "b", "usage": map[string]interface{}{"cpu": "5m", "memory": "10Mi"}},
+			},
+		}),
+	)
+
+	var gotKubeconfig []byte
+	manager := &Manager{
+		dynamicClient: mgmt,
+		childClients: func(kubeconfig []byte) (dynamic.Interface, error) {
+			gotKubeconfig = kubeconfig
+			return child, nil
+		},
+		logger: slog.Default(),
+	}
+
+	summary, err := manager.GetClusterMetrics(context.Background(), "team-a", "child")
+	if err != nil {
+		t.Fatalf("GetClusterMetrics returned error: %v", err)
+	}
+	if string(gotKubeconfig) != "apiVersion: v1\nkind: Config\n" {
+		t.Fatalf("unexpected kubeconfig passed to child factory: %q", gotKubeconfig)
+	}
+	if len(summary.Nodes) != 2 || summary.Nodes[0].Name != "node-a" {
+		t.Fatalf("expected 2 sorted nodes, got %+v", summary.Nodes)
+	}
+	if summary.Total.CPUMillicores != 1500 {
+		t.Errorf("expected 1500m total CPU, got %d", summary.Total.CPUMillicores)
+	}
+	if summary.Total.MemoryBytes != 1536*1024*1024 {
+		t.Errorf("expected 1.5Gi total memory, got %d", summary.Total.MemoryBytes)
+	}
+	if len(summary.Namespaces) != 1 {
+		t.Fatalf("expected 1 namespace, got %+v", summary.Namespaces)
+	}
+	ns := summary.Namespaces[0]
+	if ns.Namespace != "kube-system" || ns.PodCount != 2 || ns.Usage.CPUMillicores != 20 || ns.Usage.MemoryBytes != 40*1024*1024 {
+		t.Errorf("unexpected namespace usage: %+v", ns)
+	}
+}
+
+func TestGetClusterMetrics_MetricsServerMissing(t *testing.T) {
+	cd := createTestClusterDeployment("child", "team-a", nil)
+	mgmt := fake.NewSimpleDynamicClient(runtime.NewScheme(), cd, newKubeconfigSecret("team-a", "child-kubeconfig"))
+
+	child := newChildMetricsClient(t)
+	child.PrependReactor("list", "nodes", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		return true, nil, apierrors.NewNotFound(NodeMetricsGVR.GroupResource(), "")
+	})
+
+	manager := &Manager{
+		dynamicClient: mgmt,
+		childClients: func([]byte) (dynamic.Interface, error) {
+			return child, nil
+		},
+		logger: slog.Default(),
+	}
+
+	_, err := manager.GetClusterMetrics(context.Background(), "team-a", "child")
+	if !errors.Is(err, ErrMetricsUnavailable) {
+		t.Fatalf("expected ErrMetricsUnavailable, got %v", err)
+	}
+}
+
+func TestGetClusterMetrics_MissingKubeconfigSecret(t *testing.T) {
+	cd := createTestClusterDeployment("child", "team-a", nil)
+	manager := &Manager{
+		dynamicClient: fake.NewSimpleDynamicClient(runtime.NewScheme(), cd),
+		childClients: func([]byte) (dynamic.Interface, error) {
+			t.Fatal("child client should not be built without a kubeconfig")
+			return nil, nil
+		},
+		logger: slog.Default(),
+	}
+
+	_, err := manager.GetClusterMetrics(context.Background(), "team-a", "child")
+	if !errors.Is(err, ErrResourceNotFound) {
+		t.Fatalf("expected ErrResourceNotFound, got %v", err)
+	}
+}
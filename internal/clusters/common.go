@@ -1,7 +1,12 @@
 package clusters
 
 import (
+	"context"
+
+	"github.com/k0rdent/mcp-k0rdent-server/internal/kube"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 // IsResourceReady checks if a Kubernetes resource has a Ready=True condition.
@@ -28,3 +33,20 @@ func IsResourceReady(obj *unstructured.Unstructured) bool {
 
 	return false
 }
+
+// listWithRetry lists resources in a namespace (all namespaces when empty), retrying
+// transient API failures. Non-retryable errors such as Forbidden are returned immediately.
+func (m *Manager) listWithRetry(ctx context.Context, gvr schema.GroupVersionResource, namespace string) (*unstructured.UnstructuredList, error) {
+	return m.listWithOptions(ctx, gvr, namespace, metav1.ListOptions{})
+}
+
+// listWithOptions is listWithRetry with explicit list options, e.g. a page limit.
+func (m *Manager) listWithOptions(ctx context.Context, gvr schema.GroupVersionResource, namespace string, opts metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+	var list *unstructured.UnstructuredList
+	err := kube.RetryRead(ctx, func(ctx context.Context) error {
+		var listErr error
+		list, listErr = m.dynamicClient.Resource(gvr).Namespace(namespace).List(ctx, opts)
+		return listErr
+	})
+	return list, err
+}
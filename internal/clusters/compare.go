@@ -0,0 +1,218 @@
+package clusters
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/k0rdent/mcp-k0rdent-server/internal/logging"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ClusterRef identifies a ClusterDeployment.
+type ClusterRef struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+}
+
+// ClusterComparison is a structured diff between two ClusterDeployments.
+type ClusterComparison struct {
+	Left  ClusterDeploymentSummary `json:"left"`
+	Right ClusterDeploymentSummary `json:"right"`
+
+	// Identical is true when no spec, service, or condition differences were found
+	Identical bool `json:"identical"`
+
+	// SpecDifferences are leaf-level spec differences. Service entries are keyed by
+	// service name (e.g. "serviceSpec.services.ingress.values.replicaCount").
+	SpecDifferences []FieldDifference `json:"specDifferences,omitempty"`
+
+	// ServiceDifferences compare the reported state of each service in status.services
+	ServiceDifferences []ServiceDifference `json:"serviceDifferences,omitempty"`
+
+	// ConditionDifferences compare status.conditions by type
+	ConditionDifferences []ConditionDifference `json:"conditionDifferences,omitempty"`
+}
+
+// FieldDifference is a value that differs between the two clusters; a side is omitted when unset.
+type FieldDifference struct {
+	Path  string `json:"path"`
+	Left  any    `json:"left,omitempty"`
+	Right any    `json:"right,omitempty"`
+}
+
+// ServiceDifference reports a service whose status differs; a side is nil when the service is absent.
+type ServiceDifference struct {
+	Name  string                `json:"name"`
+	Left  *ServiceStatusSummary `json:"left,omitempty"`
+	Right *ServiceStatusSummary `json:"right,omitempty"`
+}
+
+// ConditionDifference reports a condition whose status or reason differs; a side is nil when absent.
+type ConditionDifference struct {
+	Type  string            `json:"type"`
+	Left  *ConditionSummary `json:"left,omitempty"`
+	Right *ConditionSummary `json:"right,omitempty"`
+}
+
+// CompareClusters fetches two ClusterDeployments and returns their summaries together with
+// the differences in spec, service status, and conditions.
+func (m *Manager) CompareClusters(ctx context.Context, left, right ClusterRef) (ClusterComparison, error) {
+	logger := logging.WithContext(ctx, m.logger)
+
+	leftObj, err := m.getClusterDeployment(ctx, left)
+	if err != nil {
+		return ClusterComparison{}, err
+	}
+	rightObj, err := m.getClusterDeployment(ctx, right)
+	if err != nil {
+		return ClusterComparison{}, err
+	}
+
+	result := ClusterComparison{
+		Left:                 SummarizeClusterDeployment(leftObj),
+		Right:                SummarizeClusterDeployment(rightObj),
+		SpecDifferences:      diffSpecs(leftObj, rightObj),
+		ServiceDifferences:   diffServiceStatuses(ExtractServiceStatuses(leftObj), ExtractServiceStatuses(rightObj)),
+		ConditionDifferences: diffConditions(extractConditions(leftObj), extractConditions(rightObj)),
+	}
+	result.Identical = len(result.SpecDifferences) == 0 && len(result.ServiceDifferences) == 0 && len(result.ConditionDifferences) == 0
+
+	logger.Debug("cluster deployments compared",
+		"left", left.Namespace+"/"+left.Name,
+		"right", right.Namespace+"/"+right.Name,
+		"spec_differences", len(result.SpecDifferences),
+		"service_differences", len(result.ServiceDifferences),
+		"condition_differences", len(result.ConditionDifferences),
+	)
+	return result, nil
+}
+
+func (m *Manager) getClusterDeployment(ctx context.Context, ref ClusterRef) (*unstructured.Unstructured, error) {
+	obj, err := m.dynamicClient.Resource(ClusterDeploymentsGVR).Namespace(ref.Namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("%w: cluster deployment %s/%s", ErrResourceNotFound, ref.Namespace, ref.Name)
+		}
+		return nil, fmt.Errorf("get cluster deployment %s/%s: %w", ref.Namespace, ref.Name, err)
+	}
+	return obj, nil
+}
+
+// diffSpecs diffs the specs of two ClusterDeployments with service entries keyed by name,
+// so reordered services do not show up as differences.
+func diffSpecs(left, right *unstructured.Unstructured) []FieldDifference {
+	changes := diffConfig("", comparableSpec(left), comparableSpec(right))
+	if len(changes) == 0 {
+		return nil
+	}
+	diffs := make([]FieldDifference, 0, len(changes))
+	for _, change := range changes {
+		diffs = append(diffs, FieldDifference{Path: change.Path, Left: change.Old, Right: change.New})
+	}
+	return diffs
+}
+
+func comparableSpec(obj *unstructured.Unstructured) map[string]any {
+	spec, _, _ := unstructured.NestedMap(obj.Object, "spec")
+	if spec == nil {
+		return map[string]any{}
+	}
+	spec = runtime.DeepCopyJSON(spec)
+	services, found, _ := unstructured.NestedSlice(spec, "serviceSpec", "services")
+	if !found {
+		return spec
+	}
+	byName := make(map[string]any, len(services))
+	for i, entry := range services {
+		service, ok := entry.(map[string]any)
+		if !ok {
+			continue
+		}
+		key := asString(service["name"])
+		if key == "" {
+			key = fmt.Sprintf("[%d]", i)
+		}
+		byName[key] = service
+	}
+	_ = unstructured.SetNestedField(spec, byName, "serviceSpec", "services")
+	return spec
+}
+
+func diffServiceStatuses(left, right []ServiceStatusSummary) []ServiceDifference {
+	leftByName := make(map[string]ServiceStatusSummary, len(left))
+	for _, svc := range left {
+		leftByName[svc.Name] = svc
+	}
+	rightByName := make(map[string]ServiceStatusSummary, len(right))
+	for _, svc := range right {
+		rightByName[svc.Name] = svc
+	}
+
+	var diffs []ServiceDifference
+	for _, name := range unionKeys(leftByName, rightByName) {
+		l, inLeft := leftByName[name]
+		r, inRight := rightByName[name]
+		if inLeft && inRight && l.State == r.State && l.Template == r.Template && l.Version == r.Version {
+			continue
+		}
+		diff := ServiceDifference{Name: name}
+		if inLeft {
+			diff.Left = &l
+		}
+		if inRight {
+			diff.Right = &r
+		}
+		diffs = append(diffs, diff)
+	}
+	return diffs
+}
+
+func diffConditions(left, right []ConditionSummary) []ConditionDifference {
+	leftByType := make(map[string]ConditionSummary, len(left))
+	for _, cond := range left {
+		leftByType[cond.Type] = cond
+	}
+	rightByType := make(map[string]ConditionSummary, len(right))
+	for _, cond := range right {
+		rightByType[cond.Type] = cond
+	}
+
+	var diffs []ConditionDifference
+	for _, condType := range unionKeys(leftByType, rightByType) {
+		l, inLeft := leftByType[condType]
+		r, inRight := rightByType[condType]
+		if inLeft && inRight && l.Status == r.Status && l.Reason == r.Reason {
+			continue
+		}
+		diff := ConditionDifference{Type: condType}
+		if inLeft {
+			diff.Left = &l
+		}
+		if inRight {
+			diff.Right = &r
+		}
+		diffs = append(diffs, diff)
+	}
+	return diffs
+}
+
+// unionKeys returns the sorted keys present in either map.
+func unionKeys[V any](a, b map[string]V) []string {
+	seen := make(map[string]struct{}, len(a)+len(b))
+	for k := range a {
+		seen[k] = struct{}{}
+	}
+	for k := range b {
+		seen[k] = struct{}{}
+	}
+	keys := make([]string, 0, len(seen))
+	for k := range seen {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
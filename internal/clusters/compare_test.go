@@ -0,0 +1,102 @@
+package clusters
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic/fake"
+)
+
+func newCompareTestDeployment(name, region, ingressReplicas, readyStatus, ingressState string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "k0rdent.mirantis.com/v1beta1",
+		"kind":       "ClusterDeployment",
+		"metadata":   map[string]interface{}{"name": name, "namespace": "kcm-system"},
+		"spec": map[string]interface{}{
+			"template":   "aws-standalone-cp-1-0-0",
+			"credential": "aws-cred",
+			"config":     map[string]interface{}{"region": region},
+			"serviceSpec": map[string]interface{}{
+				"services": []interface{}{
+					map[string]interface{}{"name": "minio", "template": "minio-1-0-0"},
+					map[string]interface{}{"name": "ingress", "template": "ingress-nginx-4-11-0", "values": "replicaCount: " + ingressReplicas},
+				},
+			},
+		},
+		"status": map[string]interface{}{
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "Ready", "status": readyStatus, "reason": "Reconciled"},
+			},
+			"services": []interface{}{
+				map[string]interface{}{"name": "ingress", "template": "ingress-nginx-4-11-0", "state": ingressState},
+			},
+		},
+	}}
+}
+
+func TestCompareClusters(t *testing.T) {
+	left := newCompareTestDeployment("works", "us-west-2", "2", "True", "Deployed")
+	right := newCompareTestDeployment("broken", "us-east-1", "3", "False", "Failed")
+	// Reordered services must not be reported as a difference
+	services, _, _ := unstructured.NestedSlice(right.Object, "spec", "serviceSpec", "services")
+	_ = unstructured.SetNestedSlice(right.Object, []interface{}{services[1], services[0]}, "spec", "serviceSpec", "services")
+
+	manager := &Manager{dynamicClient: fake.NewSimpleDynamicClient(runtime.NewScheme(), left, right), logger: slog.Default()}
+
+	result, err := manager.CompareClusters(context.Background(), ClusterRef{Namespace: "kcm-system", Name: "works"}, ClusterRef{Namespace: "kcm-system", Name: "broken"})
+	if err != nil {
+		t.Fatalf("CompareClusters returned error: %v", err)
+	}
+	if result.Identical {
+		t.Fatal("expected clusters to differ")
+	}
+
+	paths := make(map[string]FieldDifference)
+	for _, diff := range result.SpecDifferences {
+		paths[diff.Path] = diff
+	}
+	if len(paths) != 2 {
+		t.Fatalf("expected 2 spec differences, got %+v", result.SpecDifferences)
+	}
+	if diff := paths["config.region"]; diff.Left != "us-west-2" || diff.Right != "us-east-1" {
+		t.Errorf("unexpected region difference: %+v", diff)
+	}
+	if _, ok := paths["serviceSpec.services.ingress.values"]; !ok {
+		t.Errorf("expected ingress values difference keyed by service name, got %+v", result.SpecDifferences)
+	}
+
+	if len(result.ServiceDifferences) != 1 || result.ServiceDifferences[0].Right.State != "Failed" {
+		t.Errorf("unexpected service differences: %+v", result.ServiceDifferences)
+	}
+	if len(result.ConditionDifferences) != 1 || result.ConditionDifferences[0].Type != "Ready" {
+		t.Errorf("unexpected condition differences: %+v", result.ConditionDifferences)
+	}
+}
+
+func TestCompareClusters_Identical(t *testing.T) {
+	a := newCompareTestDeployment("a", "us-west-2", "2", "True", "Deployed")
+	b := newCompareTestDeployment("b", "us-west-2", "2", "True", "Deployed")
+	manager := &Manager{dynamicClient: fake.NewSimpleDynamicClient(runtime.NewScheme(), a, b), logger: slog.Default()}
+
+	result, err := manager.CompareClusters(context.Background(), ClusterRef{Namespace: "kcm-system", Name: "a"}, ClusterRef{Namespace: "kcm-system", Name: "b"})
+	if err != nil {
+		t.Fatalf("CompareClusters returned error: %v", err)
+	}
+	if !result.Identical {
+		t.Fatalf("expected identical clusters, got %+v", result)
+	}
+}
+
+func TestCompareClusters_NotFound(t *testing.T) {
+	a := newCompareTestDeployment("a", "us-west-2", "2", "True", "Deployed")
+	manager := &Manager{dynamicClient: fake.NewSimpleDynamicClient(runtime.NewScheme(), a), logger: slog.Default()}
+
+	_, err := manager.CompareClusters(context.Background(), ClusterRef{Namespace: "kcm-system", Name: "a"}, ClusterRef{Namespace: "kcm-system", Name: "missing"})
+	if !errors.Is(err, ErrResourceNotFound) {
+		t.Fatalf("expected ErrResourceNotFound, got %v", err)
+	}
+}
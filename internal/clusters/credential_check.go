@@ -0,0 +1,176 @@
+package clusters
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/k0rdent/mcp-k0rdent-server/internal/logging"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// identityResource locates a ClusterIdentity kind referenced by a Credential.
+type identityResource struct {
+	gvr        schema.GroupVersionResource
+	namespaced bool
+}
+
+// identityResources maps identityRef kinds to the resources holding them. Kinds not listed
+// here are reported without an identity check.
+var identityResources = map[string]identityResource{
+	"AWSClusterStaticIdentity":     {gvr: schema.GroupVersionResource{Group: "infrastructure.cluster.x-k8s.io", Version: "v1beta2", Resource: "awsclusterstaticidentities"}},
+	"AWSClusterRoleIdentity":       {gvr: schema.GroupVersionResource{Group: "infrastructure.cluster.x-k8s.io", Version: "v1beta2", Resource: "awsclusterroleidentities"}},
+	"AWSClusterControllerIdentity": {gvr: schema.GroupVersionResource{Group: "infrastructure.cluster.x-k8s.io", Version: "v1beta2", Resource: "awsclustercontrolleridentities"}},
+	"AzureClusterIdentity":         {gvr: schema.GroupVersionResource{Group: "infrastructure.cluster.x-k8s.io", Version: "v1beta1", Resource: "azureclusteridentities"}, namespaced: true},
+	"VSphereClusterIdentity":       {gvr: schema.GroupVersionResource{Group: "infrastructure.cluster.x-k8s.io", Version: "v1beta1", Resource: "vsphereclusteridentities"}},
+	"Secret":                       {gvr: SecretsGVR, namespaced: true},
+}
+
+// CredentialCheck reports whether a Credential appears usable for deployments, from its own
+// Ready condition and the state of the identity it references.
+type CredentialCheck struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	Provider  string `json:"provider,omitempty"`
+	// Usable is true when the Credential is ready and its identity exists and is not
+	// reported as failing
+	Usable bool `json:"usable"`
+	// Ready is the status of the Credential's Ready condition
+	Ready   bool   `json:"ready"`
+	Message string `json:"message,omitempty"`
+	// Identity is the referenced identity; nil when the Credential has no identityRef
+	Identity *IdentityCheck `json:"identity,omitempty"`
+	// Problems lists the reasons the credential is not usable
+	Problems []string `json:"problems,omitempty"`
+}
+
+// IdentityCheck is the state of the identity a Credential references.
+type IdentityCheck struct {
+	Kind      string `json:"kind"`
+	Name      string `json:"name"`
+	Namespace string `json:"namespace,omitempty"`
+	// Checked is false for identity kinds the server cannot look up
+	Checked bool `json:"checked"`
+	Found   bool `json:"found"`
+	// Ready is the identity's validation status where the provider exposes one (a Ready
+	// condition or status.ready); nil when it does not
+	Ready   *bool  `json:"ready,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// CheckCredential inspects a Credential and the identity it references without deploying
+// anything. A missing Credential is returned as ErrResourceNotFound; problems with the
+// identity are reported in the result.
+func (m *Manager) CheckCredential(ctx context.Context, namespace, name string) (CredentialCheck, error) {
+	logger := logging.WithContext(ctx, m.logger)
+
+	if name == "" {
+		return CredentialCheck{}, fmt.Errorf("%w: name is required", ErrInvalidRequest)
+	}
+	cred, err := m.dynamicClient.Resource(CredentialsGVR).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return CredentialCheck{}, fmt.Errorf("%w: credential %s/%s", ErrResourceNotFound, namespace, name)
+		}
+		return CredentialCheck{}, fmt.Errorf("get credential %s/%s: %w", namespace, name, err)
+	}
+
+	summary, _ := m.credentialToSummary(cred)
+	check := CredentialCheck{
+		Name:      name,
+		Namespace: namespace,
+		Provider:  summary.Provider,
+		Ready:     summary.Ready,
+	}
+	if ready, message, found := readyCondition(cred); found && !ready {
+		check.Message = message
+	}
+	if !check.Ready {
+		problem := "credential is not ready"
+		if check.Message != "" {
+			problem += ": " + check.Message
+		}
+		check.Problems = append(check.Problems, problem)
+	}
+
+	identityName, identityNamespace, kind, ok := extractIdentityRef(cred)
+	if !ok {
+		check.Problems = append(check.Problems, "credential has no spec.identityRef")
+	} else {
+		identity, err := m.checkIdentity(ctx, kind, identityName, identityNamespace)
+		if err != nil {
+			return CredentialCheck{}, err
+		}
+		check.Identity = &identity
+		switch {
+		case !identity.Checked:
+		case !identity.Found:
+			check.Problems = append(check.Problems, fmt.Sprintf("identity %s %s not found", kind, identityName))
+		case identity.Ready != nil && !*identity.Ready:
+			problem := fmt.Sprintf("identity %s %s is not ready", kind, identityName)
+			if identity.Message != "" {
+				problem += ": " + identity.Message
+			}
+			check.Problems = append(check.Problems, problem)
+		}
+	}
+	check.Usable = len(check.Problems) == 0
+
+	logger.Debug("credential checked",
+		"name", name,
+		"namespace", namespace,
+		"usable", check.Usable,
+		"problems", len(check.Problems),
+	)
+	return check, nil
+}
+
+// checkIdentity looks up a referenced identity. Errors other than NotFound are returned;
+// a missing CRD for a known kind is reported as not found.
+func (m *Manager) checkIdentity(ctx context.Context, kind, name, namespace string) (IdentityCheck, error) {
+	identity := IdentityCheck{Kind: kind, Name: name}
+	resource, known := identityResources[kind]
+	if !known {
+		return identity, nil
+	}
+	identity.Checked = true
+
+	client := m.dynamicClient.Resource(resource.gvr)
+	var obj *unstructured.Unstructured
+	var err error
+	if resource.namespaced {
+		identity.Namespace = namespace
+		obj, err = client.Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+	} else {
+		obj, err = client.Get(ctx, name, metav1.GetOptions{})
+	}
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return identity, nil
+		}
+		return IdentityCheck{}, fmt.Errorf("get identity %s %s: %w", kind, name, err)
+	}
+	identity.Found = true
+
+	if ready, message, found := readyCondition(obj); found {
+		identity.Ready = &ready
+		if !ready {
+			identity.Message = message
+		}
+	} else if ready, found, _ := unstructured.NestedBool(obj.Object, "status", "ready"); found {
+		identity.Ready = &ready
+	}
+	return identity, nil
+}
+
+// readyCondition returns the status and message of an object's Ready condition.
+func readyCondition(obj *unstructured.Unstructured) (ready bool, message string, found bool) {
+	for _, cond := range extractConditions(obj) {
+		if cond.Type == "Ready" {
+			return cond.Status == "True", cond.Message, true
+		}
+	}
+	return false, "", false
+}
@@ -0,0 +1,108 @@
+package clusters
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic/fake"
+)
+
+func newCheckedCredential(namespace, name, identityKind, identityName, readyStatus, message string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "k0rdent.mirantis.com/v1beta1",
+		"kind":       "Credential",
+		"metadata": map[string]interface{}{
+			"name":      name,
+			"namespace": namespace,
+		},
+		"spec": map[string]interface{}{},
+	}}
+	if identityKind != "" {
+		_ = unstructured.SetNestedMap(obj.Object, map[string]interface{}{
+			"apiVersion": "infrastructure.cluster.x-k8s.io/v1beta1",
+			"kind":       identityKind,
+			"name":       identityName,
+		}, "spec", "identityRef")
+	}
+	if readyStatus != "" {
+		_ = unstructured.SetNestedSlice(obj.Object, []interface{}{
+			map[string]interface{}{"type": "Ready", "status": readyStatus, "message": message},
+		}, "status", "conditions")
+	}
+	return obj
+}
+
+func newAzureIdentity(namespace, name, readyStatus, message string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "infrastructure.cluster.x-k8s.io/v1beta1",
+		"kind":       "AzureClusterIdentity",
+		"metadata": map[string]interface{}{
+			"name":      name,
+			"namespace": namespace,
+		},
+	}}
+	_ = unstructured.SetNestedSlice(obj.Object, []interface{}{
+		map[string]interface{}{"type": "Ready", "status": readyStatus, "message": message},
+	}, "status", "conditions")
+	return obj
+}
+
+func TestCheckCredential(t *testing.T) {
+	client := fake.NewSimpleDynamicClient(runtime.NewScheme(),
+		newCheckedCredential("kcm-system", "azure-good", "AzureClusterIdentity", "azure-id", "True", ""),
+		newAzureIdentity("kcm-system", "azure-id", "True", ""),
+		newCheckedCredential("kcm-system", "azure-bad-identity", "AzureClusterIdentity", "azure-broken", "True", ""),
+		newAzureIdentity("kcm-system", "azure-broken", "False", "client secret expired"),
+		newCheckedCredential("kcm-system", "azure-missing-identity", "AzureClusterIdentity", "gone", "True", ""),
+		newCheckedCredential("kcm-system", "not-ready", "Secret", "openstack-cloud-config", "False", "secret not found"),
+		newCheckedCredential("kcm-system", "unknown-kind", "ExampleClusterIdentity", "example", "True", ""),
+	)
+	manager := &Manager{dynamicClient: client, logger: slog.Default()}
+
+	tests := []struct {
+		name         string
+		credential   string
+		wantUsable   bool
+		wantProblem  string
+		wantChecked  bool
+		wantIdentity bool
+	}{
+		{name: "ready credential and identity", credential: "azure-good", wantUsable: true, wantChecked: true, wantIdentity: true},
+		{name: "identity not ready", credential: "azure-bad-identity", wantProblem: "client secret expired", wantChecked: true, wantIdentity: true},
+		{name: "identity missing", credential: "azure-missing-identity", wantProblem: "not found", wantChecked: true},
+		{name: "credential not ready", credential: "not-ready", wantProblem: "credential is not ready: secret not found", wantChecked: true},
+		{name: "unknown identity kind", credential: "unknown-kind", wantUsable: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			check, err := manager.CheckCredential(context.Background(), "kcm-system", tt.credential)
+			if err != nil {
+				t.Fatalf("CheckCredential returned error: %v", err)
+			}
+			if check.Usable != tt.wantUsable {
+				t.Errorf("expected usable=%v, got %+v", tt.wantUsable, check)
+			}
+			if tt.wantProblem != "" && !strings.Contains(strings.Join(check.Problems, "; "), tt.wantProblem) {
+				t.Errorf("expected problem containing %q, got %v", tt.wantProblem, check.Problems)
+			}
+			if check.Identity == nil {
+				t.Fatalf("expected identity to be reported")
+			}
+			if check.Identity.Checked != tt.wantChecked || check.Identity.Found != tt.wantIdentity {
+				t.Errorf("unexpected identity check %+v", check.Identity)
+			}
+		})
+	}
+}
+
+func TestCheckCredentialNotFound(t *testing.T) {
+	manager := &Manager{dynamicClient: fake.NewSimpleDynamicClient(runtime.NewScheme()), logger: slog.Default()}
+	if _, err := manager.CheckCredential(context.Background(), "kcm-system", "missing"); !errors.Is(err, ErrResourceNotFound) {
+		t.Fatalf("expected ErrResourceNotFound, got %v", err)
+	}
+}
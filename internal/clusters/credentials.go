@@ -2,11 +2,12 @@ package clusters
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sort"
 
 	"github.com/k0rdent/mcp-k0rdent-server/internal/logging"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -31,19 +32,19 @@ func (m *Manager) ListCredentials(ctx context.Context, namespaces []string) ([]C
 		return []CredentialSummary{}, nil
 	}
 
-	var summaries []CredentialSummary
+	perNamespace := make([][]CredentialSummary, len(namespaces))
 
-	// Query each namespace
-	for _, ns := range namespaces {
+	// Query namespaces concurrently; results are merged in namespace order below
+	errs := ForEachNamespace(ctx, namespaces, m.namespaceConcurrency, func(ctx context.Context, i int, ns string) error {
 		logger.Debug("listing credentials in namespace", "namespace", ns)
 
-		list, err := m.dynamicClient.Resource(CredentialsGVR).Namespace(ns).List(ctx, metav1.ListOptions{})
+		list, err := m.listWithRetry(ctx, CredentialsGVR, ns)
 		if err != nil {
 			logger.Error("failed to list credentials in namespace",
 				"namespace", ns,
 				"error", err,
 			)
-			return nil, fmt.Errorf("list credentials in namespace %s: %w", ns, err)
+			return fmt.Errorf("list credentials in namespace %s: %w", ns, err)
 		}
 
 		logger.Debug("found credentials in namespace",
@@ -62,8 +63,20 @@ func (m *Manager) ListCredentials(ctx context.Context, namespaces []string) ([]C
 				)
 				continue
 			}
-			summaries = append(summaries, summary)
+			perNamespace[i] = append(perNamespace[i], summary)
 		}
+		return nil
+	})
+
+	var summaries []CredentialSummary
+	for _, items := range perNamespace {
+		summaries = append(summaries, items...)
+	}
+
+	err := collectNamespaceErrors(namespaces, errs)
+	var partial *PartialError
+	if err != nil && !errors.As(err, &partial) {
+		return nil, err
 	}
 
 	logger.Info("credentials listed",
@@ -71,7 +84,37 @@ func (m *Manager) ListCredentials(ctx context.Context, namespaces []string) ([]C
 		"namespace_count", len(namespaces),
 	)
 
-	return summaries, nil
+	return summaries, err
+}
+
+// ListCredentialsPage returns one page of credential summaries across namespaces and the
+// continue token for the next page. A zero opts.Limit lists everything like ListCredentials.
+func (m *Manager) ListCredentialsPage(ctx context.Context, namespaces []string, opts ListOptions) ([]CredentialSummary, string, error) {
+	if opts.Limit == 0 && opts.Continue == "" {
+		summaries, err := m.ListCredentials(ctx, namespaces)
+		return summaries, "", err
+	}
+
+	items, next, err := m.listPage(ctx, CredentialsGVR, namespaces, opts)
+	var partial *PartialError
+	if err != nil && !errors.As(err, &partial) {
+		return nil, "", err
+	}
+
+	summaries := make([]CredentialSummary, 0, len(items))
+	for i := range items {
+		summary, convErr := m.credentialToSummary(&items[i])
+		if convErr != nil {
+			logging.WithContext(ctx, m.logger).Warn("failed to convert credential to summary",
+				"namespace", items[i].GetNamespace(),
+				"name", items[i].GetName(),
+				"error", convErr,
+			)
+			continue
+		}
+		summaries = append(summaries, summary)
+	}
+	return summaries, next, err
 }
 
 // ListIdentities aggregates ClusterIdentity references from credentials, showing which credentials reference each identity.
@@ -84,18 +127,32 @@ func (m *Manager) ListIdentities(ctx context.Context, namespaces []string) ([]Id
 		return []IdentitySummary{}, nil
 	}
 
-	identityMap := make(map[string]*IdentitySummary)
-
-	for _, ns := range namespaces {
+	lists := make([][]unstructured.Unstructured, len(namespaces))
+	errs := ForEachNamespace(ctx, namespaces, m.namespaceConcurrency, func(ctx context.Context, i int, ns string) error {
 		logger.Debug("listing credentials for identity extraction", "namespace", ns)
 
-		list, err := m.dynamicClient.Resource(CredentialsGVR).Namespace(ns).List(ctx, metav1.ListOptions{})
+		list, err := m.listWithRetry(ctx, CredentialsGVR, ns)
 		if err != nil {
-			logger.Error("failed to list credentials while building identities", "namespace", ns, "error", err)
-			return nil, fmt.Errorf("list credentials in namespace %s: %w", ns, err)
+			if apierrors.IsForbidden(err) {
+				logger.Warn("skipping namespace without credential access", "namespace", ns, "error", err)
+			} else {
+				logger.Error("failed to list credentials while building identities", "namespace", ns, "error", err)
+			}
+			return fmt.Errorf("list credentials in namespace %s: %w", ns, err)
 		}
+		lists[i] = list.Items
+		return nil
+	})
 
-		for _, item := range list.Items {
+	err := collectNamespaceErrors(namespaces, errs)
+	var partial *PartialError
+	if err != nil && !errors.As(err, &partial) {
+		return nil, err
+	}
+
+	identityMap := make(map[string]*IdentitySummary)
+	for _, items := range lists {
+		for _, item := range items {
 			name, identityNS, kind, ok := extractIdentityRef(&item)
 			if !ok {
 				continue
@@ -134,7 +191,7 @@ func (m *Manager) ListIdentities(ctx context.Context, namespaces []string) ([]Id
 		"namespace_count", len(namespaces),
 	)
 
-	return results, nil
+	return results, err
 }
 
 // credentialToSummary extracts key fields from a Credential CR into a CredentialSummary.
@@ -161,6 +218,16 @@ func (m *Manager) credentialToSummary(obj *unstructured.Unstructured) (Credentia
 		}
 	}
 
+	// Secret-backed identities (OpenStack, GCP) carry no provider in their kind; fall back
+	// to the conventional identity name prefix, e.g. "openstack-cloud-config"
+	if summary.Provider == "" {
+		if identityName, _, _ := unstructured.NestedString(obj.Object, "spec", "identityRef", "name"); identityName != "" {
+			if provider := DetectProvider(identityName); provider != ProviderUnknown {
+				summary.Provider = string(provider)
+			}
+		}
+	}
+
 	// Check readiness from status.conditions
 	summary.Ready = IsResourceReady(obj)
 
@@ -184,6 +251,9 @@ func normalizeProviderKind(kind string) string {
 		return "vsphere"
 	case "GCPClusterIdentity":
 		return "gcp"
+	case "Secret":
+		// Secrets are shared by several providers and say nothing about which one
+		return ""
 	}
 
 	// Generic fallback: lowercase the prefix before "Cluster"
@@ -345,6 +345,57 @@ func TestListCredentials_ProviderLabels(t *testing.T) {
 	}
 }
 
+func TestListCredentials_SecretIdentityProvider(t *testing.T) {
+	tests := []struct {
+		name             string
+		identityName     string
+		labels           map[string]string
+		expectedProvider string
+	}{
+		{name: "openstack secret", identityName: "openstack-cloud-config", expectedProvider: "openstack"},
+		{name: "gcp secret", identityName: "gcp-cloud-sa", expectedProvider: "gcp"},
+		{
+			name:             "label wins over identity name",
+			identityName:     "openstack-cloud-config",
+			labels:           map[string]string{"k0rdent.mirantis.com/provider": "custom"},
+			expectedProvider: "custom",
+		},
+		{name: "unrecognised secret name", identityName: "cloud-config", expectedProvider: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cred := createTestCredential("test-cred", "kcm-system", tt.labels)
+			cred.Object["spec"] = map[string]interface{}{
+				"identityRef": map[string]interface{}{
+					"apiVersion": "v1",
+					"kind":       "Secret",
+					"name":       tt.identityName,
+					"namespace":  "kcm-system",
+				},
+			}
+
+			client := fake.NewSimpleDynamicClient(runtime.NewScheme(), cred)
+			manager := &Manager{
+				dynamicClient:   client,
+				globalNamespace: "kcm-system",
+				logger:          slog.Default(),
+			}
+
+			credentials, err := manager.ListCredentials(context.Background(), []string{"kcm-system"})
+			if err != nil {
+				t.Fatalf("ListCredentials returned error: %v", err)
+			}
+			if len(credentials) != 1 {
+				t.Fatalf("expected 1 credential, got %d", len(credentials))
+			}
+			if credentials[0].Provider != tt.expectedProvider {
+				t.Errorf("expected provider %q, got %q", tt.expectedProvider, credentials[0].Provider)
+			}
+		})
+	}
+}
+
 // createTestCredential creates a test Credential unstructured object
 func createTestCredential(name, namespace string, labels map[string]string) *unstructured.Unstructured {
 	cred := &unstructured.Unstructured{
@@ -11,7 +11,8 @@ import (
 
 // DeleteCluster removes a ClusterDeployment resource using foreground propagation.
 // This ensures finalizers execute properly and child resources are cleaned up.
-// Returns idempotent result - success even if resource is already deleted.
+// Returns idempotent result - success even if resource is already deleted. The result's
+// Outcome and Initiated fields tell callers whether the request had any effect.
 func (m *Manager) DeleteCluster(ctx context.Context, namespace, name string) (DeleteResult, error) {
 	logger := logging.WithContext(ctx, m.logger)
 	logger.Info("deleting cluster",
@@ -30,7 +31,7 @@ func (m *Manager) DeleteCluster(ctx context.Context, namespace, name string) (De
 	}
 
 	// Check if resource exists
-	_, err := m.dynamicClient.Resource(ClusterDeploymentsGVR).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+	existing, err := m.dynamicClient.Resource(ClusterDeploymentsGVR).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
 	if err != nil {
 		// Check if error is NotFound - this is OK (idempotent)
 		if isNotFoundError(err) {
@@ -42,6 +43,7 @@ func (m *Manager) DeleteCluster(ctx context.Context, namespace, name string) (De
 				Name:      name,
 				Namespace: namespace,
 				Status:    "not_found",
+				Outcome:   DeleteOutcomeNotFound,
 			}, nil
 		}
 
@@ -76,6 +78,7 @@ func (m *Manager) DeleteCluster(ctx context.Context, namespace, name string) (De
 				Name:      name,
 				Namespace: namespace,
 				Status:    "not_found",
+				Outcome:   DeleteOutcomeAlreadyAbsent,
 			}, nil
 		}
 
@@ -87,16 +90,20 @@ func (m *Manager) DeleteCluster(ctx context.Context, namespace, name string) (De
 		return DeleteResult{}, fmt.Errorf("delete cluster deployment: %w", err)
 	}
 
+	// A deletion timestamp means an earlier request already started the deletion
 	result := DeleteResult{
 		Name:      name,
 		Namespace: namespace,
 		Status:    "deleted",
+		Outcome:   DeleteOutcomeDeleting,
+		Initiated: existing.GetDeletionTimestamp() == nil,
 	}
 
 	logger.Info("cluster deletion initiated",
 		"name", result.Name,
 		"namespace", result.Namespace,
 		"status", result.Status,
+		"initiated", result.Initiated,
 	)
 
 	return result, nil
@@ -7,9 +7,12 @@ import (
 	"strings"
 	"testing"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/dynamic/fake"
+	clienttesting "k8s.io/client-go/testing"
 )
 
 // TestDeleteCluster_Success tests successful cluster deletion
@@ -49,6 +52,10 @@ func TestDeleteCluster_Idempotency(t *testing.T) {
 		t.Errorf("expected status 'not_found', got %q", result.Status)
 	}
 
+	if result.Outcome != DeleteOutcomeNotFound || result.Initiated {
+		t.Errorf("expected outcome NotFound without initiation, got %q (initiated=%v)", result.Outcome, result.Initiated)
+	}
+
 	if result.Name != "nonexistent-cluster" {
 		t.Errorf("expected name 'nonexistent-cluster', got %q", result.Name)
 	}
@@ -375,3 +382,53 @@ func createTestClusterDeployment(name, namespace string, labels map[string]strin
 
 	return deployment
 }
+
+// TestDeleteCluster_Outcomes tests that the result distinguishes whether the request had an effect
+func TestDeleteCluster_Outcomes(t *testing.T) {
+	newCD := func(deleting bool) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "k0rdent.mirantis.com/v1beta1",
+			"kind":       "ClusterDeployment",
+			"metadata":   map[string]interface{}{"name": "demo", "namespace": "team-a"},
+		}}
+		if deleting {
+			now := metav1.Now()
+			obj.SetDeletionTimestamp(&now)
+			obj.SetFinalizers([]string{"k0rdent.mirantis.com/cleanup"})
+		}
+		return obj
+	}
+
+	tests := []struct {
+		name          string
+		deleting      bool
+		goneOnDelete  bool
+		wantOutcome   DeleteOutcome
+		wantInitiated bool
+	}{
+		{name: "initiated", wantOutcome: DeleteOutcomeDeleting, wantInitiated: true},
+		{name: "already deleting", deleting: true, wantOutcome: DeleteOutcomeDeleting},
+		{name: "removed concurrently", goneOnDelete: true, wantOutcome: DeleteOutcomeAlreadyAbsent},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := fake.NewSimpleDynamicClient(runtime.NewScheme(), newCD(tt.deleting))
+			client.PrependReactor("delete", "clusterdeployments", func(clienttesting.Action) (bool, runtime.Object, error) {
+				if tt.goneOnDelete {
+					return true, nil, apierrors.NewNotFound(ClusterDeploymentsGVR.GroupResource(), "demo")
+				}
+				return true, nil, nil
+			})
+			manager := &Manager{dynamicClient: client, logger: slog.Default()}
+
+			result, err := manager.DeleteCluster(context.Background(), "team-a", "demo")
+			if err != nil {
+				t.Fatalf("DeleteCluster returned error: %v", err)
+			}
+			if result.Outcome != tt.wantOutcome || result.Initiated != tt.wantInitiated {
+				t.Errorf("expected outcome %q (initiated=%v), got %q (initiated=%v)", tt.wantOutcome, tt.wantInitiated, result.Outcome, result.Initiated)
+			}
+		})
+	}
+}
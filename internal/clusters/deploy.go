@@ -3,11 +3,15 @@ package clusters
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/k0rdent/mcp-k0rdent-server/internal/logging"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
 var (
@@ -44,6 +48,10 @@ func (m *Manager) DeployCluster(ctx context.Context, namespace string, req Deplo
 		logger.Warn("credential is required")
 		return DeployResult{}, fmt.Errorf("%w: credential is required", ErrInvalidRequest)
 	}
+	if err := validateObjectMetadata(req.Labels, req.Annotations); err != nil {
+		logger.Warn("invalid labels or annotations", "error", err)
+		return DeployResult{}, err
+	}
 
 	// Resolve template reference (namespace/name or name)
 	templateNS, templateName, err := m.ResolveResourceNamespace(ctx, req.Template, namespace)
@@ -114,6 +122,10 @@ func (m *Manager) DeployCluster(ctx context.Context, namespace string, req Deplo
 			errorMsg = FormatAzureValidationError(validationResult.Errors)
 		case ProviderGCP:
 			errorMsg = FormatGCPValidationError(validationResult.Errors)
+		case ProviderVSphere:
+			errorMsg = FormatVSphereValidationError(validationResult.Errors)
+		case ProviderOpenStack:
+			errorMsg = FormatOpenStackValidationError(validationResult.Errors)
 		default:
 			// Generic format for unknown providers (shouldn't reach here)
 			errorMsg = "Configuration validation failed"
@@ -203,22 +215,48 @@ func (m *Manager) buildClusterDeployment(
 	}
 
 	// Build manifest structure
+	metadata := map[string]interface{}{
+		"name":      req.Name,
+		"namespace": namespace,
+		"labels":    labels,
+	}
+	if len(req.Annotations) > 0 {
+		annotations := make(map[string]interface{}, len(req.Annotations))
+		for k, v := range req.Annotations {
+			annotations[k] = v
+		}
+		metadata["annotations"] = annotations
+	}
+
 	obj := &unstructured.Unstructured{
 		Object: map[string]interface{}{
 			"apiVersion": "k0rdent.mirantis.com/v1beta1",
 			"kind":       "ClusterDeployment",
-			"metadata": map[string]interface{}{
-				"name":      req.Name,
-				"namespace": namespace,
-				"labels":    labels,
-			},
+			"metadata":   metadata,
 			"spec": map[string]interface{}{
-				"template": templateName,
+				"template":   templateName,
 				"credential": credentialName,
-				"config": req.Config,
+				"config":     req.Config,
 			},
 		},
 	}
 
 	return obj
 }
+
+// validateObjectMetadata checks label and annotation keys and values against
+// Kubernetes syntax rules so invalid input is rejected before the apply call.
+func validateObjectMetadata(labels, annotations map[string]string) error {
+	metadataPath := field.NewPath("metadata")
+	errs := metav1validation.ValidateLabels(labels, metadataPath.Child("labels"))
+	errs = append(errs, apivalidation.ValidateAnnotations(annotations, metadataPath.Child("annotations"))...)
+	if len(errs) == 0 {
+		return nil
+	}
+
+	messages := make([]string, 0, len(errs))
+	for _, e := range errs {
+		messages = append(messages, e.Error())
+	}
+	return fmt.Errorf("%w: %s", ErrInvalidRequest, strings.Join(messages, "; "))
+}
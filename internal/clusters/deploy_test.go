@@ -2,6 +2,7 @@ package clusters
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 	"regexp"
 	"strings"
@@ -388,6 +389,73 @@ func TestDeployCluster_Labels(t *testing.T) {
 	}
 }
 
+// TestDeployCluster_InvalidMetadata tests label and annotation syntax validation
+func TestDeployCluster_InvalidMetadata(t *testing.T) {
+	manager := &Manager{
+		dynamicClient:   fake.NewSimpleDynamicClient(runtime.NewScheme()),
+		globalNamespace: "kcm-system",
+		logger:          slog.Default(),
+	}
+
+	tests := []struct {
+		name                string
+		labels              map[string]string
+		annotations         map[string]string
+		expectedErrContains string
+	}{
+		{
+			name:                "invalid label key",
+			labels:              map[string]string{"bad key": "value"},
+			expectedErrContains: "metadata.labels",
+		},
+		{
+			name:                "invalid label value",
+			labels:              map[string]string{"team": "not/valid"},
+			expectedErrContains: "metadata.labels",
+		},
+		{
+			name:                "invalid annotation key",
+			annotations:         map[string]string{"-bad": "value"},
+			expectedErrContains: "metadata.annotations",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := manager.DeployCluster(context.Background(), "kcm-system", DeployRequest{
+				Name:        "test-cluster",
+				Template:    "test-template",
+				Credential:  "test-cred",
+				Labels:      tt.labels,
+				Annotations: tt.annotations,
+			})
+			if !errors.Is(err, ErrInvalidRequest) {
+				t.Fatalf("expected ErrInvalidRequest, got %v", err)
+			}
+			if !strings.Contains(err.Error(), tt.expectedErrContains) {
+				t.Errorf("expected error containing %q, got %v", tt.expectedErrContains, err)
+			}
+		})
+	}
+}
+
+// TestBuildClusterDeployment_Annotations tests annotations are set on the manifest
+func TestBuildClusterDeployment_Annotations(t *testing.T) {
+	manager := &Manager{logger: slog.Default()}
+	obj := manager.buildClusterDeployment(DeployRequest{
+		Name:        "test-cluster",
+		Labels:      map[string]string{"group": "edge"},
+		Annotations: map[string]string{"example.com/owner": "team-a"},
+	}, "kcm-system", "kcm-system", "test-template", "kcm-system", "test-cred")
+
+	if got := obj.GetAnnotations()["example.com/owner"]; got != "team-a" {
+		t.Errorf("expected annotation example.com/owner=team-a, got %q", got)
+	}
+	if got := obj.GetLabels()["group"]; got != "edge" {
+		t.Errorf("expected label group=edge, got %q", got)
+	}
+}
+
 // TestDeployCluster_ConfigPassthrough tests config object passthrough
 func TestDeployCluster_ConfigPassthrough(t *testing.T) {
 	template := createTestClusterTemplate("test-template", "kcm-system", nil)
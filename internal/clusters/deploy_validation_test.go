@@ -416,20 +416,10 @@ func TestDeployCluster_NonCloudProviderPassthrough(t *testing.T) {
 		config       map[string]interface{}
 	}{
 		{
-			name:         "vsphere template with arbitrary config",
-			templateName: "vsphere-standalone-cp",
-			config: map[string]interface{}{
-				"datacenter": "dc1",
-				"cluster":    "cluster1",
-				"datastore":  "datastore1",
-			},
-		},
-		{
-			name:         "openstack template",
-			templateName: "openstack-standalone",
+			name:         "adopted cluster template",
+			templateName: "adopted-cluster-1-0-1",
 			config: map[string]interface{}{
-				"cloudName": "mycloud",
-				"image":     "ubuntu-22.04",
+				"clusterLabels": map[string]interface{}{"env": "dev"},
 			},
 		},
 		{
@@ -542,6 +532,40 @@ func TestDeployCluster_ValidationErrorFormat(t *testing.T) {
 				}
 			},
 		},
+		{
+			name:         "vSphere error format",
+			templateName: "vsphere-standalone-cp",
+			config:       map[string]interface{}{},
+			provider:     "vSphere",
+			checkFormat: func(t *testing.T, errMsg string) {
+				if !strings.Contains(errMsg, "vSphere cluster configuration validation failed") {
+					t.Error("missing vSphere-specific header in error message")
+				}
+				if !strings.Contains(errMsg, "Example valid vSphere configuration") {
+					t.Error("missing example configuration in error message")
+				}
+				if !strings.Contains(errMsg, "vmTemplate") {
+					t.Error("missing vSphere-specific field (vmTemplate) in example")
+				}
+			},
+		},
+		{
+			name:         "OpenStack error format",
+			templateName: "openstack-standalone-cp",
+			config:       map[string]interface{}{},
+			provider:     "OpenStack",
+			checkFormat: func(t *testing.T, errMsg string) {
+				if !strings.Contains(errMsg, "OpenStack cluster configuration validation failed") {
+					t.Error("missing OpenStack-specific header in error message")
+				}
+				if !strings.Contains(errMsg, "Example valid OpenStack configuration") {
+					t.Error("missing example configuration in error message")
+				}
+				if !strings.Contains(errMsg, "flavor") {
+					t.Error("missing OpenStack-specific field (flavor) in example")
+				}
+			},
+		},
 	}
 
 	for _, tt := range tests {
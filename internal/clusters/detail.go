@@ -0,0 +1,87 @@
+package clusters
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/k0rdent/mcp-k0rdent-server/internal/logging"
+)
+
+// ClusterDetail is the provider-agnostic view of a ClusterDeployment: the summary returned by
+// cluster listings plus, for providers with detail support (AWS, Azure, GCP), the provider
+// infrastructure detail.
+type ClusterDetail struct {
+	ClusterDeploymentSummary
+	// Provider is the provider the deployment was recognized as; empty when unknown
+	Provider ProviderType `json:"provider,omitempty"`
+	// At most one of AWS, Azure, and GCP is set, matching Provider
+	AWS   *AWSClusterDetail   `json:"aws,omitempty"`
+	Azure *AzureClusterDetail `json:"azure,omitempty"`
+	GCP   *GCPClusterDetail   `json:"gcp,omitempty"`
+	// DetailError explains why provider detail is missing for a supported provider, e.g. while
+	// the infrastructure cluster has not been created yet
+	DetailError string `json:"detailError,omitempty"`
+}
+
+// GetClusterDetail returns the summary of a ClusterDeployment and dispatches to the
+// provider-specific detail when its provider is recognized. Failing to fetch provider detail
+// does not fail the call; the error is reported in DetailError instead.
+func (m *Manager) GetClusterDetail(ctx context.Context, namespace, name string) (ClusterDetail, error) {
+	logger := logging.WithContext(ctx, m.logger)
+
+	if name == "" {
+		return ClusterDetail{}, fmt.Errorf("%w: name is required", ErrInvalidRequest)
+	}
+	cd, err := m.getClusterDeployment(ctx, ClusterRef{Namespace: namespace, Name: name})
+	if err != nil {
+		return ClusterDetail{}, err
+	}
+
+	detail := ClusterDetail{ClusterDeploymentSummary: SummarizeClusterDeployment(cd)}
+	detail.Provider = detectClusterProvider(detail.ClusterDeploymentSummary)
+
+	var detailErr error
+	switch detail.Provider {
+	case ProviderAWS:
+		aws, err := m.GetAWSClusterDetail(ctx, namespace, name)
+		if err == nil {
+			detail.AWS = &aws
+		}
+		detailErr = err
+	case ProviderAzure:
+		detail.Azure, detailErr = m.GetAzureClusterDetail(ctx, namespace, name)
+	case ProviderGCP:
+		detail.GCP, detailErr = m.GetGCPClusterDetail(ctx, namespace, name)
+	}
+	if detailErr != nil {
+		logger.Warn("provider detail unavailable",
+			"name", name,
+			"namespace", namespace,
+			"provider", detail.Provider,
+			"error", detailErr,
+		)
+		detail.DetailError = detailErr.Error()
+	}
+
+	logger.Debug("cluster detail retrieved",
+		"name", name,
+		"namespace", namespace,
+		"provider", detail.Provider,
+	)
+	return detail, nil
+}
+
+// detectClusterProvider recognizes the provider from the summary's cloud provider (the
+// provider label, or the template or credential name prefix), falling back to the template
+// name patterns used by DetectProvider.
+func detectClusterProvider(summary ClusterDeploymentSummary) ProviderType {
+	switch provider := ProviderType(strings.ToLower(summary.CloudProvider)); provider {
+	case ProviderAWS, ProviderAzure, ProviderGCP, ProviderVSphere, ProviderOpenStack:
+		return provider
+	}
+	if provider := DetectProvider(summary.TemplateRef.Name); provider != ProviderUnknown {
+		return provider
+	}
+	return ""
+}
@@ -90,14 +90,18 @@ func (m *Manager) GetAWSClusterDetail(ctx context.Context, namespace, name strin
 	// Extract AWS-specific infrastructure details
 	detail.AWS = extractAWSInfrastructure(awsClusterObj)
 
-	// Extract control plane endpoint from AWSCluster status
-	if endpoint := extractAWSControlPlaneEndpoint(awsClusterObj); endpoint != nil {
-		detail.ControlPlaneEndpoint = endpoint
-	}
+	// Extract control plane endpoint from AWSCluster spec
+	detail.ControlPlaneEndpoint = extractControlPlaneEndpoint(awsClusterObj)
 
 	// Extract provider-specific conditions from AWSCluster status
 	detail.Conditions = extractConditions(awsClusterObj)
 
+	// Extract cluster-wide tags; fall back to the ClusterDeployment config before CAPA has reconciled
+	detail.Tags = extractAWSTags(awsClusterObj.Object, "spec", "additionalTags")
+	if detail.Tags == nil {
+		detail.Tags = extractAWSTags(cdObj.Object, "spec", "config", "additionalTags")
+	}
+
 	logger.Info("AWS cluster detail retrieved",
 		"name", name,
 		"namespace", namespace,
@@ -164,6 +168,8 @@ func extractAWSVPC(obj *unstructured.Unstructured) *AWSVPC {
 		vpc.CIDR = cidr
 	}
 
+	vpc.Tags = extractAWSTags(obj.Object, "spec", "network", "vpc", "tags")
+
 	return vpc
 }
 
@@ -203,10 +209,9 @@ func extractAWSSubnets(obj *unstructured.Unstructured) []AWSSubnet {
 		}
 
 		// Infer role from tags or name
-		if tags, ok := subnetMap["tags"].(map[string]interface{}); ok {
-			if role, ok := tags["kubernetes.io/role"].(string); ok {
-				subnet.Role = role
-			}
+		subnet.Tags = extractAWSTags(subnetMap, "tags")
+		if role, ok := subnet.Tags["kubernetes.io/role"]; ok {
+			subnet.Role = role
 		}
 
 		// Only add subnets with valid IDs
@@ -218,6 +223,26 @@ func extractAWSSubnets(obj *unstructured.Unstructured) []AWSSubnet {
 	return subnets
 }
 
+// extractAWSTags returns the string tags found at the given path, or nil when there are none.
+// Non-string values are skipped rather than failing the whole map.
+func extractAWSTags(obj map[string]interface{}, fields ...string) map[string]string {
+	raw, found, err := unstructured.NestedMap(obj, fields...)
+	if err != nil || !found || len(raw) == 0 {
+		return nil
+	}
+
+	tags := make(map[string]string, len(raw))
+	for key, value := range raw {
+		if str, ok := value.(string); ok {
+			tags[key] = str
+		}
+	}
+	if len(tags) == 0 {
+		return nil
+	}
+	return tags
+}
+
 // extractAWSInternetGateway extracts internet gateway information from the AWSCluster spec.
 func extractAWSInternetGateway(obj *unstructured.Unstructured) *AWSInternetGateway {
 	igwID, found, err := unstructured.NestedString(obj.Object, "spec", "network", "internetGatewayId")
@@ -398,26 +423,3 @@ func extractAWSIAMRoles(obj *unstructured.Unstructured) []AWSIAMRole {
 
 	return roles
 }
-
-// extractAWSControlPlaneEndpoint extracts the control plane endpoint from the AWSCluster status.
-func extractAWSControlPlaneEndpoint(obj *unstructured.Unstructured) *EndpointInfo {
-	host, found, err := unstructured.NestedString(obj.Object, "spec", "controlPlaneEndpoint", "host")
-	if err != nil || !found || host == "" {
-		// Try status path
-		host, found, err = unstructured.NestedString(obj.Object, "status", "ready")
-		if err != nil || !found {
-			return nil
-		}
-	}
-
-	endpoint := &EndpointInfo{
-		Host: host,
-	}
-
-	// Extract port if available
-	if portFloat, found, err := unstructured.NestedFloat64(obj.Object, "spec", "controlPlaneEndpoint", "port"); err == nil && found {
-		endpoint.Port = int32(portFloat)
-	}
-
-	return endpoint
-}
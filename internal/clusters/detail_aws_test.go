@@ -602,3 +602,49 @@ func createTestAWSCluster(name, namespace string, labels map[string]string) *uns
 
 	return awsCluster
 }
+
+// TestGetAWSClusterDetail_Tags tests that cluster, VPC, and subnet tags are surfaced
+func TestGetAWSClusterDetail_Tags(t *testing.T) {
+	cd := createTestClusterDeployment("tagged-cluster", "kcm-system", nil)
+	unstructured.SetNestedStringMap(cd.Object, map[string]string{"cost-center": "from-config"}, "spec", "config", "additionalTags")
+
+	awsCluster := createTestAWSCluster("tagged-cluster", "kcm-system", map[string]string{})
+	unstructured.SetNestedStringMap(awsCluster.Object, map[string]string{"cost-center": "1234", "owner": "platform"}, "spec", "additionalTags")
+	unstructured.SetNestedStringMap(awsCluster.Object, map[string]string{"Name": "tagged-vpc"}, "spec", "network", "vpc", "tags")
+
+	client := fake.NewSimpleDynamicClient(runtime.NewScheme(), cd, awsCluster)
+	manager := &Manager{
+		dynamicClient:   client,
+		globalNamespace: "kcm-system",
+		logger:          slog.Default(),
+	}
+
+	detail, err := manager.GetAWSClusterDetail(context.Background(), "kcm-system", "tagged-cluster")
+	if err != nil {
+		t.Fatalf("GetAWSClusterDetail returned error: %v", err)
+	}
+
+	if detail.Tags["cost-center"] != "1234" || detail.Tags["owner"] != "platform" {
+		t.Errorf("expected AWSCluster additionalTags to take precedence, got %v", detail.Tags)
+	}
+	if detail.AWS.VPC == nil || detail.AWS.VPC.Tags["Name"] != "tagged-vpc" {
+		t.Errorf("expected VPC tags, got %+v", detail.AWS.VPC)
+	}
+	if len(detail.AWS.Subnets) == 0 || detail.AWS.Subnets[0].Tags["kubernetes.io/role"] != "control-plane" {
+		t.Errorf("expected subnet tags, got %+v", detail.AWS.Subnets)
+	}
+	if detail.AWS.Subnets[0].Role != "control-plane" {
+		t.Errorf("expected subnet role inferred from tags, got %q", detail.AWS.Subnets[0].Role)
+	}
+
+	// Without AWSCluster tags, fall back to the ClusterDeployment config
+	unstructured.RemoveNestedField(awsCluster.Object, "spec", "additionalTags")
+	manager.dynamicClient = fake.NewSimpleDynamicClient(runtime.NewScheme(), cd, awsCluster)
+	detail, err = manager.GetAWSClusterDetail(context.Background(), "kcm-system", "tagged-cluster")
+	if err != nil {
+		t.Fatalf("GetAWSClusterDetail returned error: %v", err)
+	}
+	if detail.Tags["cost-center"] != "from-config" {
+		t.Errorf("expected fallback to ClusterDeployment additionalTags, got %v", detail.Tags)
+	}
+}
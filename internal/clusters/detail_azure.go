@@ -345,24 +345,6 @@ func extractAzureSecurityGroups(azureCluster *unstructured.Unstructured) []Azure
 	return sgs
 }
 
-// extractControlPlaneEndpoint extracts control plane endpoint from AzureCluster status
-func extractControlPlaneEndpoint(azureCluster *unstructured.Unstructured) *EndpointInfo {
-	host, found, err := unstructured.NestedString(azureCluster.Object, "spec", "controlPlaneEndpoint", "host")
-	if err != nil || !found || host == "" {
-		return nil
-	}
-
-	endpoint := &EndpointInfo{
-		Host: host,
-	}
-
-	if port, found, err := unstructured.NestedInt64(azureCluster.Object, "spec", "controlPlaneEndpoint", "port"); err == nil && found {
-		endpoint.Port = int32(port)
-	}
-
-	return endpoint
-}
-
 // contains checks if a string contains a substring (case-insensitive)
 func contains(s, substr string) bool {
 	return containsSubstr(toLower(s), toLower(substr))
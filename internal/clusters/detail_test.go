@@ -0,0 +1,98 @@
+package clusters
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+)
+
+func newDetailClient(objects ...runtime.Object) *fake.FakeDynamicClient {
+	return fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		ClusterDeploymentsGVR: "ClusterDeploymentList",
+		AzureClusterGVR:       "AzureClusterList",
+		GCPClusterGVR:         "GCPClusterList",
+	}, objects...)
+}
+
+func TestGetClusterDetailDispatchesToProvider(t *testing.T) {
+	cd := createTestClusterDeployment("prod", "kcm-system", map[string]string{"k0rdent.mirantis.com/provider": "aws"})
+	_ = unstructured.SetNestedField(cd.Object, "aws-standalone-cp-1-0-0", "spec", "template")
+	_ = unstructured.SetNestedField(cd.Object, "prod-kubeconfig", "status", "kubeconfigSecret")
+	manager := &Manager{
+		dynamicClient: newDetailClient(cd, createTestAWSCluster("prod", "kcm-system", nil)),
+		logger:        slog.Default(),
+	}
+
+	detail, err := manager.GetClusterDetail(context.Background(), "kcm-system", "prod")
+	if err != nil {
+		t.Fatalf("GetClusterDetail returned error: %v", err)
+	}
+	if detail.Provider != ProviderAWS || detail.AWS == nil || detail.Azure != nil || detail.GCP != nil {
+		t.Fatalf("expected AWS detail only, got %+v", detail)
+	}
+	if detail.DetailError != "" {
+		t.Fatalf("unexpected detail error: %s", detail.DetailError)
+	}
+	if detail.Name != "prod" || detail.TemplateRef.Name != "aws-standalone-cp-1-0-0" || detail.KubeconfigSecret.Name != "prod-kubeconfig" {
+		t.Fatalf("expected common summary fields, got %+v", detail.ClusterDeploymentSummary)
+	}
+}
+
+func TestGetClusterDetailReportsMissingProviderDetail(t *testing.T) {
+	cd := createTestClusterDeployment("new", "kcm-system", nil)
+	_ = unstructured.SetNestedField(cd.Object, "azure-standalone-cp-1-0-15", "spec", "template")
+	manager := &Manager{dynamicClient: newDetailClient(cd), logger: slog.Default()}
+
+	detail, err := manager.GetClusterDetail(context.Background(), "kcm-system", "new")
+	if err != nil {
+		t.Fatalf("GetClusterDetail returned error: %v", err)
+	}
+	if detail.Provider != ProviderAzure || detail.Azure != nil {
+		t.Fatalf("expected azure provider without detail, got %+v", detail)
+	}
+	if detail.DetailError == "" {
+		t.Fatal("expected detail error while the AzureCluster does not exist")
+	}
+	if detail.Name != "new" {
+		t.Fatalf("expected summary despite missing detail, got %+v", detail.ClusterDeploymentSummary)
+	}
+}
+
+func TestGetClusterDetailUnknownProvider(t *testing.T) {
+	manager := &Manager{dynamicClient: newDetailClient(createTestClusterDeployment("edge", "kcm-system", nil)), logger: slog.Default()}
+
+	detail, err := manager.GetClusterDetail(context.Background(), "kcm-system", "edge")
+	if err != nil {
+		t.Fatalf("GetClusterDetail returned error: %v", err)
+	}
+	if detail.Provider != "" || detail.AWS != nil || detail.Azure != nil || detail.GCP != nil || detail.DetailError != "" {
+		t.Fatalf("expected summary only for unknown provider, got %+v", detail)
+	}
+
+	if _, err := manager.GetClusterDetail(context.Background(), "kcm-system", "missing"); !errors.Is(err, ErrResourceNotFound) {
+		t.Fatalf("expected ErrResourceNotFound, got %v", err)
+	}
+}
+
+func TestDetectClusterProvider(t *testing.T) {
+	tests := []struct {
+		summary ClusterDeploymentSummary
+		want    ProviderType
+	}{
+		{ClusterDeploymentSummary{CloudProvider: "GCP"}, ProviderGCP},
+		{ClusterDeploymentSummary{CloudProvider: "vsphere"}, ProviderVSphere},
+		{ClusterDeploymentSummary{CloudProvider: "custom", TemplateRef: ResourceReference{Name: "azure-hosted-cp-1-0-0"}}, ProviderAzure},
+		{ClusterDeploymentSummary{CloudProvider: "custom", TemplateRef: ResourceReference{Name: "custom-1-0-0"}}, ""},
+	}
+	for _, tt := range tests {
+		if got := detectClusterProvider(tt.summary); got != tt.want {
+			t.Errorf("detectClusterProvider(%+v) = %q, want %q", tt.summary, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package clusters
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/k0rdent/mcp-k0rdent-server/internal/logging"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var (
+	// CAPIClusterGVR is the GroupVersionResource for Cluster API Cluster objects
+	CAPIClusterGVR = schema.GroupVersionResource{
+		Group:    "cluster.x-k8s.io",
+		Version:  "v1beta1",
+		Resource: "clusters",
+	}
+)
+
+const (
+	// defaultAPIServerPort is assumed when the control plane endpoint omits a port
+	defaultAPIServerPort = 6443
+	// EndpointProbeTimeout bounds the TCP dial and TLS handshake of an endpoint probe
+	EndpointProbeTimeout = 5 * time.Second
+)
+
+// GetClusterEndpoint returns the control plane endpoint recorded on the CAPI Cluster behind
+// a ClusterDeployment and probes it with a TCP dial and TLS handshake. An endpoint that is
+// not yet assigned or does not answer is reported in the result, not as an error.
+func (m *Manager) GetClusterEndpoint(ctx context.Context, namespace, name string) (ClusterEndpoint, error) {
+	logger := logging.WithContext(ctx, m.logger)
+
+	if _, err := m.dynamicClient.Resource(ClusterDeploymentsGVR).Namespace(namespace).Get(ctx, name, metav1.GetOptions{}); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ClusterEndpoint{}, fmt.Errorf("cluster deployment %s not found in namespace %s", name, namespace)
+		}
+		return ClusterEndpoint{}, fmt.Errorf("fetch cluster deployment: %w", err)
+	}
+
+	result := ClusterEndpoint{Name: name, Namespace: namespace, CheckedAt: time.Now().UTC()}
+
+	// The CAPI Cluster is named after the ClusterDeployment
+	capiCluster, err := m.dynamicClient.Resource(CAPIClusterGVR).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			result.Error = "CAPI Cluster not found; the cluster may not be provisioned yet"
+			return result, nil
+		}
+		return ClusterEndpoint{}, fmt.Errorf("fetch CAPI cluster: %w", err)
+	}
+
+	result.Endpoint = extractControlPlaneEndpoint(capiCluster)
+	if result.Endpoint == nil {
+		result.Error = "control plane endpoint not assigned yet"
+		return result, nil
+	}
+
+	address := net.JoinHostPort(result.Endpoint.Host, strconv.Itoa(int(result.Endpoint.Port)))
+	result.URL = "https://" + address
+	latency, err := probeEndpoint(ctx, address, EndpointProbeTimeout)
+	if err != nil {
+		result.Error = err.Error()
+	} else {
+		result.Reachable = true
+		result.LatencyMs = latency.Milliseconds()
+	}
+
+	logger.Debug("cluster endpoint probed",
+		"name", name,
+		"namespace", namespace,
+		"url", result.URL,
+		"reachable", result.Reachable,
+	)
+	return result, nil
+}
+
+// extractControlPlaneEndpoint reads spec.controlPlaneEndpoint from a CAPI Cluster or an
+// infrastructure cluster (AWSCluster, AzureCluster), which share the field under the CAPI
+// contract, defaulting the port to 6443 when it is not set.
+func extractControlPlaneEndpoint(obj *unstructured.Unstructured) *EndpointInfo {
+	host, _, _ := unstructured.NestedString(obj.Object, "spec", "controlPlaneEndpoint", "host")
+	if host == "" {
+		return nil
+	}
+
+	endpoint := &EndpointInfo{Host: host, Port: defaultAPIServerPort}
+	switch port := nestedValue(obj.Object, "spec", "controlPlaneEndpoint", "port").(type) {
+	case int64:
+		endpoint.Port = int32(port)
+	case float64:
+		endpoint.Port = int32(port)
+	}
+	if endpoint.Port == 0 {
+		endpoint.Port = defaultAPIServerPort
+	}
+	return endpoint
+}
+
+func nestedValue(obj map[string]interface{}, fields ...string) interface{} {
+	value, found, err := unstructured.NestedFieldNoCopy(obj, fields...)
+	if err != nil || !found {
+		return nil
+	}
+	return value
+}
+
+// probeEndpoint dials address and completes a TLS handshake, returning the elapsed time.
+// Certificates are not verified: the probe only establishes that the API server answers.
+func probeEndpoint(ctx context.Context, address string, timeout time.Duration) (time.Duration, error) {
+	start := time.Now()
+	dialer := &tls.Dialer{
+		NetDialer: &net.Dialer{Timeout: timeout},
+		Config:    &tls.Config{InsecureSkipVerify: true},
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return 0, fmt.Errorf("probe %s: %w", address, err)
+	}
+	_ = conn.Close()
+	return time.Since(start), nil
+}
@@ -0,0 +1,93 @@
+package clusters
+
+import (
+	"context"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic/fake"
+)
+
+func newEndpointTestObjects(host string, port int64) []runtime.Object {
+	cd := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "k0rdent.mirantis.com/v1beta1",
+		"kind":       "ClusterDeployment",
+		"metadata":   map[string]interface{}{"name": "child", "namespace": "team-a"},
+	}}
+	capi := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "cluster.x-k8s.io/v1beta1",
+		"kind":       "Cluster",
+		"metadata":   map[string]interface{}{"name": "child", "namespace": "team-a"},
+		"spec":       map[string]interface{}{},
+	}}
+	if host != "" {
+		capi.Object["spec"] = map[string]interface{}{
+			"controlPlaneEndpoint": map[string]interface{}{"host": host, "port": port},
+		}
+	}
+	return []runtime.Object{cd, capi}
+}
+
+func TestGetClusterEndpoint_Reachable(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	defer server.Close()
+
+	host, portRaw, err := net.SplitHostPort(server.Listener.Addr().String())
+	if err != nil {
+		t.Fatalf("split server address: %v", err)
+	}
+	port, _ := strconv.ParseInt(portRaw, 10, 64)
+
+	manager := &Manager{
+		dynamicClient: fake.NewSimpleDynamicClient(runtime.NewScheme(), newEndpointTestObjects(host, port)...),
+		logger:        slog.Default(),
+	}
+
+	result, err := manager.GetClusterEndpoint(context.Background(), "team-a", "child")
+	if err != nil {
+		t.Fatalf("GetClusterEndpoint returned error: %v", err)
+	}
+	if !result.Reachable || result.Error != "" {
+		t.Fatalf("expected reachable endpoint, got %+v", result)
+	}
+	if result.URL != "https://"+server.Listener.Addr().String() {
+		t.Errorf("unexpected URL %q", result.URL)
+	}
+}
+
+func TestGetClusterEndpoint_NotAssigned(t *testing.T) {
+	manager := &Manager{
+		dynamicClient: fake.NewSimpleDynamicClient(runtime.NewScheme(), newEndpointTestObjects("", 0)...),
+		logger:        slog.Default(),
+	}
+
+	result, err := manager.GetClusterEndpoint(context.Background(), "team-a", "child")
+	if err != nil {
+		t.Fatalf("GetClusterEndpoint returned error: %v", err)
+	}
+	if result.Endpoint != nil || result.Reachable || result.Error == "" {
+		t.Fatalf("expected unassigned endpoint to be reported, got %+v", result)
+	}
+
+	if _, err := manager.GetClusterEndpoint(context.Background(), "team-a", "missing"); err == nil {
+		t.Fatal("expected error for missing cluster deployment")
+	}
+}
+
+func TestExtractCAPIControlPlaneEndpoint_DefaultPort(t *testing.T) {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"spec": map[string]interface{}{
+			"controlPlaneEndpoint": map[string]interface{}{"host": "api.example.com"},
+		},
+	}}
+	endpoint := extractControlPlaneEndpoint(obj)
+	if endpoint == nil || endpoint.Port != 6443 {
+		t.Fatalf("expected default port 6443, got %+v", endpoint)
+	}
+}
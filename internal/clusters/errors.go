@@ -20,4 +20,13 @@ var (
 
 	// ErrInvalidRequest is returned when request validation fails
 	ErrInvalidRequest = errors.New("invalid request")
+
+	// ErrKubeconfigNotReady is returned when a child cluster's kubeconfig secret does not exist yet
+	ErrKubeconfigNotReady = errors.New("kubeconfig not ready")
+
+	// ErrTokenCredentialsUnsupported is returned when a child cluster cannot issue short-lived token credentials
+	ErrTokenCredentialsUnsupported = errors.New("short-lived token credentials not supported for this cluster")
+
+	// ErrMetricsUnavailable is returned when the child cluster does not serve metrics.k8s.io
+	ErrMetricsUnavailable = errors.New("metrics API (metrics.k8s.io) not available on child cluster; is metrics-server installed?")
 )
@@ -0,0 +1,283 @@
+package clusters
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/k0rdent/mcp-k0rdent-server/internal/logging"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+const (
+	// KubeconfigCredentialStatic returns the kubeconfig exactly as stored in the secret
+	KubeconfigCredentialStatic = "static"
+	// KubeconfigCredentialToken returns a kubeconfig authenticated by a time-boxed ServiceAccount token
+	KubeconfigCredentialToken = "token"
+
+	// DefaultTokenServiceAccountNamespace is the child cluster namespace of the token ServiceAccount when unset
+	DefaultTokenServiceAccountNamespace = "default"
+	// DefaultTokenExpiration is the requested token lifetime when unset
+	DefaultTokenExpiration = time.Hour
+	// MinTokenExpiration is the shortest lifetime the TokenRequest API accepts
+	MinTokenExpiration = 10 * time.Minute
+	// MaxTokenExpiration caps the requested lifetime so leaked tokens expire within a day
+	MaxTokenExpiration = 24 * time.Hour
+)
+
+// ServiceAccountsGVR is the GroupVersionResource for core ServiceAccounts; tokens are
+// requested through its "token" subresource.
+var ServiceAccountsGVR = schema.GroupVersionResource{
+	Group:    "",
+	Version:  "v1",
+	Resource: "serviceaccounts",
+}
+
+// ClusterKubeconfig is the kubeconfig of a child cluster, as stored in its kubeconfig secret.
+type ClusterKubeconfig struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	Secret    string `json:"secret"`
+	// Kubeconfig is the base64-encoded kubeconfig
+	Kubeconfig string `json:"kubeconfig"`
+	// Server is the API server URL of the kubeconfig's current context
+	Server string `json:"server,omitempty"`
+	// Credential is KubeconfigCredentialStatic or KubeconfigCredentialToken
+	Credential string `json:"credential"`
+	// ServiceAccount is the "namespace/name" the token was issued for (token credentials only)
+	ServiceAccount string `json:"serviceAccount,omitempty"`
+	// ExpiresAt is when the token stops being accepted (token credentials only)
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+}
+
+// TokenKubeconfigOptions select the ServiceAccount and lifetime of a token kubeconfig.
+type TokenKubeconfigOptions struct {
+	// ServiceAccount is the child cluster ServiceAccount to issue the token for (required)
+	ServiceAccount string
+	// ServiceAccountNamespace is its namespace (default: DefaultTokenServiceAccountNamespace)
+	ServiceAccountNamespace string
+	// Expiration is the requested token lifetime (default: DefaultTokenExpiration)
+	Expiration time.Duration
+}
+
+// GetClusterKubeconfig reads the kubeconfig secret of a ClusterDeployment. When the secret
+// does not exist yet the error wraps ErrKubeconfigNotReady. The kubeconfig is never logged.
+func (m *Manager) GetClusterKubeconfig(ctx context.Context, namespace, name string) (ClusterKubeconfig, error) {
+	logger := logging.WithContext(ctx, m.logger)
+
+	if name == "" {
+		return ClusterKubeconfig{}, fmt.Errorf("%w: name is required", ErrInvalidRequest)
+	}
+	if namespace == "" {
+		return ClusterKubeconfig{}, fmt.Errorf("%w: namespace is required", ErrInvalidRequest)
+	}
+
+	kubeconfig, secretRef, err := m.childKubeconfig(ctx, namespace, name)
+	if err != nil {
+		return ClusterKubeconfig{}, err
+	}
+
+	server, err := kubeconfigServer(kubeconfig)
+	if err != nil {
+		return ClusterKubeconfig{}, fmt.Errorf("kubeconfig secret %s/%s: %w", secretRef.Namespace, secretRef.Name, err)
+	}
+
+	logger.Debug("child kubeconfig fetched",
+		"name", name,
+		"namespace", namespace,
+		"secret", secretRef.Namespace+"/"+secretRef.Name,
+		"server", server,
+	)
+	return ClusterKubeconfig{
+		Name:       name,
+		Namespace:  namespace,
+		Secret:     secretRef.Name,
+		Kubeconfig: base64.StdEncoding.EncodeToString(kubeconfig),
+		Server:     server,
+		Credential: KubeconfigCredentialStatic,
+	}, nil
+}
+
+// GetClusterTokenKubeconfig issues a time-boxed token for a ServiceAccount in the child
+// cluster through the TokenRequest API and returns a kubeconfig that authenticates with that
+// token instead of the secret's admin credentials. The token carries only the RBAC of the
+// ServiceAccount. ErrTokenCredentialsUnsupported is returned when the stored kubeconfig
+// authenticates through an exec plugin or auth provider, or when the child cluster does not
+// serve TokenRequest. Neither the kubeconfig nor the token is logged.
+func (m *Manager) GetClusterTokenKubeconfig(ctx context.Context, namespace, name string, opts TokenKubeconfigOptions) (ClusterKubeconfig, error) {
+	logger := logging.WithContext(ctx, m.logger)
+
+	if name == "" {
+		return ClusterKubeconfig{}, fmt.Errorf("%w: name is required", ErrInvalidRequest)
+	}
+	if namespace == "" {
+		return ClusterKubeconfig{}, fmt.Errorf("%w: namespace is required", ErrInvalidRequest)
+	}
+	if opts.ServiceAccount == "" {
+		return ClusterKubeconfig{}, fmt.Errorf("%w: serviceAccount is required for token credentials", ErrInvalidRequest)
+	}
+	if opts.ServiceAccountNamespace == "" {
+		opts.ServiceAccountNamespace = DefaultTokenServiceAccountNamespace
+	}
+	if opts.Expiration == 0 {
+		opts.Expiration = DefaultTokenExpiration
+	}
+	if opts.Expiration < MinTokenExpiration || opts.Expiration > MaxTokenExpiration {
+		return ClusterKubeconfig{}, fmt.Errorf("%w: token expiration must be between %s and %s", ErrInvalidRequest, MinTokenExpiration, MaxTokenExpiration)
+	}
+
+	kubeconfig, secretRef, err := m.childKubeconfig(ctx, namespace, name)
+	if err != nil {
+		return ClusterKubeconfig{}, err
+	}
+	cfg, err := clientcmd.Load(kubeconfig)
+	if err != nil {
+		return ClusterKubeconfig{}, fmt.Errorf("kubeconfig secret %s/%s: parse kubeconfig: %w", secretRef.Namespace, secretRef.Name, err)
+	}
+	kubeCtx, err := currentKubeContext(cfg)
+	if err != nil {
+		return ClusterKubeconfig{}, fmt.Errorf("kubeconfig secret %s/%s: %w", secretRef.Namespace, secretRef.Name, err)
+	}
+	if user, ok := cfg.AuthInfos[kubeCtx.AuthInfo]; ok && (user.Exec != nil || user.AuthProvider != nil) {
+		return ClusterKubeconfig{}, fmt.Errorf("%w: kubeconfig of %s/%s authenticates through an exec plugin or auth provider", ErrTokenCredentialsUnsupported, namespace, name)
+	}
+
+	client, err := m.childClients(kubeconfig)
+	if err != nil {
+		return ClusterKubeconfig{}, err
+	}
+	token, expiresAt, err := requestServiceAccountToken(ctx, client, opts)
+	if err != nil {
+		return ClusterKubeconfig{}, err
+	}
+
+	cluster, ok := cfg.Clusters[kubeCtx.Cluster]
+	if !ok {
+		return ClusterKubeconfig{}, fmt.Errorf("kubeconfig secret %s/%s: cluster %q of current context not found", secretRef.Namespace, secretRef.Name, kubeCtx.Cluster)
+	}
+	serviceAccount := opts.ServiceAccountNamespace + "/" + opts.ServiceAccount
+	rewritten, err := tokenKubeconfig(cluster, name, opts.ServiceAccount, token)
+	if err != nil {
+		return ClusterKubeconfig{}, err
+	}
+
+	logger.Debug("child token kubeconfig issued",
+		"name", name,
+		"namespace", namespace,
+		"service_account", serviceAccount,
+		"server", cluster.Server,
+		"expires_at", expiresAt,
+	)
+	return ClusterKubeconfig{
+		Name:           name,
+		Namespace:      namespace,
+		Secret:         secretRef.Name,
+		Kubeconfig:     base64.StdEncoding.EncodeToString(rewritten),
+		Server:         cluster.Server,
+		Credential:     KubeconfigCredentialToken,
+		ServiceAccount: serviceAccount,
+		ExpiresAt:      &expiresAt,
+	}, nil
+}
+
+// requestServiceAccountToken creates a TokenRequest on the ServiceAccount's token subresource
+// and returns the issued token and its expiry.
+func requestServiceAccountToken(ctx context.Context, client dynamic.Interface, opts TokenKubeconfigOptions) (string, time.Time, error) {
+	request := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "authentication.k8s.io/v1",
+		"kind":       "TokenRequest",
+		"metadata": map[string]any{
+			"name":      opts.ServiceAccount,
+			"namespace": opts.ServiceAccountNamespace,
+		},
+		"spec": map[string]any{
+			"expirationSeconds": int64(opts.Expiration / time.Second),
+		},
+	}}
+	resp, err := client.Resource(ServiceAccountsGVR).Namespace(opts.ServiceAccountNamespace).Create(ctx, request, metav1.CreateOptions{}, "token")
+	if err != nil {
+		switch {
+		case apierrors.IsNotFound(err):
+			return "", time.Time{}, fmt.Errorf("%w: service account %s/%s in child cluster", ErrResourceNotFound, opts.ServiceAccountNamespace, opts.ServiceAccount)
+		case apierrors.IsMethodNotSupported(err):
+			return "", time.Time{}, fmt.Errorf("%w: %v", ErrTokenCredentialsUnsupported, err)
+		}
+		return "", time.Time{}, fmt.Errorf("request service account token: %w", err)
+	}
+
+	token, _, _ := unstructured.NestedString(resp.Object, "status", "token")
+	if token == "" {
+		return "", time.Time{}, fmt.Errorf("%w: token request returned no token", ErrTokenCredentialsUnsupported)
+	}
+	expiresAt := time.Now().Add(opts.Expiration).UTC()
+	if raw, _, _ := unstructured.NestedString(resp.Object, "status", "expirationTimestamp"); raw != "" {
+		if parsed, parseErr := time.Parse(time.RFC3339, raw); parseErr == nil {
+			expiresAt = parsed.UTC()
+		}
+	}
+	return token, expiresAt, nil
+}
+
+// tokenKubeconfig builds a single-context kubeconfig for the cluster that authenticates with
+// the bearer token; client certificates and keys of the source kubeconfig are not carried over.
+func tokenKubeconfig(cluster *clientcmdapi.Cluster, clusterName, serviceAccount, token string) ([]byte, error) {
+	user := serviceAccount + "@" + clusterName
+	cfg := clientcmdapi.NewConfig()
+	cfg.Clusters[clusterName] = cluster.DeepCopy()
+	cfg.AuthInfos[user] = &clientcmdapi.AuthInfo{Token: token}
+	cfg.Contexts[user] = &clientcmdapi.Context{Cluster: clusterName, AuthInfo: user}
+	cfg.CurrentContext = user
+	data, err := clientcmd.Write(*cfg)
+	if err != nil {
+		return nil, fmt.Errorf("write token kubeconfig: %w", err)
+	}
+	return data, nil
+}
+
+// currentKubeContext returns the current context, or the first context (by name) when no
+// current context is set.
+func currentKubeContext(cfg *clientcmdapi.Config) (*clientcmdapi.Context, error) {
+	if kubeCtx, ok := cfg.Contexts[cfg.CurrentContext]; ok {
+		return kubeCtx, nil
+	}
+	names := make([]string, 0, len(cfg.Contexts))
+	for contextName := range cfg.Contexts {
+		names = append(names, contextName)
+	}
+	if len(names) == 0 {
+		return nil, fmt.Errorf("kubeconfig has no contexts")
+	}
+	sort.Strings(names)
+	return cfg.Contexts[names[0]], nil
+}
+
+// kubeconfigServer returns the server URL of the current context's cluster, or of the first
+// cluster (by name) when no current context is set.
+func kubeconfigServer(kubeconfig []byte) (string, error) {
+	cfg, err := clientcmd.Load(kubeconfig)
+	if err != nil {
+		return "", fmt.Errorf("parse kubeconfig: %w", err)
+	}
+	if kubeCtx, ok := cfg.Contexts[cfg.CurrentContext]; ok {
+		if cluster, ok := cfg.Clusters[kubeCtx.Cluster]; ok {
+			return cluster.Server, nil
+		}
+	}
+	names := make([]string, 0, len(cfg.Clusters))
+	for clusterName := range cfg.Clusters {
+		names = append(names, clusterName)
+	}
+	if len(names) == 0 {
+		return "", nil
+	}
+	sort.Strings(names)
+	return cfg.Clusters[names[0]].Server, nil
+}
@@ -0,0 +1,228 @@
+package clusters
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/fake"
+	clienttesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+const testChildKubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: child
+  cluster:
+    server: https://10.0.0.1:6443
+contexts:
+- name: child-admin@child
+  context:
+    cluster: child
+    user: child-admin
+current-context: child-admin@child
+users:
+- name: child-admin
+  user:
+    token: secret-token
+`
+
+func TestGetClusterKubeconfig(t *testing.T) {
+	secret := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Secret",
+		"metadata": map[string]interface{}{
+			"name":      "child-kubeconfig",
+			"namespace": "team-a",
+		},
+		"data": map[string]interface{}{
+			"kubeconfig": base64.StdEncoding.EncodeToString([]byte(testChildKubeconfig)),
+		},
+	}}
+	cd := createTestClusterDeployment("child", "team-a", nil)
+	manager := &Manager{dynamicClient: fake.NewSimpleDynamicClient(runtime.NewScheme(), cd, secret), logger: slog.Default()}
+
+	result, err := manager.GetClusterKubeconfig(context.Background(), "team-a", "child")
+	if err != nil {
+		t.Fatalf("GetClusterKubeconfig returned error: %v", err)
+	}
+	if result.Server != "https://10.0.0.1:6443" {
+		t.Fatalf("expected server from current context, got %q", result.Server)
+	}
+	if result.Secret != "child-kubeconfig" {
+		t.Fatalf("expected secret child-kubeconfig, got %q", result.Secret)
+	}
+	decoded, err := base64.StdEncoding.DecodeString(result.Kubeconfig)
+	if err != nil || string(decoded) != testChildKubeconfig {
+		t.Fatalf("kubeconfig did not round-trip: %v", err)
+	}
+}
+
+func TestGetClusterKubeconfig_NotReady(t *testing.T) {
+	cd := createTestClusterDeployment("child", "team-a", nil)
+	manager := &Manager{dynamicClient: fake.NewSimpleDynamicClient(runtime.NewScheme(), cd), logger: slog.Default()}
+
+	_, err := manager.GetClusterKubeconfig(context.Background(), "team-a", "child")
+	if !errors.Is(err, ErrKubeconfigNotReady) {
+		t.Fatalf("expected ErrKubeconfigNotReady, got %v", err)
+	}
+}
+
+const testExecKubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: child
+  cluster:
+    server: https://eks.example.com
+contexts:
+- name: child
+  context:
+    cluster: child
+    user: aws
+current-context: child
+users:
+- name: aws
+  user:
+    exec:
+      apiVersion: client.authentication.k8s.io/v1beta1
+      command: aws
+`
+
+// newKubeconfigSecretWith returns the child's kubeconfig secret holding the given kubeconfig.
+func newKubeconfigSecretWith(kubeconfig string) *unstructured.Unstructured {
+	secret := newKubeconfigSecret("team-a", "child-kubeconfig")
+	_ = unstructured.SetNestedField(secret.Object, base64.StdEncoding.EncodeToString([]byte(kubeconfig)), "data", "value")
+	return secret
+}
+
+func TestGetClusterTokenKubeconfig(t *testing.T) {
+	cd := createTestClusterDeployment("child", "team-a", nil)
+	mgmt := fake.NewSimpleDynamicClient(runtime.NewScheme(), cd, newKubeconfigSecretWith(testChildKubeconfig))
+	child := fake.NewSimpleDynamicClient(runtime.NewScheme())
+
+	var gotRequest *unstructured.Unstructured
+	var gotSubresource string
+	child.PrependReactor("create", "serviceaccounts", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		create := action.(clienttesting.CreateAction)
+		gotSubresource = create.GetSubresource()
+		gotRequest = create.GetObject().(*unstructured.Unstructured)
+		resp := gotRequest.DeepCopy()
+		resp.Object["status"] = map[string]interface{}{
+			"token":               "short-lived-token",
+			"expirationTimestamp": "2030-01-01T01:00:00Z",
+		}
+		return true, resp, nil
+	})
+
+	manager := &Manager{
+		dynamicClient: mgmt,
+		childClients:  func([]byte) (dynamic.Interface, error) { return child, nil },
+		logger:        slog.Default(),
+	}
+
+	result, err := manager.GetClusterTokenKubeconfig(context.Background(), "team-a", "child", TokenKubeconfigOptions{
+		ServiceAccount:          "viewer",
+		ServiceAccountNamespace: "kube-system",
+		Expiration:              30 * time.Minute,
+	})
+	if err != nil {
+		t.Fatalf("GetClusterTokenKubeconfig returned error: %v", err)
+	}
+	if gotSubresource != "token" {
+		t.Fatalf("expected token subresource, got %q", gotSubresource)
+	}
+	if gotRequest.GetName() != "viewer" || gotRequest.GetNamespace() != "kube-system" {
+		t.Fatalf("unexpected token request target %s/%s", gotRequest.GetNamespace(), gotRequest.GetName())
+	}
+	if seconds, _, _ := unstructured.NestedInt64(gotRequest.Object, "spec", "expirationSeconds"); seconds != 1800 {
+		t.Fatalf("expected expirationSeconds 1800, got %d", seconds)
+	}
+
+	if result.Credential != KubeconfigCredentialToken || result.ServiceAccount != "kube-system/viewer" {
+		t.Fatalf("unexpected credential metadata: %+v", result)
+	}
+	if result.ExpiresAt == nil || !result.ExpiresAt.Equal(time.Date(2030, 1, 1, 1, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected expiresAt: %v", result.ExpiresAt)
+	}
+	if result.Server != "https://10.0.0.1:6443" {
+		t.Fatalf("expected server to be preserved, got %q", result.Server)
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(result.Kubeconfig)
+	if err != nil {
+		t.Fatalf("decode kubeconfig: %v", err)
+	}
+	if strings.Contains(string(decoded), "secret-token") {
+		t.Fatalf("token kubeconfig leaks the admin credential:\n%s", decoded)
+	}
+	cfg, err := clientcmd.Load(decoded)
+	if err != nil {
+		t.Fatalf("parse token kubeconfig: %v", err)
+	}
+	user := cfg.AuthInfos[cfg.Contexts[cfg.CurrentContext].AuthInfo]
+	if user == nil || user.Token != "short-lived-token" || len(user.ClientCertificateData) != 0 {
+		t.Fatalf("expected token-only user, got %+v", user)
+	}
+	if len(cfg.AuthInfos) != 1 {
+		t.Fatalf("expected a single user, got %d", len(cfg.AuthInfos))
+	}
+}
+
+func TestGetClusterTokenKubeconfig_Errors(t *testing.T) {
+	tests := []struct {
+		name       string
+		kubeconfig string
+		opts       TokenKubeconfigOptions
+		wantErr    error
+	}{
+		{
+			name:       "missing service account",
+			kubeconfig: testChildKubeconfig,
+			wantErr:    ErrInvalidRequest,
+		},
+		{
+			name:       "expiration too long",
+			kubeconfig: testChildKubeconfig,
+			opts:       TokenKubeconfigOptions{ServiceAccount: "viewer", Expiration: 48 * time.Hour},
+			wantErr:    ErrInvalidRequest,
+		},
+		{
+			name:       "exec plugin kubeconfig",
+			kubeconfig: testExecKubeconfig,
+			opts:       TokenKubeconfigOptions{ServiceAccount: "viewer"},
+			wantErr:    ErrTokenCredentialsUnsupported,
+		},
+		{
+			name:       "service account not found",
+			kubeconfig: testChildKubeconfig,
+			opts:       TokenKubeconfigOptions{ServiceAccount: "missing"},
+			wantErr:    ErrResourceNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cd := createTestClusterDeployment("child", "team-a", nil)
+			mgmt := fake.NewSimpleDynamicClient(runtime.NewScheme(), cd, newKubeconfigSecretWith(tt.kubeconfig))
+			child := fake.NewSimpleDynamicClient(runtime.NewScheme())
+			manager := &Manager{
+				dynamicClient: mgmt,
+				childClients:  func([]byte) (dynamic.Interface, error) { return child, nil },
+				logger:        slog.Default(),
+			}
+
+			_, err := manager.GetClusterTokenKubeconfig(context.Background(), "team-a", "child", tt.opts)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package clusters
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/k0rdent/mcp-k0rdent-server/internal/logging"
@@ -11,27 +12,32 @@ import (
 // ListClusters retrieves ClusterDeployment resources from the specified namespaces.
 // Returns summaries with key metadata including template, ready status, and labels.
 func (m *Manager) ListClusters(ctx context.Context, namespaces []string) ([]ClusterDeploymentSummary, error) {
+	return m.listClusters(ctx, namespaces, "")
+}
+
+// listClusters is ListClusters restricted to objects matching labelSelector when it is set.
+func (m *Manager) listClusters(ctx context.Context, namespaces []string, labelSelector string) ([]ClusterDeploymentSummary, error) {
 	logger := logging.WithContext(ctx, m.logger)
-	logger.Debug("listing cluster deployments", "namespace_count", len(namespaces))
+	logger.Debug("listing cluster deployments", "namespace_count", len(namespaces), "label_selector", labelSelector)
 
 	if len(namespaces) == 0 {
 		logger.Warn("no namespaces provided for cluster deployment listing")
 		return []ClusterDeploymentSummary{}, nil
 	}
 
-	var summaries []ClusterDeploymentSummary
+	perNamespace := make([][]ClusterDeploymentSummary, len(namespaces))
 
-	// Query each namespace
-	for _, ns := range namespaces {
+	// Query namespaces concurrently; results are merged in namespace order below
+	errs := ForEachNamespace(ctx, namespaces, m.namespaceConcurrency, func(ctx context.Context, i int, ns string) error {
 		logger.Debug("listing cluster deployments in namespace", "namespace", ns)
 
-		list, err := m.dynamicClient.Resource(ClusterDeploymentsGVR).Namespace(ns).List(ctx, metav1.ListOptions{})
+		list, err := m.listWithOptions(ctx, ClusterDeploymentsGVR, ns, metav1.ListOptions{LabelSelector: labelSelector})
 		if err != nil {
 			logger.Error("failed to list cluster deployments in namespace",
 				"namespace", ns,
 				"error", err,
 			)
-			return nil, fmt.Errorf("list cluster deployments in namespace %s: %w", ns, err)
+			return fmt.Errorf("list cluster deployments in namespace %s: %w", ns, err)
 		}
 
 		logger.Debug("found cluster deployments in namespace",
@@ -40,9 +46,21 @@ func (m *Manager) ListClusters(ctx context.Context, namespaces []string) ([]Clus
 		)
 
 		// Convert each ClusterDeployment to summary
-		for i := range list.Items {
-			summaries = append(summaries, SummarizeClusterDeployment(&list.Items[i]))
+		for j := range list.Items {
+			perNamespace[i] = append(perNamespace[i], SummarizeClusterDeployment(&list.Items[j]))
 		}
+		return nil
+	})
+
+	var summaries []ClusterDeploymentSummary
+	for _, items := range perNamespace {
+		summaries = append(summaries, items...)
+	}
+
+	err := collectNamespaceErrors(namespaces, errs)
+	var partial *PartialError
+	if err != nil && !errors.As(err, &partial) {
+		return nil, err
 	}
 
 	logger.Info("cluster deployments listed",
@@ -50,5 +68,26 @@ func (m *Manager) ListClusters(ctx context.Context, namespaces []string) ([]Clus
 		"namespace_count", len(namespaces),
 	)
 
-	return summaries, nil
+	return summaries, err
+}
+
+// ListClustersPage returns one page of ClusterDeployment summaries across namespaces and
+// the continue token for the next page. A zero opts.Limit lists everything like ListClusters.
+func (m *Manager) ListClustersPage(ctx context.Context, namespaces []string, opts ListOptions) ([]ClusterDeploymentSummary, string, error) {
+	if opts.Limit == 0 && opts.Continue == "" {
+		summaries, err := m.listClusters(ctx, namespaces, opts.LabelSelector)
+		return summaries, "", err
+	}
+
+	items, next, err := m.listPage(ctx, ClusterDeploymentsGVR, namespaces, opts)
+	var partial *PartialError
+	if err != nil && !errors.As(err, &partial) {
+		return nil, "", err
+	}
+
+	summaries := make([]ClusterDeploymentSummary, 0, len(items))
+	for i := range items {
+		summaries = append(summaries, SummarizeClusterDeployment(&items[i]))
+	}
+	return summaries, next, err
 }
@@ -4,17 +4,23 @@ import (
 	"log/slog"
 	"regexp"
 
+	"github.com/k0rdent/mcp-k0rdent-server/internal/config"
 	"github.com/k0rdent/mcp-k0rdent-server/internal/logging"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/dynamic"
 )
 
 // Manager handles cluster provisioning operations using the k0rdent API.
 type Manager struct {
-	dynamicClient   dynamic.Interface
-	namespaceFilter *regexp.Regexp
-	globalNamespace string
-	fieldOwner      string
-	logger          *slog.Logger
+	dynamicClient        dynamic.Interface
+	namespaceFilter      *regexp.Regexp
+	globalNamespace      string
+	defaultNamespace     string
+	fieldOwner           string
+	childClients         ChildClientFactory
+	namespaceConcurrency int
+	stableSelector       labels.Selector
+	logger               *slog.Logger
 }
 
 // Options configure the cluster Manager.
@@ -28,9 +34,21 @@ type Options struct {
 	// GlobalNamespace is the namespace where global resources reside (default: "kcm-system")
 	GlobalNamespace string
 
+	// DefaultNamespace is the target namespace when none is given and the filter allows it (default: GlobalNamespace)
+	DefaultNamespace string
+
 	// FieldOwner is the identifier for server-side apply operations (default: "mcp.clusters")
 	FieldOwner string
 
+	// NamespaceConcurrency bounds parallel per-namespace API calls (default: config.DefaultNamespaceConcurrency)
+	NamespaceConcurrency int
+
+	// StableTemplateSelector restricts template auto-selection to templates whose labels match (nil = highest version wins)
+	StableTemplateSelector labels.Selector
+
+	// ChildClientFactory builds clients for child clusters from kubeconfig bytes (optional, defaults to NewChildDynamicClient)
+	ChildClientFactory ChildClientFactory
+
 	// Logger is used for structured logging (optional, defaults to slog.Default())
 	Logger *slog.Logger
 }
@@ -45,19 +63,35 @@ func NewManager(opts Options) (*Manager, error) {
 		opts.GlobalNamespace = "kcm-system"
 	}
 
+	if opts.DefaultNamespace == "" {
+		opts.DefaultNamespace = opts.GlobalNamespace
+	}
+
 	if opts.FieldOwner == "" {
 		opts.FieldOwner = "mcp.clusters"
 	}
 
+	if opts.NamespaceConcurrency <= 0 {
+		opts.NamespaceConcurrency = config.DefaultNamespaceConcurrency
+	}
+
+	if opts.ChildClientFactory == nil {
+		opts.ChildClientFactory = NewChildDynamicClient
+	}
+
 	if opts.Logger == nil {
 		opts.Logger = slog.Default()
 	}
 
 	return &Manager{
-		dynamicClient:   opts.DynamicClient,
-		namespaceFilter: opts.NamespaceFilter,
-		globalNamespace: opts.GlobalNamespace,
-		fieldOwner:      opts.FieldOwner,
-		logger:          logging.WithComponent(opts.Logger, "clusters.manager"),
+		dynamicClient:        opts.DynamicClient,
+		namespaceFilter:      opts.NamespaceFilter,
+		globalNamespace:      opts.GlobalNamespace,
+		defaultNamespace:     opts.DefaultNamespace,
+		fieldOwner:           opts.FieldOwner,
+		childClients:         opts.ChildClientFactory,
+		namespaceConcurrency: opts.NamespaceConcurrency,
+		stableSelector:       opts.StableTemplateSelector,
+		logger:               logging.WithComponent(opts.Logger, "clusters.manager"),
 	}, nil
 }
@@ -5,15 +5,14 @@ import (
 	"fmt"
 
 	"github.com/k0rdent/mcp-k0rdent-server/internal/logging"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 // ResolveTargetNamespace determines which namespace to use for a cluster operation.
 // It implements the auth mode-aware logic described in the design doc:
 // - If explicit namespace is provided, validate against filter
-// - If no namespace and filter is nil or matches kcm-system: default to kcm-system (DEV mode)
-// - If no namespace and filter exists and doesn't match kcm-system: require explicit namespace (OIDC mode)
+// - If no namespace and filter is nil or matches the default namespace: use it (DEV mode)
+// - If no namespace and filter exists and doesn't match the default namespace: require explicit namespace (OIDC mode)
 func (m *Manager) ResolveTargetNamespace(ctx context.Context, explicitNamespace string) (string, error) {
 	logger := logging.WithContext(ctx, m.logger)
 
@@ -31,12 +30,16 @@ func (m *Manager) ResolveTargetNamespace(ctx context.Context, explicitNamespace
 	}
 
 	// Case 2: No explicit namespace - determine default behavior
-	// DEV_ALLOW_ANY mode (no filter or matches kcm-system): default to global namespace
-	if m.namespaceFilter == nil || m.namespaceFilter.MatchString(m.globalNamespace) {
-		logger.Debug("defaulting to global namespace (DEV mode)",
-			"namespace", m.globalNamespace,
+	// DEV_ALLOW_ANY mode (no filter or matches the default namespace): use the default namespace
+	defaultNamespace := m.defaultNamespace
+	if defaultNamespace == "" {
+		defaultNamespace = m.globalNamespace
+	}
+	if m.namespaceFilter == nil || m.namespaceFilter.MatchString(defaultNamespace) {
+		logger.Debug("defaulting to default namespace (DEV mode)",
+			"namespace", defaultNamespace,
 		)
-		return m.globalNamespace, nil
+		return defaultNamespace, nil
 	}
 
 	// OIDC_REQUIRED mode - require explicit namespace
@@ -58,7 +61,7 @@ func (m *Manager) GetAllowedNamespaces(ctx context.Context) ([]string, error) {
 		Resource: "namespaces",
 	}
 
-	nsList, err := m.dynamicClient.Resource(nsGVR).List(ctx, metav1.ListOptions{})
+	nsList, err := m.listWithRetry(ctx, nsGVR, "")
 	if err != nil {
 		logger.Error("failed to list namespaces", "error", err)
 		return nil, fmt.Errorf("list namespaces: %w", err)
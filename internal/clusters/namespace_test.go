@@ -2,6 +2,7 @@ package clusters
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 	"regexp"
 	"testing"
@@ -414,3 +415,47 @@ func TestResolveTargetNamespace_NilFilter(t *testing.T) {
 		})
 	}
 }
+
+// TestResolveTargetNamespace_DefaultNamespace tests the configured default namespace
+func TestResolveTargetNamespace_DefaultNamespace(t *testing.T) {
+	client := fake.NewSimpleDynamicClient(runtime.NewScheme())
+
+	tests := []struct {
+		name              string
+		filter            *regexp.Regexp
+		expectedNamespace string
+		expectedErr       error
+	}{
+		{name: "no filter uses default", filter: nil, expectedNamespace: "team-a"},
+		{name: "filter matching default", filter: regexp.MustCompile("^team-"), expectedNamespace: "team-a"},
+		{name: "filter rejecting default", filter: regexp.MustCompile("^kcm-"), expectedErr: ErrNamespaceRequired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manager, err := NewManager(Options{
+				DynamicClient:    client,
+				NamespaceFilter:  tt.filter,
+				DefaultNamespace: "team-a",
+				Logger:           slog.Default(),
+			})
+			if err != nil {
+				t.Fatalf("NewManager failed: %v", err)
+			}
+
+			namespace, err := manager.ResolveTargetNamespace(context.Background(), "")
+			if tt.expectedErr != nil {
+				if !errors.Is(err, tt.expectedErr) {
+					t.Fatalf("expected %v, got %v", tt.expectedErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if namespace != tt.expectedNamespace {
+				t.Errorf("expected namespace %q, got %q", tt.expectedNamespace, namespace)
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package clusters
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/k0rdent/mcp-k0rdent-server/internal/logging"
+)
+
+// DefaultPageLimit is the page size used when a continue token is resumed without a limit
+// and the token does not record the original one.
+const DefaultPageLimit = 100
+
+// ListOptions filters and pages multi-namespace list operations.
+type ListOptions struct {
+	// LabelSelector restricts the listed objects, in Kubernetes label selector syntax
+	LabelSelector string
+	// Limit is the maximum number of objects returned per call; zero lists everything, or
+	// keeps the page size of the previous page when Continue is set
+	Limit int64
+	// Continue is the token returned with the previous page
+	Continue string
+}
+
+// pageToken is the decoded form of a multi-namespace continue token: the namespace to
+// resume in, the API server's continue token within it, and the page size it was issued for.
+type pageToken struct {
+	Namespace string `json:"ns"`
+	Continue  string `json:"c,omitempty"`
+	Limit     int64  `json:"l,omitempty"`
+}
+
+func encodePageToken(token pageToken) string {
+	data, _ := json.Marshal(token)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodePageToken(raw string) (pageToken, error) {
+	var token pageToken
+	data, err := base64.RawURLEncoding.DecodeString(raw)
+	if err == nil {
+		err = json.Unmarshal(data, &token)
+	}
+	if err != nil || token.Namespace == "" {
+		return pageToken{}, fmt.Errorf("%w: invalid continue token", ErrInvalidRequest)
+	}
+	return token, nil
+}
+
+// listPage lists up to opts.Limit objects across namespaces, visiting them in order and
+// resuming where opts.Continue left off. Continuing without a limit keeps the page size the
+// token was issued for, or DefaultPageLimit. It returns the continue token for the next page,
+// empty after the last one. Namespaces that fail are skipped and reported like
+// collectNamespaceErrors does for the namespaces visited.
+func (m *Manager) listPage(ctx context.Context, gvr schema.GroupVersionResource, namespaces []string, opts ListOptions) ([]unstructured.Unstructured, string, error) {
+	logger := logging.WithContext(ctx, m.logger)
+	if opts.Limit < 0 || (opts.Limit == 0 && opts.Continue == "") {
+		return nil, "", fmt.Errorf("%w: limit must be positive", ErrInvalidRequest)
+	}
+	start, apiContinue := 0, ""
+	if opts.Continue != "" {
+		token, err := decodePageToken(opts.Continue)
+		if err != nil {
+			return nil, "", err
+		}
+		start = slices.Index(namespaces, token.Namespace)
+		if start < 0 {
+			return nil, "", fmt.Errorf("%w: continue token namespace %q is not in the listed namespaces", ErrInvalidRequest, token.Namespace)
+		}
+		apiContinue = token.Continue
+		if opts.Limit == 0 {
+			opts.Limit = token.Limit
+			if opts.Limit <= 0 {
+				opts.Limit = DefaultPageLimit
+			}
+		}
+	}
+
+	var items []unstructured.Unstructured
+	var visited []string
+	var errs []error
+	next := ""
+	remaining := opts.Limit
+	for i := start; i < len(namespaces); i++ {
+		ns := namespaces[i]
+		list, err := m.listWithOptions(ctx, gvr, ns, metav1.ListOptions{LabelSelector: opts.LabelSelector, Limit: remaining, Continue: apiContinue})
+		apiContinue = ""
+		visited = append(visited, ns)
+		if err != nil {
+			logger.Error("failed to list page in namespace", "resource", gvr.Resource, "namespace", ns, "error", err)
+			errs = append(errs, fmt.Errorf("list %s in namespace %s: %w", gvr.Resource, ns, err))
+			continue
+		}
+		errs = append(errs, nil)
+		items = append(items, list.Items...)
+		remaining -= int64(len(list.Items))
+		if cont := list.GetContinue(); cont != "" {
+			next = encodePageToken(pageToken{Namespace: ns, Continue: cont, Limit: opts.Limit})
+			break
+		}
+		if remaining <= 0 {
+			if i+1 < len(namespaces) {
+				next = encodePageToken(pageToken{Namespace: namespaces[i+1], Limit: opts.Limit})
+			}
+			break
+		}
+	}
+	return items, next, collectNamespaceErrors(visited, errs)
+}
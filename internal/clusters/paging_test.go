@@ -0,0 +1,187 @@
+package clusters
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strconv"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+// pagedListReactor serves the scripted pages for each namespace in turn, since the fake
+// dynamic client ignores limit and continue.
+func pagedListReactor(pages map[string][][]string) clienttesting.ReactionFunc {
+	calls := map[string]int{}
+	return func(action clienttesting.Action) (bool, runtime.Object, error) {
+		ns := action.GetNamespace()
+		call := calls[ns]
+		calls[ns]++
+		list := &unstructured.UnstructuredList{Object: map[string]interface{}{
+			"apiVersion": "k0rdent.mirantis.com/v1beta1",
+			"kind":       "ClusterDeploymentList",
+		}}
+		if call >= len(pages[ns]) {
+			return true, list, nil
+		}
+		for _, name := range pages[ns][call] {
+			list.Items = append(list.Items, *createTestClusterDeployment(name, ns, nil))
+		}
+		if call+1 < len(pages[ns]) {
+			list.SetContinue("page-" + strconv.Itoa(call+1))
+		}
+		return true, list, nil
+	}
+}
+
+func newPagingClient() *fake.FakeDynamicClient {
+	return fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{ClusterDeploymentsGVR: "ClusterDeploymentList"})
+}
+
+func TestListClustersPageWalksNamespaces(t *testing.T) {
+	client := newPagingClient()
+	client.PrependReactor("list", "clusterdeployments", pagedListReactor(map[string][][]string{
+		"team-a": {{"a1", "a2"}, {"a3"}},
+		"team-b": {{"b1"}},
+	}))
+	manager := &Manager{dynamicClient: client, logger: slog.Default()}
+	namespaces := []string{"team-a", "team-b"}
+
+	first, next, err := manager.ListClustersPage(context.Background(), namespaces, ListOptions{Limit: 2})
+	if err != nil {
+		t.Fatalf("first page returned error: %v", err)
+	}
+	if len(first) != 2 || first[0].Name != "a1" || first[1].Name != "a2" {
+		t.Fatalf("unexpected first page: %+v", first)
+	}
+	token, err := decodePageToken(next)
+	if err != nil || token.Namespace != "team-a" || token.Continue != "page-1" {
+		t.Fatalf("expected token resuming team-a, got %+v (%v)", token, err)
+	}
+
+	second, next, err := manager.ListClustersPage(context.Background(), namespaces, ListOptions{Limit: 2, Continue: next})
+	if err != nil {
+		t.Fatalf("second page returned error: %v", err)
+	}
+	if len(second) != 2 || second[0].Name != "a3" || second[1].Name != "b1" {
+		t.Fatalf("unexpected second page: %+v", second)
+	}
+	if next != "" {
+		t.Fatalf("expected empty continue after last page, got %q", next)
+	}
+}
+
+func TestListClustersPageLimitReachedAtNamespaceEnd(t *testing.T) {
+	client := fake.NewSimpleDynamicClient(runtime.NewScheme(),
+		createTestClusterDeployment("a1", "team-a", nil),
+		createTestClusterDeployment("b1", "team-b", nil),
+	)
+	manager := &Manager{dynamicClient: client, logger: slog.Default()}
+	namespaces := []string{"team-a", "team-b"}
+
+	page, next, err := manager.ListClustersPage(context.Background(), namespaces, ListOptions{Limit: 1})
+	if err != nil {
+		t.Fatalf("ListClustersPage returned error: %v", err)
+	}
+	if len(page) != 1 || page[0].Name != "a1" {
+		t.Fatalf("unexpected page: %+v", page)
+	}
+	token, err := decodePageToken(next)
+	if err != nil || token.Namespace != "team-b" || token.Continue != "" {
+		t.Fatalf("expected token starting team-b, got %+v (%v)", token, err)
+	}
+
+	page, next, err = manager.ListClustersPage(context.Background(), namespaces, ListOptions{Limit: 1, Continue: next})
+	if err != nil || len(page) != 1 || page[0].Name != "b1" || next != "" {
+		t.Fatalf("unexpected last page: %+v next=%q err=%v", page, next, err)
+	}
+}
+
+func TestListClustersPageZeroLimitListsEverything(t *testing.T) {
+	client := fake.NewSimpleDynamicClient(runtime.NewScheme(),
+		createTestClusterDeployment("a1", "team-a", nil),
+		createTestClusterDeployment("a2", "team-a", nil),
+	)
+	manager := &Manager{dynamicClient: client, logger: slog.Default()}
+
+	page, next, err := manager.ListClustersPage(context.Background(), []string{"team-a"}, ListOptions{})
+	if err != nil || len(page) != 2 || next != "" {
+		t.Fatalf("expected full list without token, got %+v next=%q err=%v", page, next, err)
+	}
+}
+
+func TestListClustersPageContinueWithoutLimit(t *testing.T) {
+	client := fake.NewSimpleDynamicClient(runtime.NewScheme(),
+		createTestClusterDeployment("a1", "team-a", nil),
+		createTestClusterDeployment("b1", "team-b", nil),
+		createTestClusterDeployment("c1", "team-c", nil),
+	)
+	manager := &Manager{dynamicClient: client, logger: slog.Default()}
+	namespaces := []string{"team-a", "team-b", "team-c"}
+
+	_, next, err := manager.ListClustersPage(context.Background(), namespaces, ListOptions{Limit: 1})
+	if err != nil || next == "" {
+		t.Fatalf("first page: next=%q err=%v", next, err)
+	}
+
+	// Continuing without a limit keeps the page size of the first call
+	page, next, err := manager.ListClustersPage(context.Background(), namespaces, ListOptions{Continue: next})
+	if err != nil || len(page) != 1 || page[0].Name != "b1" || next == "" {
+		t.Fatalf("expected one-item page resuming at team-b, got %+v next=%q err=%v", page, next, err)
+	}
+
+	// A token without a recorded page size falls back to DefaultPageLimit
+	page, next, err = manager.ListClustersPage(context.Background(), namespaces, ListOptions{Continue: encodePageToken(pageToken{Namespace: "team-b"})})
+	if err != nil || len(page) != 2 || next != "" {
+		t.Fatalf("expected the rest of the list, got %+v next=%q err=%v", page, next, err)
+	}
+}
+
+func TestListClustersPageRejectsInvalidOptions(t *testing.T) {
+	manager := &Manager{dynamicClient: newPagingClient(), logger: slog.Default()}
+	namespaces := []string{"team-a"}
+
+	tests := map[string]ListOptions{
+		"negative limit":      {Limit: -1},
+		"garbage token":       {Limit: 1, Continue: "not-a-token"},
+		"unknown namespace":   {Limit: 1, Continue: encodePageToken(pageToken{Namespace: "team-z"})},
+		"negative with token": {Limit: -1, Continue: encodePageToken(pageToken{Namespace: "team-a"})},
+	}
+	for label, opts := range tests {
+		if _, _, err := manager.ListClustersPage(context.Background(), namespaces, opts); !errors.Is(err, ErrInvalidRequest) {
+			t.Errorf("%s: expected ErrInvalidRequest, got %v", label, err)
+		}
+	}
+}
+
+func TestListPagesApplyLabelSelector(t *testing.T) {
+	client := fake.NewSimpleDynamicClient(runtime.NewScheme(),
+		createTestClusterDeployment("prod-1", "team-a", map[string]string{"environment": "prod"}),
+		createTestClusterDeployment("dev-1", "team-a", map[string]string{"environment": "dev"}),
+		createTestClusterDeployment("prod-2", "team-b", map[string]string{"environment": "prod"}),
+	)
+	manager := &Manager{dynamicClient: client, logger: slog.Default()}
+	namespaces := []string{"team-a", "team-b"}
+
+	all, _, err := manager.ListClustersPage(context.Background(), namespaces, ListOptions{LabelSelector: "environment=prod"})
+	if err != nil {
+		t.Fatalf("ListClustersPage returned error: %v", err)
+	}
+	if len(all) != 2 || all[0].Name != "prod-1" || all[1].Name != "prod-2" {
+		t.Fatalf("expected only prod clusters, got %+v", all)
+	}
+
+	page, _, err := manager.ListClustersPage(context.Background(), namespaces, ListOptions{LabelSelector: "environment=dev", Limit: 5})
+	if err != nil {
+		t.Fatalf("paged ListClustersPage returned error: %v", err)
+	}
+	if len(page) != 1 || page[0].Name != "dev-1" {
+		t.Fatalf("expected only dev cluster, got %+v", page)
+	}
+}
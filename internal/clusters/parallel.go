@@ -0,0 +1,84 @@
+package clusters
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"golang.org/x/sync/errgroup"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/k0rdent/mcp-k0rdent-server/internal/config"
+)
+
+// NamespaceFailure records an operation that failed in a single namespace.
+// Reason carries the Kubernetes status reason (e.g. "Forbidden") when the error had one.
+type NamespaceFailure struct {
+	Namespace string `json:"namespace"`
+	Error     string `json:"error"`
+	Reason    string `json:"reason,omitempty"`
+}
+
+// PartialError is returned by multi-namespace operations when some namespaces failed
+// and others succeeded. The accompanying results cover the successful namespaces only.
+type PartialError struct {
+	Failures []NamespaceFailure
+}
+
+func (e *PartialError) Error() string {
+	parts := make([]string, 0, len(e.Failures))
+	for _, failure := range e.Failures {
+		parts = append(parts, fmt.Sprintf("%s: %s", failure.Namespace, failure.Error))
+	}
+	return fmt.Sprintf("%d namespace(s) failed: %s", len(e.Failures), strings.Join(parts, "; "))
+}
+
+// ForEachNamespace runs fn for every namespace with at most limit calls in flight.
+// Errors do not cancel the remaining namespaces; they are returned in a slice aligned
+// with namespaces (nil for successes) so callers can assemble results in stable order.
+func ForEachNamespace(ctx context.Context, namespaces []string, limit int, fn func(ctx context.Context, index int, namespace string) error) []error {
+	if limit <= 0 {
+		limit = config.DefaultNamespaceConcurrency
+	}
+
+	errs := make([]error, len(namespaces))
+	var group errgroup.Group
+	group.SetLimit(limit)
+	for i, ns := range namespaces {
+		group.Go(func() error {
+			errs[i] = fn(ctx, i, ns)
+			return nil
+		})
+	}
+	_ = group.Wait()
+	return errs
+}
+
+// collectNamespaceErrors folds per-namespace errors into the error returned by list
+// operations: nil when all succeeded, the first error when every namespace failed,
+// and a *PartialError otherwise.
+func collectNamespaceErrors(namespaces []string, errs []error) error {
+	var failures []NamespaceFailure
+	var first error
+	for i, err := range errs {
+		if err == nil {
+			continue
+		}
+		if first == nil {
+			first = err
+		}
+		failures = append(failures, NamespaceFailure{
+			Namespace: namespaces[i],
+			Error:     err.Error(),
+			Reason:    string(apierrors.ReasonForError(err)),
+		})
+	}
+	switch {
+	case len(failures) == 0:
+		return nil
+	case len(failures) == len(namespaces):
+		return first
+	default:
+		return &PartialError{Failures: failures}
+	}
+}
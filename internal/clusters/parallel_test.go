@@ -0,0 +1,104 @@
+package clusters
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+func TestForEachNamespaceBoundsConcurrency(t *testing.T) {
+	namespaces := []string{"a", "b", "c", "d", "e", "f"}
+	var inFlight, peak int32
+
+	errs := ForEachNamespace(context.Background(), namespaces, 2, func(ctx context.Context, i int, ns string) error {
+		current := atomic.AddInt32(&inFlight, 1)
+		for {
+			old := atomic.LoadInt32(&peak)
+			if current <= old || atomic.CompareAndSwapInt32(&peak, old, current) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		atomic.AddInt32(&inFlight, -1)
+		if ns == "c" {
+			return fmt.Errorf("boom")
+		}
+		return nil
+	})
+
+	if peak > 2 {
+		t.Fatalf("expected at most 2 concurrent calls, saw %d", peak)
+	}
+	for i, err := range errs {
+		if (namespaces[i] == "c") != (err != nil) {
+			t.Fatalf("unexpected error for namespace %s: %v", namespaces[i], err)
+		}
+	}
+}
+
+func TestCollectNamespaceErrors(t *testing.T) {
+	namespaces := []string{"a", "b"}
+	boom := errors.New("boom")
+
+	if err := collectNamespaceErrors(namespaces, []error{nil, nil}); err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+	if err := collectNamespaceErrors(namespaces, []error{boom, boom}); !errors.Is(err, boom) {
+		t.Fatalf("expected first error when all namespaces fail, got %v", err)
+	}
+
+	var partial *PartialError
+	err := collectNamespaceErrors(namespaces, []error{nil, boom})
+	if !errors.As(err, &partial) {
+		t.Fatalf("expected PartialError, got %v", err)
+	}
+	if len(partial.Failures) != 1 || partial.Failures[0].Namespace != "b" {
+		t.Fatalf("unexpected failures: %+v", partial.Failures)
+	}
+	if partial.Failures[0].Reason != "" {
+		t.Fatalf("expected no reason for a non-API error, got %q", partial.Failures[0].Reason)
+	}
+
+	forbidden := fmt.Errorf("list in namespace b: %w", apierrors.NewForbidden(schema.GroupResource{Resource: "credentials"}, "", boom))
+	err = collectNamespaceErrors(namespaces, []error{nil, forbidden})
+	if !errors.As(err, &partial) || partial.Failures[0].Reason != "Forbidden" {
+		t.Fatalf("expected Forbidden reason on wrapped API error, got %v", err)
+	}
+}
+
+func TestListClustersPartialFailure(t *testing.T) {
+	client := fake.NewSimpleDynamicClient(runtime.NewScheme(),
+		createTestClusterDeployment("one", "team-a", nil),
+		createTestClusterDeployment("two", "team-c", nil),
+	)
+	client.PrependReactor("list", "clusterdeployments", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		if action.GetNamespace() == "team-b" {
+			return true, nil, errors.New("forbidden")
+		}
+		return false, nil, nil
+	})
+
+	manager := &Manager{dynamicClient: client, namespaceConcurrency: 3, logger: slog.Default()}
+
+	summaries, err := manager.ListClusters(context.Background(), []string{"team-a", "team-b", "team-c"})
+	var partial *PartialError
+	if !errors.As(err, &partial) {
+		t.Fatalf("expected PartialError, got %v", err)
+	}
+	if len(partial.Failures) != 1 || partial.Failures[0].Namespace != "team-b" {
+		t.Fatalf("unexpected failures: %+v", partial.Failures)
+	}
+	if len(summaries) != 2 || summaries[0].Namespace != "team-a" || summaries[1].Namespace != "team-c" {
+		t.Fatalf("expected results from team-a and team-c in order, got %+v", summaries)
+	}
+}
@@ -0,0 +1,134 @@
+package clusters
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/k0rdent/mcp-k0rdent-server/internal/kube"
+	"github.com/k0rdent/mcp-k0rdent-server/internal/logging"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// DeploymentsGVR is the GroupVersionResource for apps/v1 Deployments
+var DeploymentsGVR = schema.GroupVersionResource{
+	Group:    "apps",
+	Version:  "v1",
+	Resource: "deployments",
+}
+
+const (
+	// capiProviderLabel is set by clusterctl and the k0rdent provider charts on every
+	// component of a CAPI provider, e.g. "infrastructure-aws"
+	capiProviderLabel = "cluster.x-k8s.io/provider"
+	// infrastructureProviderPrefix marks infrastructure providers in capiProviderLabel
+	infrastructureProviderPrefix = "infrastructure-"
+)
+
+// InstalledProvider is a CAPI infrastructure provider whose controller runs on the
+// management cluster.
+type InstalledProvider struct {
+	// Name is the provider name without the "infrastructure-" prefix, e.g. "aws"
+	Name string `json:"name"`
+	// Component is the value of the cluster.x-k8s.io/provider label
+	Component  string `json:"component"`
+	Namespace  string `json:"namespace"`
+	Deployment string `json:"deployment"`
+	// Version is the image tag of the controller's first container
+	Version       string `json:"version,omitempty"`
+	Replicas      int64  `json:"replicas"`
+	ReadyReplicas int64  `json:"readyReplicas"`
+	// Ready is true when at least one controller replica is ready
+	Ready bool `json:"ready"`
+}
+
+// ListInstalledProviders finds the CAPI infrastructure providers installed on the management
+// cluster from the controller Deployments labelled cluster.x-k8s.io/provider in the global
+// namespace, where k0rdent installs them, so a caller without cluster-wide Deployment access
+// can still list them. Providers are sorted by name; a provider with several controller
+// Deployments is reported once, preferring a ready one.
+func (m *Manager) ListInstalledProviders(ctx context.Context) ([]InstalledProvider, error) {
+	logger := logging.WithContext(ctx, m.logger)
+
+	var list *unstructured.UnstructuredList
+	err := kube.RetryRead(ctx, func(ctx context.Context) error {
+		var listErr error
+		list, listErr = m.dynamicClient.Resource(DeploymentsGVR).Namespace(m.globalNamespace).List(ctx, metav1.ListOptions{LabelSelector: capiProviderLabel})
+		return listErr
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list provider deployments: %w", err)
+	}
+
+	byName := make(map[string]InstalledProvider)
+	for i := range list.Items {
+		provider, ok := installedProviderFromDeployment(&list.Items[i])
+		if !ok {
+			continue
+		}
+		if existing, seen := byName[provider.Name]; seen && (existing.Ready || !provider.Ready) {
+			continue
+		}
+		byName[provider.Name] = provider
+	}
+
+	providers := make([]InstalledProvider, 0, len(byName))
+	for _, provider := range byName {
+		providers = append(providers, provider)
+	}
+	sort.Slice(providers, func(i, j int) bool { return providers[i].Name < providers[j].Name })
+
+	logger.Debug("installed infrastructure providers listed",
+		"deployment_count", len(list.Items),
+		"provider_count", len(providers),
+	)
+	return providers, nil
+}
+
+// installedProviderFromDeployment summarizes a provider controller Deployment; ok is false
+// for components of core, bootstrap, and control plane providers.
+func installedProviderFromDeployment(obj *unstructured.Unstructured) (InstalledProvider, bool) {
+	component := obj.GetLabels()[capiProviderLabel]
+	if !strings.HasPrefix(component, infrastructureProviderPrefix) {
+		return InstalledProvider{}, false
+	}
+
+	replicas, found, _ := unstructured.NestedInt64(obj.Object, "spec", "replicas")
+	if !found {
+		replicas = 1
+	}
+	readyReplicas, _, _ := unstructured.NestedInt64(obj.Object, "status", "readyReplicas")
+
+	provider := InstalledProvider{
+		Name:          strings.TrimPrefix(component, infrastructureProviderPrefix),
+		Component:     component,
+		Namespace:     obj.GetNamespace(),
+		Deployment:    obj.GetName(),
+		Replicas:      replicas,
+		ReadyReplicas: readyReplicas,
+		Ready:         readyReplicas > 0,
+	}
+
+	containers, _, _ := unstructured.NestedSlice(obj.Object, "spec", "template", "spec", "containers")
+	if len(containers) > 0 {
+		if container, ok := containers[0].(map[string]any); ok {
+			provider.Version = imageTag(asString(container["image"]))
+		}
+	}
+	return provider, true
+}
+
+// imageTag returns the tag of an image reference, or "" when it has none:
+// "registry.k8s.io/cluster-api-aws/cluster-api-aws-controller:v2.7.1" -> "v2.7.1".
+func imageTag(image string) string {
+	image, _, _ = strings.Cut(image, "@")
+	slash := strings.LastIndex(image, "/")
+	colon := strings.LastIndex(image, ":")
+	if colon <= slash {
+		return ""
+	}
+	return image[colon+1:]
+}
@@ -0,0 +1,86 @@
+package clusters
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+)
+
+func newProviderDeployment(namespace, name, component, image string, readyReplicas int64) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]interface{}{
+			"name":      name,
+			"namespace": namespace,
+		},
+		"spec": map[string]interface{}{
+			"replicas": int64(1),
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers": []interface{}{
+						map[string]interface{}{"name": "manager", "image": image},
+					},
+				},
+			},
+		},
+		"status": map[string]interface{}{
+			"readyReplicas": readyReplicas,
+		},
+	}}
+	if component != "" {
+		obj.SetLabels(map[string]string{capiProviderLabel: component})
+	}
+	return obj
+}
+
+func TestListInstalledProviders(t *testing.T) {
+	client := fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{DeploymentsGVR: "DeploymentList"},
+		newProviderDeployment("kcm-system", "capa-controller-manager", "infrastructure-aws", "registry.k8s.io/cluster-api-aws/cluster-api-aws-controller:v2.7.1", 1),
+		newProviderDeployment("kcm-system", "capz-controller-manager", "infrastructure-azure", "mcr.microsoft.com/oss/azure/capz:v1.17.2", 0),
+		newProviderDeployment("kcm-system", "capi-controller-manager", "cluster-api", "registry.k8s.io/cluster-api/cluster-api-controller:v1.9.0", 1),
+		newProviderDeployment("kcm-system", "kcm-controller-manager", "", "ghcr.io/k0rdent/kcm/controller:1.0.0", 1),
+		newProviderDeployment("team-a", "capg-controller-manager", "infrastructure-gcp", "registry.k8s.io/cluster-api-gcp/cluster-api-gcp-controller:v1.8.0", 1),
+	)
+	manager := &Manager{dynamicClient: client, globalNamespace: "kcm-system", logger: slog.Default()}
+
+	providers, err := manager.ListInstalledProviders(context.Background())
+	if err != nil {
+		t.Fatalf("ListInstalledProviders returned error: %v", err)
+	}
+	if len(providers) != 2 {
+		t.Fatalf("expected 2 infrastructure providers from the global namespace, got %+v", providers)
+	}
+
+	aws := providers[0]
+	if aws.Name != "aws" || aws.Component != "infrastructure-aws" || aws.Deployment != "capa-controller-manager" {
+		t.Errorf("unexpected aws provider: %+v", aws)
+	}
+	if aws.Version != "v2.7.1" || !aws.Ready {
+		t.Errorf("expected ready aws provider at v2.7.1, got %+v", aws)
+	}
+	if azure := providers[1]; azure.Name != "azure" || azure.Ready || azure.ReadyReplicas != 0 {
+		t.Errorf("expected not-ready azure provider, got %+v", azure)
+	}
+}
+
+func TestImageTag(t *testing.T) {
+	tests := map[string]string{
+		"registry.k8s.io/capi/controller:v1.9.0":         "v1.9.0",
+		"localhost:5000/capi/controller":                 "",
+		"localhost:5000/capi/controller:dev":             "dev",
+		"ghcr.io/capi/controller:v1.0.0@sha256:deadbeef": "v1.0.0",
+		"controller": "",
+	}
+	for image, want := range tests {
+		if got := imageTag(image); got != want {
+			t.Errorf("imageTag(%q) = %q, want %q", image, got, want)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package clusters
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/k0rdent/mcp-k0rdent-server/internal/logging"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// ReconcileAnnotation is bumped with a timestamp to prompt the controller to requeue a
+// ClusterDeployment.
+const ReconcileAnnotation = "k0rdent.mirantis.com/reconcile"
+
+// ReconcileResult reports the reconcile annotation written to a ClusterDeployment.
+type ReconcileResult struct {
+	Name       string `json:"name"`
+	Namespace  string `json:"namespace"`
+	Annotation string `json:"annotation"`
+	Value      string `json:"value"`
+}
+
+// RequestReconcile sets the reconcile annotation of a ClusterDeployment to now (RFC 3339,
+// UTC) with a merge patch. The annotation change triggers a new reconciliation without
+// touching the spec.
+func (m *Manager) RequestReconcile(ctx context.Context, namespace, name string, now time.Time) (ReconcileResult, error) {
+	logger := logging.WithContext(ctx, m.logger)
+
+	if name == "" {
+		return ReconcileResult{}, fmt.Errorf("%w: name is required", ErrInvalidRequest)
+	}
+	if namespace == "" {
+		return ReconcileResult{}, fmt.Errorf("%w: namespace is required", ErrInvalidRequest)
+	}
+
+	value := now.UTC().Format(time.RFC3339Nano)
+	body, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"annotations": map[string]any{ReconcileAnnotation: value},
+		},
+	})
+	if err != nil {
+		return ReconcileResult{}, fmt.Errorf("encode reconcile patch: %w", err)
+	}
+
+	_, err = m.dynamicClient.Resource(ClusterDeploymentsGVR).Namespace(namespace).
+		Patch(ctx, name, types.MergePatchType, body, metav1.PatchOptions{FieldManager: m.fieldOwner})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return ReconcileResult{}, fmt.Errorf("%w: cluster deployment %s/%s", ErrResourceNotFound, namespace, name)
+		}
+		return ReconcileResult{}, fmt.Errorf("patch cluster deployment: %w", err)
+	}
+
+	logger.Info("cluster reconcile requested", "name", name, "namespace", namespace, "value", value)
+	return ReconcileResult{Name: name, Namespace: namespace, Annotation: ReconcileAnnotation, Value: value}, nil
+}
@@ -0,0 +1,39 @@
+package clusters
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic/fake"
+)
+
+func TestRequestReconcile(t *testing.T) {
+	client := fake.NewSimpleDynamicClient(runtime.NewScheme(), newUpdateTestDeployment())
+	manager := &Manager{dynamicClient: client, logger: slog.Default(), fieldOwner: "mcp.clusters"}
+
+	now := time.Date(2025, 11, 10, 8, 30, 0, 0, time.UTC)
+	result, err := manager.RequestReconcile(context.Background(), "kcm-system", "dev", now)
+	if err != nil {
+		t.Fatalf("RequestReconcile returned error: %v", err)
+	}
+	if result.Annotation != ReconcileAnnotation || result.Value != "2025-11-10T08:30:00Z" {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+
+	obj, err := client.Resource(ClusterDeploymentsGVR).Namespace("kcm-system").Get(context.Background(), "dev", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("get deployment: %v", err)
+	}
+	if got := obj.GetAnnotations()[ReconcileAnnotation]; got != result.Value {
+		t.Fatalf("expected annotation %q, got %q", result.Value, got)
+	}
+
+	if _, err := manager.RequestReconcile(context.Background(), "kcm-system", "missing", now); !errors.Is(err, ErrResourceNotFound) {
+		t.Fatalf("expected ErrResourceNotFound for missing cluster, got %v", err)
+	}
+}
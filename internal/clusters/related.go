@@ -0,0 +1,234 @@
+package clusters
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/k0rdent/mcp-k0rdent-server/internal/logging"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// capiClusterNameLabel is set by Cluster API on every object belonging to a cluster
+const capiClusterNameLabel = "cluster.x-k8s.io/cluster-name"
+
+// relatedResourceGVRs are the namespaced resources searched for objects belonging to a
+// ClusterDeployment. Resources whose CRD is not installed are skipped.
+var relatedResourceGVRs = []schema.GroupVersionResource{
+	CAPIClusterGVR,
+	{Group: "cluster.x-k8s.io", Version: "v1beta1", Resource: "machinedeployments"},
+	{Group: "cluster.x-k8s.io", Version: "v1beta1", Resource: "machinesets"},
+	{Group: "cluster.x-k8s.io", Version: "v1beta1", Resource: "machines"},
+	{Group: "controlplane.cluster.x-k8s.io", Version: "v1beta1", Resource: "k0scontrolplanes"},
+	{Group: "controlplane.cluster.x-k8s.io", Version: "v1beta1", Resource: "k0smotroncontrolplanes"},
+	{Group: "bootstrap.cluster.x-k8s.io", Version: "v1beta1", Resource: "k0sworkerconfigtemplates"},
+	{Group: "bootstrap.cluster.x-k8s.io", Version: "v1beta1", Resource: "k0sworkerconfigs"},
+	AWSClusterGVR,
+	{Group: "infrastructure.cluster.x-k8s.io", Version: "v1beta2", Resource: "awsmachinetemplates"},
+	{Group: "infrastructure.cluster.x-k8s.io", Version: "v1beta2", Resource: "awsmachines"},
+	AzureClusterGVR,
+	{Group: "infrastructure.cluster.x-k8s.io", Version: "v1beta1", Resource: "azuremachinetemplates"},
+	{Group: "infrastructure.cluster.x-k8s.io", Version: "v1beta1", Resource: "azuremachines"},
+	GCPClusterGVR,
+	{Group: "infrastructure.cluster.x-k8s.io", Version: "v1beta1", Resource: "gcpmachinetemplates"},
+	{Group: "infrastructure.cluster.x-k8s.io", Version: "v1beta1", Resource: "gcpmachines"},
+	{Group: "infrastructure.cluster.x-k8s.io", Version: "v1beta1", Resource: "vsphereclusters"},
+	{Group: "infrastructure.cluster.x-k8s.io", Version: "v1beta1", Resource: "vspheremachinetemplates"},
+	{Group: "infrastructure.cluster.x-k8s.io", Version: "v1beta1", Resource: "vspheremachines"},
+	{Group: "infrastructure.cluster.x-k8s.io", Version: "v1beta1", Resource: "openstackclusters"},
+	{Group: "infrastructure.cluster.x-k8s.io", Version: "v1beta1", Resource: "openstackmachinetemplates"},
+	{Group: "infrastructure.cluster.x-k8s.io", Version: "v1beta1", Resource: "openstackmachines"},
+	SecretsGVR,
+}
+
+// RelatedResource is a Kubernetes object belonging to a ClusterDeployment. Secret data is
+// never included.
+type RelatedResource struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Name       string `json:"name"`
+	Namespace  string `json:"namespace"`
+	// Parent is the "Kind/name" of the owning node; empty for the root
+	Parent string `json:"parent,omitempty"`
+	// Depth is the distance from the root ClusterDeployment (0 for the root)
+	Depth int `json:"depth"`
+	// Ready is the status of the Ready condition; nil when the object has none
+	Ready   *bool  `json:"ready,omitempty"`
+	Phase   string `json:"phase,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// RelatedResourceTree is the ownership tree rooted at a ClusterDeployment. The tree is
+// flattened depth-first: each resource follows its parent, and siblings are sorted by kind
+// and name, so Parent and Depth are enough to rebuild it.
+type RelatedResourceTree struct {
+	Root      RelatedResource   `json:"root"`
+	Resources []RelatedResource `json:"resources"`
+	// Count is the number of related objects, excluding the root
+	Count int `json:"count"`
+	// Warnings lists resource types that could not be listed
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// GetRelatedResources enumerates the objects belonging to a ClusterDeployment: objects
+// labelled with its cluster name (cluster.x-k8s.io/cluster-name), the CAPI Cluster named after
+// it, and anything owned, directly or transitively, by one of those. Objects are nested under
+// their owner; objects without an owner in the set hang off the ClusterDeployment.
+func (m *Manager) GetRelatedResources(ctx context.Context, namespace, name string) (RelatedResourceTree, error) {
+	logger := logging.WithContext(ctx, m.logger)
+
+	if name == "" {
+		return RelatedResourceTree{}, fmt.Errorf("%w: name is required", ErrInvalidRequest)
+	}
+	cd, err := m.getClusterDeployment(ctx, ClusterRef{Namespace: namespace, Name: name})
+	if err != nil {
+		return RelatedResourceTree{}, err
+	}
+
+	var candidates []unstructured.Unstructured
+	var warnings []string
+	for _, gvr := range relatedResourceGVRs {
+		list, listErr := m.listWithRetry(ctx, gvr, namespace)
+		if listErr != nil {
+			if apierrors.IsNotFound(listErr) {
+				continue
+			}
+			logger.Warn("failed to list related resources", "resource", gvr.Resource, "error", listErr)
+			warnings = append(warnings, fmt.Sprintf("list %s: %v", gvr.GroupResource(), listErr))
+			continue
+		}
+		candidates = append(candidates, list.Items...)
+	}
+
+	related := collectRelatedObjects(cd, candidates)
+	nodes := buildRelatedTree(cd, related)
+	tree := RelatedResourceTree{
+		Root:      nodes[0],
+		Resources: nodes[1:],
+		Count:     len(nodes) - 1,
+		Warnings:  warnings,
+	}
+
+	logger.Debug("related resources collected",
+		"name", name,
+		"namespace", namespace,
+		"count", tree.Count,
+		"warnings", len(warnings),
+	)
+	return tree, nil
+}
+
+// collectRelatedObjects selects the candidates that belong to the ClusterDeployment, adding
+// objects owned by already selected ones until no more are found.
+func collectRelatedObjects(cd *unstructured.Unstructured, candidates []unstructured.Unstructured) []*unstructured.Unstructured {
+	selected := map[types.UID]bool{cd.GetUID(): true}
+	var related []*unstructured.Unstructured
+	taken := make([]bool, len(candidates))
+
+	for changed := true; changed; {
+		changed = false
+		for i := range candidates {
+			if taken[i] {
+				continue
+			}
+			obj := &candidates[i]
+			if !belongsToCluster(obj, cd.GetName()) && !ownedBySelected(obj, selected) {
+				continue
+			}
+			taken[i] = true
+			selected[obj.GetUID()] = true
+			related = append(related, obj)
+			changed = true
+		}
+	}
+	return related
+}
+
+func belongsToCluster(obj *unstructured.Unstructured, clusterName string) bool {
+	if obj.GetLabels()[capiClusterNameLabel] == clusterName {
+		return true
+	}
+	return obj.GetKind() == "Cluster" && obj.GetName() == clusterName &&
+		obj.GroupVersionKind().Group == CAPIClusterGVR.Group
+}
+
+func ownedBySelected(obj *unstructured.Unstructured, selected map[types.UID]bool) bool {
+	for _, owner := range obj.GetOwnerReferences() {
+		if selected[owner.UID] {
+			return true
+		}
+	}
+	return false
+}
+
+// buildRelatedTree places related objects under their first owner in the set; the rest are
+// attached to the ClusterDeployment. It returns the tree flattened depth-first, starting with
+// the ClusterDeployment, with siblings sorted by kind and name.
+func buildRelatedTree(cd *unstructured.Unstructured, related []*unstructured.Unstructured) []RelatedResource {
+	present := map[types.UID]bool{cd.GetUID(): true}
+	for _, obj := range related {
+		present[obj.GetUID()] = true
+	}
+
+	children := make(map[types.UID][]*unstructured.Unstructured)
+	for _, obj := range related {
+		parent := cd.GetUID()
+		for _, owner := range obj.GetOwnerReferences() {
+			if present[owner.UID] && owner.UID != obj.GetUID() {
+				parent = owner.UID
+				break
+			}
+		}
+		children[parent] = append(children[parent], obj)
+	}
+	for _, siblings := range children {
+		sort.Slice(siblings, func(i, j int) bool {
+			if siblings[i].GetKind() != siblings[j].GetKind() {
+				return siblings[i].GetKind() < siblings[j].GetKind()
+			}
+			return siblings[i].GetName() < siblings[j].GetName()
+		})
+	}
+
+	nodes := make([]RelatedResource, 0, len(related)+1)
+	visited := make(map[types.UID]bool)
+	var walk func(obj *unstructured.Unstructured, parent string, depth int)
+	walk = func(obj *unstructured.Unstructured, parent string, depth int) {
+		visited[obj.GetUID()] = true
+		node := summarizeRelatedResource(obj)
+		node.Parent = parent
+		node.Depth = depth
+		nodes = append(nodes, node)
+		for _, child := range children[obj.GetUID()] {
+			if !visited[child.GetUID()] {
+				walk(child, obj.GetKind()+"/"+obj.GetName(), depth+1)
+			}
+		}
+	}
+	walk(cd, "", 0)
+	return nodes
+}
+
+func summarizeRelatedResource(obj *unstructured.Unstructured) RelatedResource {
+	node := RelatedResource{
+		APIVersion: obj.GetAPIVersion(),
+		Kind:       obj.GetKind(),
+		Name:       obj.GetName(),
+		Namespace:  obj.GetNamespace(),
+	}
+	node.Phase, _, _ = unstructured.NestedString(obj.Object, "status", "phase")
+	for _, cond := range extractConditions(obj) {
+		if cond.Type != "Ready" {
+			continue
+		}
+		ready := cond.Status == "True"
+		node.Ready = &ready
+		if !ready {
+			node.Message = cond.Message
+		}
+	}
+	return node
+}
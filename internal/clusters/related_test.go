@@ -0,0 +1,140 @@
+package clusters
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic/fake"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+func newRelatedObject(apiVersion, kind, name, uid string, labels map[string]string, owners ...*unstructured.Unstructured) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": apiVersion,
+		"kind":       kind,
+		"metadata": map[string]interface{}{
+			"name":      name,
+			"namespace": "team-a",
+			"uid":       uid,
+		},
+	}}
+	obj.SetLabels(labels)
+	refs := make([]metav1.OwnerReference, 0, len(owners))
+	for _, owner := range owners {
+		refs = append(refs, metav1.OwnerReference{APIVersion: owner.GetAPIVersion(), Kind: owner.GetKind(), Name: owner.GetName(), UID: owner.GetUID()})
+	}
+	obj.SetOwnerReferences(refs)
+	return obj
+}
+
+func newRelatedClient(objects ...runtime.Object) *fake.FakeDynamicClient {
+	listKinds := map[schema.GroupVersionResource]string{}
+	for _, gvr := range relatedResourceGVRs {
+		listKinds[gvr] = gvr.Resource + "List"
+	}
+	listKinds[ClusterDeploymentsGVR] = "ClusterDeploymentList"
+	return fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), listKinds, objects...)
+}
+
+func TestGetRelatedResources(t *testing.T) {
+	cd := createTestClusterDeployment("child", "team-a", nil)
+	cd.SetUID(types.UID("cd-uid"))
+	clusterLabel := map[string]string{capiClusterNameLabel: "child"}
+
+	capiCluster := newRelatedObject("cluster.x-k8s.io/v1beta1", "Cluster", "child", "cluster-uid", nil)
+	capiCluster.Object["status"] = map[string]interface{}{
+		"phase":      "Provisioned",
+		"conditions": []interface{}{map[string]interface{}{"type": "Ready", "status": "True"}},
+	}
+	awsCluster := newRelatedObject("infrastructure.cluster.x-k8s.io/v1beta2", "AWSCluster", "child", "aws-uid", nil, capiCluster)
+	awsCluster.Object["status"] = map[string]interface{}{
+		"conditions": []interface{}{map[string]interface{}{"type": "Ready", "status": "False", "message": "waiting for VPC"}},
+	}
+	md := newRelatedObject("cluster.x-k8s.io/v1beta1", "MachineDeployment", "child-md", "md-uid", clusterLabel)
+	ms := newRelatedObject("cluster.x-k8s.io/v1beta1", "MachineSet", "child-md-abc", "ms-uid", nil, md)
+	machine := newRelatedObject("cluster.x-k8s.io/v1beta1", "Machine", "child-md-abc-1", "machine-uid", nil, ms)
+	kubeconfig := newRelatedObject("v1", "Secret", "child-kubeconfig", "secret-uid", clusterLabel, capiCluster)
+	kubeconfig.Object["data"] = map[string]interface{}{"value": "c2VjcmV0"}
+
+	otherCluster := newRelatedObject("cluster.x-k8s.io/v1beta1", "Cluster", "other", "other-uid", nil)
+	otherSecret := newRelatedObject("v1", "Secret", "other-kubeconfig", "other-secret-uid", map[string]string{capiClusterNameLabel: "other"}, otherCluster)
+
+	client := newRelatedClient(cd, capiCluster, awsCluster, md, ms, machine, kubeconfig, otherCluster, otherSecret)
+	manager := &Manager{dynamicClient: client, logger: slog.Default()}
+
+	tree, err := manager.GetRelatedResources(context.Background(), "team-a", "child")
+	if err != nil {
+		t.Fatalf("GetRelatedResources returned error: %v", err)
+	}
+	if tree.Count != 6 || len(tree.Resources) != 6 {
+		t.Fatalf("expected 6 related objects, got %d (%+v)", tree.Count, tree.Resources)
+	}
+	if tree.Root.Kind != "ClusterDeployment" || tree.Root.Depth != 0 || tree.Root.Parent != "" {
+		t.Fatalf("unexpected root: %+v", tree.Root)
+	}
+
+	want := []struct {
+		kind, name, parent string
+		depth              int
+	}{
+		{"Cluster", "child", "ClusterDeployment/child", 1},
+		{"AWSCluster", "child", "Cluster/child", 2},
+		{"Secret", "child-kubeconfig", "Cluster/child", 2},
+		{"MachineDeployment", "child-md", "ClusterDeployment/child", 1},
+		{"MachineSet", "child-md-abc", "MachineDeployment/child-md", 2},
+		{"Machine", "child-md-abc-1", "MachineSet/child-md-abc", 3},
+	}
+	for i, w := range want {
+		got := tree.Resources[i]
+		if got.Kind != w.kind || got.Name != w.name || got.Parent != w.parent || got.Depth != w.depth {
+			t.Errorf("resource %d: expected %s/%s under %s at depth %d, got %+v", i, w.kind, w.name, w.parent, w.depth, got)
+		}
+	}
+
+	cluster := tree.Resources[0]
+	if cluster.Phase != "Provisioned" || cluster.Ready == nil || !*cluster.Ready {
+		t.Errorf("unexpected cluster node: %+v", cluster)
+	}
+	if infra := tree.Resources[1]; infra.Ready == nil || *infra.Ready || infra.Message != "waiting for VPC" {
+		t.Errorf("expected not-ready AWSCluster with message, got %+v", infra)
+	}
+}
+
+func TestGetRelatedResources_ListFailures(t *testing.T) {
+	cd := createTestClusterDeployment("child", "team-a", nil)
+	cd.SetUID(types.UID("cd-uid"))
+	client := newRelatedClient(cd)
+	client.PrependReactor("list", "openstackclusters", func(clienttesting.Action) (bool, runtime.Object, error) {
+		return true, nil, apierrors.NewNotFound(schema.GroupResource{Resource: "openstackclusters"}, "")
+	})
+	client.PrependReactor("list", "secrets", func(clienttesting.Action) (bool, runtime.Object, error) {
+		return true, nil, apierrors.NewForbidden(SecretsGVR.GroupResource(), "", errors.New("no access"))
+	})
+	manager := &Manager{dynamicClient: client, logger: slog.Default()}
+
+	tree, err := manager.GetRelatedResources(context.Background(), "team-a", "child")
+	if err != nil {
+		t.Fatalf("GetRelatedResources returned error: %v", err)
+	}
+	if len(tree.Warnings) != 1 || !strings.Contains(tree.Warnings[0], "secrets") {
+		t.Fatalf("expected a single secrets warning (missing CRDs are skipped), got %v", tree.Warnings)
+	}
+}
+
+func TestGetRelatedResources_NotFound(t *testing.T) {
+	manager := &Manager{dynamicClient: newRelatedClient(), logger: slog.Default()}
+
+	_, err := manager.GetRelatedResources(context.Background(), "team-a", "missing")
+	if !errors.Is(err, ErrResourceNotFound) {
+		t.Fatalf("expected ErrResourceNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,76 @@
+package clusters
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/k0rdent/mcp-k0rdent-server/internal/logging"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// ScaleResult reports the node counts of a ClusterDeployment after a scale request.
+type ScaleResult struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+
+	// Status is "updated" or "unchanged"
+	Status string `json:"status"`
+
+	ControlPlaneNumber int64 `json:"controlPlaneNumber"`
+	WorkersNumber      int64 `json:"workersNumber"`
+
+	// Changes lists the node count keys whose value changed
+	Changes []ConfigChange `json:"changes"`
+}
+
+// ScaleCluster patches spec.config.controlPlaneNumber and/or spec.config.workersNumber of a
+// ClusterDeployment. Only the counts that are non-nil are patched. The control plane needs
+// at least one node; the worker count may not be negative.
+func (m *Manager) ScaleCluster(ctx context.Context, namespace, name string, controlPlaneNumber, workersNumber *int) (ScaleResult, error) {
+	logger := logging.WithContext(ctx, m.logger)
+
+	patch := make(map[string]any, 2)
+	if controlPlaneNumber != nil {
+		if *controlPlaneNumber < 1 {
+			return ScaleResult{}, fmt.Errorf("%w: controlPlaneNumber must be at least 1 (got %d)", ErrInvalidRequest, *controlPlaneNumber)
+		}
+		patch["controlPlaneNumber"] = *controlPlaneNumber
+	}
+	if workersNumber != nil {
+		if *workersNumber < 0 {
+			return ScaleResult{}, fmt.Errorf("%w: workersNumber must not be negative (got %d)", ErrInvalidRequest, *workersNumber)
+		}
+		patch["workersNumber"] = *workersNumber
+	}
+	if len(patch) == 0 {
+		return ScaleResult{}, fmt.Errorf("%w: controlPlaneNumber or workersNumber is required", ErrInvalidRequest)
+	}
+
+	updated, err := m.UpdateClusterConfig(ctx, namespace, name, patch, false)
+	if err != nil {
+		return ScaleResult{}, err
+	}
+
+	current, err := m.dynamicClient.Resource(ClusterDeploymentsGVR).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return ScaleResult{}, fmt.Errorf("get cluster deployment: %w", err)
+	}
+	result := ScaleResult{
+		Name:      name,
+		Namespace: namespace,
+		Status:    updated.Status,
+		Changes:   updated.Changes,
+	}
+	result.ControlPlaneNumber, _, _ = unstructured.NestedInt64(current.Object, "spec", "config", "controlPlaneNumber")
+	result.WorkersNumber, _, _ = unstructured.NestedInt64(current.Object, "spec", "config", "workersNumber")
+
+	logger.Info("cluster scaled",
+		"name", name,
+		"namespace", namespace,
+		"status", result.Status,
+		"control_plane_number", result.ControlPlaneNumber,
+		"workers_number", result.WorkersNumber,
+	)
+	return result, nil
+}
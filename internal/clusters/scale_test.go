@@ -0,0 +1,60 @@
+package clusters
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic/fake"
+)
+
+func TestScaleCluster(t *testing.T) {
+	client := fake.NewSimpleDynamicClient(runtime.NewScheme(), newUpdateTestDeployment())
+	manager := &Manager{dynamicClient: client, logger: slog.Default(), fieldOwner: "mcp.clusters"}
+
+	workers := 5
+	result, err := manager.ScaleCluster(context.Background(), "kcm-system", "dev", nil, &workers)
+	if err != nil {
+		t.Fatalf("ScaleCluster returned error: %v", err)
+	}
+	if result.Status != "updated" || result.WorkersNumber != 5 {
+		t.Fatalf("expected workers scaled to 5, got %+v", result)
+	}
+	if len(result.Changes) != 1 || result.Changes[0].Path != "workersNumber" {
+		t.Fatalf("expected only workersNumber to change, got %+v", result.Changes)
+	}
+	if result.ControlPlaneNumber != 0 {
+		t.Fatalf("expected unset controlPlaneNumber to stay unset, got %d", result.ControlPlaneNumber)
+	}
+
+	result, err = manager.ScaleCluster(context.Background(), "kcm-system", "dev", nil, &workers)
+	if err != nil {
+		t.Fatalf("ScaleCluster returned error: %v", err)
+	}
+	if result.Status != "unchanged" {
+		t.Fatalf("expected unchanged status on repeat, got %q", result.Status)
+	}
+}
+
+func TestScaleClusterValidation(t *testing.T) {
+	client := fake.NewSimpleDynamicClient(runtime.NewScheme(), newUpdateTestDeployment())
+	manager := &Manager{dynamicClient: client, logger: slog.Default(), fieldOwner: "mcp.clusters"}
+
+	zero, negative := 0, -1
+	cases := map[string][2]*int{
+		"no counts":           {nil, nil},
+		"zero control plane":  {&zero, nil},
+		"negative workers":    {nil, &negative},
+		"negative everything": {&negative, &negative},
+	}
+	for name, counts := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := manager.ScaleCluster(context.Background(), "kcm-system", "dev", counts[0], counts[1])
+			if !errors.Is(err, ErrInvalidRequest) {
+				t.Fatalf("expected ErrInvalidRequest, got %v", err)
+			}
+		})
+	}
+}
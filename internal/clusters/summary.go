@@ -269,6 +269,11 @@ func extractOwner(obj *unstructured.Unstructured) string {
 	return ""
 }
 
+// ExtractConditions returns the status.conditions of any k0rdent or CAPI resource.
+func ExtractConditions(obj *unstructured.Unstructured) []ConditionSummary {
+	return extractConditions(obj)
+}
+
 func extractConditions(obj *unstructured.Unstructured) []ConditionSummary {
 	list, found, err := unstructured.NestedSlice(obj.Object, "status", "conditions")
 	if err != nil || !found || len(list) == 0 {
@@ -0,0 +1,203 @@
+package clusters
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/k0rdent/mcp-k0rdent-server/internal/logging"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ClusterTemplateChainsGVR is the GroupVersionResource for ClusterTemplateChain CRs, which
+// declare the supported templates of a namespace and their allowed upgrades.
+var ClusterTemplateChainsGVR = schema.GroupVersionResource{
+	Group:    "k0rdent.mirantis.com",
+	Version:  "v1beta1",
+	Resource: "clustertemplatechains",
+}
+
+// TemplateUpgradeChain groups the versions of one template family (e.g. "aws-standalone-cp")
+// for a provider, ordered from oldest to newest.
+type TemplateUpgradeChain struct {
+	Provider  string               `json:"provider"`
+	Family    string               `json:"family"`
+	Namespace string               `json:"namespace"`
+	Templates []TemplateChainEntry `json:"templates"`
+}
+
+// TemplateChainEntry is a template version and the templates it may be upgraded to.
+type TemplateChainEntry struct {
+	Name       string   `json:"name"`
+	Version    string   `json:"version,omitempty"`
+	K8sVersion string   `json:"k8s_version,omitempty"`
+	UpgradesTo []string `json:"upgradesTo,omitempty"`
+}
+
+// ListTemplateUpgradeChains lists ClusterTemplates in the namespaces and groups them into
+// upgrade chains. Allowed transitions come from the availableUpgrades of ClusterTemplateChain
+// resources in the same namespace; namespaces without chains report versions only. A
+// *PartialError is returned alongside the chains when templates or chains could not be listed
+// in some namespaces; the chains of those namespaces lack upgrades or are missing.
+func (m *Manager) ListTemplateUpgradeChains(ctx context.Context, namespaces []string) ([]TemplateUpgradeChain, error) {
+	logger := logging.WithContext(ctx, m.logger)
+
+	templates, err := m.ListTemplates(ctx, namespaces)
+	var partial *PartialError
+	if err != nil && !errors.As(err, &partial) {
+		return nil, err
+	}
+
+	perNamespace := make([]map[string][]string, len(namespaces))
+	errs := ForEachNamespace(ctx, namespaces, m.namespaceConcurrency, func(ctx context.Context, i int, ns string) error {
+		list, chainErr := m.listWithRetry(ctx, ClusterTemplateChainsGVR, ns)
+		if chainErr != nil {
+			if apierrors.IsNotFound(chainErr) {
+				return nil
+			}
+			return fmt.Errorf("list cluster template chains in namespace %s: %w", ns, chainErr)
+		}
+		perNamespace[i] = make(map[string][]string)
+		for j := range list.Items {
+			collectAvailableUpgrades(&list.Items[j], perNamespace[i])
+		}
+		return nil
+	})
+	if chainErr := collectNamespaceErrors(namespaces, errs); chainErr != nil {
+		var chainPartial *PartialError
+		if !errors.As(chainErr, &chainPartial) {
+			return nil, chainErr
+		}
+		partial = mergePartialErrors(partial, chainPartial)
+	}
+
+	upgrades := make(map[string][]string)
+	for _, nsUpgrades := range perNamespace {
+		for key, targets := range nsUpgrades {
+			upgrades[key] = targets
+		}
+	}
+
+	chains := BuildTemplateUpgradeChains(templates, upgrades)
+	logger.Info("cluster template upgrade chains built",
+		"chain_count", len(chains),
+		"template_count", len(templates),
+		"namespace_count", len(namespaces),
+	)
+	if partial != nil {
+		return chains, partial
+	}
+	return chains, nil
+}
+
+// mergePartialErrors adds the failures of extra to base, keeping one failure per namespace.
+func mergePartialErrors(base, extra *PartialError) *PartialError {
+	if base == nil {
+		return extra
+	}
+	for _, failure := range extra.Failures {
+		if !slices.ContainsFunc(base.Failures, func(f NamespaceFailure) bool { return f.Namespace == failure.Namespace }) {
+			base.Failures = append(base.Failures, failure)
+		}
+	}
+	return base
+}
+
+// collectAvailableUpgrades records spec.supportedTemplates[].availableUpgrades of a
+// ClusterTemplateChain, keyed by "namespace/template".
+func collectAvailableUpgrades(chain *unstructured.Unstructured, upgrades map[string][]string) {
+	supported, found, err := unstructured.NestedSlice(chain.Object, "spec", "supportedTemplates")
+	if err != nil || !found {
+		return
+	}
+	for _, entry := range supported {
+		tmpl, ok := entry.(map[string]any)
+		if !ok {
+			continue
+		}
+		name := asString(tmpl["name"])
+		if name == "" {
+			continue
+		}
+		key := chain.GetNamespace() + "/" + name
+		available, _ := tmpl["availableUpgrades"].([]any)
+		for _, item := range available {
+			upgrade, ok := item.(map[string]any)
+			if !ok {
+				continue
+			}
+			if target := asString(upgrade["name"]); target != "" && !slices.Contains(upgrades[key], target) {
+				upgrades[key] = append(upgrades[key], target)
+			}
+		}
+	}
+}
+
+// BuildTemplateUpgradeChains groups templates by namespace, provider, and family, orders each
+// chain by version, and annotates entries with the upgrades keyed by "namespace/template".
+func BuildTemplateUpgradeChains(templates []ClusterTemplateSummary, upgrades map[string][]string) []TemplateUpgradeChain {
+	index := make(map[string]int)
+	var chains []TemplateUpgradeChain
+	for _, tmpl := range templates {
+		provider := tmpl.Provider
+		if provider == "" {
+			provider = "unknown"
+		}
+		family := templateFamily(tmpl.Name, tmpl.Version)
+		key := tmpl.Namespace + "/" + provider + "/" + family
+		i, ok := index[key]
+		if !ok {
+			i = len(chains)
+			index[key] = i
+			chains = append(chains, TemplateUpgradeChain{Provider: provider, Family: family, Namespace: tmpl.Namespace})
+		}
+		targets := append([]string(nil), upgrades[tmpl.Namespace+"/"+tmpl.Name]...)
+		sort.Strings(targets)
+		chains[i].Templates = append(chains[i].Templates, TemplateChainEntry{
+			Name:       tmpl.Name,
+			Version:    tmpl.Version,
+			K8sVersion: tmpl.K8sVersion,
+			UpgradesTo: targets,
+		})
+	}
+
+	for i := range chains {
+		entries := chains[i].Templates
+		sort.SliceStable(entries, func(a, b int) bool {
+			if cmp := compareVersions(entries[a].Version, entries[b].Version); cmp != 0 {
+				return cmp < 0
+			}
+			return entries[a].Name < entries[b].Name
+		})
+	}
+	sort.SliceStable(chains, func(a, b int) bool {
+		if chains[a].Provider != chains[b].Provider {
+			return chains[a].Provider < chains[b].Provider
+		}
+		if chains[a].Family != chains[b].Family {
+			return chains[a].Family < chains[b].Family
+		}
+		return chains[a].Namespace < chains[b].Namespace
+	})
+	return chains
+}
+
+// templateFamily strips the version suffix from a template name:
+// "aws-standalone-cp-1-0-15" with version "1.0.15" -> "aws-standalone-cp".
+func templateFamily(name, version string) string {
+	for _, v := range []string{version, extractVersionFromName(name)} {
+		if v == "" {
+			continue
+		}
+		suffix := "-" + strings.ReplaceAll(v, ".", "-")
+		if strings.HasSuffix(name, suffix) && len(name) > len(suffix) {
+			return strings.TrimSuffix(name, suffix)
+		}
+	}
+	return name
+}
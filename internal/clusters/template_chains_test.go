@@ -0,0 +1,191 @@
+package clusters
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"reflect"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+// TestListTemplateUpgradeChains tests grouping templates into ordered chains annotated with
+// the availableUpgrades of a ClusterTemplateChain
+func TestListTemplateUpgradeChains(t *testing.T) {
+	awsLabels := map[string]string{"k0rdent.mirantis.com/provider": "aws"}
+	azureLabels := map[string]string{"k0rdent.mirantis.com/provider": "azure"}
+
+	chain := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "k0rdent.mirantis.com/v1beta1",
+			"kind":       "ClusterTemplateChain",
+			"metadata": map[string]interface{}{
+				"name":      "stable",
+				"namespace": "kcm-system",
+			},
+			"spec": map[string]interface{}{
+				"supportedTemplates": []interface{}{
+					map[string]interface{}{
+						"name": "aws-standalone-cp-1-0-14",
+						"availableUpgrades": []interface{}{
+							map[string]interface{}{"name": "aws-standalone-cp-1-0-15"},
+							map[string]interface{}{"name": "aws-standalone-cp-1-0-16"},
+						},
+					},
+					map[string]interface{}{
+						"name": "aws-standalone-cp-1-0-15",
+						"availableUpgrades": []interface{}{
+							map[string]interface{}{"name": "aws-standalone-cp-1-0-16"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	client := fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		ClusterTemplatesGVR:      "ClusterTemplateList",
+		ClusterTemplateChainsGVR: "ClusterTemplateChainList",
+	},
+		createTestClusterTemplateWithVersion("aws-standalone-cp-1-0-16", "kcm-system", "1.0.16", awsLabels),
+		createTestClusterTemplateWithVersion("aws-standalone-cp-1-0-14", "kcm-system", "1.0.14", awsLabels),
+		createTestClusterTemplateWithVersion("aws-standalone-cp-1-0-15", "kcm-system", "1.0.15", awsLabels),
+		createTestClusterTemplateWithVersion("azure-standalone-cp-1-0-2", "kcm-system", "1.0.2", azureLabels),
+		chain,
+	)
+
+	manager := &Manager{
+		dynamicClient:   client,
+		globalNamespace: "kcm-system",
+		logger:          slog.Default(),
+	}
+
+	chains, err := manager.ListTemplateUpgradeChains(context.Background(), []string{"kcm-system"})
+	if err != nil {
+		t.Fatalf("ListTemplateUpgradeChains returned error: %v", err)
+	}
+	if len(chains) != 2 {
+		t.Fatalf("expected 2 chains, got %d: %+v", len(chains), chains)
+	}
+
+	aws := chains[0]
+	if aws.Provider != "aws" || aws.Family != "aws-standalone-cp" {
+		t.Fatalf("unexpected first chain %s/%s", aws.Provider, aws.Family)
+	}
+	var names []string
+	for _, entry := range aws.Templates {
+		names = append(names, entry.Name)
+	}
+	wantNames := []string{"aws-standalone-cp-1-0-14", "aws-standalone-cp-1-0-15", "aws-standalone-cp-1-0-16"}
+	if !reflect.DeepEqual(names, wantNames) {
+		t.Fatalf("expected versions ordered %v, got %v", wantNames, names)
+	}
+	if want := []string{"aws-standalone-cp-1-0-15", "aws-standalone-cp-1-0-16"}; !reflect.DeepEqual(aws.Templates[0].UpgradesTo, want) {
+		t.Errorf("expected upgrades %v, got %v", want, aws.Templates[0].UpgradesTo)
+	}
+	if len(aws.Templates[2].UpgradesTo) != 0 {
+		t.Errorf("expected newest template to have no upgrades, got %v", aws.Templates[2].UpgradesTo)
+	}
+
+	azure := chains[1]
+	if azure.Provider != "azure" || len(azure.Templates) != 1 || len(azure.Templates[0].UpgradesTo) != 0 {
+		t.Errorf("unexpected azure chain: %+v", azure)
+	}
+}
+
+// TestListTemplateUpgradeChainsPartialFailure tests that a namespace whose chains cannot be
+// listed is reported in a PartialError while the other namespaces keep their upgrades
+func TestListTemplateUpgradeChainsPartialFailure(t *testing.T) {
+	awsLabels := map[string]string{"k0rdent.mirantis.com/provider": "aws"}
+	chain := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "k0rdent.mirantis.com/v1beta1",
+			"kind":       "ClusterTemplateChain",
+			"metadata": map[string]interface{}{
+				"name":      "stable",
+				"namespace": "kcm-system",
+			},
+			"spec": map[string]interface{}{
+				"supportedTemplates": []interface{}{
+					map[string]interface{}{
+						"name": "aws-standalone-cp-1-0-14",
+						"availableUpgrades": []interface{}{
+							map[string]interface{}{"name": "aws-standalone-cp-1-0-15"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	client := fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		ClusterTemplatesGVR:      "ClusterTemplateList",
+		ClusterTemplateChainsGVR: "ClusterTemplateChainList",
+	},
+		createTestClusterTemplateWithVersion("aws-standalone-cp-1-0-14", "kcm-system", "1.0.14", awsLabels),
+		createTestClusterTemplateWithVersion("aws-standalone-cp-1-0-15", "kcm-system", "1.0.15", awsLabels),
+		createTestClusterTemplateWithVersion("aws-standalone-cp-1-0-14", "team-a", "1.0.14", awsLabels),
+		chain,
+	)
+	client.PrependReactor("list", "clustertemplatechains", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		if action.GetNamespace() == "team-a" {
+			return true, nil, apierrors.NewForbidden(ClusterTemplateChainsGVR.GroupResource(), "", errors.New("denied"))
+		}
+		return false, nil, nil
+	})
+
+	manager := &Manager{
+		dynamicClient:   client,
+		globalNamespace: "kcm-system",
+		logger:          slog.Default(),
+	}
+
+	chains, err := manager.ListTemplateUpgradeChains(context.Background(), []string{"kcm-system", "team-a"})
+	var partial *PartialError
+	if !errors.As(err, &partial) {
+		t.Fatalf("expected PartialError, got %v", err)
+	}
+	if len(partial.Failures) != 1 || partial.Failures[0].Namespace != "team-a" || partial.Failures[0].Reason != "Forbidden" {
+		t.Fatalf("unexpected failures: %+v", partial.Failures)
+	}
+	if len(chains) != 2 {
+		t.Fatalf("expected 2 chains, got %d: %+v", len(chains), chains)
+	}
+	for _, c := range chains {
+		if c.Namespace == "kcm-system" && !reflect.DeepEqual(c.Templates[0].UpgradesTo, []string{"aws-standalone-cp-1-0-15"}) {
+			t.Errorf("expected kcm-system upgrades to be kept, got %+v", c.Templates)
+		}
+	}
+
+	client.PrependReactor("list", "clustertemplatechains", func(clienttesting.Action) (bool, runtime.Object, error) {
+		return true, nil, apierrors.NewForbidden(ClusterTemplateChainsGVR.GroupResource(), "", errors.New("denied"))
+	})
+	if _, err := manager.ListTemplateUpgradeChains(context.Background(), []string{"kcm-system", "team-a"}); err == nil || errors.As(err, &partial) {
+		t.Fatalf("expected a plain error when every namespace fails, got %v", err)
+	}
+}
+
+// TestTemplateFamily tests stripping version suffixes from template names
+func TestTemplateFamily(t *testing.T) {
+	tests := map[string]struct {
+		name    string
+		version string
+		want    string
+	}{
+		"spec version":     {name: "aws-standalone-cp-1-0-15", version: "1.0.15", want: "aws-standalone-cp"},
+		"name version":     {name: "azure-aks-1-0-2", version: "", want: "azure-aks"},
+		"no version":       {name: "custom-template", version: "", want: "custom-template"},
+		"version mismatch": {name: "gcp-gke-1-2-0", version: "v1.2.0", want: "gcp-gke"},
+	}
+	for label, tc := range tests {
+		if got := templateFamily(tc.name, tc.version); got != tc.want {
+			t.Errorf("%s: templateFamily(%q, %q) = %q, want %q", label, tc.name, tc.version, got, tc.want)
+		}
+	}
+}
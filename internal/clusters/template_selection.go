@@ -8,11 +8,13 @@ import (
 	"strings"
 
 	"github.com/k0rdent/mcp-k0rdent-server/internal/logging"
+	"k8s.io/apimachinery/pkg/labels"
 )
 
 // SelectLatestTemplate finds the latest stable template for the specified provider.
-// It filters templates by provider prefix pattern (e.g., "aws-standalone-cp-") and
-// returns the template name with the highest semantic version.
+// It filters templates by provider prefix pattern (e.g., "aws-standalone-cp-") and, when a
+// stable selector is configured, by template labels, then returns the template name with
+// the highest semantic version.
 // Returns error if no matching templates exist in the namespace.
 func (m *Manager) SelectLatestTemplate(ctx context.Context, provider string, namespace string) (string, error) {
 	logger := logging.WithContext(ctx, m.logger)
@@ -27,45 +29,79 @@ func (m *Manager) SelectLatestTemplate(ctx context.Context, provider string, nam
 		return "", fmt.Errorf("list templates: %w", err)
 	}
 
-	// Filter by provider prefix pattern (e.g., "aws-standalone-cp-")
-	pattern := fmt.Sprintf("%s-standalone-cp-", provider)
-	var matching []ClusterTemplateSummary
-	for _, t := range templates {
-		if strings.HasPrefix(t.Name, pattern) {
-			matching = append(matching, t)
-		}
-	}
-
-	if len(matching) == 0 {
+	latest, ok := m.LatestStableTemplate(templates, provider)
+	if !ok {
 		logger.Warn("no matching templates found",
 			"provider", provider,
 			"namespace", namespace,
-			"pattern", pattern,
+			"pattern", standaloneTemplatePrefix(provider),
+			"stable_selector", m.stableSelectorString(),
 		)
+		if m.stableSelector != nil {
+			return "", fmt.Errorf("no templates matching stable selector %q found for provider %s in namespace %s", m.stableSelector.String(), provider, namespace)
+		}
 		return "", fmt.Errorf("no templates found for provider %s in namespace %s", provider, namespace)
 	}
 
-	logger.Debug("found matching templates",
-		"count", len(matching),
-		"provider", provider,
-	)
-
-	// Sort by version (descending - highest version first)
-	sort.Slice(matching, func(i, j int) bool {
-		return compareVersions(matching[i].Version, matching[j].Version) > 0
-	})
-
-	latest := matching[0]
 	logger.Info("selected latest template",
 		"template", latest.Name,
 		"version", latest.Version,
 		"provider", provider,
 		"namespace", namespace,
+		"stable_selector", m.stableSelectorString(),
 	)
 
 	return latest.Name, nil
 }
 
+// LatestStableTemplate applies the manager's stable template policy: the highest-versioned
+// standalone template for the provider whose labels match the configured stable selector.
+func (m *Manager) LatestStableTemplate(templates []ClusterTemplateSummary, provider string) (ClusterTemplateSummary, bool) {
+	return LatestStandaloneTemplateMatching(templates, provider, m.stableSelector)
+}
+
+func (m *Manager) stableSelectorString() string {
+	if m.stableSelector == nil {
+		return ""
+	}
+	return m.stableSelector.String()
+}
+
+// LatestStandaloneTemplate returns the highest-versioned standalone control plane
+// template for the provider (e.g., "aws-standalone-cp-*") from the given templates.
+func LatestStandaloneTemplate(templates []ClusterTemplateSummary, provider string) (ClusterTemplateSummary, bool) {
+	return LatestStandaloneTemplateMatching(templates, provider, nil)
+}
+
+// LatestStandaloneTemplateMatching is LatestStandaloneTemplate restricted to templates whose
+// labels match selector. A nil selector matches every template.
+func LatestStandaloneTemplateMatching(templates []ClusterTemplateSummary, provider string, selector labels.Selector) (ClusterTemplateSummary, bool) {
+	pattern := standaloneTemplatePrefix(provider)
+	var matching []ClusterTemplateSummary
+	for _, t := range templates {
+		if !strings.HasPrefix(t.Name, pattern) {
+			continue
+		}
+		if selector != nil && !selector.Matches(labels.Set(t.Labels)) {
+			continue
+		}
+		matching = append(matching, t)
+	}
+	if len(matching) == 0 {
+		return ClusterTemplateSummary{}, false
+	}
+
+	// Sort by version (descending - highest version first)
+	sort.Slice(matching, func(i, j int) bool {
+		return compareVersions(matching[i].Version, matching[j].Version) > 0
+	})
+	return matching[0], true
+}
+
+func standaloneTemplatePrefix(provider string) string {
+	return fmt.Sprintf("%s-standalone-cp-", provider)
+}
+
 // compareVersions compares two semantic version strings.
 // Returns:
 //   - 1 if v1 > v2
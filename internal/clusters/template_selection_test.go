@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/dynamic/fake"
 )
@@ -376,3 +377,55 @@ func createTestClusterTemplateWithVersion(name, namespace, version string, label
 
 	return template
 }
+
+// TestLatestStandaloneTemplate tests picking the newest standalone template and its Kubernetes version
+func TestLatestStandaloneTemplate(t *testing.T) {
+	templates := []ClusterTemplateSummary{
+		{Name: "aws-standalone-cp-1-0-14", Version: "1.0.14", K8sVersion: "v1.31.1+k0s.0"},
+		{Name: "aws-standalone-cp-1-0-15", Version: "1.0.15", K8sVersion: "v1.32.1+k0s.0"},
+		{Name: "aws-eks-1-0-20", Version: "1.0.20", K8sVersion: "v1.33.0"},
+	}
+
+	latest, ok := LatestStandaloneTemplate(templates, "aws")
+	if !ok {
+		t.Fatal("expected a matching template")
+	}
+	if latest.Name != "aws-standalone-cp-1-0-15" || latest.K8sVersion != "v1.32.1+k0s.0" {
+		t.Errorf("unexpected latest template: %+v", latest)
+	}
+
+	if _, ok := LatestStandaloneTemplate(templates, "gcp"); ok {
+		t.Error("expected no template for gcp")
+	}
+}
+
+// TestLatestStandaloneTemplateMatching tests restricting selection to templates matching a stable selector
+func TestLatestStandaloneTemplateMatching(t *testing.T) {
+	templates := []ClusterTemplateSummary{
+		{Name: "aws-standalone-cp-1-0-14", Version: "1.0.14", Labels: map[string]string{"k0rdent.mirantis.com/channel": "stable"}},
+		{Name: "aws-standalone-cp-1-0-15", Version: "1.0.15", Labels: map[string]string{"k0rdent.mirantis.com/channel": "stable"}},
+		{Name: "aws-standalone-cp-1-1-0", Version: "1.1.0", Labels: map[string]string{"k0rdent.mirantis.com/channel": "candidate"}},
+		{Name: "aws-standalone-cp-1-2-0", Version: "1.2.0"},
+	}
+
+	selector, err := labels.Parse("k0rdent.mirantis.com/channel=stable")
+	if err != nil {
+		t.Fatalf("parse selector: %v", err)
+	}
+
+	manager := &Manager{stableSelector: selector}
+	latest, ok := manager.LatestStableTemplate(templates, "aws")
+	if !ok || latest.Name != "aws-standalone-cp-1-0-15" {
+		t.Errorf("expected stable template aws-standalone-cp-1-0-15, got %+v (found=%v)", latest, ok)
+	}
+
+	latest, ok = (&Manager{}).LatestStableTemplate(templates, "aws")
+	if !ok || latest.Name != "aws-standalone-cp-1-2-0" {
+		t.Errorf("expected default heuristic to pick aws-standalone-cp-1-2-0, got %+v", latest)
+	}
+
+	none, _ := labels.Parse("k0rdent.mirantis.com/channel=lts")
+	if _, ok := LatestStandaloneTemplateMatching(templates, "aws", none); ok {
+		t.Error("expected no template matching channel=lts")
+	}
+}
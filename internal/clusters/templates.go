@@ -2,6 +2,7 @@ package clusters
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/k0rdent/mcp-k0rdent-server/internal/logging"
@@ -22,27 +23,32 @@ var (
 // ListTemplates retrieves ClusterTemplate resources from the specified namespaces.
 // Returns summaries with key metadata including description, provider, cloud tags, and version.
 func (m *Manager) ListTemplates(ctx context.Context, namespaces []string) ([]ClusterTemplateSummary, error) {
+	return m.listTemplates(ctx, namespaces, "")
+}
+
+// listTemplates is ListTemplates restricted to objects matching labelSelector when it is set.
+func (m *Manager) listTemplates(ctx context.Context, namespaces []string, labelSelector string) ([]ClusterTemplateSummary, error) {
 	logger := logging.WithContext(ctx, m.logger)
-	logger.Debug("listing cluster templates", "namespace_count", len(namespaces))
+	logger.Debug("listing cluster templates", "namespace_count", len(namespaces), "label_selector", labelSelector)
 
 	if len(namespaces) == 0 {
 		logger.Warn("no namespaces provided for template listing")
 		return []ClusterTemplateSummary{}, nil
 	}
 
-	var summaries []ClusterTemplateSummary
+	perNamespace := make([][]ClusterTemplateSummary, len(namespaces))
 
-	// Query each namespace
-	for _, ns := range namespaces {
+	// Query namespaces concurrently; results are merged in namespace order below
+	errs := ForEachNamespace(ctx, namespaces, m.namespaceConcurrency, func(ctx context.Context, i int, ns string) error {
 		logger.Debug("listing templates in namespace", "namespace", ns)
 
-		list, err := m.dynamicClient.Resource(ClusterTemplatesGVR).Namespace(ns).List(ctx, metav1.ListOptions{})
+		list, err := m.listWithOptions(ctx, ClusterTemplatesGVR, ns, metav1.ListOptions{LabelSelector: labelSelector})
 		if err != nil {
 			logger.Error("failed to list templates in namespace",
 				"namespace", ns,
 				"error", err,
 			)
-			return nil, fmt.Errorf("list templates in namespace %s: %w", ns, err)
+			return fmt.Errorf("list templates in namespace %s: %w", ns, err)
 		}
 
 		logger.Debug("found templates in namespace",
@@ -61,8 +67,20 @@ func (m *Manager) ListTemplates(ctx context.Context, namespaces []string) ([]Clu
 				)
 				continue
 			}
-			summaries = append(summaries, summary)
+			perNamespace[i] = append(perNamespace[i], summary)
 		}
+		return nil
+	})
+
+	var summaries []ClusterTemplateSummary
+	for _, items := range perNamespace {
+		summaries = append(summaries, items...)
+	}
+
+	err := collectNamespaceErrors(namespaces, errs)
+	var partial *PartialError
+	if err != nil && !errors.As(err, &partial) {
+		return nil, err
 	}
 
 	logger.Info("cluster templates listed",
@@ -70,7 +88,37 @@ func (m *Manager) ListTemplates(ctx context.Context, namespaces []string) ([]Clu
 		"namespace_count", len(namespaces),
 	)
 
-	return summaries, nil
+	return summaries, err
+}
+
+// ListTemplatesPage returns one page of ClusterTemplate summaries across namespaces and
+// the continue token for the next page. A zero opts.Limit lists everything like ListTemplates.
+func (m *Manager) ListTemplatesPage(ctx context.Context, namespaces []string, opts ListOptions) ([]ClusterTemplateSummary, string, error) {
+	if opts.Limit == 0 && opts.Continue == "" {
+		summaries, err := m.listTemplates(ctx, namespaces, opts.LabelSelector)
+		return summaries, "", err
+	}
+
+	items, next, err := m.listPage(ctx, ClusterTemplatesGVR, namespaces, opts)
+	var partial *PartialError
+	if err != nil && !errors.As(err, &partial) {
+		return nil, "", err
+	}
+
+	summaries := make([]ClusterTemplateSummary, 0, len(items))
+	for i := range items {
+		summary, convErr := m.templateToSummary(&items[i])
+		if convErr != nil {
+			logging.WithContext(ctx, m.logger).Warn("failed to convert template to summary",
+				"namespace", items[i].GetNamespace(),
+				"name", items[i].GetName(),
+				"error", convErr,
+			)
+			continue
+		}
+		summaries = append(summaries, summary)
+	}
+	return summaries, next, err
 }
 
 // templateToSummary extracts key fields from a ClusterTemplate CR into a ClusterTemplateSummary.
@@ -109,6 +157,11 @@ func (m *Manager) templateToSummary(obj *unstructured.Unstructured) (ClusterTemp
 		summary.Version = extractVersionFromName(obj.GetName())
 	}
 
+	// Kubernetes version reported by the controller once the template is validated
+	if k8sVersion, found, err := unstructured.NestedString(obj.Object, "status", "k8sVersion"); err == nil && found {
+		summary.K8sVersion = k8sVersion
+	}
+
 	return summary, nil
 }
 
@@ -19,6 +19,13 @@ type ProviderSummary struct {
 	Name        string `json:"name"`
 	Title       string `json:"title"`
 	Description string `json:"description,omitempty"`
+
+	// Regions is a curated list of commonly used regions for the provider
+	Regions []string `json:"regions,omitempty"`
+
+	// DefaultK8sVersion is the Kubernetes version of the newest standalone template
+	// available for the provider in the global namespace
+	DefaultK8sVersion string `json:"default_k8s_version,omitempty"`
 }
 
 // IdentitySummary links ClusterIdentity resources to the Credentials that reference them.
@@ -38,6 +45,7 @@ type ClusterTemplateSummary struct {
 	Provider    string            `json:"provider,omitempty"`
 	Cloud       string            `json:"cloud,omitempty"`
 	Version     string            `json:"version,omitempty"`
+	K8sVersion  string            `json:"k8s_version,omitempty"`
 	Labels      map[string]string `json:"labels,omitempty"`
 	CreatedAt   time.Time         `json:"created_at"`
 }
@@ -59,6 +67,9 @@ type DeployRequest struct {
 	// Labels are additional labels to apply to the ClusterDeployment
 	Labels map[string]string `json:"labels,omitempty"`
 
+	// Annotations are additional annotations to apply to the ClusterDeployment
+	Annotations map[string]string `json:"annotations,omitempty"`
+
 	// Config is the arbitrary configuration object passed to spec.config
 	Config map[string]interface{} `json:"config,omitempty"`
 }
@@ -97,6 +108,53 @@ type DeleteResult struct {
 
 	// Status indicates "deleted" or "not_found" (idempotent)
 	Status string `json:"status"`
+
+	// Outcome discriminates what the request did: Deleting, AlreadyAbsent, or NotFound
+	Outcome DeleteOutcome `json:"outcome"`
+
+	// Initiated is true only when this request started the deletion
+	Initiated bool `json:"initiated"`
+}
+
+// DeleteOutcome describes the effect of a delete request on a ClusterDeployment.
+type DeleteOutcome string
+
+const (
+	// DeleteOutcomeDeleting means the ClusterDeployment is being deleted; Initiated reports
+	// whether this request started it or a deletion was already in progress.
+	DeleteOutcomeDeleting DeleteOutcome = "Deleting"
+	// DeleteOutcomeAlreadyAbsent means the ClusterDeployment existed but disappeared before
+	// the delete call, typically because a concurrent deletion finished.
+	DeleteOutcomeAlreadyAbsent DeleteOutcome = "AlreadyAbsent"
+	// DeleteOutcomeNotFound means no ClusterDeployment with that name exists.
+	DeleteOutcomeNotFound DeleteOutcome = "NotFound"
+)
+
+// UpdateConfigResult reports the outcome of a spec.config update.
+type UpdateConfigResult struct {
+	// Name of the ClusterDeployment
+	Name string `json:"name"`
+
+	// Namespace of the ClusterDeployment
+	Namespace string `json:"namespace"`
+
+	// Status is "updated", "unchanged", or "dry_run"
+	Status string `json:"status"`
+
+	// Changes lists every spec.config key whose value changed
+	Changes []ConfigChange `json:"changes"`
+}
+
+// ConfigChange describes a single changed spec.config key.
+type ConfigChange struct {
+	// Path is the dotted key path below spec.config (e.g. "worker.instanceType")
+	Path string `json:"path"`
+
+	// Old is the previous value (omitted when the key was added)
+	Old any `json:"old,omitempty"`
+
+	// New is the updated value (omitted when the key was removed)
+	New any `json:"new,omitempty"`
 }
 
 // ClusterDeploymentSummary captures key metadata about a ClusterDeployment resource.
@@ -115,6 +173,7 @@ type ClusterDeploymentSummary struct {
 	Region             string             `json:"region,omitempty"`
 	Ready              bool               `json:"ready"`
 	Phase              string             `json:"phase,omitempty"`
+	ProvisioningPhase  string             `json:"provisioningPhase,omitempty"`
 	Message            string             `json:"message,omitempty"`
 	Conditions         []ConditionSummary `json:"conditions,omitempty"`
 	KubeconfigSecret   ResourceReference  `json:"kubeconfigSecret,omitempty"`
@@ -233,6 +292,9 @@ type AWSClusterDetail struct {
 
 	// Provider-specific conditions
 	Conditions []ConditionSummary `json:"conditions,omitempty"`
+
+	// Tags applied to all AWS resources (AWSCluster spec.additionalTags)
+	Tags map[string]string `json:"tags,omitempty"`
 }
 
 // AWSInfrastructure contains AWS-specific resource IDs and topology.
@@ -256,17 +318,19 @@ type AWSInfrastructure struct {
 
 // AWSVPC represents an AWS VPC.
 type AWSVPC struct {
-	ID   string `json:"id"`
-	CIDR string `json:"cidr,omitempty"`
+	ID   string            `json:"id"`
+	CIDR string            `json:"cidr,omitempty"`
+	Tags map[string]string `json:"tags,omitempty"`
 }
 
 // AWSSubnet represents an AWS subnet.
 type AWSSubnet struct {
-	ID               string `json:"id"`
-	CIDR             string `json:"cidr,omitempty"`
-	AvailabilityZone string `json:"availabilityZone,omitempty"`
-	IsPublic         bool   `json:"isPublic,omitempty"`
-	Role             string `json:"role,omitempty"` // e.g., "control-plane", "worker"
+	ID               string            `json:"id"`
+	CIDR             string            `json:"cidr,omitempty"`
+	AvailabilityZone string            `json:"availabilityZone,omitempty"`
+	IsPublic         bool              `json:"isPublic,omitempty"`
+	Role             string            `json:"role,omitempty"` // e.g., "control-plane", "worker"
+	Tags             map[string]string `json:"tags,omitempty"`
 }
 
 // AWSInternetGateway represents an AWS Internet Gateway.
@@ -382,3 +446,45 @@ type EndpointInfo struct {
 	Host string `json:"host"`
 	Port int32  `json:"port,omitempty"`
 }
+
+// ClusterMetricsSummary aggregates metrics.k8s.io usage for a child cluster.
+type ClusterMetricsSummary struct {
+	Name        string           `json:"name"`
+	Namespace   string           `json:"namespace"`
+	CollectedAt time.Time        `json:"collectedAt"`
+	Total       ResourceUsage    `json:"total"`
+	Nodes       []NodeUsage      `json:"nodes"`
+	Namespaces  []NamespaceUsage `json:"namespaces"`
+}
+
+// ResourceUsage captures CPU and memory consumption.
+type ResourceUsage struct {
+	CPUMillicores int64 `json:"cpuMillicores"`
+	MemoryBytes   int64 `json:"memoryBytes"`
+}
+
+// NodeUsage reports usage for a single child cluster node.
+type NodeUsage struct {
+	Name  string        `json:"name"`
+	Usage ResourceUsage `json:"usage"`
+}
+
+// NamespaceUsage reports aggregated pod usage for a child cluster namespace.
+type NamespaceUsage struct {
+	Namespace string        `json:"namespace"`
+	PodCount  int           `json:"podCount"`
+	Usage     ResourceUsage `json:"usage"`
+}
+
+// ClusterEndpoint reports a child cluster's API server endpoint and whether it answers.
+type ClusterEndpoint struct {
+	Name      string        `json:"name"`
+	Namespace string        `json:"namespace"`
+	Endpoint  *EndpointInfo `json:"endpoint,omitempty"`
+	URL       string        `json:"url,omitempty"`
+	// Reachable is true when a TCP connection and TLS handshake to the endpoint succeeded.
+	Reachable bool      `json:"reachable"`
+	LatencyMs int64     `json:"latencyMs,omitempty"`
+	Error     string    `json:"error,omitempty"`
+	CheckedAt time.Time `json:"checkedAt"`
+}
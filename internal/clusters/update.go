@@ -0,0 +1,188 @@
+package clusters
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/k0rdent/mcp-k0rdent-server/internal/logging"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+)
+
+// immutableConfigFields are spec.config keys that cannot change after a cluster is
+// provisioned (changing them would require recreating the cluster).
+var immutableConfigFields = []string{
+	"clusterIdentity",
+	"region",
+	"location",
+	"project",
+	"subscriptionID",
+}
+
+// UpdateClusterConfig applies a JSON merge patch (RFC 7386) to a ClusterDeployment's
+// spec.config. Keys set to null are removed. The patch is rejected if it changes an
+// immutable field. The merge patch carries the observed resourceVersion, so concurrent
+// writers cause a conflict that is retried against the latest object.
+func (m *Manager) UpdateClusterConfig(ctx context.Context, namespace, name string, patch map[string]any, dryRun bool) (UpdateConfigResult, error) {
+	logger := logging.WithContext(ctx, m.logger)
+	logger.Info("updating cluster config",
+		"name", name,
+		"namespace", namespace,
+		"dry_run", dryRun,
+	)
+
+	if name == "" {
+		return UpdateConfigResult{}, fmt.Errorf("%w: name is required", ErrInvalidRequest)
+	}
+	if namespace == "" {
+		return UpdateConfigResult{}, fmt.Errorf("%w: namespace is required", ErrInvalidRequest)
+	}
+	if len(patch) == 0 {
+		return UpdateConfigResult{}, fmt.Errorf("%w: config patch is required", ErrInvalidRequest)
+	}
+
+	result := UpdateConfigResult{Name: name, Namespace: namespace}
+	client := m.dynamicClient.Resource(ClusterDeploymentsGVR).Namespace(namespace)
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		current, err := client.Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return fmt.Errorf("%w: cluster deployment %s/%s", ErrResourceNotFound, namespace, name)
+			}
+			return fmt.Errorf("get cluster deployment: %w", err)
+		}
+
+		oldConfig, _, _ := unstructured.NestedMap(current.Object, "spec", "config")
+		newConfig := mergePatch(oldConfig, patch)
+		changes := diffConfig("", oldConfig, newConfig)
+
+		for _, change := range changes {
+			if field := immutableField(change.Path); field != "" {
+				return fmt.Errorf("%w: spec.config.%s is immutable", ErrInvalidRequest, field)
+			}
+		}
+
+		result.Changes = changes
+		switch {
+		case len(changes) == 0:
+			result.Status = "unchanged"
+			return nil
+		case dryRun:
+			result.Status = "dry_run"
+			return nil
+		}
+
+		body, err := json.Marshal(map[string]any{
+			"metadata": map[string]any{"resourceVersion": current.GetResourceVersion()},
+			"spec":     map[string]any{"config": patch},
+		})
+		if err != nil {
+			return fmt.Errorf("encode config patch: %w", err)
+		}
+		if _, err := client.Patch(ctx, name, types.MergePatchType, body, metav1.PatchOptions{FieldManager: m.fieldOwner}); err != nil {
+			if apierrors.IsConflict(err) {
+				logger.Debug("cluster deployment changed concurrently, retrying", "name", name, "namespace", namespace)
+				return err
+			}
+			return fmt.Errorf("patch cluster deployment: %w", err)
+		}
+		result.Status = "updated"
+		return nil
+	})
+	if err != nil {
+		logger.Warn("cluster config update failed", "name", name, "namespace", namespace, "error", err)
+		return UpdateConfigResult{}, err
+	}
+
+	logger.Info("cluster config update complete",
+		"name", name,
+		"namespace", namespace,
+		"status", result.Status,
+		"changed_keys", len(result.Changes),
+	)
+	return result, nil
+}
+
+// immutableField returns the immutable top-level key touched by a change path, if any.
+func immutableField(path string) string {
+	top, _, _ := strings.Cut(path, ".")
+	for _, field := range immutableConfigFields {
+		if top == field {
+			return field
+		}
+	}
+	return ""
+}
+
+// mergePatch returns target with an RFC 7386 JSON merge patch applied. Neither input is modified.
+func mergePatch(target, patch map[string]any) map[string]any {
+	out := make(map[string]any, len(target)+len(patch))
+	for k, v := range target {
+		out[k] = v
+	}
+	for k, v := range patch {
+		if v == nil {
+			delete(out, k)
+			continue
+		}
+		if patchMap, ok := v.(map[string]any); ok {
+			existing, _ := out[k].(map[string]any)
+			out[k] = mergePatch(existing, patchMap)
+			continue
+		}
+		out[k] = v
+	}
+	return out
+}
+
+// diffConfig lists leaf-level differences between two config maps, sorted by path.
+func diffConfig(prefix string, oldConfig, newConfig map[string]any) []ConfigChange {
+	keys := make(map[string]struct{}, len(oldConfig)+len(newConfig))
+	for k := range oldConfig {
+		keys[k] = struct{}{}
+	}
+	for k := range newConfig {
+		keys[k] = struct{}{}
+	}
+
+	var changes []ConfigChange
+	for k := range keys {
+		path := k
+		if prefix != "" {
+			path = prefix + "." + k
+		}
+		oldValue, hadOld := oldConfig[k]
+		newValue, hasNew := newConfig[k]
+		oldMap, oldIsMap := oldValue.(map[string]any)
+		newMap, newIsMap := newValue.(map[string]any)
+		switch {
+		case oldIsMap && newIsMap:
+			changes = append(changes, diffConfig(path, oldMap, newMap)...)
+		case hadOld && hasNew && jsonEqual(oldValue, newValue):
+		default:
+			changes = append(changes, ConfigChange{Path: path, Old: oldValue, New: newValue})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Path < changes[j].Path })
+	return changes
+}
+
+// jsonEqual compares values by their JSON encoding so that numeric types decoded
+// from different sources (int64 vs float64) compare equal.
+func jsonEqual(a, b any) bool {
+	if reflect.DeepEqual(a, b) {
+		return true
+	}
+	aJSON, errA := json.Marshal(a)
+	bJSON, errB := json.Marshal(b)
+	return errA == nil && errB == nil && string(aJSON) == string(bJSON)
+}
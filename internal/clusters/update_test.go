@@ -0,0 +1,114 @@
+package clusters
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic/fake"
+)
+
+func newUpdateTestDeployment() *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "k0rdent.mirantis.com/v1beta1",
+		"kind":       "ClusterDeployment",
+		"metadata":   map[string]interface{}{"name": "dev", "namespace": "kcm-system"},
+		"spec": map[string]interface{}{
+			"template": "aws-standalone-cp-1-0-0",
+			"config": map[string]interface{}{
+				"region":        "us-west-2",
+				"workersNumber": int64(2),
+				"worker":        map[string]interface{}{"instanceType": "t3.small", "rootVolumeSize": int64(32)},
+				"bastion":       map[string]interface{}{"enabled": true},
+			},
+		},
+	}}
+}
+
+func TestUpdateClusterConfig(t *testing.T) {
+	client := fake.NewSimpleDynamicClient(runtime.NewScheme(), newUpdateTestDeployment())
+	manager := &Manager{dynamicClient: client, logger: slog.Default(), fieldOwner: "mcp.clusters"}
+
+	patch := map[string]any{
+		"worker":        map[string]any{"instanceType": "t3.large"},
+		"workersNumber": float64(2),
+		"bastion":       nil,
+	}
+	result, err := manager.UpdateClusterConfig(context.Background(), "kcm-system", "dev", patch, false)
+	if err != nil {
+		t.Fatalf("UpdateClusterConfig returned error: %v", err)
+	}
+	if result.Status != "updated" {
+		t.Fatalf("expected status updated, got %q", result.Status)
+	}
+	if len(result.Changes) != 2 || result.Changes[0].Path != "bastion" || result.Changes[0].New != nil || result.Changes[1].Path != "worker.instanceType" {
+		t.Fatalf("unexpected changes: %+v", result.Changes)
+	}
+
+	obj, err := client.Resource(ClusterDeploymentsGVR).Namespace("kcm-system").Get(context.Background(), "dev", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("get updated deployment: %v", err)
+	}
+	instanceType, _, _ := unstructured.NestedString(obj.Object, "spec", "config", "worker", "instanceType")
+	if instanceType != "t3.large" {
+		t.Errorf("expected instanceType t3.large, got %q", instanceType)
+	}
+	rootVolume, _, _ := unstructured.NestedInt64(obj.Object, "spec", "config", "worker", "rootVolumeSize")
+	if rootVolume != 32 {
+		t.Errorf("expected untouched rootVolumeSize 32, got %d", rootVolume)
+	}
+	if _, found, _ := unstructured.NestedMap(obj.Object, "spec", "config", "bastion"); found {
+		t.Errorf("expected bastion to be removed")
+	}
+}
+
+func TestUpdateClusterConfig_ImmutableField(t *testing.T) {
+	client := fake.NewSimpleDynamicClient(runtime.NewScheme(), newUpdateTestDeployment())
+	manager := &Manager{dynamicClient: client, logger: slog.Default()}
+
+	_, err := manager.UpdateClusterConfig(context.Background(), "kcm-system", "dev", map[string]any{"region": "us-east-1"}, false)
+	if !errors.Is(err, ErrInvalidRequest) {
+		t.Fatalf("expected ErrInvalidRequest for immutable field, got %v", err)
+	}
+
+	// Re-stating the current value is not a change
+	result, err := manager.UpdateClusterConfig(context.Background(), "kcm-system", "dev", map[string]any{"region": "us-west-2"}, false)
+	if err != nil {
+		t.Fatalf("unexpected error for unchanged immutable field: %v", err)
+	}
+	if result.Status != "unchanged" {
+		t.Errorf("expected status unchanged, got %q", result.Status)
+	}
+}
+
+func TestUpdateClusterConfig_DryRun(t *testing.T) {
+	client := fake.NewSimpleDynamicClient(runtime.NewScheme(), newUpdateTestDeployment())
+	manager := &Manager{dynamicClient: client, logger: slog.Default()}
+
+	result, err := manager.UpdateClusterConfig(context.Background(), "kcm-system", "dev", map[string]any{"workersNumber": float64(4)}, true)
+	if err != nil {
+		t.Fatalf("UpdateClusterConfig returned error: %v", err)
+	}
+	if result.Status != "dry_run" || len(result.Changes) != 1 {
+		t.Fatalf("unexpected dry-run result: %+v", result)
+	}
+
+	obj, _ := client.Resource(ClusterDeploymentsGVR).Namespace("kcm-system").Get(context.Background(), "dev", metav1.GetOptions{})
+	workers, _, _ := unstructured.NestedInt64(obj.Object, "spec", "config", "workersNumber")
+	if workers != 2 {
+		t.Errorf("expected dry run to leave workersNumber at 2, got %d", workers)
+	}
+}
+
+func TestUpdateClusterConfig_NotFound(t *testing.T) {
+	manager := &Manager{dynamicClient: fake.NewSimpleDynamicClient(runtime.NewScheme()), logger: slog.Default()}
+
+	_, err := manager.UpdateClusterConfig(context.Background(), "kcm-system", "missing", map[string]any{"workersNumber": float64(3)}, false)
+	if !errors.Is(err, ErrResourceNotFound) {
+		t.Fatalf("expected ErrResourceNotFound, got %v", err)
+	}
+}
@@ -13,6 +13,12 @@ const (
 	// ProviderGCP represents Google Cloud Platform
 	ProviderGCP ProviderType = "gcp"
 
+	// ProviderVSphere represents VMware vSphere
+	ProviderVSphere ProviderType = "vsphere"
+
+	// ProviderOpenStack represents OpenStack
+	ProviderOpenStack ProviderType = "openstack"
+
 	// ProviderUnknown represents an unknown or unsupported provider
 	ProviderUnknown ProviderType = "unknown"
 )
@@ -51,6 +57,8 @@ func (r ValidationResult) IsValid() bool {
 // - "aws-*" -> ProviderAWS
 // - "azure-*" -> ProviderAzure
 // - "gcp-*" -> ProviderGCP
+// - "vsphere-*" -> ProviderVSphere
+// - "openstack-*" -> ProviderOpenStack
 // - anything else -> ProviderUnknown
 func DetectProvider(templateName string) ProviderType {
 	// Convert to lowercase for case-insensitive matching
@@ -74,6 +82,12 @@ func DetectProvider(templateName string) ProviderType {
 	if len(lower) >= 4 && lower[:4] == "gcp-" {
 		return ProviderGCP
 	}
+	if len(lower) >= 8 && lower[:8] == "vsphere-" {
+		return ProviderVSphere
+	}
+	if len(lower) >= 10 && lower[:10] == "openstack-" {
+		return ProviderOpenStack
+	}
 
 	return ProviderUnknown
 }
@@ -91,6 +105,10 @@ func ValidateConfig(templateName string, config map[string]interface{}) Validati
 		return ValidateAzureConfig(config)
 	case ProviderGCP:
 		return ValidateGCPConfig(config)
+	case ProviderVSphere:
+		return ValidateVSphereConfig(config)
+	case ProviderOpenStack:
+		return ValidateOpenStackConfig(config)
 	case ProviderUnknown:
 		// No validation for unknown providers - allow them through
 		return ValidationResult{
@@ -0,0 +1,94 @@
+package clusters
+
+import "fmt"
+
+// ValidateOpenStackConfig validates OpenStack-specific cluster configuration.
+// It checks for required fields per OpenStack ClusterDeployment documentation:
+// https://docs.k0rdent.io/latest/admin/installation/prepare-mgmt-cluster/openstack/
+func ValidateOpenStackConfig(config map[string]interface{}) ValidationResult {
+	result := ValidationResult{
+		Provider: ProviderOpenStack,
+	}
+
+	// Check for required Keystone endpoint and region
+	if !hasNonEmptyString(config, "authURL") {
+		result.Errors = append(result.Errors, ValidationError{
+			Field:   "config.authURL",
+			Message: "OpenStack Keystone auth URL is required (e.g., 'https://keystone.example.com:5000/v3')",
+			Code:    "openstack.authURL.required",
+		})
+	}
+	if !hasNonEmptyNestedString(config, "identityRef.region") {
+		result.Errors = append(result.Errors, ValidationError{
+			Field:   "config.identityRef.region",
+			Message: "OpenStack region is required (e.g., 'RegionOne')",
+			Code:    "openstack.identityRef.region.required",
+		})
+	}
+
+	// Check for required external network
+	if !hasNonEmptyNestedString(config, "externalNetwork.filter.name") {
+		result.Errors = append(result.Errors, ValidationError{
+			Field:   "config.externalNetwork.filter.name",
+			Message: "OpenStack external network name is required (e.g., 'public')",
+			Code:    "openstack.externalNetwork.required",
+		})
+	}
+
+	// Check for the flavor and image of each node pool
+	for _, role := range []string{"controlPlane", "worker"} {
+		if !hasNonEmptyNestedString(config, role+".flavor") {
+			result.Errors = append(result.Errors, ValidationError{
+				Field:   "config." + role + ".flavor",
+				Message: "OpenStack flavor is required (e.g., 'm1.medium')",
+				Code:    "openstack." + role + ".flavor.required",
+			})
+		}
+		if !hasNonEmptyNestedString(config, role+".image.filter.name") {
+			result.Errors = append(result.Errors, ValidationError{
+				Field:   "config." + role + ".image.filter.name",
+				Message: "OpenStack image name is required (e.g., 'ubuntu-22.04-x86_64')",
+				Code:    "openstack." + role + ".image.required",
+			})
+		}
+	}
+
+	return result
+}
+
+// FormatOpenStackValidationError formats validation errors for OpenStack configurations.
+// It provides helpful error messages with examples of correct configuration.
+func FormatOpenStackValidationError(errors []ValidationError) string {
+	if len(errors) == 0 {
+		return ""
+	}
+
+	msg := "OpenStack cluster configuration validation failed:\n"
+	for _, err := range errors {
+		msg += fmt.Sprintf("  - %s: %s\n", err.Field, err.Message)
+	}
+
+	msg += "\nExample valid OpenStack configuration:\n"
+	msg += `{
+  "authURL": "https://keystone.example.com:5000/v3",
+  "identityRef": {
+    "cloudName": "openstack",
+    "region": "RegionOne"
+  },
+  "externalNetwork": {
+    "filter": {"name": "public"}
+  },
+  "controlPlane": {
+    "flavor": "m1.medium",
+    "image": {"filter": {"name": "ubuntu-22.04-x86_64"}}
+  },
+  "worker": {
+    "flavor": "m1.medium",
+    "image": {"filter": {"name": "ubuntu-22.04-x86_64"}}
+  }
+}
+
+For more information, see: https://docs.k0rdent.io/latest/admin/installation/prepare-mgmt-cluster/openstack/`
+
+	return msg
+}
@@ -0,0 +1,111 @@
+package clusters
+
+import (
+	"strings"
+	"testing"
+)
+
+func validOpenStackConfig() map[string]interface{} {
+	node := func() map[string]interface{} {
+		return map[string]interface{}{
+			"flavor": "m1.medium",
+			"image": map[string]interface{}{
+				"filter": map[string]interface{}{"name": "ubuntu-22.04-x86_64"},
+			},
+		}
+	}
+	return map[string]interface{}{
+		"authURL": "https://keystone.example.com:5000/v3",
+		"identityRef": map[string]interface{}{
+			"cloudName": "openstack",
+			"region":    "RegionOne",
+		},
+		"externalNetwork": map[string]interface{}{
+			"filter": map[string]interface{}{"name": "public"},
+		},
+		"controlPlane": node(),
+		"worker":       node(),
+	}
+}
+
+// TestValidateOpenStackConfig tests OpenStack configuration validation
+func TestValidateOpenStackConfig(t *testing.T) {
+	tests := []struct {
+		name           string
+		mutate         func(config map[string]interface{})
+		wantErrorCodes []string
+	}{
+		{
+			name:   "valid OpenStack config",
+			mutate: func(map[string]interface{}) {},
+		},
+		{
+			name: "missing auth URL",
+			mutate: func(config map[string]interface{}) {
+				delete(config, "authURL")
+			},
+			wantErrorCodes: []string{"openstack.authURL.required"},
+		},
+		{
+			name: "missing region",
+			mutate: func(config map[string]interface{}) {
+				delete(config["identityRef"].(map[string]interface{}), "region")
+			},
+			wantErrorCodes: []string{"openstack.identityRef.region.required"},
+		},
+		{
+			name: "external network given as plain string",
+			mutate: func(config map[string]interface{}) {
+				config["externalNetwork"] = "public"
+			},
+			wantErrorCodes: []string{"openstack.externalNetwork.required"},
+		},
+		{
+			name: "control plane without flavor and image",
+			mutate: func(config map[string]interface{}) {
+				config["controlPlane"] = map[string]interface{}{}
+			},
+			wantErrorCodes: []string{"openstack.controlPlane.flavor.required", "openstack.controlPlane.image.required"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := validOpenStackConfig()
+			tt.mutate(config)
+
+			result := ValidateOpenStackConfig(config)
+			if result.Provider != ProviderOpenStack {
+				t.Errorf("expected provider %s, got %s", ProviderOpenStack, result.Provider)
+			}
+			if len(result.Errors) != len(tt.wantErrorCodes) {
+				t.Fatalf("expected %d errors, got %d: %+v", len(tt.wantErrorCodes), len(result.Errors), result.Errors)
+			}
+			for i, code := range tt.wantErrorCodes {
+				if result.Errors[i].Code != code {
+					t.Errorf("error %d: expected code %q, got %q", i, code, result.Errors[i].Code)
+				}
+			}
+		})
+	}
+}
+
+// TestFormatOpenStackValidationError tests OpenStack error formatting
+func TestFormatOpenStackValidationError(t *testing.T) {
+	if msg := FormatOpenStackValidationError(nil); msg != "" {
+		t.Errorf("expected empty message for no errors, got %q", msg)
+	}
+
+	msg := FormatOpenStackValidationError(ValidateOpenStackConfig(map[string]interface{}{}).Errors)
+	for _, want := range []string{
+		"OpenStack cluster configuration validation failed",
+		"config.authURL",
+		"config.worker.image.filter.name",
+		"Example valid OpenStack configuration",
+		"https://docs.k0rdent.io",
+	} {
+		if !strings.Contains(msg, want) {
+			t.Errorf("expected message to contain %q, got: %s", want, msg)
+		}
+	}
+}
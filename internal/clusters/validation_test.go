@@ -131,9 +131,18 @@ func TestDetectProvider(t *testing.T) {
 		{"GCP mixed case", "Gcp-Standalone-Cp", ProviderGCP},
 		{"GCP prefix only", "gcp-", ProviderGCP},
 
+		// vSphere templates
+		{"vSphere standalone", "vsphere-standalone-cp-1-0-12", ProviderVSphere},
+		{"vSphere hosted", "vsphere-hosted-cp-1-0-11", ProviderVSphere},
+		{"vSphere mixed case", "vSphere-Standalone-Cp", ProviderVSphere},
+
+		// OpenStack templates
+		{"OpenStack standalone", "openstack-standalone-cp-1-0-10", ProviderOpenStack},
+		{"OpenStack hosted", "openstack-hosted-cp", ProviderOpenStack},
+
 		// Unknown/unsupported providers
-		{"vSphere", "vsphere-standalone-cp", ProviderUnknown},
-		{"OpenStack", "openstack-hosted-cp", ProviderUnknown},
+		{"Almost vSphere", "vsphere", ProviderUnknown},
+		{"Adopted", "adopted-cluster-1-0-1", ProviderUnknown},
 		{"Custom", "my-custom-template", ProviderUnknown},
 		{"Empty string", "", ProviderUnknown},
 		{"No prefix", "standalone-cp", ProviderUnknown},
@@ -229,10 +238,30 @@ func TestValidateConfig(t *testing.T) {
 			provider:     ProviderGCP,
 		},
 
+		// vSphere validation
+		{
+			name:         "vSphere missing all fields",
+			templateName: "vsphere-standalone-cp-1-0-12",
+			config:       map[string]interface{}{},
+			expectValid:  false,
+			expectErrors: 9,
+			provider:     ProviderVSphere,
+		},
+
+		// OpenStack validation
+		{
+			name:         "OpenStack missing all fields",
+			templateName: "openstack-standalone-cp-1-0-10",
+			config:       map[string]interface{}{},
+			expectValid:  false,
+			expectErrors: 7,
+			provider:     ProviderOpenStack,
+		},
+
 		// Unknown provider (no validation)
 		{
 			name:         "Unknown provider passes through",
-			templateName: "vsphere-standalone-cp",
+			templateName: "adopted-cluster-1-0-1",
 			config:       map[string]interface{}{},
 			expectValid:  true,
 			expectErrors: 0,
@@ -0,0 +1,95 @@
+package clusters
+
+import "fmt"
+
+// vsphereNodeRoles are the node pools of the vsphere-standalone-cp template that each need
+// their own VM template and network.
+var vsphereNodeRoles = []string{"controlPlane", "worker"}
+
+// ValidateVSphereConfig validates vSphere-specific cluster configuration.
+// It checks for required fields per vSphere ClusterDeployment documentation:
+// https://docs.k0rdent.io/latest/admin/installation/prepare-mgmt-cluster/vsphere/
+func ValidateVSphereConfig(config map[string]interface{}) ValidationResult {
+	result := ValidationResult{
+		Provider: ProviderVSphere,
+	}
+
+	// Check for required vCenter placement fields
+	required := []struct {
+		path    string
+		message string
+	}{
+		{"vsphere.server", "vCenter server address is required (e.g., 'vcenter.example.com')"},
+		{"vsphere.datacenter", "vSphere datacenter is required (e.g., 'DC1')"},
+		{"vsphere.datastore", "vSphere datastore is required (e.g., '/DC1/datastore/DS1')"},
+		{"vsphere.resourcePool", "vSphere resource pool is required (e.g., '/DC1/host/Cluster1/Resources/Pool1')"},
+		{"controlPlaneEndpointIP", "control plane endpoint IP is required (an unused static IP on the cluster network)"},
+	}
+	for _, field := range required {
+		if !hasNonEmptyNestedString(config, field.path) {
+			result.Errors = append(result.Errors, ValidationError{
+				Field:   "config." + field.path,
+				Message: field.message,
+				Code:    "vsphere." + field.path + ".required",
+			})
+		}
+	}
+
+	// Check for the VM template and network of each node pool
+	for _, role := range vsphereNodeRoles {
+		if !hasNonEmptyNestedString(config, role+".vmTemplate") {
+			result.Errors = append(result.Errors, ValidationError{
+				Field:   "config." + role + ".vmTemplate",
+				Message: "VM template path is required (e.g., '/DC1/vm/ubuntu-22.04-template')",
+				Code:    "vsphere." + role + ".vmTemplate.required",
+			})
+		}
+		if !hasNonEmptyNestedString(config, role+".network") {
+			result.Errors = append(result.Errors, ValidationError{
+				Field:   "config." + role + ".network",
+				Message: "vSphere network path is required (e.g., '/DC1/network/VM Network')",
+				Code:    "vsphere." + role + ".network.required",
+			})
+		}
+	}
+
+	return result
+}
+
+// FormatVSphereValidationError formats validation errors for vSphere configurations.
+// It provides helpful error messages with examples of correct configuration.
+func FormatVSphereValidationError(errors []ValidationError) string {
+	if len(errors) == 0 {
+		return ""
+	}
+
+	msg := "vSphere cluster configuration validation failed:\n"
+	for _, err := range errors {
+		msg += fmt.Sprintf("  - %s: %s\n", err.Field, err.Message)
+	}
+
+	msg += "\nExample valid vSphere configuration:\n"
+	msg += `{
+  "vsphere": {
+    "server": "vcenter.example.com",
+    "thumbprint": "AA:BB:CC:...",
+    "datacenter": "DC1",
+    "datastore": "/DC1/datastore/DS1",
+    "resourcePool": "/DC1/host/Cluster1/Resources/Pool1",
+    "folder": "/DC1/vm/k0rdent"
+  },
+  "controlPlaneEndpointIP": "10.0.0.100",
+  "controlPlane": {
+    "vmTemplate": "/DC1/vm/ubuntu-22.04-template",
+    "network": "/DC1/network/VM Network"
+  },
+  "worker": {
+    "vmTemplate": "/DC1/vm/ubuntu-22.04-template",
+    "network": "/DC1/network/VM Network"
+  }
+}
+
+For more information, see: https://docs.k0rdent.io/latest/admin/installation/prepare-mgmt-cluster/vsphere/`
+
+	return msg
+}
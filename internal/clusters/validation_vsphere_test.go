@@ -0,0 +1,120 @@
+package clusters
+
+import (
+	"strings"
+	"testing"
+)
+
+func validVSphereConfig() map[string]interface{} {
+	node := func() map[string]interface{} {
+		return map[string]interface{}{
+			"vmTemplate": "/DC1/vm/ubuntu-22.04-template",
+			"network":    "/DC1/network/VM Network",
+		}
+	}
+	return map[string]interface{}{
+		"vsphere": map[string]interface{}{
+			"server":       "vcenter.example.com",
+			"datacenter":   "DC1",
+			"datastore":    "/DC1/datastore/DS1",
+			"resourcePool": "/DC1/host/Cluster1/Resources/Pool1",
+		},
+		"controlPlaneEndpointIP": "10.0.0.100",
+		"controlPlane":           node(),
+		"worker":                 node(),
+	}
+}
+
+// TestValidateVSphereConfig tests vSphere configuration validation
+func TestValidateVSphereConfig(t *testing.T) {
+	tests := []struct {
+		name           string
+		mutate         func(config map[string]interface{})
+		wantErrorCodes []string
+	}{
+		{
+			name:   "valid vSphere config",
+			mutate: func(map[string]interface{}) {},
+		},
+		{
+			name: "missing datastore",
+			mutate: func(config map[string]interface{}) {
+				delete(config["vsphere"].(map[string]interface{}), "datastore")
+			},
+			wantErrorCodes: []string{"vsphere.vsphere.datastore.required"},
+		},
+		{
+			name: "blank resource pool",
+			mutate: func(config map[string]interface{}) {
+				config["vsphere"].(map[string]interface{})["resourcePool"] = "  "
+			},
+			wantErrorCodes: []string{"vsphere.vsphere.resourcePool.required"},
+		},
+		{
+			name: "missing vsphere object entirely",
+			mutate: func(config map[string]interface{}) {
+				delete(config, "vsphere")
+			},
+			wantErrorCodes: []string{
+				"vsphere.vsphere.server.required",
+				"vsphere.vsphere.datacenter.required",
+				"vsphere.vsphere.datastore.required",
+				"vsphere.vsphere.resourcePool.required",
+			},
+		},
+		{
+			name: "missing endpoint IP",
+			mutate: func(config map[string]interface{}) {
+				delete(config, "controlPlaneEndpointIP")
+			},
+			wantErrorCodes: []string{"vsphere.controlPlaneEndpointIP.required"},
+		},
+		{
+			name: "worker without VM template and network",
+			mutate: func(config map[string]interface{}) {
+				config["worker"] = map[string]interface{}{"cpus": 4}
+			},
+			wantErrorCodes: []string{"vsphere.worker.vmTemplate.required", "vsphere.worker.network.required"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := validVSphereConfig()
+			tt.mutate(config)
+
+			result := ValidateVSphereConfig(config)
+			if result.Provider != ProviderVSphere {
+				t.Errorf("expected provider %s, got %s", ProviderVSphere, result.Provider)
+			}
+			if len(result.Errors) != len(tt.wantErrorCodes) {
+				t.Fatalf("expected %d errors, got %d: %+v", len(tt.wantErrorCodes), len(result.Errors), result.Errors)
+			}
+			for i, code := range tt.wantErrorCodes {
+				if result.Errors[i].Code != code {
+					t.Errorf("error %d: expected code %q, got %q", i, code, result.Errors[i].Code)
+				}
+			}
+		})
+	}
+}
+
+// TestFormatVSphereValidationError tests vSphere error formatting
+func TestFormatVSphereValidationError(t *testing.T) {
+	if msg := FormatVSphereValidationError(nil); msg != "" {
+		t.Errorf("expected empty message for no errors, got %q", msg)
+	}
+
+	msg := FormatVSphereValidationError(ValidateVSphereConfig(map[string]interface{}{}).Errors)
+	for _, want := range []string{
+		"vSphere cluster configuration validation failed",
+		"config.vsphere.datacenter",
+		"config.controlPlane.vmTemplate",
+		"Example valid vSphere configuration",
+		"https://docs.k0rdent.io",
+	} {
+		if !strings.Contains(msg, want) {
+			t.Errorf("expected message to contain %q, got: %s", want, msg)
+		}
+	}
+}
@@ -7,13 +7,16 @@ import (
 	"log/slog"
 	"os"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"sigs.k8s.io/yaml"
 
 	"github.com/k0rdent/mcp-k0rdent-server/internal/logging"
 )
@@ -26,9 +29,35 @@ const (
 	envLogLevel       = "LOG_LEVEL"
 	envLogSinkEnabled = "LOG_EXTERNAL_SINK_ENABLED"
 
-	envClusterGlobalNamespace       = "CLUSTER_GLOBAL_NAMESPACE"
-	envClusterDefaultNamespaceDev   = "CLUSTER_DEFAULT_NAMESPACE_DEV"
-	envClusterDeployFieldOwner      = "CLUSTER_DEPLOY_FIELD_OWNER"
+	envClusterGlobalNamespace      = "CLUSTER_GLOBAL_NAMESPACE"
+	envClusterDefaultNamespaceDev  = "CLUSTER_DEFAULT_NAMESPACE_DEV"
+	envDefaultNamespace            = "DEFAULT_NAMESPACE"
+	envClusterDeployFieldOwner     = "CLUSTER_DEPLOY_FIELD_OWNER"
+	envMaxNamespaceConcurrency     = "MAX_NAMESPACE_CONCURRENCY"
+	envClusterMonitorSharedWatches = "CLUSTER_MONITOR_SHARED_WATCHES"
+	envServiceFieldOwner           = "SERVICE_FIELD_OWNER"
+	envServiceFieldOwnerPerSubject = "SERVICE_FIELD_OWNER_PER_SUBJECT"
+	envServiceDefaultValuesFile    = "SERVICE_DEFAULT_VALUES_FILE"
+	envTemplateStableSelector      = "CLUSTER_TEMPLATE_STABLE_SELECTOR"
+	envCatalogDeleteKinds          = "CATALOG_DELETE_KINDS"
+	envCatalogCacheTTL             = "CATALOG_CACHE_TTL"
+	envCatalogWarmOnStart          = "CATALOG_WARM_ON_START"
+	envRequireExplicitNamespace    = "REQUIRE_EXPLICIT_NAMESPACE"
+	envStreamMaxUpdatesPerSecond   = "STREAM_MAX_UPDATES_PER_SECOND"
+	envKubeListTimeout             = "KUBE_LIST_TIMEOUT"
+
+	envTLSCertFile = "TLS_CERT_FILE"
+	envTLSKeyFile  = "TLS_KEY_FILE"
+	envTLSClientCA = "TLS_CLIENT_CA"
+
+	// DefaultCatalogDeleteKinds are the resource kinds the catalog delete tool removes when CATALOG_DELETE_KINDS is unset.
+	DefaultCatalogDeleteKinds = "ServiceTemplate,HelmRepository"
+
+	// DefaultNamespaceConcurrency bounds parallel per-namespace work when MAX_NAMESPACE_CONCURRENCY is unset.
+	DefaultNamespaceConcurrency = 4
+
+	// DefaultKubeListTimeout bounds each namespace or resource list in a tool handler when KUBE_LIST_TIMEOUT is unset.
+	DefaultKubeListTimeout = 30 * time.Second
 )
 
 // AuthMode determines how incoming requests are authenticated.
@@ -63,6 +92,25 @@ type Settings struct {
 	RawConfig       *clientcmdapi.Config
 	Logging         LoggingSettings
 	Cluster         ClusterSettings
+	TLS             TLSSettings
+}
+
+// TLSSettings configure HTTPS serving. TLS is disabled when no certificate is configured.
+type TLSSettings struct {
+	CertFile string
+	KeyFile  string
+	// ClientCAFile enables mutual TLS: client certificates must chain to a CA in this bundle.
+	ClientCAFile string
+}
+
+// Enabled reports whether the server should serve HTTPS.
+func (t TLSSettings) Enabled() bool {
+	return t.CertFile != ""
+}
+
+// MutualTLS reports whether client certificates are required and verified.
+func (t TLSSettings) MutualTLS() bool {
+	return t.Enabled() && t.ClientCAFile != ""
 }
 
 // LoggingSettings describe how structured logging is configured.
@@ -73,9 +121,43 @@ type LoggingSettings struct {
 
 // ClusterSettings describe cluster provisioning configuration.
 type ClusterSettings struct {
-	GlobalNamespace       string
-	DefaultNamespaceDev   string
-	DeployFieldOwner      string
+	GlobalNamespace string
+	// DefaultNamespace is the target of namespaced tools called without a namespace, used
+	// when the namespace filter allows it (DEFAULT_NAMESPACE, defaults to kcm-system).
+	DefaultNamespace string
+	DeployFieldOwner string
+	// NamespaceConcurrency bounds parallel per-namespace operations (multi-namespace lists, catalog installs).
+	NamespaceConcurrency int
+	// MonitorSharedWatches shares one ClusterDeployment watch between all cluster-monitor
+	// subscriptions for the same cluster, across sessions.
+	MonitorSharedWatches bool
+	// ServiceFieldOwner is the server-side apply field manager for cluster service changes.
+	ServiceFieldOwner string
+	// ServiceFieldOwnerPerSubject suffixes ServiceFieldOwner with the caller's token subject
+	// so apply conflicts can be traced to the session that owns the fields.
+	ServiceFieldOwnerPerSubject bool
+	// ServiceDefaultValues are Helm values layered under the values of every service apply.
+	ServiceDefaultValues map[string]any
+	// TemplateStableSelector restricts deploy-time template auto-selection to ClusterTemplates
+	// whose labels match. Nil keeps the default: the highest-versioned standalone template.
+	TemplateStableSelector labels.Selector
+	// CatalogDeleteKinds lists the namespaced resource kinds the catalog delete tool may remove.
+	CatalogDeleteKinds []string
+	// CatalogCacheTTL is how long the cached catalog index is trusted before it is rechecked.
+	// Zero keeps the catalog package default.
+	CatalogCacheTTL time.Duration
+	// CatalogWarmOnStart loads the catalog index in the background at startup so the first
+	// catalog tool call does not wait for the download.
+	CatalogWarmOnStart bool
+	// RequireExplicitNamespace disables listing every namespace when a tool is called without
+	// one; multi-namespace tools then require an explicit namespace regardless of auth mode.
+	RequireExplicitNamespace bool
+	// StreamMaxUpdatesPerSecond caps the notifications each event and pod log subscription
+	// publishes per second; updates over the cap are summarized. Zero disables the cap.
+	StreamMaxUpdatesPerSecond int
+	// KubeListTimeout bounds each namespace and resource list a tool handler issues, so a
+	// hung API server fails the tool instead of waiting for the client's request deadline.
+	KubeListTimeout time.Duration
 }
 
 // Loader loads runtime configuration from the environment and validates cluster access.
@@ -114,6 +196,14 @@ func (l *Loader) Load(ctx context.Context) (*Settings, error) {
 	log := logging.WithContext(ctx, l.logger)
 	log.Info("loading configuration")
 
+	// The namespace filter is validated before touching the kubeconfig so a bad
+	// pattern fails startup immediately instead of surfacing in a tool call.
+	namespaceFilter, err := l.compileNamespaceFilter()
+	if err != nil {
+		log.Error("failed to compile namespace filter", "error", err)
+		return nil, err
+	}
+
 	source, kubeconfigBytes, err := l.readKubeconfig()
 	if err != nil {
 		log.Error("failed to read kubeconfig source", "error", err)
@@ -133,20 +223,41 @@ func (l *Loader) Load(ctx context.Context) (*Settings, error) {
 		return nil, err
 	}
 
-	namespaceFilter, err := l.compileNamespaceFilter()
+	authMode, err := l.resolveAuthMode()
 	if err != nil {
-		log.Error("failed to compile namespace filter", "error", err)
+		log.Error("failed to resolve auth mode", "error", err)
 		return nil, err
 	}
 
-	authMode, err := l.resolveAuthMode()
+	loggingSettings := l.resolveLogging(log)
+	clusterSettings := l.resolveCluster(log)
+
+	serviceDefaults, err := l.loadServiceDefaultValues()
 	if err != nil {
-		log.Error("failed to resolve auth mode", "error", err)
+		log.Error("failed to load service default values", "error", err)
 		return nil, err
 	}
+	clusterSettings.ServiceDefaultValues = serviceDefaults
 
-	loggingSettings := l.resolveLogging(log)
-	clusterSettings := l.resolveCluster()
+	stableSelector, err := l.resolveTemplateStableSelector()
+	if err != nil {
+		log.Error("failed to parse template stable selector", "error", err)
+		return nil, err
+	}
+	clusterSettings.TemplateStableSelector = stableSelector
+
+	cacheTTL, err := l.resolveCatalogCacheTTL()
+	if err != nil {
+		log.Error("failed to parse catalog cache TTL", "error", err)
+		return nil, err
+	}
+	clusterSettings.CatalogCacheTTL = cacheTTL
+
+	tlsSettings, err := l.resolveTLS()
+	if err != nil {
+		log.Error("failed to resolve TLS settings", "error", err)
+		return nil, err
+	}
 
 	overrides := &clientcmd.ConfigOverrides{
 		CurrentContext: contextName,
@@ -163,6 +274,7 @@ func (l *Loader) Load(ctx context.Context) (*Settings, error) {
 	log.Info("configuration loaded",
 		"context", contextName,
 		"auth_mode", authMode,
+		"namespace_filter", namespaceFilterPattern(namespaceFilter),
 		"source", source)
 
 	settings := &Settings{
@@ -174,6 +286,7 @@ func (l *Loader) Load(ctx context.Context) (*Settings, error) {
 		RawConfig:       cfg,
 		Logging:         loggingSettings,
 		Cluster:         clusterSettings,
+		TLS:             tlsSettings,
 	}
 
 	// Ping cluster after loading configuration so banner can be shown first
@@ -230,11 +343,19 @@ func (l *Loader) compileNamespaceFilter() (*regexp.Regexp, error) {
 	}
 	re, err := regexp.Compile(value)
 	if err != nil {
-		return nil, fmt.Errorf("compile namespace filter regex: %w", err)
+		return nil, fmt.Errorf("compile namespace filter regex %s=%q: %w", envNamespaceExpr, value, err)
 	}
 	return re, nil
 }
 
+// namespaceFilterPattern returns the compiled filter's pattern, or "" when no filter is set.
+func namespaceFilterPattern(re *regexp.Regexp) string {
+	if re == nil {
+		return ""
+	}
+	return re.String()
+}
+
 func (l *Loader) resolveAuthMode() (AuthMode, error) {
 	value, ok := l.envLookup(envAuthMode)
 	if !ok || value == "" {
@@ -282,28 +403,211 @@ func (l *Loader) resolveLogging(logger *slog.Logger) LoggingSettings {
 	return settings
 }
 
-func (l *Loader) resolveCluster() ClusterSettings {
+func (l *Loader) resolveCluster(logger *slog.Logger) ClusterSettings {
 	settings := ClusterSettings{
-		GlobalNamespace:     "kcm-system",
-		DefaultNamespaceDev: "kcm-system",
-		DeployFieldOwner:    "mcp.clusters",
+		GlobalNamespace:      "kcm-system",
+		DefaultNamespace:     "kcm-system",
+		DeployFieldOwner:     "mcp.clusters",
+		NamespaceConcurrency: DefaultNamespaceConcurrency,
+		ServiceFieldOwner:    "mcp.services",
+		CatalogDeleteKinds:   parseKindList(DefaultCatalogDeleteKinds),
+		KubeListTimeout:      DefaultKubeListTimeout,
 	}
 
 	if raw, ok := l.envLookup(envClusterGlobalNamespace); ok && strings.TrimSpace(raw) != "" {
 		settings.GlobalNamespace = strings.TrimSpace(raw)
 	}
 
+	// CLUSTER_DEFAULT_NAMESPACE_DEV is the older name of DEFAULT_NAMESPACE, which wins when both are set
 	if raw, ok := l.envLookup(envClusterDefaultNamespaceDev); ok && strings.TrimSpace(raw) != "" {
-		settings.DefaultNamespaceDev = strings.TrimSpace(raw)
+		settings.DefaultNamespace = strings.TrimSpace(raw)
+	}
+
+	if raw, ok := l.envLookup(envDefaultNamespace); ok && strings.TrimSpace(raw) != "" {
+		settings.DefaultNamespace = strings.TrimSpace(raw)
 	}
 
 	if raw, ok := l.envLookup(envClusterDeployFieldOwner); ok && strings.TrimSpace(raw) != "" {
 		settings.DeployFieldOwner = strings.TrimSpace(raw)
 	}
 
+	if raw, ok := l.envLookup(envMaxNamespaceConcurrency); ok && strings.TrimSpace(raw) != "" {
+		limit, err := strconv.Atoi(strings.TrimSpace(raw))
+		if err != nil || limit <= 0 {
+			if logger != nil {
+				logger.Warn("invalid MAX_NAMESPACE_CONCURRENCY value; using default", "value", raw, "default", DefaultNamespaceConcurrency)
+			}
+		} else {
+			settings.NamespaceConcurrency = limit
+		}
+	}
+
+	if raw, ok := l.envLookup(envClusterMonitorSharedWatches); ok && strings.TrimSpace(raw) != "" {
+		enabled, err := parseBoolEnv(raw)
+		if err != nil {
+			if logger != nil {
+				logger.Warn("invalid CLUSTER_MONITOR_SHARED_WATCHES value", "value", raw)
+			}
+		} else {
+			settings.MonitorSharedWatches = enabled
+		}
+	}
+
+	if raw, ok := l.envLookup(envCatalogWarmOnStart); ok && strings.TrimSpace(raw) != "" {
+		enabled, err := parseBoolEnv(raw)
+		if err != nil {
+			if logger != nil {
+				logger.Warn("invalid CATALOG_WARM_ON_START value", "value", raw)
+			}
+		} else {
+			settings.CatalogWarmOnStart = enabled
+		}
+	}
+
+	if raw, ok := l.envLookup(envRequireExplicitNamespace); ok && strings.TrimSpace(raw) != "" {
+		enabled, err := parseBoolEnv(raw)
+		if err != nil {
+			if logger != nil {
+				logger.Warn("invalid REQUIRE_EXPLICIT_NAMESPACE value", "value", raw)
+			}
+		} else {
+			settings.RequireExplicitNamespace = enabled
+		}
+	}
+
+	if raw, ok := l.envLookup(envStreamMaxUpdatesPerSecond); ok && strings.TrimSpace(raw) != "" {
+		limit, err := strconv.Atoi(strings.TrimSpace(raw))
+		if err != nil || limit < 0 {
+			if logger != nil {
+				logger.Warn("invalid STREAM_MAX_UPDATES_PER_SECOND value; streams are not rate limited", "value", raw)
+			}
+		} else {
+			settings.StreamMaxUpdatesPerSecond = limit
+		}
+	}
+
+	if raw, ok := l.envLookup(envKubeListTimeout); ok && strings.TrimSpace(raw) != "" {
+		timeout, err := time.ParseDuration(strings.TrimSpace(raw))
+		if err != nil || timeout <= 0 {
+			if logger != nil {
+				logger.Warn("invalid KUBE_LIST_TIMEOUT value; using default", "value", raw, "default", DefaultKubeListTimeout.String())
+			}
+		} else {
+			settings.KubeListTimeout = timeout
+		}
+	}
+
+	if raw, ok := l.envLookup(envServiceFieldOwner); ok && strings.TrimSpace(raw) != "" {
+		settings.ServiceFieldOwner = strings.TrimSpace(raw)
+	}
+
+	if raw, ok := l.envLookup(envServiceFieldOwnerPerSubject); ok && strings.TrimSpace(raw) != "" {
+		enabled, err := parseBoolEnv(raw)
+		if err != nil {
+			if logger != nil {
+				logger.Warn("invalid SERVICE_FIELD_OWNER_PER_SUBJECT value", "value", raw)
+			}
+		} else {
+			settings.ServiceFieldOwnerPerSubject = enabled
+		}
+	}
+
+	if raw, ok := l.envLookup(envCatalogDeleteKinds); ok && strings.TrimSpace(raw) != "" {
+		if kinds := parseKindList(raw); len(kinds) > 0 {
+			settings.CatalogDeleteKinds = kinds
+		} else if logger != nil {
+			logger.Warn("invalid CATALOG_DELETE_KINDS value; using default", "value", raw, "default", DefaultCatalogDeleteKinds)
+		}
+	}
+
 	return settings
 }
 
+// parseKindList splits a comma-separated list of resource kinds, dropping blanks and duplicates.
+func parseKindList(raw string) []string {
+	var kinds []string
+	seen := make(map[string]struct{})
+	for _, part := range strings.Split(raw, ",") {
+		kind := strings.TrimSpace(part)
+		if kind == "" {
+			continue
+		}
+		if _, dup := seen[kind]; dup {
+			continue
+		}
+		seen[kind] = struct{}{}
+		kinds = append(kinds, kind)
+	}
+	return kinds
+}
+
+// resolveCatalogCacheTTL parses CATALOG_CACHE_TTL as a positive Go duration (e.g. "30m", "24h").
+func (l *Loader) resolveCatalogCacheTTL() (time.Duration, error) {
+	raw, ok := l.envLookup(envCatalogCacheTTL)
+	if !ok || strings.TrimSpace(raw) == "" {
+		return 0, nil
+	}
+	ttl, err := time.ParseDuration(strings.TrimSpace(raw))
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %w", envCatalogCacheTTL, err)
+	}
+	if ttl <= 0 {
+		return 0, fmt.Errorf("invalid %s %q: must be a positive duration", envCatalogCacheTTL, raw)
+	}
+	return ttl, nil
+}
+
+// resolveTemplateStableSelector parses CLUSTER_TEMPLATE_STABLE_SELECTOR as a label selector.
+func (l *Loader) resolveTemplateStableSelector() (labels.Selector, error) {
+	raw, ok := l.envLookup(envTemplateStableSelector)
+	if !ok || strings.TrimSpace(raw) == "" {
+		return nil, nil
+	}
+	selector, err := labels.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", envTemplateStableSelector, err)
+	}
+	return selector, nil
+}
+
+// loadServiceDefaultValues reads the YAML or JSON values map referenced by SERVICE_DEFAULT_VALUES_FILE.
+func (l *Loader) loadServiceDefaultValues() (map[string]any, error) {
+	path, ok := l.envLookup(envServiceDefaultValuesFile)
+	if !ok || strings.TrimSpace(path) == "" {
+		return nil, nil
+	}
+	data, err := l.readFile(strings.TrimSpace(path))
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %w", envServiceDefaultValuesFile, err)
+	}
+	var values map[string]any
+	if err := yaml.Unmarshal(data, &values); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", envServiceDefaultValuesFile, err)
+	}
+	return values, nil
+}
+
+// resolveTLS reads the TLS certificate, key, and optional client CA paths. The certificate and
+// key must be set together, and a client CA requires TLS to be enabled.
+func (l *Loader) resolveTLS() (TLSSettings, error) {
+	lookup := func(key string) string {
+		value, _ := l.envLookup(key)
+		return strings.TrimSpace(value)
+	}
+	settings := TLSSettings{
+		CertFile:     lookup(envTLSCertFile),
+		KeyFile:      lookup(envTLSKeyFile),
+		ClientCAFile: lookup(envTLSClientCA),
+	}
+	if (settings.CertFile == "") != (settings.KeyFile == "") {
+		return TLSSettings{}, fmt.Errorf("%s and %s must be set together", envTLSCertFile, envTLSKeyFile)
+	}
+	if settings.ClientCAFile != "" && !settings.Enabled() {
+		return TLSSettings{}, fmt.Errorf("%s requires %s and %s", envTLSClientCA, envTLSCertFile, envTLSKeyFile)
+	}
+	return settings, nil
+}
+
 func parseBoolEnv(value string) (bool, error) {
 	switch strings.ToLower(strings.TrimSpace(value)) {
 	case "1", "true", "t", "yes", "y", "on":
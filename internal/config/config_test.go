@@ -152,6 +152,40 @@ func TestLoadRejectsInvalidNamespaceRegex(t *testing.T) {
 	}
 }
 
+func TestLoadRejectsInvalidNamespaceRegexBeforeConnecting(t *testing.T) {
+	env := map[string]string{
+		envKubeconfigPath: "/tmp/kubeconfig",
+		envNamespaceExpr:  "team-[a-z",
+	}
+
+	loader := NewLoader(testLogger())
+	loader.envLookup = func(key string) (string, bool) {
+		val, ok := env[key]
+		return val, ok
+	}
+	kubeconfigRead := false
+	loader.readFile = func(path string) ([]byte, error) {
+		kubeconfigRead = true
+		return []byte(minimalKubeconfig()), nil
+	}
+	pinged := false
+	loader.ping = func(context.Context, *rest.Config) error {
+		pinged = true
+		return nil
+	}
+
+	_, err := loader.Load(context.Background())
+	if err == nil {
+		t.Fatal("expected startup error for invalid namespace regex")
+	}
+	if !strings.Contains(err.Error(), `K0RDENT_NAMESPACE_FILTER="team-[a-z"`) {
+		t.Fatalf("expected error to name the variable and pattern, got %v", err)
+	}
+	if kubeconfigRead || pinged {
+		t.Fatalf("expected failure before reading the kubeconfig (read=%t, pinged=%t)", kubeconfigRead, pinged)
+	}
+}
+
 func TestLoadRejectsInvalidAuthMode(t *testing.T) {
 	env := map[string]string{
 		envKubeconfigPath: "/tmp/kubeconfig",
@@ -305,6 +339,381 @@ func TestResolveLoggingSinkEnabled(t *testing.T) {
 	}
 }
 
+func TestResolveClusterNamespaceConcurrency(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		set   bool
+		want  int
+	}{
+		{name: "default", want: DefaultNamespaceConcurrency},
+		{name: "override", value: "16", set: true, want: 16},
+		{name: "invalid", value: "lots", set: true, want: DefaultNamespaceConcurrency},
+		{name: "non-positive", value: "0", set: true, want: DefaultNamespaceConcurrency},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loader := NewLoader(testLogger())
+			loader.envLookup = func(key string) (string, bool) {
+				if key == envMaxNamespaceConcurrency && tt.set {
+					return tt.value, true
+				}
+				return "", false
+			}
+			settings := loader.resolveCluster(testLogger())
+			if settings.NamespaceConcurrency != tt.want {
+				t.Fatalf("expected concurrency %d, got %d", tt.want, settings.NamespaceConcurrency)
+			}
+		})
+	}
+}
+
+func TestResolveClusterKubeListTimeout(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		set   bool
+		want  time.Duration
+	}{
+		{name: "default", want: DefaultKubeListTimeout},
+		{name: "override", value: "5s", set: true, want: 5 * time.Second},
+		{name: "invalid", value: "soon", set: true, want: DefaultKubeListTimeout},
+		{name: "non-positive", value: "0s", set: true, want: DefaultKubeListTimeout},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loader := NewLoader(testLogger())
+			loader.envLookup = func(key string) (string, bool) {
+				if key == envKubeListTimeout && tt.set {
+					return tt.value, true
+				}
+				return "", false
+			}
+			settings := loader.resolveCluster(testLogger())
+			if settings.KubeListTimeout != tt.want {
+				t.Fatalf("expected timeout %s, got %s", tt.want, settings.KubeListTimeout)
+			}
+		})
+	}
+}
+
+func TestResolveClusterDefaultNamespace(t *testing.T) {
+	tests := []struct {
+		name string
+		env  map[string]string
+		want string
+	}{
+		{name: "default", want: "kcm-system"},
+		{name: "override", env: map[string]string{envDefaultNamespace: " team-a "}, want: "team-a"},
+		{name: "legacy name", env: map[string]string{envClusterDefaultNamespaceDev: "team-b"}, want: "team-b"},
+		{name: "new name wins", env: map[string]string{envClusterDefaultNamespaceDev: "team-b", envDefaultNamespace: "team-a"}, want: "team-a"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loader := NewLoader(testLogger())
+			loader.envLookup = func(key string) (string, bool) {
+				value, ok := tt.env[key]
+				return value, ok
+			}
+			settings := loader.resolveCluster(testLogger())
+			if settings.DefaultNamespace != tt.want {
+				t.Fatalf("expected default namespace %q, got %q", tt.want, settings.DefaultNamespace)
+			}
+			if settings.GlobalNamespace != "kcm-system" {
+				t.Fatalf("expected global namespace to stay kcm-system, got %q", settings.GlobalNamespace)
+			}
+		})
+	}
+}
+
+func TestResolveClusterServiceFieldOwner(t *testing.T) {
+	loader := NewLoader(testLogger())
+	loader.envLookup = func(string) (string, bool) { return "", false }
+	settings := loader.resolveCluster(testLogger())
+	if settings.ServiceFieldOwner != "mcp.services" || settings.ServiceFieldOwnerPerSubject {
+		t.Fatalf("unexpected defaults: owner=%q perSubject=%v", settings.ServiceFieldOwner, settings.ServiceFieldOwnerPerSubject)
+	}
+
+	env := map[string]string{
+		envServiceFieldOwner:           " k0rdent-mcp ",
+		envServiceFieldOwnerPerSubject: "true",
+	}
+	loader.envLookup = func(key string) (string, bool) {
+		value, ok := env[key]
+		return value, ok
+	}
+	settings = loader.resolveCluster(testLogger())
+	if settings.ServiceFieldOwner != "k0rdent-mcp" || !settings.ServiceFieldOwnerPerSubject {
+		t.Fatalf("unexpected overrides: owner=%q perSubject=%v", settings.ServiceFieldOwner, settings.ServiceFieldOwnerPerSubject)
+	}
+}
+
+func TestResolveClusterRequireExplicitNamespace(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		set   bool
+		want  bool
+	}{
+		{name: "unset", want: false},
+		{name: "enabled", value: "true", set: true, want: true},
+		{name: "disabled", value: "false", set: true, want: false},
+		{name: "invalid", value: "sometimes", set: true, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loader := NewLoader(testLogger())
+			loader.envLookup = func(key string) (string, bool) {
+				if key == envRequireExplicitNamespace && tt.set {
+					return tt.value, true
+				}
+				return "", false
+			}
+			settings := loader.resolveCluster(testLogger())
+			if settings.RequireExplicitNamespace != tt.want {
+				t.Fatalf("expected RequireExplicitNamespace %v, got %v", tt.want, settings.RequireExplicitNamespace)
+			}
+		})
+	}
+}
+
+func TestResolveClusterCatalogWarmOnStart(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		set   bool
+		want  bool
+	}{
+		{name: "unset", want: false},
+		{name: "enabled", value: "true", set: true, want: true},
+		{name: "disabled", value: "0", set: true, want: false},
+		{name: "invalid", value: "eventually", set: true, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loader := NewLoader(testLogger())
+			loader.envLookup = func(key string) (string, bool) {
+				if key == envCatalogWarmOnStart && tt.set {
+					return tt.value, true
+				}
+				return "", false
+			}
+			settings := loader.resolveCluster(testLogger())
+			if settings.CatalogWarmOnStart != tt.want {
+				t.Fatalf("expected CatalogWarmOnStart %v, got %v", tt.want, settings.CatalogWarmOnStart)
+			}
+		})
+	}
+}
+
+func TestResolveClusterStreamMaxUpdatesPerSecond(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		set   bool
+		want  int
+	}{
+		{name: "unset", want: 0},
+		{name: "valid", value: "20", set: true, want: 20},
+		{name: "negative", value: "-1", set: true, want: 0},
+		{name: "invalid", value: "fast", set: true, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loader := NewLoader(testLogger())
+			loader.envLookup = func(key string) (string, bool) {
+				if key == envStreamMaxUpdatesPerSecond && tt.set {
+					return tt.value, true
+				}
+				return "", false
+			}
+			settings := loader.resolveCluster(testLogger())
+			if settings.StreamMaxUpdatesPerSecond != tt.want {
+				t.Fatalf("expected StreamMaxUpdatesPerSecond %d, got %d", tt.want, settings.StreamMaxUpdatesPerSecond)
+			}
+		})
+	}
+}
+
+func TestResolveClusterCatalogDeleteKinds(t *testing.T) {
+	loader := NewLoader(testLogger())
+	loader.envLookup = func(string) (string, bool) { return "", false }
+	settings := loader.resolveCluster(testLogger())
+	if got := strings.Join(settings.CatalogDeleteKinds, ","); got != DefaultCatalogDeleteKinds {
+		t.Fatalf("unexpected default kinds %q", got)
+	}
+
+	loader.envLookup = func(key string) (string, bool) {
+		if key == envCatalogDeleteKinds {
+			return " ServiceTemplate, HelmRepository ,ConfigMap,ConfigMap ", true
+		}
+		return "", false
+	}
+	settings = loader.resolveCluster(testLogger())
+	if got := strings.Join(settings.CatalogDeleteKinds, ","); got != "ServiceTemplate,HelmRepository,ConfigMap" {
+		t.Fatalf("unexpected kinds %q", got)
+	}
+
+	loader.envLookup = func(key string) (string, bool) {
+		if key == envCatalogDeleteKinds {
+			return " , ", true
+		}
+		return "", false
+	}
+	settings = loader.resolveCluster(testLogger())
+	if got := strings.Join(settings.CatalogDeleteKinds, ","); got != DefaultCatalogDeleteKinds {
+		t.Fatalf("expected default kinds for blank list, got %q", got)
+	}
+}
+
+func TestLoadServiceDefaultValues(t *testing.T) {
+	loader := NewLoader(testLogger())
+	loader.envLookup = func(key string) (string, bool) {
+		if key == envServiceDefaultValuesFile {
+			return "/etc/mcp/service-defaults.yaml", true
+		}
+		return "", false
+	}
+
+	loader.readFile = func(string) ([]byte, error) {
+		return []byte("resources:\n  limits:\n    memory: 256Mi\n"), nil
+	}
+	values, err := loader.loadServiceDefaultValues()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	limits, _ := values["resources"].(map[string]any)["limits"].(map[string]any)
+	if limits["memory"] != "256Mi" {
+		t.Fatalf("unexpected default values: %#v", values)
+	}
+
+	loader.readFile = func(string) ([]byte, error) {
+		return []byte("- not\n- a map\n"), nil
+	}
+	if _, err := loader.loadServiceDefaultValues(); err == nil {
+		t.Fatal("expected error for non-map default values")
+	}
+}
+
+func TestResolveTemplateStableSelector(t *testing.T) {
+	loader := NewLoader(testLogger())
+	loader.envLookup = func(string) (string, bool) { return "", false }
+	selector, err := loader.resolveTemplateStableSelector()
+	if err != nil || selector != nil {
+		t.Fatalf("expected no selector by default, got %v, %v", selector, err)
+	}
+
+	loader.envLookup = func(key string) (string, bool) {
+		if key == envTemplateStableSelector {
+			return " k0rdent.mirantis.com/channel=stable ", true
+		}
+		return "", false
+	}
+	selector, err = loader.resolveTemplateStableSelector()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if selector.String() != "k0rdent.mirantis.com/channel=stable" {
+		t.Fatalf("unexpected selector %q", selector.String())
+	}
+
+	loader.envLookup = func(key string) (string, bool) {
+		if key == envTemplateStableSelector {
+			return "channel in (stable", true
+		}
+		return "", false
+	}
+	if _, err := loader.resolveTemplateStableSelector(); err == nil {
+		t.Fatal("expected error for invalid selector")
+	}
+}
+
+func TestResolveCatalogCacheTTL(t *testing.T) {
+	loader := NewLoader(testLogger())
+	loader.envLookup = func(string) (string, bool) { return "", false }
+	ttl, err := loader.resolveCatalogCacheTTL()
+	if err != nil || ttl != 0 {
+		t.Fatalf("expected no override by default, got %v, %v", ttl, err)
+	}
+
+	for value, want := range map[string]time.Duration{"30m": 30 * time.Minute, " 24h ": 24 * time.Hour} {
+		loader.envLookup = func(key string) (string, bool) {
+			if key == envCatalogCacheTTL {
+				return value, true
+			}
+			return "", false
+		}
+		ttl, err := loader.resolveCatalogCacheTTL()
+		if err != nil || ttl != want {
+			t.Fatalf("%q: expected %v, got %v, %v", value, want, ttl, err)
+		}
+	}
+
+	for _, value := range []string{"soon", "0s", "-1h"} {
+		loader.envLookup = func(key string) (string, bool) {
+			if key == envCatalogCacheTTL {
+				return value, true
+			}
+			return "", false
+		}
+		if _, err := loader.resolveCatalogCacheTTL(); err == nil {
+			t.Fatalf("expected error for %q", value)
+		}
+	}
+}
+
+func TestResolveTLS(t *testing.T) {
+	cases := []struct {
+		name    string
+		env     map[string]string
+		want    TLSSettings
+		wantErr bool
+	}{
+		{name: "disabled", want: TLSSettings{}},
+		{
+			name: "cert and key",
+			env:  map[string]string{envTLSCertFile: "/tls/tls.crt", envTLSKeyFile: "/tls/tls.key"},
+			want: TLSSettings{CertFile: "/tls/tls.crt", KeyFile: "/tls/tls.key"},
+		},
+		{
+			name: "mutual tls",
+			env:  map[string]string{envTLSCertFile: "/tls/tls.crt", envTLSKeyFile: "/tls/tls.key", envTLSClientCA: "/tls/ca.crt"},
+			want: TLSSettings{CertFile: "/tls/tls.crt", KeyFile: "/tls/tls.key", ClientCAFile: "/tls/ca.crt"},
+		},
+		{name: "cert without key", env: map[string]string{envTLSCertFile: "/tls/tls.crt"}, wantErr: true},
+		{name: "client ca without cert", env: map[string]string{envTLSClientCA: "/tls/ca.crt"}, wantErr: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			loader := NewLoader(testLogger())
+			loader.envLookup = func(key string) (string, bool) {
+				value, ok := tc.env[key]
+				return value, ok
+			}
+			got, err := loader.resolveTLS()
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tc.want {
+				t.Fatalf("unexpected settings: got %+v want %+v", got, tc.want)
+			}
+		})
+	}
+}
+
 func testLogger() *slog.Logger {
 	return slog.New(slog.NewJSONHandler(io.Discard, nil))
 }
@@ -353,3 +762,95 @@ users:
     token: token
 `)
 }
+
+func TestParseConfigFile(t *testing.T) {
+	yamlSource, err := parseFile("k0rdent-mcp.yaml", []byte(`
+listen_addr: 0.0.0.0:8080
+auth_mode: OIDC_REQUIRED
+namespace_filter: ^team-
+log_level: debug
+external_sink_enabled: true
+`))
+	if err != nil {
+		t.Fatalf("parse yaml: %v", err)
+	}
+	tomlSource, err := parseFile("k0rdent-mcp.toml", []byte(`
+listen_addr = "0.0.0.0:8080"
+auth_mode = "OIDC_REQUIRED"
+namespace_filter = "^team-"
+log_level = "debug"
+external_sink_enabled = true
+`))
+	if err != nil {
+		t.Fatalf("parse toml: %v", err)
+	}
+
+	want := map[string]string{
+		"LISTEN_ADDR":               "0.0.0.0:8080",
+		"AUTH_MODE":                 "OIDC_REQUIRED",
+		"K0RDENT_NAMESPACE_FILTER":  "^team-",
+		"LOG_LEVEL":                 "debug",
+		"LOG_EXTERNAL_SINK_ENABLED": "true",
+	}
+	for name, source := range map[string]*FileSource{"yaml": yamlSource, "toml": tomlSource} {
+		env := source.Env()
+		if len(env) != len(want) {
+			t.Fatalf("%s: expected %d variables, got %v", name, len(want), env)
+		}
+		for key, value := range want {
+			if env[key] != value {
+				t.Fatalf("%s: expected %s=%q, got %q", name, key, value, env[key])
+			}
+		}
+	}
+}
+
+func TestParseConfigFileErrorsNameKey(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		key  string
+	}{
+		{name: "unknown key", data: "listen: :8080\n", key: `"listen"`},
+		{name: "wrong type", data: "external_sink_enabled: sometimes\n", key: `"external_sink_enabled"`},
+		{name: "invalid auth mode", data: "auth_mode: NONE\n", key: `"auth_mode"`},
+		{name: "invalid regex", data: "namespace_filter: \"[\"\n", key: `"namespace_filter"`},
+		{name: "invalid level", data: "log_level: loud\n", key: `"log_level"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseFile("k0rdent-mcp.yaml", []byte(tt.data))
+			if err == nil {
+				t.Fatal("expected error")
+			}
+			if !strings.Contains(err.Error(), tt.key) {
+				t.Fatalf("expected error to name key %s, got %v", tt.key, err)
+			}
+		})
+	}
+}
+
+func TestFileSourceApplyEnvPrecedence(t *testing.T) {
+	listen := "0.0.0.0:8080"
+	level := "debug"
+	source := &FileSource{ListenAddr: &listen, LogLevel: &level}
+
+	env := map[string]string{"LOG_LEVEL": "warn"}
+	lookup := func(key string) (string, bool) {
+		value, ok := env[key]
+		return value, ok
+	}
+	setenv := func(key, value string) error {
+		env[key] = value
+		return nil
+	}
+	if err := source.ApplyEnv(lookup, setenv); err != nil {
+		t.Fatalf("ApplyEnv returned error: %v", err)
+	}
+	if env["LISTEN_ADDR"] != listen {
+		t.Fatalf("expected LISTEN_ADDR from file, got %q", env["LISTEN_ADDR"])
+	}
+	if env["LOG_LEVEL"] != "warn" {
+		t.Fatalf("expected environment LOG_LEVEL to win, got %q", env["LOG_LEVEL"])
+	}
+}
@@ -0,0 +1,147 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"sigs.k8s.io/yaml"
+
+	"github.com/k0rdent/mcp-k0rdent-server/internal/logging"
+)
+
+// envListenAddr is read by the server command; the config file can set it like any other variable.
+const envListenAddr = "LISTEN_ADDR"
+
+// FileSource holds the settings that can be committed in a k0rdent-mcp config file. Unset
+// keys are nil so the environment (and flags, which set the environment) take precedence
+// only where the file is silent: file < env < flags.
+type FileSource struct {
+	Path string
+
+	ListenAddr          *string
+	AuthMode            *string
+	NamespaceFilter     *string
+	LogLevel            *string
+	ExternalSinkEnabled *bool
+}
+
+// fileKeys maps config file keys to the environment variables they provide.
+var fileKeys = map[string]string{
+	"listen_addr":           envListenAddr,
+	"auth_mode":             envAuthMode,
+	"namespace_filter":      envNamespaceExpr,
+	"log_level":             envLogLevel,
+	"external_sink_enabled": envLogSinkEnabled,
+}
+
+// LoadFile reads a YAML (.yaml, .yml) or TOML (.toml) config file. Unknown keys, values of
+// the wrong type, and invalid values are reported with the offending key.
+func LoadFile(path string) (*FileSource, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read config file: %w", err)
+	}
+	return parseFile(path, data)
+}
+
+func parseFile(path string, data []byte) (*FileSource, error) {
+	raw := map[string]any{}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".toml":
+		if _, err := toml.Decode(string(data), &raw); err != nil {
+			return nil, fmt.Errorf("parse config file %s: %w", path, err)
+		}
+	default:
+		if err := yaml.Unmarshal(data, &raw); err != nil {
+			return nil, fmt.Errorf("parse config file %s: %w", path, err)
+		}
+	}
+
+	keys := make([]string, 0, len(raw))
+	for key := range raw {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	source := &FileSource{Path: path}
+	for _, key := range keys {
+		value := raw[key]
+		if _, known := fileKeys[key]; !known {
+			return nil, fmt.Errorf("config file %s: unknown key %q", path, key)
+		}
+		if key == "external_sink_enabled" {
+			enabled, ok := value.(bool)
+			if !ok {
+				return nil, fmt.Errorf("config file %s: key %q must be a boolean, got %v", path, key, value)
+			}
+			source.ExternalSinkEnabled = &enabled
+			continue
+		}
+
+		str, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("config file %s: key %q must be a string, got %v", path, key, value)
+		}
+		switch key {
+		case "listen_addr":
+			source.ListenAddr = &str
+		case "auth_mode":
+			if _, valid := validAuthModes[AuthMode(str)]; !valid {
+				return nil, fmt.Errorf("config file %s: key %q has invalid value %q", path, key, str)
+			}
+			source.AuthMode = &str
+		case "namespace_filter":
+			if _, err := regexp.Compile(str); err != nil {
+				return nil, fmt.Errorf("config file %s: key %q is not a valid regular expression: %w", path, key, err)
+			}
+			source.NamespaceFilter = &str
+		case "log_level":
+			if _, err := logging.ParseLevel(str); err != nil {
+				return nil, fmt.Errorf("config file %s: key %q: %w", path, key, err)
+			}
+			source.LogLevel = &str
+		}
+	}
+	return source, nil
+}
+
+// Env returns the environment variables provided by the file, keyed by variable name.
+func (f *FileSource) Env() map[string]string {
+	env := make(map[string]string)
+	if f == nil {
+		return env
+	}
+	set := func(key string, value *string) {
+		if value != nil {
+			env[key] = *value
+		}
+	}
+	set(envListenAddr, f.ListenAddr)
+	set(envAuthMode, f.AuthMode)
+	set(envNamespaceExpr, f.NamespaceFilter)
+	set(envLogLevel, f.LogLevel)
+	if f.ExternalSinkEnabled != nil {
+		env[envLogSinkEnabled] = strconv.FormatBool(*f.ExternalSinkEnabled)
+	}
+	return env
+}
+
+// ApplyEnv sets each variable provided by the file that is not already present in the
+// environment, so environment variables override the file.
+func (f *FileSource) ApplyEnv(lookup func(string) (string, bool), setenv func(string, string) error) error {
+	for key, value := range f.Env() {
+		if _, exists := lookup(key); exists {
+			continue
+		}
+		if err := setenv(key, value); err != nil {
+			return fmt.Errorf("set %s from config file: %w", key, err)
+		}
+	}
+	return nil
+}
@@ -3,6 +3,8 @@ package helm
 import (
 	"context"
 	"fmt"
+	"os"
+	"strings"
 )
 
 const (
@@ -10,8 +12,30 @@ const (
 	KGSTChartURL = "oci://ghcr.io/k0rdent/catalog/charts/kgst"
 	// DefaultKGSTVersion is the default kgst chart version to use
 	DefaultKGSTVersion = "2.0.0"
+
+	// EnvKGSTChartPath points at a local kgst chart directory or packaged archive,
+	// for air-gapped installs. It takes precedence over EnvKGSTChartRef.
+	EnvKGSTChartPath = "KGST_CHART_PATH"
+	// EnvKGSTChartRef overrides the kgst chart reference (e.g. a mirrored OCI registry);
+	// the chart version is appended as with KGSTChartURL.
+	EnvKGSTChartRef = "KGST_CHART_REF"
 )
 
+// ChartSourceError reports that the kgst chart could not be loaded from the named source.
+type ChartSourceError struct {
+	Source string
+	Err    error
+}
+
+func (e *ChartSourceError) Error() string {
+	return fmt.Sprintf("kgst chart unavailable from %s: %v (set %s to a local chart directory or archive, or %s to a reachable chart reference)",
+		e.Source, e.Err, EnvKGSTChartPath, EnvKGSTChartRef)
+}
+
+func (e *ChartSourceError) Unwrap() error {
+	return e.Err
+}
+
 // LoadChart validates chart URL and version for CLI usage
 func (c *Client) LoadChart(ctx context.Context, chartURL string, version string) (string, error) {
 	if chartURL == "" {
@@ -32,19 +56,54 @@ func (c *Client) LoadChart(ctx context.Context, chartURL string, version string)
 	return chartRef, nil
 }
 
-// LoadKGSTChart validates the kgst chart reference for CLI usage
+// LoadKGSTChart validates the kgst chart reference for CLI usage. A local chart from
+// KGST_CHART_PATH is used when configured, otherwise KGST_CHART_REF or the public OCI chart.
+// Failures are reported as *ChartSourceError naming the attempted source.
 func (c *Client) LoadKGSTChart(ctx context.Context, version string) (string, error) {
+	if c.kgstChartPath != "" {
+		c.logger.Debug("validating local kgst chart", "path", c.kgstChartPath)
+		if _, err := os.Stat(c.kgstChartPath); err != nil {
+			return "", &ChartSourceError{Source: fmt.Sprintf("%s=%s", EnvKGSTChartPath, c.kgstChartPath), Err: err}
+		}
+		c.logger.Info("kgst chart validated", "path", c.kgstChartPath)
+		return c.kgstChartPath, nil
+	}
+
 	if version == "" {
 		version = c.kgstVersion
 	}
-	
-	c.logger.Debug("validating kgst chart", "version", version, "configured_version", c.kgstVersion)
-	
-	chartRef, err := c.LoadChart(ctx, KGSTChartURL, version)
+
+	chartURL := KGSTChartURL
+	if c.kgstChartRef != "" {
+		chartURL = c.kgstChartRef
+	}
+
+	c.logger.Debug("validating kgst chart", "chart_url", chartURL, "version", version, "configured_version", c.kgstVersion)
+
+	chartRef, err := c.LoadChart(ctx, chartURL, version)
 	if err != nil {
-		return "", fmt.Errorf("validate kgst chart: %w", err)
+		return "", &ChartSourceError{Source: chartURL, Err: err}
 	}
-	
+
 	c.logger.Info("kgst chart validated", "version", version, "configured_version", c.kgstVersion)
 	return chartRef, nil
 }
+
+// isChartFetchError reports whether helm CLI output indicates that the chart itself
+// could not be pulled, as opposed to a failure installing it.
+func isChartFetchError(output string) bool {
+	for _, marker := range []string{
+		"chart not found",
+		"authentication failed",
+		"connection refused",
+		"no such host",
+		"failed to do request",
+		"i/o timeout",
+		"failed to download",
+	} {
+		if strings.Contains(output, marker) {
+			return true
+		}
+	}
+	return false
+}
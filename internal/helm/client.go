@@ -9,9 +9,11 @@ import (
 // Client represents a Helm client for installing kgst charts
 // This implementation uses the helm CLI instead of the Helm SDK to avoid compatibility issues
 type Client struct {
-	namespace    string
-	logger       *slog.Logger
-	kgstVersion  string
+	namespace     string
+	logger        *slog.Logger
+	kgstVersion   string
+	kgstChartPath string
+	kgstChartRef  string
 }
 
 // NewClient creates a new Helm client configured for the given namespace
@@ -38,9 +40,11 @@ func NewClientWithVersion(_ interface{}, namespace string, logger *slog.Logger,
 	}
 
 	client := &Client{
-		namespace:   namespace,
-		logger:      logger,
-		kgstVersion: kgstVersion,
+		namespace:     namespace,
+		logger:        logger,
+		kgstVersion:   kgstVersion,
+		kgstChartPath: os.Getenv(EnvKGSTChartPath),
+		kgstChartRef:  os.Getenv(EnvKGSTChartRef),
 	}
 
 	logger.Debug("Helm client created (CLI implementation)", "namespace", namespace, "kgst_version", kgstVersion)
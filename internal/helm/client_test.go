@@ -1,7 +1,11 @@
 package helm
 
 import (
+	"context"
+	"errors"
 	"log/slog"
+	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -232,3 +236,155 @@ func TestLoadKGSTChart(t *testing.T) {
 		t.Errorf("Expected '%s', got '%s'", expectedDefault, chartRef)
 	}
 }
+
+func TestLoadKGSTChart_Sources(t *testing.T) {
+	t.Run("chart ref override", func(t *testing.T) {
+		t.Setenv(EnvKGSTChartRef, "oci://registry.internal/charts/kgst")
+		client, err := NewClient(nil, "test-namespace", slog.Default())
+		if err != nil {
+			t.Fatalf("Failed to create client: %v", err)
+		}
+		chartRef, err := client.LoadKGSTChart(nil, "2.1.0")
+		if err != nil {
+			t.Fatalf("Failed to load kgst chart: %v", err)
+		}
+		if chartRef != "oci://registry.internal/charts/kgst:2.1.0" {
+			t.Errorf("Unexpected chart ref '%s'", chartRef)
+		}
+	})
+
+	t.Run("local chart path", func(t *testing.T) {
+		chartDir := t.TempDir()
+		t.Setenv(EnvKGSTChartPath, chartDir)
+		t.Setenv(EnvKGSTChartRef, "oci://registry.internal/charts/kgst")
+		client, err := NewClient(nil, "test-namespace", slog.Default())
+		if err != nil {
+			t.Fatalf("Failed to create client: %v", err)
+		}
+		chartRef, err := client.LoadKGSTChart(nil, "")
+		if err != nil {
+			t.Fatalf("Failed to load kgst chart: %v", err)
+		}
+		if chartRef != chartDir {
+			t.Errorf("Expected local path '%s', got '%s'", chartDir, chartRef)
+		}
+	})
+
+	t.Run("missing local chart path", func(t *testing.T) {
+		missing := filepath.Join(t.TempDir(), "kgst-2.0.0.tgz")
+		t.Setenv(EnvKGSTChartPath, missing)
+		client, err := NewClient(nil, "test-namespace", slog.Default())
+		if err != nil {
+			t.Fatalf("Failed to create client: %v", err)
+		}
+		_, err = client.LoadKGSTChart(nil, "")
+		var sourceErr *ChartSourceError
+		if !errors.As(err, &sourceErr) {
+			t.Fatalf("Expected ChartSourceError, got %v", err)
+		}
+		if !strings.Contains(err.Error(), missing) || !strings.Contains(err.Error(), EnvKGSTChartPath) {
+			t.Errorf("Expected error to name the attempted source, got: %v", err)
+		}
+	})
+}
+
+func TestParseReleaseListFiltersKGST(t *testing.T) {
+	output := []byte(`[
+		{"name":"minio","namespace":"team-a","revision":"3","updated":"2025-11-01 10:00:00 +0000 UTC","status":"failed","chart":"kgst-2.0.0","app_version":"2.0.0"},
+		{"name":"ingress","namespace":"team-a","revision":"1","updated":"2025-11-01 09:00:00 +0000 UTC","status":"deployed","chart":"ingress-nginx-4.11.0","app_version":"1.11.0"},
+		{"name":"postgres","namespace":"team-a","revision":"1","updated":"2025-11-01 11:00:00 +0000 UTC","status":"pending-install","chart":"kgst-2.1.0","app_version":"2.1.0"}
+	]`)
+
+	releases, err := parseReleaseList(output)
+	if err != nil {
+		t.Fatalf("parseReleaseList returned error: %v", err)
+	}
+	if len(releases) != 3 {
+		t.Fatalf("expected 3 releases, got %d", len(releases))
+	}
+	if releases[0].Revision != 3 || releases[0].AppVersion != "2.0.0" {
+		t.Errorf("unexpected first release: %+v", releases[0])
+	}
+
+	var kgst []string
+	for _, release := range releases {
+		if isKGSTChart(release.Chart) {
+			kgst = append(kgst, release.Name)
+		}
+	}
+	if strings.Join(kgst, ",") != "minio,postgres" {
+		t.Errorf("expected kgst releases minio,postgres, got %v", kgst)
+	}
+	if releases[0].Healthy() || releases[2].Healthy() || !releases[1].Healthy() {
+		t.Errorf("unexpected health classification: %+v", releases)
+	}
+	if isKGSTChart("kgst-operator-1.0.0") {
+		t.Errorf("kgst-operator should not be treated as the kgst chart")
+	}
+}
+
+func TestUninstallValidatesReleaseName(t *testing.T) {
+	client, err := NewClient(nil, "test-namespace", slog.Default())
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	if err := client.Uninstall(context.Background(), "", false); err == nil {
+		t.Error("expected error for empty release name")
+	}
+	if err := client.Uninstall(context.Background(), "--all", false); err == nil {
+		t.Error("expected error for release name that looks like a flag")
+	}
+	if !isReleaseNotFound("Error: uninstall: Release not loaded: minio: release: not found") {
+		t.Error("expected helm not-found output to be recognized")
+	}
+}
+
+func TestParseDryRunRelease(t *testing.T) {
+	output := []byte("WARNING: Kubernetes configuration file is group-readable.\n" + `{
+		"name": "minio",
+		"namespace": "team-a",
+		"version": 2,
+		"info": {"status": "pending-upgrade", "description": "Dry run complete"},
+		"chart": {"metadata": {"name": "kgst", "version": "2.0.0"}},
+		"manifest": "---\napiVersion: k0rdent.mirantis.com/v1beta1\nkind: ServiceTemplate\nmetadata:\n  name: minio-14-1-2\n"
+	}`)
+
+	release, err := parseDryRunRelease(output)
+	if err != nil {
+		t.Fatalf("parseDryRunRelease returned error: %v", err)
+	}
+	if release.Name != "minio" || release.Namespace != "team-a" || release.Version != 2 || release.Chart != "kgst-2.0.0" {
+		t.Errorf("unexpected release: %+v", release)
+	}
+
+	client, err := NewClient(nil, "team-a", slog.Default())
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	resources := client.ExtractAppliedResources(release)
+	if len(resources) != 1 || resources[0] != "team-a/ServiceTemplate/minio-14-1-2" {
+		t.Errorf("expected rendered ServiceTemplate, got %v", resources)
+	}
+
+	if _, err := parseDryRunRelease([]byte("Error: no JSON here")); err == nil {
+		t.Error("expected error for output without JSON")
+	}
+}
+
+func TestInstallOrUpgradeDryRunValidatesInput(t *testing.T) {
+	client, err := NewClient(nil, "test-namespace", slog.Default())
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	values := client.BuildKGSTValues("minio", "14.1.2", "test-namespace")
+
+	if _, err := client.InstallOrUpgradeDryRun(context.Background(), "", "oci://example/kgst", values); err == nil {
+		t.Error("expected error for empty release name")
+	}
+	if _, err := client.InstallOrUpgradeDryRun(context.Background(), "minio", "", values); err == nil {
+		t.Error("expected error for empty chart reference")
+	}
+	if _, err := client.InstallOrUpgradeDryRun(context.Background(), "minio", "oci://example/kgst", nil); err == nil {
+		t.Error("expected error for nil values")
+	}
+}
@@ -1,6 +1,7 @@
 package helm
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -98,6 +99,9 @@ func (c *Client) InstallOrUpgrade(ctx context.Context, releaseName string, chart
 
 		// Try to extract more detailed error information
 		detailedErr := c.parseCLIError(string(output))
+		if isChartFetchError(string(output)) {
+			return nil, &ChartSourceError{Source: chartRef, Err: detailedErr}
+		}
 		return nil, fmt.Errorf("helm install/upgrade failed: %w", detailedErr)
 	}
 
@@ -137,6 +141,95 @@ func (c *Client) InstallOrUpgrade(ctx context.Context, releaseName string, chart
 	return release, nil
 }
 
+// InstallOrUpgradeDryRun renders what InstallOrUpgrade would apply using helm upgrade --install
+// --dry-run. Nothing is persisted; the returned Release carries the rendered manifest and the
+// revision the real install would create.
+func (c *Client) InstallOrUpgradeDryRun(ctx context.Context, releaseName string, chartRef string, values map[string]interface{}) (*Release, error) {
+	if releaseName == "" {
+		return nil, fmt.Errorf("release name is required")
+	}
+	if chartRef == "" {
+		return nil, fmt.Errorf("chart reference is required")
+	}
+	if values == nil {
+		return nil, fmt.Errorf("values are required")
+	}
+
+	c.logger.Info("starting Helm install/upgrade dry run",
+		"release_name", releaseName,
+		"chart_ref", chartRef,
+		"namespace", c.namespace)
+
+	if err := c.ValidateKGSTValues(values); err != nil {
+		return nil, fmt.Errorf("invalid values: %w", err)
+	}
+
+	valuesData, err := c.buildValuesData(values)
+	if err != nil {
+		return nil, fmt.Errorf("build values data: %w", err)
+	}
+
+	args := []string{
+		"upgrade",
+		"--install",
+		releaseName,
+		chartRef,
+		"--namespace", c.namespace,
+		"--dry-run",
+		"--values", "-",
+		"--output", "json",
+	}
+
+	cmd := exec.CommandContext(ctx, "helm", args...)
+	cmd.Stdin = strings.NewReader(valuesData)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		output := stderr.String() + stdout.String()
+		c.logger.Error("Helm install/upgrade dry run failed",
+			"release_name", releaseName,
+			"chart_ref", chartRef,
+			"namespace", c.namespace,
+			"error", err,
+			"output", output)
+
+		detailedErr := c.parseCLIError(output)
+		if isChartFetchError(output) {
+			return nil, &ChartSourceError{Source: chartRef, Err: detailedErr}
+		}
+		return nil, fmt.Errorf("helm install/upgrade dry run failed: %w", detailedErr)
+	}
+
+	release, err := parseDryRunRelease(stdout.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	c.logger.Info("install/upgrade dry run completed",
+		"release_name", release.Name,
+		"namespace", release.Namespace,
+		"chart_name", release.Chart,
+		"revision", release.Version)
+
+	return release, nil
+}
+
+// parseDryRunRelease parses the JSON release printed by helm upgrade --dry-run --output json.
+// Anything helm prints before the JSON document (warnings) is skipped.
+func parseDryRunRelease(output []byte) (*Release, error) {
+	start := bytes.IndexByte(output, '{')
+	if start < 0 {
+		return nil, fmt.Errorf("parse dry run output: no release JSON in helm output")
+	}
+	var data releaseJSON
+	if err := json.Unmarshal(output[start:], &data); err != nil {
+		return nil, fmt.Errorf("parse dry run output: %w", err)
+	}
+	return data.toRelease(data.Manifest), nil
+}
+
 // buildValuesData converts values map to YAML string for helm CLI
 func (c *Client) buildValuesData(values map[string]interface{}) (string, error) {
 	valuesJSON, err := json.Marshal(values)
@@ -274,23 +367,7 @@ func (c *Client) getRelease(releaseName string) (*Release, error) {
 	}
 
 	// Parse the JSON status output
-	var statusData struct {
-		Name      string `json:"name"`
-		Namespace string `json:"namespace"`
-		Version   int    `json:"version"`
-		Info      struct {
-			Status      string `json:"status"`
-			Description string `json:"description"`
-			Notes       string `json:"notes"`
-		} `json:"info"`
-		Chart struct {
-			Metadata struct {
-				Name    string `json:"name"`
-				Version string `json:"version"`
-			} `json:"metadata"`
-		} `json:"chart"`
-	}
-
+	var statusData releaseJSON
 	if err := json.Unmarshal(statusOutput, &statusData); err != nil {
 		return nil, fmt.Errorf("parse status json: %w", err)
 	}
@@ -309,22 +386,43 @@ func (c *Client) getRelease(releaseName string) (*Release, error) {
 		// Don't fail if we can't get the manifest, status is more important
 	}
 
-	// Build Release struct from the data
-	release := &Release{
-		Name:      statusData.Name,
-		Namespace: statusData.Namespace,
-		Version:   statusData.Version,
-		Status:    statusData.Info.Status,
-		Chart:     fmt.Sprintf("%s-%s", statusData.Chart.Metadata.Name, statusData.Chart.Metadata.Version),
-		Manifest:  string(manifestOutput),
+	return statusData.toRelease(string(manifestOutput)), nil
+}
+
+// releaseJSON is the subset of helm's JSON release output (helm status, upgrade --output json)
+// used to build a Release.
+type releaseJSON struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	Version   int    `json:"version"`
+	Manifest  string `json:"manifest"`
+	Info      struct {
+		Status      string `json:"status"`
+		Description string `json:"description"`
+		Notes       string `json:"notes"`
+	} `json:"info"`
+	Chart struct {
+		Metadata struct {
+			Name    string `json:"name"`
+			Version string `json:"version"`
+		} `json:"metadata"`
+	} `json:"chart"`
+}
+
+func (r releaseJSON) toRelease(manifest string) *Release {
+	return &Release{
+		Name:      r.Name,
+		Namespace: r.Namespace,
+		Version:   r.Version,
+		Status:    r.Info.Status,
+		Chart:     fmt.Sprintf("%s-%s", r.Chart.Metadata.Name, r.Chart.Metadata.Version),
+		Manifest:  manifest,
 		Info: ReleaseInfo{
-			Status:      statusData.Info.Status,
-			Description: statusData.Info.Description,
-			Notes:       statusData.Info.Notes,
+			Status:      r.Info.Status,
+			Description: r.Info.Description,
+			Notes:       r.Info.Notes,
 		},
 	}
-
-	return release, nil
 }
 
 // parseCLIError parses helm CLI output to extract meaningful error information
@@ -0,0 +1,156 @@
+package helm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+// kgstChartName is the chart name of kgst releases; helm list reports charts as "<name>-<version>".
+const kgstChartName = "kgst"
+
+// ErrReleaseNotFound is returned when uninstalling a release that does not exist.
+var ErrReleaseNotFound = errors.New("release not found")
+
+// ReleaseSummary is one entry of `helm list` output.
+type ReleaseSummary struct {
+	Name       string `json:"name"`
+	Namespace  string `json:"namespace"`
+	Revision   int    `json:"revision"`
+	Status     string `json:"status"`
+	Chart      string `json:"chart"`
+	AppVersion string `json:"appVersion,omitempty"`
+	Updated    string `json:"updated,omitempty"`
+}
+
+// Healthy reports whether the release is deployed (or superseded by a newer revision).
+// Failed, pending-*, uninstalling, and unknown releases need operator attention.
+func (r ReleaseSummary) Healthy() bool {
+	return r.Status == "deployed" || r.Status == "superseded"
+}
+
+// ListKGSTReleases lists the kgst-managed releases in the configured namespace in any state,
+// including failed and pending releases left behind by interrupted installs.
+func (c *Client) ListKGSTReleases(ctx context.Context) ([]ReleaseSummary, error) {
+	c.logger.Debug("listing kgst releases", "namespace", c.namespace)
+
+	cmd := exec.CommandContext(ctx, "helm", "list",
+		"--namespace", c.namespace,
+		"--all",
+		"--output", "json")
+
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		c.logger.Error("failed to list kgst releases", "namespace", c.namespace, "error", err)
+		return nil, fmt.Errorf("list releases: %w, output: %s", err, string(output))
+	}
+
+	releases, err := parseReleaseList(output)
+	if err != nil {
+		return nil, err
+	}
+
+	kgst := make([]ReleaseSummary, 0, len(releases))
+	for _, release := range releases {
+		if isKGSTChart(release.Chart) {
+			kgst = append(kgst, release)
+		}
+	}
+
+	c.logger.Debug("listed kgst releases", "namespace", c.namespace, "count", len(kgst), "total", len(releases))
+	return kgst, nil
+}
+
+// Uninstall removes a release and the resources it created. With keepHistory the release
+// records are kept so the release can still be inspected (helm uninstall --keep-history).
+// A missing release is reported as ErrReleaseNotFound.
+func (c *Client) Uninstall(ctx context.Context, releaseName string, keepHistory bool) error {
+	if releaseName == "" {
+		return fmt.Errorf("release name is required")
+	}
+	if strings.HasPrefix(releaseName, "-") {
+		return fmt.Errorf("invalid release name %q", releaseName)
+	}
+
+	c.logger.Info("uninstalling Helm release",
+		"release_name", releaseName,
+		"namespace", c.namespace,
+		"keep_history", keepHistory)
+
+	args := []string{
+		"uninstall",
+		releaseName,
+		"--namespace", c.namespace,
+		"--wait",
+		"--timeout", "5m",
+	}
+	if keepHistory {
+		args = append(args, "--keep-history")
+	}
+
+	output, err := exec.CommandContext(ctx, "helm", args...).CombinedOutput()
+	if err != nil {
+		if isReleaseNotFound(string(output)) {
+			return fmt.Errorf("%w: %s in namespace %s", ErrReleaseNotFound, releaseName, c.namespace)
+		}
+		c.logger.Error("Helm uninstall failed",
+			"release_name", releaseName,
+			"namespace", c.namespace,
+			"error", err,
+			"output", string(output))
+		return fmt.Errorf("helm uninstall failed: %w", c.parseCLIError(string(output)))
+	}
+
+	c.logger.Info("release uninstalled", "release_name", releaseName, "namespace", c.namespace)
+	return nil
+}
+
+// isReleaseNotFound reports whether helm CLI output says the release does not exist
+// ("Error: uninstall: Release not loaded: minio: release: not found").
+func isReleaseNotFound(output string) bool {
+	return strings.Contains(output, "release: not found")
+}
+
+// parseReleaseList decodes `helm list --output json`, where the revision is a string.
+func parseReleaseList(output []byte) ([]ReleaseSummary, error) {
+	var raw []struct {
+		Name       string `json:"name"`
+		Namespace  string `json:"namespace"`
+		Revision   string `json:"revision"`
+		Updated    string `json:"updated"`
+		Status     string `json:"status"`
+		Chart      string `json:"chart"`
+		AppVersion string `json:"app_version"`
+	}
+	if err := json.Unmarshal(output, &raw); err != nil {
+		return nil, fmt.Errorf("parse releases json: %w", err)
+	}
+
+	releases := make([]ReleaseSummary, 0, len(raw))
+	for _, entry := range raw {
+		revision, _ := strconv.Atoi(entry.Revision)
+		releases = append(releases, ReleaseSummary{
+			Name:       entry.Name,
+			Namespace:  entry.Namespace,
+			Revision:   revision,
+			Status:     entry.Status,
+			Chart:      entry.Chart,
+			AppVersion: entry.AppVersion,
+			Updated:    entry.Updated,
+		})
+	}
+	return releases, nil
+}
+
+// isKGSTChart reports whether a helm list chart column ("kgst-2.0.0") names the kgst chart.
+func isKGSTChart(chart string) bool {
+	name, version, found := strings.Cut(chart, "-")
+	if !found {
+		return chart == kgstChartName
+	}
+	return name == kgstChartName && version != "" && version[0] >= '0' && version[0] <= '9'
+}
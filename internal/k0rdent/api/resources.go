@@ -3,6 +3,7 @@ package api
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/k0rdent/mcp-k0rdent-server/internal/clusters"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -34,6 +35,15 @@ type ServiceTemplateSummary struct {
 // ClusterDeploymentSummary provides a compact view of a ClusterDeployment.
 type ClusterDeploymentSummary = clusters.ClusterDeploymentSummary
 
+// ServiceTemplateStatus reports whether the controller accepted a ServiceTemplate.
+type ServiceTemplateStatus struct {
+	Name            string                      `json:"name"`
+	Namespace       string                      `json:"namespace"`
+	Valid           bool                        `json:"valid"`
+	ValidationError string                      `json:"validationError,omitempty"`
+	Conditions      []clusters.ConditionSummary `json:"conditions,omitempty"`
+}
+
 // MultiClusterServiceSummary provides a compact view of a MultiClusterService.
 type MultiClusterServiceSummary struct {
 	Name         string            `json:"name"`
@@ -56,6 +66,15 @@ func ListServiceTemplates(ctx context.Context, client dynamic.Interface) ([]Serv
 	return summaries, nil
 }
 
+// GetServiceTemplateStatus fetches a ServiceTemplate and returns its validation status and conditions.
+func GetServiceTemplateStatus(ctx context.Context, client dynamic.Interface, namespace, name string) (ServiceTemplateStatus, error) {
+	obj, err := client.Resource(serviceTemplateGVR).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return ServiceTemplateStatus{}, fmt.Errorf("get service template %s/%s: %w", namespace, name, err)
+	}
+	return SummarizeServiceTemplateStatus(obj), nil
+}
+
 // ListClusterDeployments returns ClusterDeployment summaries filtered by an optional label selector.
 func ListClusterDeployments(ctx context.Context, client dynamic.Interface, selector string) ([]ClusterDeploymentSummary, error) {
 	var opts metav1.ListOptions
@@ -112,6 +131,21 @@ func SummarizeServiceTemplate(obj *unstructured.Unstructured) ServiceTemplateSum
 	}
 }
 
+func SummarizeServiceTemplateStatus(obj *unstructured.Unstructured) ServiceTemplateStatus {
+	if obj == nil {
+		return ServiceTemplateStatus{}
+	}
+	valid, _, _ := unstructured.NestedBool(obj.Object, "status", "valid")
+	validationError, _, _ := unstructured.NestedString(obj.Object, "status", "validationError")
+	return ServiceTemplateStatus{
+		Name:            obj.GetName(),
+		Namespace:       obj.GetNamespace(),
+		Valid:           valid,
+		ValidationError: validationError,
+		Conditions:      clusters.ExtractConditions(obj),
+	}
+}
+
 func SummarizeClusterDeployment(obj *unstructured.Unstructured) ClusterDeploymentSummary {
 	return clusters.SummarizeClusterDeployment(obj)
 }
@@ -167,3 +201,78 @@ func MatchDeploymentSelector(objLabels map[string]string, selector map[string]an
 func ServiceTemplateGVR() schema.GroupVersionResource     { return serviceTemplateGVR }
 func ClusterDeploymentGVR() schema.GroupVersionResource   { return clusterDeploymentGVR }
 func MultiClusterServiceGVR() schema.GroupVersionResource { return multiClusterServiceGVR }
+
+// ServiceTemplateConsumer identifies a resource whose service spec references a ServiceTemplate.
+type ServiceTemplateConsumer struct {
+	Kind        string `json:"kind"`
+	Name        string `json:"name"`
+	Namespace   string `json:"namespace,omitempty"`
+	ServiceName string `json:"serviceName,omitempty"`
+}
+
+// ListServiceTemplateConsumers returns ClusterDeployments and MultiClusterServices whose
+// spec.serviceSpec.services entries reference the named ServiceTemplate. An unqualified
+// reference resolves in the consumer's own namespace, so it matches only consumers in
+// templateNamespace (cluster-scoped MultiClusterServices match on name); a "namespace/name"
+// reference is matched only when templateNamespace agrees.
+func ListServiceTemplateConsumers(ctx context.Context, client dynamic.Interface, templateNamespace, templateName string) ([]ServiceTemplateConsumer, error) {
+	var consumers []ServiceTemplateConsumer
+
+	for _, source := range []struct {
+		kind string
+		gvr  schema.GroupVersionResource
+	}{
+		{kind: "ClusterDeployment", gvr: clusterDeploymentGVR},
+		{kind: "MultiClusterService", gvr: multiClusterServiceGVR},
+	} {
+		list, err := client.Resource(source.gvr).Namespace(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("list %s resources: %w", source.kind, err)
+		}
+		for i := range list.Items {
+			obj := &list.Items[i]
+			for _, serviceName := range servicesReferencingTemplate(obj, templateNamespace, templateName) {
+				consumers = append(consumers, ServiceTemplateConsumer{
+					Kind:        source.kind,
+					Name:        obj.GetName(),
+					Namespace:   obj.GetNamespace(),
+					ServiceName: serviceName,
+				})
+			}
+		}
+	}
+
+	return consumers, nil
+}
+
+// servicesReferencingTemplate returns the names of service entries in obj that use the template.
+func servicesReferencingTemplate(obj *unstructured.Unstructured, templateNamespace, templateName string) []string {
+	list, found, err := unstructured.NestedSlice(obj.Object, "spec", "serviceSpec", "services")
+	if err != nil || !found {
+		return nil
+	}
+	var names []string
+	for _, entry := range list {
+		service, ok := entry.(map[string]any)
+		if !ok {
+			continue
+		}
+		ref, _ := service["template"].(string)
+		if ref == "" {
+			continue
+		}
+		refName, refNamespace := ref, obj.GetNamespace()
+		if ns, name, ok := strings.Cut(ref, "/"); ok {
+			refNamespace, refName = ns, name
+		}
+		if templateNamespace != "" && refNamespace != "" && refNamespace != templateNamespace {
+			continue
+		}
+		if refName != templateName {
+			continue
+		}
+		serviceName, _ := service["name"].(string)
+		names = append(names, serviceName)
+	}
+	return names
+}
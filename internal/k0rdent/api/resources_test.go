@@ -0,0 +1,123 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+)
+
+func TestListServiceTemplateConsumers(t *testing.T) {
+	mcs := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "k0rdent.mirantis.com/v1beta1",
+		"kind":       "MultiClusterService",
+		"metadata":   map[string]any{"name": "global-ingress"},
+		"spec": map[string]any{
+			"serviceSpec": map[string]any{
+				"services": []any{
+					map[string]any{"name": "ingress", "template": "ingress-nginx-4-11-0"},
+				},
+			},
+		},
+	}}
+
+	client := fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		clusterDeploymentGVR:   "ClusterDeploymentList",
+		multiClusterServiceGVR: "MultiClusterServiceList",
+	},
+		newClusterDeployment("kcm-system", "uses-it", []map[string]any{
+			{"name": "ingress", "template": "ingress-nginx-4-11-0"},
+			{"name": "minio", "template": "minio-1-0-0"},
+		}, nil),
+		newClusterDeployment("tenant-a", "same-name-own-namespace", []map[string]any{
+			{"name": "ingress", "template": "ingress-nginx-4-11-0"},
+		}, nil),
+		newClusterDeployment("tenant-b", "qualified", []map[string]any{
+			{"name": "edge", "template": "kcm-system/ingress-nginx-4-11-0"},
+		}, nil),
+		newClusterDeployment("tenant-c", "other-namespace", []map[string]any{
+			{"name": "edge", "template": "team-x/ingress-nginx-4-11-0"},
+		}, nil),
+		newClusterDeployment("tenant-d", "unrelated", []map[string]any{
+			{"name": "minio", "template": "minio-1-0-0"},
+		}, nil),
+		mcs,
+	)
+
+	consumers, err := ListServiceTemplateConsumers(context.Background(), client, "kcm-system", "ingress-nginx-4-11-0")
+	if err != nil {
+		t.Fatalf("ListServiceTemplateConsumers returned error: %v", err)
+	}
+
+	got := map[string]string{}
+	for _, c := range consumers {
+		got[c.Kind+"/"+c.Namespace+"/"+c.Name] = c.ServiceName
+	}
+	want := map[string]string{
+		"ClusterDeployment/kcm-system/uses-it": "ingress",
+		"ClusterDeployment/tenant-b/qualified": "edge",
+		"MultiClusterService//global-ingress":  "ingress",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("expected %d consumers, got %v", len(want), got)
+	}
+	for key, service := range want {
+		if got[key] != service {
+			t.Errorf("expected consumer %s with service %q, got %v", key, service, got)
+		}
+	}
+}
+
+func TestServicesReferencingTemplateNamespaces(t *testing.T) {
+	services := []map[string]any{{"name": "ingress", "template": "ingress-nginx-4-11-0"}}
+	teamA := newClusterDeployment("team-a", "demo", services, nil)
+	teamB := newClusterDeployment("team-b", "demo", services, nil)
+
+	if got := servicesReferencingTemplate(teamA, "team-a", "ingress-nginx-4-11-0"); len(got) != 1 || got[0] != "ingress" {
+		t.Fatalf("expected team-a consumer of its own template, got %v", got)
+	}
+	if got := servicesReferencingTemplate(teamB, "team-a", "ingress-nginx-4-11-0"); len(got) != 0 {
+		t.Fatalf("expected team-b's unqualified reference to resolve in team-b, got %v", got)
+	}
+	if got := servicesReferencingTemplate(teamB, "team-b", "ingress-nginx-4-11-0"); len(got) != 1 {
+		t.Fatalf("expected team-b consumer of its own template, got %v", got)
+	}
+}
+
+func TestGetServiceTemplateStatus(t *testing.T) {
+	template := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "k0rdent.mirantis.com/v1beta1",
+		"kind":       "ServiceTemplate",
+		"metadata":   map[string]any{"name": "minio-1-0-0", "namespace": "kcm-system"},
+		"status": map[string]any{
+			"valid":           false,
+			"validationError": "helm chart minio not found",
+			"conditions": []any{
+				map[string]any{"type": "Ready", "status": "False", "reason": "ChartNotFound", "message": "helm chart minio not found"},
+			},
+		},
+	}}
+
+	client := fake.NewSimpleDynamicClient(runtime.NewScheme(), template)
+
+	status, err := GetServiceTemplateStatus(context.Background(), client, "kcm-system", "minio-1-0-0")
+	if err != nil {
+		t.Fatalf("GetServiceTemplateStatus returned error: %v", err)
+	}
+	if status.Valid {
+		t.Errorf("expected template to be invalid")
+	}
+	if status.ValidationError != "helm chart minio not found" {
+		t.Errorf("unexpected validation error %q", status.ValidationError)
+	}
+	if len(status.Conditions) != 1 || status.Conditions[0].Reason != "ChartNotFound" {
+		t.Errorf("unexpected conditions %+v", status.Conditions)
+	}
+
+	if _, err := GetServiceTemplateStatus(context.Background(), client, "kcm-system", "missing"); err == nil {
+		t.Fatalf("expected error for missing template")
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -14,6 +16,14 @@ const (
 	defaultServiceFieldOwner = "mcp.services"
 )
 
+// ErrServiceHasDependents is returned when removing a service that other services still list
+// in their dependsOn.
+var ErrServiceHasDependents = errors.New("service is a dependency of other services")
+
+// ErrInvalidServiceDependencies is returned when the dependsOn graph of a cluster's services
+// references unknown services or contains a cycle.
+var ErrInvalidServiceDependencies = errors.New("invalid service dependencies")
+
 // ClusterServiceValuesFrom models a single valuesFrom entry for a managed service.
 type ClusterServiceValuesFrom struct {
 	Kind     string `json:"kind"`
@@ -64,6 +74,24 @@ type ApplyClusterServiceResult struct {
 	Service map[string]any             `json:"service"`
 }
 
+// ApplyClusterServicesOptions control how several service entries are merged into a
+// ClusterDeployment in a single apply.
+type ApplyClusterServicesOptions struct {
+	ClusterNamespace string                    `json:"clusterNamespace"`
+	ClusterName      string                    `json:"clusterName"`
+	FieldOwner       string                    `json:"fieldOwner"`
+	DryRun           bool                      `json:"dryRun,omitempty"`
+	Services         []ClusterServiceApplySpec `json:"services"`
+	ProviderConfig   *map[string]any           `json:"providerConfig,omitempty"`
+}
+
+// ApplyClusterServicesResult reports the outcome of a multi-service apply operation.
+type ApplyClusterServicesResult struct {
+	Cluster *unstructured.Unstructured `json:"cluster"`
+	// Services are the entries that were sent, in request order
+	Services []map[string]any `json:"services"`
+}
+
 // RemoveClusterServiceOptions specifies parameters for removing a service from a ClusterDeployment.
 type RemoveClusterServiceOptions struct {
 	ClusterNamespace string `json:"clusterNamespace"`
@@ -127,13 +155,94 @@ func ApplyClusterService(ctx context.Context, client dynamic.Interface, opts App
 
 	updatedServices, appliedEntry := mergeServiceEntries(existingServices, serviceName, opts, templateRef)
 
+	result, err := applyServiceSpec(ctx, client, cluster, updatedServices, opts.ProviderConfig, fieldOwner, opts.DryRun)
+	if err != nil {
+		return ApplyClusterServiceResult{}, fmt.Errorf("apply cluster service: %w", err)
+	}
+
+	return ApplyClusterServiceResult{
+		Cluster: result,
+		Service: deepCopyMap(appliedEntry),
+	}, nil
+}
+
+// ApplyClusterServices merges several service entries into a ClusterDeployment and applies
+// them with a single server-side apply, so the controller reconciles the final service set
+// once. The dependsOn graph of the resulting services is validated before anything is sent;
+// unknown references and cycles fail with ErrInvalidServiceDependencies.
+func ApplyClusterServices(ctx context.Context, client dynamic.Interface, opts ApplyClusterServicesOptions) (ApplyClusterServicesResult, error) {
+	if client == nil {
+		return ApplyClusterServicesResult{}, errors.New("dynamic client is required")
+	}
+	if opts.ClusterNamespace == "" {
+		return ApplyClusterServicesResult{}, errors.New("cluster namespace is required")
+	}
+	if opts.ClusterName == "" {
+		return ApplyClusterServicesResult{}, errors.New("cluster name is required")
+	}
+	if len(opts.Services) == 0 {
+		return ApplyClusterServicesResult{}, errors.New("at least one service is required")
+	}
+
+	fieldOwner := opts.FieldOwner
+	if fieldOwner == "" {
+		fieldOwner = defaultServiceFieldOwner
+	}
+
+	cluster, err := client.
+		Resource(clusterDeploymentGVR).
+		Namespace(opts.ClusterNamespace).
+		Get(ctx, opts.ClusterName, metav1.GetOptions{})
+	if err != nil {
+		return ApplyClusterServicesResult{}, fmt.Errorf("get cluster deployment: %w", err)
+	}
+
+	updatedServices, err := existingServiceEntries(cluster)
+	if err != nil {
+		return ApplyClusterServicesResult{}, err
+	}
+
+	applied := make([]map[string]any, 0, len(opts.Services))
+	for i, spec := range opts.Services {
+		if spec.TemplateName == "" {
+			return ApplyClusterServicesResult{}, fmt.Errorf("services[%d]: service template name is required", i)
+		}
+		serviceName := spec.ServiceName
+		if serviceName == "" {
+			serviceName = spec.TemplateName
+		}
+		single := ApplyClusterServiceOptions{ClusterNamespace: opts.ClusterNamespace, Service: spec}
+		var entry map[string]any
+		updatedServices, entry = mergeServiceEntries(updatedServices, serviceName, single, buildTemplateReference(spec.TemplateNamespace, spec.TemplateName))
+		applied = append(applied, entry)
+	}
+
+	if err := validateServiceDependencies(updatedServices); err != nil {
+		return ApplyClusterServicesResult{}, err
+	}
+
+	result, err := applyServiceSpec(ctx, client, cluster, updatedServices, opts.ProviderConfig, fieldOwner, opts.DryRun)
+	if err != nil {
+		return ApplyClusterServicesResult{}, fmt.Errorf("apply cluster services: %w", err)
+	}
+
+	services := make([]map[string]any, len(applied))
+	for i, entry := range applied {
+		services[i] = deepCopyMap(entry)
+	}
+	return ApplyClusterServicesResult{Cluster: result, Services: services}, nil
+}
+
+// applyServiceSpec server-side applies services as the cluster's spec.serviceSpec.services,
+// along with the provider config when set.
+func applyServiceSpec(ctx context.Context, client dynamic.Interface, cluster *unstructured.Unstructured, services []map[string]any, providerConfig *map[string]any, fieldOwner string, dryRun bool) (*unstructured.Unstructured, error) {
 	serviceSpec := map[string]any{
-		"services": toInterfaceSlice(updatedServices),
+		"services": toInterfaceSlice(services),
 	}
 
-	if opts.ProviderConfig != nil {
+	if providerConfig != nil {
 		providerMap := existingProviderConfig(cluster)
-		providerMap["config"] = deepCopyMap(*opts.ProviderConfig)
+		providerMap["config"] = deepCopyMap(*providerConfig)
 		serviceSpec["provider"] = providerMap
 	}
 
@@ -142,8 +251,8 @@ func ApplyClusterService(ctx context.Context, client dynamic.Interface, opts App
 			"apiVersion": cluster.GetAPIVersion(),
 			"kind":       cluster.GetKind(),
 			"metadata": map[string]any{
-				"name":      opts.ClusterName,
-				"namespace": opts.ClusterNamespace,
+				"name":      cluster.GetName(),
+				"namespace": cluster.GetNamespace(),
 			},
 			"spec": map[string]any{
 				"serviceSpec": serviceSpec,
@@ -155,22 +264,86 @@ func ApplyClusterService(ctx context.Context, client dynamic.Interface, opts App
 		FieldManager: fieldOwner,
 		Force:        true,
 	}
-	if opts.DryRun {
+	if dryRun {
 		applyOptions.DryRun = []string{metav1.DryRunAll}
 	}
 
-	result, err := client.
+	return client.
 		Resource(clusterDeploymentGVR).
-		Namespace(opts.ClusterNamespace).
-		Apply(ctx, opts.ClusterName, payload, applyOptions)
-	if err != nil {
-		return ApplyClusterServiceResult{}, fmt.Errorf("apply cluster service: %w", err)
+		Namespace(cluster.GetNamespace()).
+		Apply(ctx, cluster.GetName(), payload, applyOptions)
+}
+
+// validateServiceDependencies checks that every dependsOn entry names one of the services and
+// that the dependencies form no cycle. A cycle is reported as the path that closes it.
+func validateServiceDependencies(services []map[string]any) error {
+	order := make([]string, 0, len(services))
+	deps := make(map[string][]string, len(services))
+	for _, entry := range services {
+		name, _ := entry["name"].(string)
+		order = append(order, name)
+		deps[name] = serviceDependsOn(entry)
 	}
 
-	return ApplyClusterServiceResult{
-		Cluster: result,
-		Service: deepCopyMap(appliedEntry),
-	}, nil
+	var missing []string
+	for _, name := range order {
+		for _, dep := range deps[name] {
+			if _, ok := deps[dep]; !ok {
+				missing = append(missing, fmt.Sprintf("%s (required by %s)", dep, name))
+			}
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("%w: dependsOn references unknown services: %s", ErrInvalidServiceDependencies, strings.Join(missing, ", "))
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int, len(order))
+	var path []string
+	var visit func(name string) []string
+	visit = func(name string) []string {
+		state[name] = visiting
+		path = append(path, name)
+		for _, dep := range deps[name] {
+			switch state[dep] {
+			case visiting:
+				start := slices.Index(path, dep)
+				return append(slices.Clone(path[start:]), dep)
+			case unvisited:
+				if cycle := visit(dep); cycle != nil {
+					return cycle
+				}
+			}
+		}
+		path = path[:len(path)-1]
+		state[name] = visited
+		return nil
+	}
+	for _, name := range order {
+		if state[name] != unvisited {
+			continue
+		}
+		if cycle := visit(name); cycle != nil {
+			return fmt.Errorf("%w: dependsOn cycle %s", ErrInvalidServiceDependencies, strings.Join(cycle, " -> "))
+		}
+	}
+	return nil
+}
+
+// serviceDependsOn returns the names listed in a service entry's dependsOn.
+func serviceDependsOn(entry map[string]any) []string {
+	raw, _ := entry["dependsOn"].([]any)
+	names := make([]string, 0, len(raw))
+	for _, dep := range raw {
+		if name, ok := dep.(string); ok && name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
 }
 
 func existingServiceEntries(cluster *unstructured.Unstructured) ([]map[string]any, error) {
@@ -370,6 +543,18 @@ func existingProviderConfig(cluster *unstructured.Unstructured) map[string]any {
 	return deepCopyMap(provider)
 }
 
+// serviceDependents returns the names of the services whose dependsOn lists target.
+func serviceDependents(services []map[string]any, target string) []string {
+	var dependents []string
+	for _, entry := range services {
+		if slices.Contains(serviceDependsOn(entry), target) {
+			entryName, _ := entry["name"].(string)
+			dependents = append(dependents, entryName)
+		}
+	}
+	return dependents
+}
+
 // filterServiceEntries removes a service entry from the services slice by name.
 // Returns the filtered slice and the removed entry (nil if not found).
 func filterServiceEntries(existing []map[string]any, targetName string) ([]map[string]any, map[string]any) {
@@ -394,7 +579,8 @@ func filterServiceEntries(existing []map[string]any, targetName string) ([]map[s
 
 // RemoveClusterService removes a service entry from ClusterDeployment.spec.serviceSpec.services[]
 // and applies the change via server-side apply. It returns the removed service entry (if found),
-// the updated ClusterDeployment object (or the dry-run preview), and a status message.
+// the updated ClusterDeployment object (or the dry-run preview), and a status message. Removal is
+// refused with ErrServiceHasDependents while other services list the service in dependsOn.
 func RemoveClusterService(ctx context.Context, client dynamic.Interface, opts RemoveClusterServiceOptions) (RemoveClusterServiceResult, error) {
 	if client == nil {
 		return RemoveClusterServiceResult{}, errors.New("dynamic client is required")
@@ -437,6 +623,10 @@ func RemoveClusterService(ctx context.Context, client dynamic.Interface, opts Re
 		}, nil
 	}
 
+	if dependents := serviceDependents(filteredServices, opts.ServiceName); len(dependents) > 0 {
+		return RemoveClusterServiceResult{}, fmt.Errorf("%w: %s is listed in dependsOn of %s", ErrServiceHasDependents, opts.ServiceName, strings.Join(dependents, ", "))
+	}
+
 	payload := &unstructured.Unstructured{
 		Object: map[string]any{
 			"apiVersion": cluster.GetAPIVersion(),
@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -123,6 +124,90 @@ func TestApplyClusterServiceDryRunDoesNotPersist(t *testing.T) {
 	}
 }
 
+func TestApplyClusterServicesAppliesBatchOnce(t *testing.T) {
+	client := testdynamic.NewFakeDynamicClient()
+	existing := map[string]any{"name": "cert-manager", "namespace": "tenant-a", "template": "cert-manager-1-0-0"}
+	client.Add(ClusterDeploymentGVR(), newClusterDeployment("tenant-a", "dev-cluster", []map[string]any{existing}, nil))
+
+	ingressDeps := []string{"cert-manager"}
+	appDeps := []string{"ingress", "cert-manager"}
+	opts := ApplyClusterServicesOptions{
+		ClusterNamespace: "tenant-a",
+		ClusterName:      "dev-cluster",
+		Services: []ClusterServiceApplySpec{
+			{TemplateName: "app-1-0-0", ServiceName: "app", DependsOn: &appDeps},
+			{TemplateName: "ingress-1-0-0", ServiceName: "ingress", DependsOn: &ingressDeps},
+		},
+	}
+
+	result, err := ApplyClusterServices(context.Background(), client, opts)
+	if err != nil {
+		t.Fatalf("ApplyClusterServices returned error: %v", err)
+	}
+	if len(result.Services) != 2 || result.Services[0]["name"] != "app" || result.Services[1]["name"] != "ingress" {
+		t.Fatalf("expected applied entries in request order, got %#v", result.Services)
+	}
+
+	obj, _ := client.GetObject(ClusterDeploymentGVR(), "tenant-a", "dev-cluster")
+	list := extractServiceEntries(obj)
+	if len(list) != 3 {
+		t.Fatalf("expected 3 services after apply, got %#v", list)
+	}
+	if list[0]["name"] != "cert-manager" || list[1]["name"] != "app" || list[2]["name"] != "ingress" {
+		t.Fatalf("unexpected service order: %#v", list)
+	}
+}
+
+func TestApplyClusterServicesRejectsInvalidDependencies(t *testing.T) {
+	tests := []struct {
+		name     string
+		services []ClusterServiceApplySpec
+		want     string
+	}{
+		{
+			name:     "unknown reference",
+			services: []ClusterServiceApplySpec{{TemplateName: "app-1-0-0", ServiceName: "app", DependsOn: &[]string{"database"}}},
+			want:     "database (required by app)",
+		},
+		{
+			name: "cycle through existing service",
+			services: []ClusterServiceApplySpec{
+				{TemplateName: "ingress-1-0-0", ServiceName: "ingress", DependsOn: &[]string{"app"}},
+				{TemplateName: "app-1-0-0", ServiceName: "app", DependsOn: &[]string{"cert-manager"}},
+				{TemplateName: "cert-manager-1-0-0", ServiceName: "cert-manager", DependsOn: &[]string{"ingress"}},
+			},
+			want: "cert-manager -> ingress -> app -> cert-manager",
+		},
+		{
+			name:     "self reference",
+			services: []ClusterServiceApplySpec{{TemplateName: "app-1-0-0", ServiceName: "app", DependsOn: &[]string{"app"}}},
+			want:     "app -> app",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := testdynamic.NewFakeDynamicClient()
+			existing := map[string]any{"name": "cert-manager", "namespace": "tenant-a", "template": "cert-manager-1-0-0"}
+			client.Add(ClusterDeploymentGVR(), newClusterDeployment("tenant-a", "dev-cluster", []map[string]any{existing}, nil))
+
+			_, err := ApplyClusterServices(context.Background(), client, ApplyClusterServicesOptions{
+				ClusterNamespace: "tenant-a",
+				ClusterName:      "dev-cluster",
+				Services:         tt.services,
+			})
+			if !errors.Is(err, ErrInvalidServiceDependencies) || !contains(err.Error(), tt.want) {
+				t.Fatalf("expected ErrInvalidServiceDependencies mentioning %q, got %v", tt.want, err)
+			}
+
+			obj, _ := client.GetObject(ClusterDeploymentGVR(), "tenant-a", "dev-cluster")
+			if list := extractServiceEntries(obj); len(list) != 1 {
+				t.Fatalf("expected services untouched after rejected batch, got %#v", list)
+			}
+		})
+	}
+}
+
 func newClusterDeployment(namespace, name string, services []map[string]any, status []map[string]any) *unstructured.Unstructured {
 	obj := &unstructured.Unstructured{
 		Object: map[string]any{
@@ -320,6 +405,48 @@ func TestRemoveNonexistentService(t *testing.T) {
 	}
 }
 
+func TestRemoveServiceWithDependents(t *testing.T) {
+	client := testdynamic.NewFakeDynamicClient()
+	services := []map[string]any{
+		{
+			"name":      "minio",
+			"namespace": "tenant-a",
+			"template":  "minio-1-0-0",
+		},
+		{
+			"name":      "backup",
+			"namespace": "tenant-a",
+			"template":  "velero-1-0-0",
+			"dependsOn": []any{"minio"},
+		},
+	}
+	client.Add(ClusterDeploymentGVR(), newClusterDeployment("tenant-a", "dev-cluster", services, nil))
+
+	opts := RemoveClusterServiceOptions{
+		ClusterNamespace: "tenant-a",
+		ClusterName:      "dev-cluster",
+		ServiceName:      "minio",
+	}
+
+	_, err := RemoveClusterService(context.Background(), client, opts)
+	if !errors.Is(err, ErrServiceHasDependents) {
+		t.Fatalf("expected ErrServiceHasDependents, got %v", err)
+	}
+	if !contains(err.Error(), "backup") {
+		t.Fatalf("expected error to name the dependent service, got: %v", err)
+	}
+
+	obj, _ := client.GetObject(ClusterDeploymentGVR(), "tenant-a", "dev-cluster")
+	if list := extractServiceEntries(obj); len(list) != 2 {
+		t.Fatalf("expected services unchanged, got %d services", len(list))
+	}
+
+	opts.ServiceName = "backup"
+	if _, err := RemoveClusterService(context.Background(), client, opts); err != nil {
+		t.Fatalf("removing the dependent service should succeed, got %v", err)
+	}
+}
+
 func TestRemoveDryRun(t *testing.T) {
 	client := testdynamic.NewFakeDynamicClient()
 	services := []map[string]any{
@@ -0,0 +1,342 @@
+package api
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/xeipuuv/gojsonschema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"sigs.k8s.io/yaml"
+)
+
+var (
+	helmRepositoryGVR = schema.GroupVersionResource{Group: "source.toolkit.fluxcd.io", Version: "v1", Resource: "helmrepositories"}
+	helmChartGVR      = schema.GroupVersionResource{Group: "source.toolkit.fluxcd.io", Version: "v1", Resource: "helmcharts"}
+)
+
+const (
+	// maxChartArchiveBytes caps chart downloads used for schema lookup.
+	maxChartArchiveBytes = 20 << 20
+	chartFetchTimeout    = 30 * time.Second
+)
+
+// ErrValuesSchemaUnavailable indicates that the chart behind a ServiceTemplate does not
+// publish a values.schema.json, or that the chart could not be located for inspection.
+var ErrValuesSchemaUnavailable = errors.New("values schema not available")
+
+// ErrInvalidValuesSchema indicates that the chart ships a values.schema.json that is not
+// a usable JSON schema. The schema belongs to the chart author, so callers should treat
+// this as a reason to skip validation rather than to reject the user's values.
+var ErrInvalidValuesSchema = errors.New("invalid chart values schema")
+
+// ChartValues holds the schema and default values shipped inside a Helm chart.
+type ChartValues struct {
+	Schema   []byte
+	Defaults map[string]any
+
+	compileOnce sync.Once
+	compiled    *gojsonschema.Schema
+	compileErr  error
+}
+
+// compiledSchema parses Schema once and reuses the result for later validations.
+func (c *ChartValues) compiledSchema() (*gojsonschema.Schema, error) {
+	c.compileOnce.Do(func() {
+		c.compiled, c.compileErr = gojsonschema.NewSchema(gojsonschema.NewBytesLoader(c.Schema))
+		if c.compileErr != nil {
+			c.compileErr = fmt.Errorf("%w: %v", ErrInvalidValuesSchema, c.compileErr)
+		}
+	})
+	return c.compiled, c.compileErr
+}
+
+// ValuesSchemaFetcher resolves the chart values schema for a ServiceTemplate.
+type ValuesSchemaFetcher interface {
+	FetchChartValues(ctx context.Context, template *unstructured.Unstructured) (*ChartValues, error)
+}
+
+// ChartValuesSchemaFetcher downloads the chart referenced by a ServiceTemplate from its
+// HTTP(S) HelmRepository and extracts values.schema.json and values.yaml. OCI repositories
+// are not supported and report ErrValuesSchemaUnavailable. Charts with a pinned version are
+// cached by repository, chart, and version, so repeated applies reuse the parsed schema.
+type ChartValuesSchemaFetcher struct {
+	Client     dynamic.Interface
+	HTTPClient *http.Client
+
+	mu    sync.Mutex
+	cache map[string]*ChartValues
+}
+
+// FetchChartValues implements ValuesSchemaFetcher.
+func (f *ChartValuesSchemaFetcher) FetchChartValues(ctx context.Context, template *unstructured.Unstructured) (*ChartValues, error) {
+	chart, version, repoName, err := f.resolveChart(ctx, template)
+	if err != nil {
+		return nil, err
+	}
+
+	repo, err := f.Client.Resource(helmRepositoryGVR).Namespace(template.GetNamespace()).Get(ctx, repoName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("%w: get HelmRepository %s: %v", ErrValuesSchemaUnavailable, repoName, err)
+	}
+	repoURL, _, _ := unstructured.NestedString(repo.Object, "spec", "url")
+	repoType, _, _ := unstructured.NestedString(repo.Object, "spec", "type")
+	if repoType == "oci" || strings.HasPrefix(repoURL, "oci://") {
+		return nil, fmt.Errorf("%w: OCI repository %s is not supported for schema lookup", ErrValuesSchemaUnavailable, repoName)
+	}
+	if repoURL == "" {
+		return nil, fmt.Errorf("%w: HelmRepository %s has no spec.url", ErrValuesSchemaUnavailable, repoName)
+	}
+
+	// Unpinned versions resolve to whatever the index lists first, so only pinned ones are cached.
+	cacheKey := ""
+	if version != "" {
+		cacheKey = strings.TrimSuffix(repoURL, "/") + "|" + chart + "|" + version
+		if cached := f.cached(cacheKey); cached != nil {
+			return cached, nil
+		}
+	}
+
+	chartURL, err := f.lookupChartURL(ctx, repoURL, chart, version)
+	if err != nil {
+		return nil, err
+	}
+	archive, err := f.get(ctx, chartURL)
+	if err != nil {
+		return nil, fmt.Errorf("%w: download chart %s: %v", ErrValuesSchemaUnavailable, chartURL, err)
+	}
+	values, err := extractChartValues(archive)
+	if err != nil {
+		return nil, err
+	}
+	if cacheKey != "" {
+		f.store(cacheKey, values)
+	}
+	return values, nil
+}
+
+func (f *ChartValuesSchemaFetcher) cached(key string) *ChartValues {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.cache[key]
+}
+
+func (f *ChartValuesSchemaFetcher) store(key string, values *ChartValues) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.cache == nil {
+		f.cache = make(map[string]*ChartValues)
+	}
+	f.cache[key] = values
+}
+
+// resolveChart returns the chart name, version, and HelmRepository name from the
+// ServiceTemplate's spec.helm.chartSpec or the HelmChart referenced by spec.helm.chartRef.
+func (f *ChartValuesSchemaFetcher) resolveChart(ctx context.Context, template *unstructured.Unstructured) (chart, version, repo string, err error) {
+	spec, found, _ := unstructured.NestedMap(template.Object, "spec", "helm", "chartSpec")
+	if !found {
+		refName, _, _ := unstructured.NestedString(template.Object, "spec", "helm", "chartRef", "name")
+		refKind, _, _ := unstructured.NestedString(template.Object, "spec", "helm", "chartRef", "kind")
+		if refName == "" || (refKind != "" && refKind != "HelmChart") {
+			return "", "", "", fmt.Errorf("%w: service template has no helm chartSpec or HelmChart reference", ErrValuesSchemaUnavailable)
+		}
+		helmChart, getErr := f.Client.Resource(helmChartGVR).Namespace(template.GetNamespace()).Get(ctx, refName, metav1.GetOptions{})
+		if getErr != nil {
+			return "", "", "", fmt.Errorf("%w: get HelmChart %s: %v", ErrValuesSchemaUnavailable, refName, getErr)
+		}
+		spec, _, _ = unstructured.NestedMap(helmChart.Object, "spec")
+	}
+
+	chart, _, _ = unstructured.NestedString(spec, "chart")
+	version, _, _ = unstructured.NestedString(spec, "version")
+	repo, _, _ = unstructured.NestedString(spec, "sourceRef", "name")
+	kind, _, _ := unstructured.NestedString(spec, "sourceRef", "kind")
+	if chart == "" || repo == "" || (kind != "" && kind != "HelmRepository") {
+		return "", "", "", fmt.Errorf("%w: chart source is not a HelmRepository", ErrValuesSchemaUnavailable)
+	}
+	return chart, version, repo, nil
+}
+
+// lookupChartURL reads the repository index.yaml and returns the archive URL for the chart version.
+func (f *ChartValuesSchemaFetcher) lookupChartURL(ctx context.Context, repoURL, chart, version string) (string, error) {
+	indexURL := strings.TrimSuffix(repoURL, "/") + "/index.yaml"
+	data, err := f.get(ctx, indexURL)
+	if err != nil {
+		return "", fmt.Errorf("%w: fetch repository index: %v", ErrValuesSchemaUnavailable, err)
+	}
+
+	var index struct {
+		Entries map[string][]struct {
+			Version string   `json:"version"`
+			URLs    []string `json:"urls"`
+		} `json:"entries"`
+	}
+	if err := yaml.Unmarshal(data, &index); err != nil {
+		return "", fmt.Errorf("%w: parse repository index: %v", ErrValuesSchemaUnavailable, err)
+	}
+
+	for _, entry := range index.Entries[chart] {
+		if (version == "" || entry.Version == version) && len(entry.URLs) > 0 {
+			base, err := url.Parse(indexURL)
+			if err != nil {
+				return "", fmt.Errorf("%w: parse repository url: %v", ErrValuesSchemaUnavailable, err)
+			}
+			ref, err := url.Parse(entry.URLs[0])
+			if err != nil {
+				return "", fmt.Errorf("%w: parse chart url: %v", ErrValuesSchemaUnavailable, err)
+			}
+			return base.ResolveReference(ref).String(), nil
+		}
+	}
+	return "", fmt.Errorf("%w: chart %s version %s not found in repository index", ErrValuesSchemaUnavailable, chart, version)
+}
+
+func (f *ChartValuesSchemaFetcher) get(ctx context.Context, rawURL string) ([]byte, error) {
+	client := f.HTTPClient
+	if client == nil {
+		client = &http.Client{Timeout: chartFetchTimeout}
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxChartArchiveBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxChartArchiveBytes {
+		return nil, fmt.Errorf("response exceeds the %dMB size limit", maxChartArchiveBytes>>20)
+	}
+	return data, nil
+}
+
+// extractChartValues reads the top-level values.schema.json and values.yaml from a chart archive.
+func extractChartValues(archive []byte) (*ChartValues, error) {
+	gz, err := gzip.NewReader(bytes.NewReader(archive))
+	if err != nil {
+		return nil, fmt.Errorf("%w: open chart archive: %v", ErrValuesSchemaUnavailable, err)
+	}
+	defer gz.Close()
+
+	result := &ChartValues{}
+	reader := tar.NewReader(gz)
+	for {
+		header, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: read chart archive: %v", ErrValuesSchemaUnavailable, err)
+		}
+		// Chart archives are rooted at <chart>/; ignore files from subcharts.
+		dir, file := path.Split(strings.TrimPrefix(header.Name, "./"))
+		if strings.Count(dir, "/") != 1 {
+			continue
+		}
+		switch file {
+		case "values.schema.json":
+			if result.Schema, err = io.ReadAll(reader); err != nil {
+				return nil, fmt.Errorf("%w: read values.schema.json: %v", ErrValuesSchemaUnavailable, err)
+			}
+		case "values.yaml":
+			data, err := io.ReadAll(reader)
+			if err != nil {
+				return nil, fmt.Errorf("%w: read values.yaml: %v", ErrValuesSchemaUnavailable, err)
+			}
+			if err := yaml.Unmarshal(data, &result.Defaults); err != nil {
+				return nil, fmt.Errorf("%w: parse values.yaml: %v", ErrValuesSchemaUnavailable, err)
+			}
+		}
+	}
+
+	if len(result.Schema) == 0 {
+		return nil, fmt.Errorf("%w: chart does not include values.schema.json", ErrValuesSchemaUnavailable)
+	}
+	return result, nil
+}
+
+// ValuesFieldError describes a single schema violation.
+type ValuesFieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// ValuesValidationError reports values that do not satisfy the chart schema.
+type ValuesValidationError struct {
+	Template string
+	Fields   []ValuesFieldError
+}
+
+func (e *ValuesValidationError) Error() string {
+	parts := make([]string, 0, len(e.Fields))
+	for _, field := range e.Fields {
+		parts = append(parts, fmt.Sprintf("%s: %s", field.Field, field.Message))
+	}
+	return fmt.Sprintf("INVALID_INPUT: values for service template %s failed schema validation: %s", e.Template, strings.Join(parts, "; "))
+}
+
+// ValidateServiceValues validates values, layered over the chart defaults as Helm would,
+// against the chart's values schema. It returns a *ValuesValidationError on violations and
+// an error wrapping ErrInvalidValuesSchema when the chart's schema cannot be parsed.
+func ValidateServiceValues(template string, chart *ChartValues, values map[string]any) error {
+	compiled, err := chart.compiledSchema()
+	if err != nil {
+		return err
+	}
+
+	merged := mergeValues(chart.Defaults, values)
+	result, err := compiled.Validate(gojsonschema.NewGoLoader(merged))
+	if err != nil {
+		return fmt.Errorf("validate values against schema: %w", err)
+	}
+	if result.Valid() {
+		return nil
+	}
+
+	fields := make([]ValuesFieldError, 0, len(result.Errors()))
+	for _, desc := range result.Errors() {
+		fields = append(fields, ValuesFieldError{Field: desc.Field(), Message: desc.Description()})
+	}
+	sort.Slice(fields, func(i, j int) bool { return fields[i].Field < fields[j].Field })
+	return &ValuesValidationError{Template: template, Fields: fields}
+}
+
+// mergeValues deep-merges overrides onto defaults, matching Helm's values coalescing for maps.
+func mergeValues(defaults, overrides map[string]any) map[string]any {
+	merged := make(map[string]any, len(defaults)+len(overrides))
+	for k, v := range defaults {
+		merged[k] = v
+	}
+	for k, v := range overrides {
+		if overrideMap, ok := v.(map[string]any); ok {
+			if defaultMap, ok := merged[k].(map[string]any); ok {
+				merged[k] = mergeValues(defaultMap, overrideMap)
+				continue
+			}
+		}
+		merged[k] = v
+	}
+	return merged
+}
@@ -0,0 +1,197 @@
+package api
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic/fake"
+)
+
+const testValuesSchema = `{
+  "type": "object",
+  "required": ["replicaCount", "image"],
+  "properties": {
+    "replicaCount": {"type": "integer", "minimum": 1},
+    "image": {
+      "type": "object",
+      "properties": {"tag": {"type": "string"}}
+    }
+  }
+}`
+
+func TestValidateServiceValues(t *testing.T) {
+	chart := &ChartValues{
+		Schema:   []byte(testValuesSchema),
+		Defaults: map[string]any{"replicaCount": 1, "image": map[string]any{"tag": "latest"}},
+	}
+
+	if err := ValidateServiceValues("kcm-system/minio", chart, map[string]any{"image": map[string]any{"tag": "1.0"}}); err != nil {
+		t.Fatalf("expected values layered over defaults to validate, got %v", err)
+	}
+
+	err := ValidateServiceValues("kcm-system/minio", chart, map[string]any{
+		"replicaCount": 0,
+		"image":        map[string]any{"tag": 5},
+	})
+	var validationErr *ValuesValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("expected ValuesValidationError, got %v", err)
+	}
+	if len(validationErr.Fields) != 2 {
+		t.Fatalf("expected 2 field errors, got %+v", validationErr.Fields)
+	}
+	if validationErr.Fields[0].Field != "image.tag" || validationErr.Fields[1].Field != "replicaCount" {
+		t.Fatalf("unexpected fields: %+v", validationErr.Fields)
+	}
+	if !strings.HasPrefix(err.Error(), "INVALID_INPUT:") {
+		t.Fatalf("expected INVALID_INPUT prefix, got %q", err.Error())
+	}
+}
+
+func TestChartValuesSchemaFetcher(t *testing.T) {
+	archive := buildChartArchive(t, map[string]string{
+		"minio/Chart.yaml":                    "name: minio\nversion: 1.0.0\n",
+		"minio/values.yaml":                   "replicaCount: 1\n",
+		"minio/values.schema.json":            testValuesSchema,
+		"minio/charts/sub/values.schema.json": `{"type": "string"}`,
+	})
+
+	var downloads int
+	server := newChartServer(t, func(w http.ResponseWriter) {
+		downloads++
+		_, _ = w.Write(archive)
+	})
+	defer server.Close()
+
+	fetcher := &ChartValuesSchemaFetcher{Client: newHelmRepositoryClient(t, server.URL), HTTPClient: server.Client()}
+	template := newChartServiceTemplate()
+	chart, err := fetcher.FetchChartValues(context.Background(), template)
+	if err != nil {
+		t.Fatalf("FetchChartValues returned error: %v", err)
+	}
+	if string(chart.Schema) != testValuesSchema {
+		t.Fatalf("expected top-level chart schema, got %s", chart.Schema)
+	}
+	if chart.Defaults["replicaCount"] != float64(1) {
+		t.Fatalf("expected defaults from values.yaml, got %#v", chart.Defaults)
+	}
+
+	cached, err := fetcher.FetchChartValues(context.Background(), template)
+	if err != nil {
+		t.Fatalf("second FetchChartValues returned error: %v", err)
+	}
+	if cached != chart || downloads != 1 {
+		t.Fatalf("expected pinned chart version to be served from cache, downloads=%d", downloads)
+	}
+
+	noHelm := &unstructured.Unstructured{Object: map[string]any{
+		"metadata": map[string]any{"name": "raw", "namespace": "kcm-system"},
+	}}
+	if _, err := fetcher.FetchChartValues(context.Background(), noHelm); !errors.Is(err, ErrValuesSchemaUnavailable) {
+		t.Fatalf("expected ErrValuesSchemaUnavailable, got %v", err)
+	}
+}
+
+func TestChartValuesSchemaFetcherRejectsOversizedChart(t *testing.T) {
+	server := newChartServer(t, func(w http.ResponseWriter) {
+		_, _ = w.Write(make([]byte, maxChartArchiveBytes+1))
+	})
+	defer server.Close()
+
+	fetcher := &ChartValuesSchemaFetcher{Client: newHelmRepositoryClient(t, server.URL), HTTPClient: server.Client()}
+	_, err := fetcher.FetchChartValues(context.Background(), newChartServiceTemplate())
+	if !errors.Is(err, ErrValuesSchemaUnavailable) {
+		t.Fatalf("expected ErrValuesSchemaUnavailable, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "20MB size limit") {
+		t.Fatalf("expected error to name the size limit, got %v", err)
+	}
+}
+
+func TestValidateServiceValuesMalformedSchema(t *testing.T) {
+	chart := &ChartValues{Schema: []byte(`{"type": "object", "properties": {"replicaCount": {"type": 7}}}`)}
+
+	err := ValidateServiceValues("kcm-system/minio", chart, map[string]any{"replicaCount": 1})
+	if !errors.Is(err, ErrInvalidValuesSchema) {
+		t.Fatalf("expected ErrInvalidValuesSchema, got %v", err)
+	}
+	var validationErr *ValuesValidationError
+	if errors.As(err, &validationErr) {
+		t.Fatalf("malformed chart schema must not be reported as invalid values: %v", err)
+	}
+}
+
+// newChartServer serves a repository index listing minio 1.0.0 and delegates the
+// chart archive download to serveChart.
+func newChartServer(t *testing.T, serveChart func(w http.ResponseWriter)) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/index.yaml", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("entries:\n  minio:\n  - version: 1.0.0\n    urls: [charts/minio-1.0.0.tgz]\n"))
+	})
+	mux.HandleFunc("/charts/minio-1.0.0.tgz", func(w http.ResponseWriter, r *http.Request) {
+		serveChart(w)
+	})
+	return httptest.NewServer(mux)
+}
+
+func newHelmRepositoryClient(t *testing.T, url string) *fake.FakeDynamicClient {
+	t.Helper()
+	repo := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "source.toolkit.fluxcd.io/v1",
+		"kind":       "HelmRepository",
+		"metadata":   map[string]any{"name": "k0rdent-catalog", "namespace": "kcm-system"},
+		"spec":       map[string]any{"url": url},
+	}}
+	client := fake.NewSimpleDynamicClient(runtime.NewScheme())
+	if err := client.Tracker().Create(helmRepositoryGVR, repo, "kcm-system"); err != nil {
+		t.Fatalf("seed HelmRepository: %v", err)
+	}
+	return client
+}
+
+func newChartServiceTemplate() *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"metadata": map[string]any{"name": "minio-1-0-0", "namespace": "kcm-system"},
+		"spec": map[string]any{
+			"helm": map[string]any{
+				"chartSpec": map[string]any{
+					"chart":     "minio",
+					"version":   "1.0.0",
+					"sourceRef": map[string]any{"kind": "HelmRepository", "name": "k0rdent-catalog"},
+				},
+			},
+		},
+	}}
+}
+
+func buildChartArchive(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for name, content := range files {
+		if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: int64(len(content))}); err != nil {
+			t.Fatalf("write header: %v", err)
+		}
+		if _, err := tw.Write([]byte(content)); err != nil {
+			t.Fatalf("write content: %v", err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatalf("close tar: %v", err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatalf("close gzip: %v", err)
+	}
+	return buf.Bytes()
+}
@@ -23,8 +23,11 @@ func DetectPhase(cd *unstructured.Unstructured, recentEvents []eventsprovider.Ev
 	if cd == nil {
 		return PhaseInitializing
 	}
+	return DetectPhaseFromSummary(clusters.SummarizeClusterDeployment(cd), recentEvents)
+}
 
-	summary := clusters.SummarizeClusterDeployment(cd)
+// DetectPhaseFromSummary is DetectPhase for an already summarized ClusterDeployment.
+func DetectPhaseFromSummary(summary clusters.ClusterDeploymentSummary, recentEvents []eventsprovider.Event) ProvisioningPhase {
 	conditions := summary.Conditions
 
 	if summary.Ready {
@@ -85,6 +88,35 @@ func EstimateProgress(phase ProvisioningPhase, conditions []clusters.ConditionSu
 	return ptr(base)
 }
 
+// EstimateETA extrapolates a completion time from the progress reached by the most recent
+// condition transition, assuming the remaining progress continues at the average rate
+// observed since creation. It returns nil when no estimate can be derived: the progress is
+// unknown or terminal, no condition has transitioned since creation, or the estimate has
+// already passed.
+func EstimateETA(progress *int, createdAt time.Time, conditions []clusters.ConditionSummary) *time.Time {
+	if progress == nil || *progress <= 0 || *progress >= 100 || createdAt.IsZero() {
+		return nil
+	}
+
+	var latest time.Time
+	for _, cond := range conditions {
+		if cond.LastTransitionTime != nil && cond.LastTransitionTime.After(latest) {
+			latest = *cond.LastTransitionTime
+		}
+	}
+	elapsed := latest.Sub(createdAt)
+	if elapsed <= 0 {
+		return nil
+	}
+
+	remaining := time.Duration(float64(elapsed) * float64(100-*progress) / float64(*progress))
+	eta := latest.Add(remaining).UTC().Truncate(time.Second)
+	if eta.Before(timeNow()) {
+		return nil
+	}
+	return &eta
+}
+
 func detectPhaseFromConditions(conditions []clusters.ConditionSummary) ProvisioningPhase {
 	switch {
 	case isConditionFalse(conditions, "InfrastructureReady"):
@@ -148,3 +148,27 @@ func newCondition(condType, status, reason, message string) map[string]any {
 	cond["lastTransitionTime"] = time.Now().UTC().Format(time.RFC3339)
 	return cond
 }
+
+func TestEstimateETA(t *testing.T) {
+	created := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	transitioned := created.Add(10 * time.Minute)
+	conditions := []clusters.ConditionSummary{
+		{Type: "InfrastructureReady", Status: string(corev1.ConditionTrue), LastTransitionTime: &transitioned},
+	}
+
+	original := timeNow
+	timeNow = func() time.Time { return transitioned.Add(time.Minute) }
+	defer func() { timeNow = original }()
+
+	// 40% reached after 10 minutes leaves 60% at the same rate: 15 more minutes.
+	eta := EstimateETA(ptr(40), created, conditions)
+	require.NotNil(t, eta)
+	require.Equal(t, transitioned.Add(15*time.Minute), *eta)
+
+	require.Nil(t, EstimateETA(nil, created, conditions))
+	require.Nil(t, EstimateETA(ptr(100), created, conditions))
+	require.Nil(t, EstimateETA(ptr(40), created, nil), "no transition times to derive a rate from")
+
+	timeNow = func() time.Time { return transitioned.Add(time.Hour) }
+	require.Nil(t, EstimateETA(ptr(40), created, conditions), "estimate already in the past")
+}
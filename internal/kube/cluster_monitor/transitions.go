@@ -0,0 +1,84 @@
+package clustermonitor
+
+import (
+	"time"
+
+	"github.com/k0rdent/mcp-k0rdent-server/internal/clusters"
+)
+
+// MaxConditionTransitions bounds the condition transitions a ConditionHistory retains, so
+// long-running subscriptions do not grow without limit.
+const MaxConditionTransitions = 50
+
+// ConditionTransition records a condition status change observed during a subscription.
+type ConditionTransition struct {
+	Type string `json:"type"`
+	// PreviousStatus is empty when the condition was first observed
+	PreviousStatus     string     `json:"previousStatus,omitempty"`
+	Status             string     `json:"status"`
+	Reason             string     `json:"reason,omitempty"`
+	LastTransitionTime *time.Time `json:"lastTransitionTime,omitempty"`
+	// ObservedAt is when the subscription saw the change
+	ObservedAt time.Time `json:"observedAt"`
+}
+
+// ConditionHistory accumulates condition transitions across successive observations of a
+// ClusterDeployment, keeping the most recent MaxConditionTransitions. The zero value is ready
+// to use; it is not safe for concurrent use.
+type ConditionHistory struct {
+	last        map[string]clusters.ConditionSummary
+	transitions []ConditionTransition
+}
+
+// Observe compares conditions with the previous observation and records a transition for
+// every condition that appeared or whose status or lastTransitionTime changed. It reports
+// whether any transition was recorded.
+func (h *ConditionHistory) Observe(conditions []clusters.ConditionSummary, now time.Time) bool {
+	if h.last == nil {
+		h.last = make(map[string]clusters.ConditionSummary, len(conditions))
+	}
+	recorded := false
+	for _, cond := range conditions {
+		if cond.Type == "" {
+			continue
+		}
+		previous, seen := h.last[cond.Type]
+		h.last[cond.Type] = cond
+		if seen && previous.Status == cond.Status && sameTime(previous.LastTransitionTime, cond.LastTransitionTime) {
+			continue
+		}
+		transition := ConditionTransition{
+			Type:               cond.Type,
+			Status:             cond.Status,
+			Reason:             cond.Reason,
+			LastTransitionTime: cond.LastTransitionTime,
+			ObservedAt:         now,
+		}
+		if seen {
+			transition.PreviousStatus = previous.Status
+		}
+		h.transitions = append(h.transitions, transition)
+		recorded = true
+	}
+	if excess := len(h.transitions) - MaxConditionTransitions; excess > 0 {
+		h.transitions = append([]ConditionTransition(nil), h.transitions[excess:]...)
+	}
+	return recorded
+}
+
+// Transitions returns a copy of the retained transitions, oldest first.
+func (h *ConditionHistory) Transitions() []ConditionTransition {
+	if len(h.transitions) == 0 {
+		return nil
+	}
+	out := make([]ConditionTransition, len(h.transitions))
+	copy(out, h.transitions)
+	return out
+}
+
+func sameTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
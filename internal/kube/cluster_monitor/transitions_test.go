@@ -0,0 +1,64 @@
+package clustermonitor
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/k0rdent/mcp-k0rdent-server/internal/clusters"
+)
+
+func TestConditionHistoryObserve(t *testing.T) {
+	start := time.Unix(1_700_000_000, 0).UTC()
+	later := start.Add(time.Minute)
+	var history ConditionHistory
+
+	require.True(t, history.Observe([]clusters.ConditionSummary{
+		{Type: "InfrastructureReady", Status: "False", Reason: "Provisioning", LastTransitionTime: &start},
+		{Type: "Ready", Status: "False"},
+	}, start))
+	require.False(t, history.Observe([]clusters.ConditionSummary{
+		{Type: "InfrastructureReady", Status: "False", Reason: "StillProvisioning", LastTransitionTime: &start},
+		{Type: "Ready", Status: "False"},
+	}, start.Add(time.Second)), "a reason change without a status change is not a transition")
+	require.True(t, history.Observe([]clusters.ConditionSummary{
+		{Type: "InfrastructureReady", Status: "True", Reason: "Provisioned", LastTransitionTime: &later},
+		{Type: "Ready", Status: "False"},
+	}, later))
+
+	transitions := history.Transitions()
+	require.Len(t, transitions, 3)
+	require.Equal(t, "InfrastructureReady", transitions[0].Type)
+	require.Empty(t, transitions[0].PreviousStatus)
+	require.Equal(t, "Ready", transitions[1].Type)
+
+	last := transitions[2]
+	require.Equal(t, "InfrastructureReady", last.Type)
+	require.Equal(t, "False", last.PreviousStatus)
+	require.Equal(t, "True", last.Status)
+	require.Equal(t, "Provisioned", last.Reason)
+	require.Equal(t, later, *last.LastTransitionTime)
+	require.Equal(t, later, last.ObservedAt)
+
+	transitions[0].Status = "mutated"
+	require.Equal(t, "False", history.Transitions()[0].Status, "Transitions must return a copy")
+}
+
+func TestConditionHistoryIsBounded(t *testing.T) {
+	var history ConditionHistory
+	now := time.Unix(1_700_000_000, 0).UTC()
+	for i := 0; i < MaxConditionTransitions+10; i++ {
+		status := "False"
+		if i%2 == 1 {
+			status = "True"
+		}
+		history.Observe([]clusters.ConditionSummary{{Type: "Ready", Status: status, Reason: fmt.Sprintf("step-%d", i)}}, now)
+	}
+
+	transitions := history.Transitions()
+	require.Len(t, transitions, MaxConditionTransitions)
+	require.Equal(t, "step-10", transitions[0].Reason, "the oldest transitions must be dropped first")
+	require.Equal(t, fmt.Sprintf("step-%d", MaxConditionTransitions+9), transitions[len(transitions)-1].Reason)
+}
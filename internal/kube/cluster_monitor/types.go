@@ -1,6 +1,7 @@
 package clustermonitor
 
 import (
+	"strings"
 	"time"
 
 	"github.com/k0rdent/mcp-k0rdent-server/internal/clusters"
@@ -19,6 +20,8 @@ const (
 	PhaseInstalling    ProvisioningPhase = "Installing"
 	PhaseReady         ProvisioningPhase = "Ready"
 	PhaseFailed        ProvisioningPhase = "Failed"
+	// PhaseDeleted marks a cluster that left a fleet subscription; DetectPhase never reports it.
+	PhaseDeleted ProvisioningPhase = "Deleted"
 )
 
 func (p ProvisioningPhase) String() string {
@@ -28,6 +31,22 @@ func (p ProvisioningPhase) String() string {
 	return string(p)
 }
 
+// knownPhases lists every phase DetectPhase can report.
+var knownPhases = []ProvisioningPhase{
+	PhaseUnknown, PhaseInitializing, PhaseProvisioning, PhaseBootstrapping,
+	PhaseScaling, PhaseInstalling, PhaseReady, PhaseFailed,
+}
+
+// ParsePhase resolves a phase name case-insensitively.
+func ParsePhase(value string) (ProvisioningPhase, bool) {
+	for _, phase := range knownPhases {
+		if strings.EqualFold(string(phase), strings.TrimSpace(value)) {
+			return phase, true
+		}
+	}
+	return "", false
+}
+
 // SeverityLevel indicates the importance of a progress update.
 type SeverityLevel string
 
@@ -83,6 +102,7 @@ type ProgressUpdate struct {
 	Timestamp     time.Time                   `json:"timestamp"`
 	Phase         ProvisioningPhase           `json:"phase"`
 	Progress      *int                        `json:"progress,omitempty"`
+	ETA           *time.Time                  `json:"eta,omitempty"` // Estimated completion, see EstimateETA
 	Message       string                      `json:"message,omitempty"`
 	Reason        string                      `json:"reason,omitempty"`
 	Source        UpdateSource                `json:"source,omitempty"`
@@ -92,6 +112,8 @@ type ProgressUpdate struct {
 	Terminal      bool                        `json:"terminal,omitempty"`
 	Metadata      ClusterMetadata             `json:"metadata"`           // Basic operational context
 	Services      []ServiceStatus             `json:"services,omitempty"` // Service deployment states
+	// ConditionTransitions is the bounded condition history observed by the subscription
+	ConditionTransitions []ConditionTransition `json:"conditionTransitions,omitempty"`
 }
 
 // IsTerminal reports whether the supplied phase represents a terminal lifecycle state.
@@ -113,9 +135,17 @@ func (u *ProgressUpdate) Copy() ProgressUpdate {
 		clone.Conditions = make([]clusters.ConditionSummary, len(u.Conditions))
 		copy(clone.Conditions, u.Conditions)
 	}
+	if len(u.ConditionTransitions) > 0 {
+		clone.ConditionTransitions = make([]ConditionTransition, len(u.ConditionTransitions))
+		copy(clone.ConditionTransitions, u.ConditionTransitions)
+	}
 	if u.Progress != nil {
 		val := *u.Progress
 		clone.Progress = &val
 	}
+	if u.ETA != nil {
+		eta := *u.ETA
+		clone.ETA = &eta
+	}
 	return clone
 }
@@ -54,3 +54,16 @@ func TestProgressUpdateSerialization(t *testing.T) {
 	require.Equal(t, update.Conditions[0].Type, decoded.Conditions[0].Type)
 	require.False(t, decoded.Terminal)
 }
+
+func TestParsePhase(t *testing.T) {
+	phase, ok := ParsePhase(" failed ")
+	require.True(t, ok)
+	require.Equal(t, PhaseFailed, phase)
+
+	phase, ok = ParsePhase("Provisioning")
+	require.True(t, ok)
+	require.Equal(t, PhaseProvisioning, phase)
+
+	_, ok = ParsePhase("Deleting")
+	require.False(t, ok)
+}
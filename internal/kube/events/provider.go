@@ -38,6 +38,9 @@ type WatchOptions struct {
 	ForKind  string
 	ForName  string
 	Selector string
+	// ResourceVersion resumes the watch after the given version instead of replaying
+	// existing events. Leave empty to start with the current state.
+	ResourceVersion string
 }
 
 // Delta describes a change observed from a watch stream.
@@ -49,6 +52,7 @@ type Delta struct {
 // Event represents a Kubernetes Event in a transport-friendly structure.
 type Event struct {
 	Name                string           `json:"name"`
+	ResourceVersion     string           `json:"resourceVersion,omitempty"`
 	Namespace           string           `json:"namespace"`
 	Reason              string           `json:"reason"`
 	Message             string           `json:"message"`
@@ -102,23 +106,31 @@ func NewProvider(ctx context.Context, client kubernetes.Interface) (*Provider, e
 
 // List returns events within the namespace that satisfy the provided filters.
 func (p *Provider) List(ctx context.Context, namespace string, opts ListOptions) ([]Event, error) {
+	events, _, err := p.ListWithResourceVersion(ctx, namespace, opts)
+	return events, err
+}
+
+// ListWithResourceVersion behaves like List and also returns the resourceVersion of the
+// list, which can be passed to WatchNamespace to stream only events that follow it.
+func (p *Provider) ListWithResourceVersion(ctx context.Context, namespace string, opts ListOptions) ([]Event, string, error) {
 	if namespace == "" {
-		return nil, errors.New("namespace is required")
+		return nil, "", errors.New("namespace is required")
 	}
 
 	var events []Event
+	var resourceVersion string
 	var err error
 	if p.useEventsV1 {
-		events, err = p.listEventsV1(ctx, namespace, opts)
+		events, resourceVersion, err = p.listEventsV1(ctx, namespace, opts)
 	} else {
-		events, err = p.listCoreEvents(ctx, namespace, opts)
+		events, resourceVersion, err = p.listCoreEvents(ctx, namespace, opts)
 	}
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	filtered := p.filterEvents(events, opts)
-	return p.enforceLimit(filtered, opts.Limit), nil
+	return p.enforceLimit(filtered, opts.Limit), resourceVersion, nil
 }
 
 // WatchNamespace streams event deltas for the namespace until the context is cancelled.
@@ -129,6 +141,7 @@ func (p *Provider) WatchNamespace(ctx context.Context, namespace string, opts Wa
 
 	listOpts := metav1.ListOptions{
 		AllowWatchBookmarks: true,
+		ResourceVersion:     opts.ResourceVersion,
 	}
 
 	watcher, err := p.startWatch(ctx, namespace, listOpts)
@@ -156,6 +169,15 @@ func (p *Provider) WatchNamespace(ctx context.Context, namespace string, opts Wa
 				if event.Type == watch.Bookmark {
 					continue
 				}
+				if event.Type == watch.Error {
+					// The server ends the watch after an error event, e.g. when the
+					// requested resourceVersion has expired.
+					select {
+					case errCh <- apierrors.FromObject(event.Object):
+					case <-ctx.Done():
+					}
+					return
+				}
 
 				converted, convErr := p.convertWatchEvent(event)
 				if convErr != nil {
@@ -179,7 +201,7 @@ func (p *Provider) WatchNamespace(ctx context.Context, namespace string, opts Wa
 	return eventCh, errCh, nil
 }
 
-func (p *Provider) listEventsV1(ctx context.Context, namespace string, opts ListOptions) ([]Event, error) {
+func (p *Provider) listEventsV1(ctx context.Context, namespace string, opts ListOptions) ([]Event, string, error) {
 	result, err := p.client.EventsV1().Events(namespace).List(ctx, metav1.ListOptions{})
 	if err != nil {
 		// Fallback to core/v1 if the API becomes unavailable.
@@ -187,27 +209,27 @@ func (p *Provider) listEventsV1(ctx context.Context, namespace string, opts List
 			p.useEventsV1 = false
 			return p.listCoreEvents(ctx, namespace, opts)
 		}
-		return nil, fmt.Errorf("list events.v1: %w", err)
+		return nil, "", fmt.Errorf("list events.v1: %w", err)
 	}
 
 	events := make([]Event, 0, len(result.Items))
 	for _, item := range result.Items {
 		events = append(events, convertEventV1(&item))
 	}
-	return events, nil
+	return events, result.ResourceVersion, nil
 }
 
-func (p *Provider) listCoreEvents(ctx context.Context, namespace string, opts ListOptions) ([]Event, error) {
+func (p *Provider) listCoreEvents(ctx context.Context, namespace string, opts ListOptions) ([]Event, string, error) {
 	result, err := p.client.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{})
 	if err != nil {
-		return nil, fmt.Errorf("list core events: %w", err)
+		return nil, "", fmt.Errorf("list core events: %w", err)
 	}
 
 	events := make([]Event, 0, len(result.Items))
 	for _, item := range result.Items {
 		events = append(events, convertCoreEvent(&item))
 	}
-	return events, nil
+	return events, result.ResourceVersion, nil
 }
 
 func (p *Provider) filterEvents(events []Event, opts ListOptions) []Event {
@@ -338,6 +360,7 @@ func convertEventV1(event *eventsv1.Event) Event {
 
 	e := Event{
 		Name:                event.Name,
+		ResourceVersion:     event.ResourceVersion,
 		Namespace:           event.Namespace,
 		Reason:              event.Reason,
 		Message:             event.Note,
@@ -386,12 +409,13 @@ func convertCoreEvent(event *corev1.Event) Event {
 	}
 
 	e := Event{
-		Name:      event.Name,
-		Namespace: event.Namespace,
-		Reason:    event.Reason,
-		Message:   event.Message,
-		Type:      event.Type,
-		Count:     event.Count,
+		Name:            event.Name,
+		ResourceVersion: event.ResourceVersion,
+		Namespace:       event.Namespace,
+		Reason:          event.Reason,
+		Message:         event.Message,
+		Type:            event.Type,
+		Count:           event.Count,
 		InvolvedObject: InvolvedObject{
 			Namespace: event.InvolvedObject.Namespace,
 			Name:      event.InvolvedObject.Name,
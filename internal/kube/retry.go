@@ -0,0 +1,70 @@
+package kube
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"syscall"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+const (
+	// DefaultReadAttempts bounds how many times a read-only call is attempted.
+	DefaultReadAttempts = 3
+
+	// DefaultReadBackoff is the delay before the first retry; it doubles per attempt.
+	DefaultReadBackoff = 200 * time.Millisecond
+)
+
+// RetryRead runs a read-only API call, retrying transient failures with exponential
+// backoff. Non-retryable errors (Forbidden, NotFound, validation errors) are returned
+// immediately. Only use it for idempotent reads such as List and Get.
+func RetryRead(ctx context.Context, fn func(ctx context.Context) error) error {
+	return retryRead(ctx, DefaultReadAttempts, DefaultReadBackoff, fn)
+}
+
+func retryRead(ctx context.Context, attempts int, backoff time.Duration, fn func(ctx context.Context) error) error {
+	var err error
+	for attempt := 0; attempt < attempts; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-time.After(backoff * (1 << uint(attempt-1))):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+
+		if err = fn(ctx); err == nil || !IsRetryable(err) {
+			return err
+		}
+	}
+	return err
+}
+
+// IsRetryable reports whether an API error is transient: server timeouts, throttling,
+// temporarily unavailable servers, and dropped or timed-out connections.
+func IsRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if apierrors.IsForbidden(err) || apierrors.IsUnauthorized(err) || apierrors.IsNotFound(err) ||
+		apierrors.IsBadRequest(err) || apierrors.IsInvalid(err) {
+		return false
+	}
+	if apierrors.IsServerTimeout(err) || apierrors.IsTimeout(err) || apierrors.IsTooManyRequests(err) ||
+		apierrors.IsServiceUnavailable(err) || apierrors.IsInternalError(err) {
+		return true
+	}
+	if errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
@@ -0,0 +1,80 @@
+package kube
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"syscall"
+	"testing"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestRetryReadRetriesTransientErrors(t *testing.T) {
+	calls := 0
+	err := retryRead(context.Background(), 3, time.Millisecond, func(context.Context) error {
+		calls++
+		if calls < 3 {
+			return apierrors.NewTooManyRequests("slow down", 0)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("expected success after retries, got %v", err)
+	}
+	if calls != 3 {
+		t.Fatalf("expected 3 calls, got %d", calls)
+	}
+}
+
+func TestRetryReadFailsFastOnForbidden(t *testing.T) {
+	calls := 0
+	forbidden := apierrors.NewForbidden(schema.GroupResource{Resource: "namespaces"}, "", errors.New("denied"))
+	err := retryRead(context.Background(), 3, time.Millisecond, func(context.Context) error {
+		calls++
+		return forbidden
+	})
+	if !apierrors.IsForbidden(err) {
+		t.Fatalf("expected forbidden error, got %v", err)
+	}
+	if calls != 1 {
+		t.Fatalf("expected a single call, got %d", calls)
+	}
+}
+
+func TestRetryReadGivesUpAfterAttempts(t *testing.T) {
+	calls := 0
+	err := retryRead(context.Background(), 2, time.Millisecond, func(context.Context) error {
+		calls++
+		return apierrors.NewServerTimeout(schema.GroupResource{Resource: "namespaces"}, "list", 1)
+	})
+	if !apierrors.IsServerTimeout(err) {
+		t.Fatalf("expected server timeout error, got %v", err)
+	}
+	if calls != 2 {
+		t.Fatalf("expected 2 calls, got %d", calls)
+	}
+}
+
+func TestIsRetryable(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "nil", err: nil, want: false},
+		{name: "service unavailable", err: apierrors.NewServiceUnavailable("down"), want: true},
+		{name: "connection reset", err: fmt.Errorf("list: %w", syscall.ECONNRESET), want: true},
+		{name: "not found", err: apierrors.NewNotFound(schema.GroupResource{Resource: "namespaces"}, "x"), want: false},
+		{name: "context canceled", err: context.Canceled, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsRetryable(tt.err); got != tt.want {
+				t.Fatalf("IsRetryable(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package metrics
+
+import (
+	"sync"
+	"time"
+)
+
+// CatalogMetrics tracks freshness of the local catalog index.
+// Like ClusterMetrics this is an in-memory placeholder until Prometheus is integrated.
+type CatalogMetrics struct {
+	mu sync.RWMutex
+
+	indexTimestamp string
+	indexedAt      time.Time
+	appCount       int
+	templateCount  int
+}
+
+// NewCatalogMetrics creates a new catalog freshness tracker.
+func NewCatalogMetrics() *CatalogMetrics {
+	return &CatalogMetrics{}
+}
+
+// RecordIndex records the state of the catalog index after a rebuild or status check.
+func (m *CatalogMetrics) RecordIndex(indexTimestamp string, indexedAt time.Time, appCount, templateCount int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.indexTimestamp = indexTimestamp
+	m.indexedAt = indexedAt
+	m.appCount = appCount
+	m.templateCount = templateCount
+}
+
+// IndexAge returns how long ago the catalog index was last rebuilt, or zero if it never was.
+func (m *CatalogMetrics) IndexAge() time.Duration {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if m.indexedAt.IsZero() {
+		return 0
+	}
+	return time.Since(m.indexedAt)
+}
+
+// IndexTimestamp returns the generated timestamp of the indexed catalog.
+func (m *CatalogMetrics) IndexTimestamp() string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.indexTimestamp
+}
+
+// EntryCounts returns the number of apps and ServiceTemplate versions in the index.
+func (m *CatalogMetrics) EntryCounts() (apps, templates int) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.appCount, m.templateCount
+}
+
+// TODO: Export as Prometheus gauges, e.g.
+//
+//     k0rdent_catalog_index_age_seconds
+//     k0rdent_catalog_entries{kind="apps|templates"}
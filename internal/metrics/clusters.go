@@ -5,6 +5,60 @@ import (
 	"time"
 )
 
+// NamespaceAll is the namespace label used when an operation spans more than one namespace.
+const NamespaceAll = "_all"
+
+// Labels identify the tool and target namespace of a recorded operation.
+//
+// Cardinality: every distinct (tool, namespace, outcome) combination becomes its own
+// series once these counters are exported. Tool names are a fixed set, so the namespace
+// label dominates. Callers must only pass the resolved target namespace (after the
+// namespace filter has been applied), never raw user input, so the label set stays
+// bounded by the namespaces that actually exist and are allowed for the session.
+// Operations that fan out over several namespaces should use NamespaceAll.
+type Labels struct {
+	Tool      string
+	Namespace string
+}
+
+// NamespaceLabel returns the namespace label for an operation that targeted the given
+// resolved namespaces.
+func NamespaceLabel(namespaces []string) string {
+	if len(namespaces) == 1 {
+		return namespaces[0]
+	}
+	return NamespaceAll
+}
+
+type seriesKey struct {
+	Labels
+	outcome string
+}
+
+// ClusterRecorder records cluster operation metrics. Tool handlers depend on this
+// interface so that sessions without a metrics backend can use NoopClusterMetrics.
+type ClusterRecorder interface {
+	RecordListCredentials(labels Labels, outcome string)
+	RecordListTemplates(labels Labels, outcome string)
+	RecordDeploy(labels Labels, outcome string, duration time.Duration)
+	RecordDelete(labels Labels, outcome string, duration time.Duration)
+	RecordServiceApply(labels Labels, outcome string, duration time.Duration)
+}
+
+var (
+	_ ClusterRecorder = (*ClusterMetrics)(nil)
+	_ ClusterRecorder = NoopClusterMetrics{}
+)
+
+// NoopClusterMetrics is a ClusterRecorder that discards all observations.
+type NoopClusterMetrics struct{}
+
+func (NoopClusterMetrics) RecordListCredentials(Labels, string)             {}
+func (NoopClusterMetrics) RecordListTemplates(Labels, string)               {}
+func (NoopClusterMetrics) RecordDeploy(Labels, string, time.Duration)       {}
+func (NoopClusterMetrics) RecordDelete(Labels, string, time.Duration)       {}
+func (NoopClusterMetrics) RecordServiceApply(Labels, string, time.Duration) {}
+
 // ClusterMetrics tracks cluster operation metrics.
 // This is a placeholder implementation until Prometheus is fully integrated.
 // TODO: Replace with actual Prometheus metrics collectors (prometheus.Counter, prometheus.Histogram).
@@ -12,96 +66,127 @@ type ClusterMetrics struct {
 	mu sync.RWMutex
 
 	// Counters for cluster operations
-	listCredentialsTotal map[string]int64 // outcome -> count
-	listTemplatesTotal   map[string]int64 // outcome -> count
-	deployTotal          map[string]int64 // outcome -> count
-	deleteTotal          map[string]int64 // outcome -> count
-	serviceApplyTotal    map[string]int64 // outcome -> count
+	listCredentialsTotal map[seriesKey]int64
+	listTemplatesTotal   map[seriesKey]int64
+	deployTotal          map[seriesKey]int64
+	deleteTotal          map[seriesKey]int64
+	serviceApplyTotal    map[seriesKey]int64
 
 	// Duration tracking (simplified until Prometheus histograms are added)
-	deployDurations       []time.Duration
-	deleteDurations       []time.Duration
-	serviceApplyDurations []time.Duration
+	deployDurations       map[Labels][]time.Duration
+	deleteDurations       map[Labels][]time.Duration
+	serviceApplyDurations map[Labels][]time.Duration
 }
 
 // NewClusterMetrics creates a new metrics tracker for cluster operations.
 func NewClusterMetrics() *ClusterMetrics {
 	return &ClusterMetrics{
-		listCredentialsTotal:  make(map[string]int64),
-		listTemplatesTotal:    make(map[string]int64),
-		deployTotal:           make(map[string]int64),
-		deleteTotal:           make(map[string]int64),
-		serviceApplyTotal:     make(map[string]int64),
-		deployDurations:       make([]time.Duration, 0),
-		deleteDurations:       make([]time.Duration, 0),
-		serviceApplyDurations: make([]time.Duration, 0),
+		listCredentialsTotal:  make(map[seriesKey]int64),
+		listTemplatesTotal:    make(map[seriesKey]int64),
+		deployTotal:           make(map[seriesKey]int64),
+		deleteTotal:           make(map[seriesKey]int64),
+		serviceApplyTotal:     make(map[seriesKey]int64),
+		deployDurations:       make(map[Labels][]time.Duration),
+		deleteDurations:       make(map[Labels][]time.Duration),
+		serviceApplyDurations: make(map[Labels][]time.Duration),
 	}
 }
 
 // RecordListCredentials records a list credentials operation.
-func (m *ClusterMetrics) RecordListCredentials(outcome string) {
+func (m *ClusterMetrics) RecordListCredentials(labels Labels, outcome string) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
-	m.listCredentialsTotal[outcome]++
+	m.listCredentialsTotal[seriesKey{labels, outcome}]++
 }
 
 // RecordListTemplates records a list templates operation.
-func (m *ClusterMetrics) RecordListTemplates(outcome string) {
+func (m *ClusterMetrics) RecordListTemplates(labels Labels, outcome string) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
-	m.listTemplatesTotal[outcome]++
+	m.listTemplatesTotal[seriesKey{labels, outcome}]++
 }
 
 // RecordDeploy records a cluster deployment operation.
-func (m *ClusterMetrics) RecordDeploy(outcome string, duration time.Duration) {
+func (m *ClusterMetrics) RecordDeploy(labels Labels, outcome string, duration time.Duration) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
-	m.deployTotal[outcome]++
-	m.deployDurations = append(m.deployDurations, duration)
+	m.deployTotal[seriesKey{labels, outcome}]++
+	m.deployDurations[labels] = append(m.deployDurations[labels], duration)
 }
 
 // RecordDelete records a cluster deletion operation.
-func (m *ClusterMetrics) RecordDelete(outcome string, duration time.Duration) {
+func (m *ClusterMetrics) RecordDelete(labels Labels, outcome string, duration time.Duration) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
-	m.deleteTotal[outcome]++
-	m.deleteDurations = append(m.deleteDurations, duration)
+	m.deleteTotal[seriesKey{labels, outcome}]++
+	m.deleteDurations[labels] = append(m.deleteDurations[labels], duration)
 }
 
 // RecordServiceApply records a service apply operation on a ClusterDeployment.
-func (m *ClusterMetrics) RecordServiceApply(outcome string, duration time.Duration) {
+func (m *ClusterMetrics) RecordServiceApply(labels Labels, outcome string, duration time.Duration) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
-	m.serviceApplyTotal[outcome]++
-	m.serviceApplyDurations = append(m.serviceApplyDurations, duration)
+	m.serviceApplyTotal[seriesKey{labels, outcome}]++
+	m.serviceApplyDurations[labels] = append(m.serviceApplyDurations[labels], duration)
 }
 
-// GetListCredentialsTotal returns the total count for list credentials operations.
+// GetListCredentialsTotal returns the total count for list credentials operations across all labels.
 func (m *ClusterMetrics) GetListCredentialsTotal(outcome string) int64 {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
-	return m.listCredentialsTotal[outcome]
+	return sumOutcome(m.listCredentialsTotal, outcome)
 }
 
-// GetListTemplatesTotal returns the total count for list templates operations.
+// GetListTemplatesTotal returns the total count for list templates operations across all labels.
 func (m *ClusterMetrics) GetListTemplatesTotal(outcome string) int64 {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
-	return m.listTemplatesTotal[outcome]
+	return sumOutcome(m.listTemplatesTotal, outcome)
 }
 
-// GetDeployTotal returns the total count for deploy operations.
+// GetDeployTotal returns the total count for deploy operations across all labels.
 func (m *ClusterMetrics) GetDeployTotal(outcome string) int64 {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
-	return m.deployTotal[outcome]
+	return sumOutcome(m.deployTotal, outcome)
 }
 
-// GetDeleteTotal returns the total count for delete operations.
+// GetDeleteTotal returns the total count for delete operations across all labels.
 func (m *ClusterMetrics) GetDeleteTotal(outcome string) int64 {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
-	return m.deleteTotal[outcome]
+	return sumOutcome(m.deleteTotal, outcome)
+}
+
+// GetServiceApplyTotal returns the total count for service apply operations across all labels.
+func (m *ClusterMetrics) GetServiceApplyTotal(outcome string) int64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return sumOutcome(m.serviceApplyTotal, outcome)
+}
+
+// GetServiceApplyTotalFor returns the service apply count for a single label set.
+func (m *ClusterMetrics) GetServiceApplyTotalFor(labels Labels, outcome string) int64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.serviceApplyTotal[seriesKey{labels, outcome}]
+}
+
+// GetDeleteTotalFor returns the delete count for a single label set.
+func (m *ClusterMetrics) GetDeleteTotalFor(labels Labels, outcome string) int64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.deleteTotal[seriesKey{labels, outcome}]
+}
+
+func sumOutcome(series map[seriesKey]int64, outcome string) int64 {
+	var total int64
+	for key, count := range series {
+		if key.outcome == outcome {
+			total += count
+		}
+	}
+	return total
 }
 
 // Common outcome labels
@@ -121,7 +206,7 @@ const (
 //             Name: "k0rdent_clusters_list_credentials_total",
 //             Help: "Total number of list credentials operations",
 //         },
-//         []string{"outcome"},
+//         []string{"tool", "namespace", "outcome"},
 //     )
 //
 //     clustersListTemplatesTotal = promauto.NewCounterVec(
@@ -129,7 +214,7 @@ const (
 //             Name: "k0rdent_clusters_list_templates_total",
 //             Help: "Total number of list templates operations",
 //         },
-//         []string{"outcome"},
+//         []string{"tool", "namespace", "outcome"},
 //     )
 //
 //     clustersDeployTotal = promauto.NewCounterVec(
@@ -137,7 +222,7 @@ const (
 //             Name: "k0rdent_clusters_deploy_total",
 //             Help: "Total number of cluster deploy operations",
 //         },
-//         []string{"outcome"},
+//         []string{"tool", "namespace", "outcome"},
 //     )
 //
 //     clustersDeleteTotal = promauto.NewCounterVec(
@@ -145,22 +230,24 @@ const (
 //             Name: "k0rdent_clusters_delete_total",
 //             Help: "Total number of cluster delete operations",
 //         },
-//         []string{"outcome"},
+//         []string{"tool", "namespace", "outcome"},
 //     )
 //
-//     clustersDeployDuration = promauto.NewHistogram(
+//     clustersDeployDuration = promauto.NewHistogramVec(
 //         prometheus.HistogramOpts{
 //             Name:    "k0rdent_clusters_deploy_duration_seconds",
 //             Help:    "Duration of cluster deploy operations in seconds",
 //             Buckets: prometheus.DefBuckets,
 //         },
+//         []string{"tool", "namespace"},
 //     )
 //
-//     clustersDeleteDuration = promauto.NewHistogram(
+//     clustersDeleteDuration = promauto.NewHistogramVec(
 //         prometheus.HistogramOpts{
 //             Name:    "k0rdent_clusters_delete_duration_seconds",
 //             Help:    "Duration of cluster delete operations in seconds",
 //             Buckets: prometheus.DefBuckets,
 //         },
+//         []string{"tool", "namespace"},
 //     )
 // )
@@ -0,0 +1,43 @@
+package metrics
+
+import (
+	"testing"
+	"time"
+)
+
+func TestClusterMetricsLabels(t *testing.T) {
+	m := NewClusterMetrics()
+
+	teamA := Labels{Tool: "k0rdent.mgmt.clusterDeployments.delete", Namespace: "team-a"}
+	teamB := Labels{Tool: "k0rdent.mgmt.clusterDeployments.delete", Namespace: "team-b"}
+
+	m.RecordDelete(teamA, OutcomeSuccess, time.Second)
+	m.RecordDelete(teamA, OutcomeSuccess, time.Second)
+	m.RecordDelete(teamB, OutcomeSuccess, time.Second)
+	m.RecordDelete(teamB, OutcomeNotFound, time.Second)
+
+	if got := m.GetDeleteTotalFor(teamA, OutcomeSuccess); got != 2 {
+		t.Fatalf("expected 2 successes for team-a, got %d", got)
+	}
+	if got := m.GetDeleteTotalFor(teamB, OutcomeSuccess); got != 1 {
+		t.Fatalf("expected 1 success for team-b, got %d", got)
+	}
+	if got := m.GetDeleteTotal(OutcomeSuccess); got != 3 {
+		t.Fatalf("expected 3 successes across labels, got %d", got)
+	}
+	if got := m.GetDeleteTotal(OutcomeNotFound); got != 1 {
+		t.Fatalf("expected 1 not_found across labels, got %d", got)
+	}
+}
+
+func TestNamespaceLabel(t *testing.T) {
+	if got := NamespaceLabel([]string{"team-a"}); got != "team-a" {
+		t.Fatalf("expected single namespace label, got %q", got)
+	}
+	if got := NamespaceLabel([]string{"team-a", "team-b"}); got != NamespaceAll {
+		t.Fatalf("expected %q for multiple namespaces, got %q", NamespaceAll, got)
+	}
+	if got := NamespaceLabel(nil); got != NamespaceAll {
+		t.Fatalf("expected %q for no namespaces, got %q", NamespaceAll, got)
+	}
+}
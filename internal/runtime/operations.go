@@ -0,0 +1,87 @@
+package runtime
+
+import (
+	"sync"
+	"time"
+)
+
+// DefaultOperationLogSize bounds the number of operations kept in memory.
+const DefaultOperationLogSize = 200
+
+// Operation records a single mutating tool call.
+type Operation struct {
+	Time       time.Time `json:"time"`
+	Tool       string    `json:"tool"`
+	Namespace  string    `json:"namespace,omitempty"`
+	Target     string    `json:"target,omitempty"`
+	Outcome    string    `json:"outcome"`
+	Error      string    `json:"error,omitempty"`
+	DryRun     bool      `json:"dryRun,omitempty"`
+	Subject    string    `json:"subject,omitempty"`
+	DurationMS int64     `json:"durationMs"`
+}
+
+// OperationLog is a fixed-size ring buffer of recent operations shared by every session of
+// the server process; readers filter it to what the caller may see. It is not an audit log:
+// entries are lost on restart and once the buffer wraps. A nil log discards records.
+type OperationLog struct {
+	mu      sync.Mutex
+	entries []Operation
+	next    int
+	full    bool
+}
+
+// NewOperationLog constructs a ring buffer holding up to size operations.
+func NewOperationLog(size int) *OperationLog {
+	if size <= 0 {
+		size = DefaultOperationLogSize
+	}
+	return &OperationLog{entries: make([]Operation, size)}
+}
+
+// Record appends an operation, overwriting the oldest entry once the buffer is full.
+func (l *OperationLog) Record(op Operation) {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.entries[l.next] = op
+	l.next = (l.next + 1) % len(l.entries)
+	if l.next == 0 {
+		l.full = true
+	}
+}
+
+// Recent returns up to limit operations, newest first. A non-positive limit returns all
+// retained operations.
+func (l *OperationLog) Recent(limit int) []Operation {
+	return l.RecentMatching(limit, nil)
+}
+
+// RecentMatching returns up to limit operations accepted by match, newest first. A nil match
+// accepts every operation; a non-positive limit returns all matching operations.
+func (l *OperationLog) RecentMatching(limit int, match func(Operation) bool) []Operation {
+	if l == nil {
+		return nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	count := l.next
+	if l.full {
+		count = len(l.entries)
+	}
+	if limit <= 0 || limit > count {
+		limit = count
+	}
+	out := make([]Operation, 0, limit)
+	for i := 1; i <= count && len(out) < limit; i++ {
+		idx := (l.next - i + len(l.entries)) % len(l.entries)
+		if match != nil && !match(l.entries[idx]) {
+			continue
+		}
+		out = append(out, l.entries[idx])
+	}
+	return out
+}
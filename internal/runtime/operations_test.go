@@ -0,0 +1,58 @@
+package runtime
+
+import (
+	"testing"
+)
+
+func TestOperationLogRecent(t *testing.T) {
+	log := NewOperationLog(3)
+	if got := log.Recent(0); len(got) != 0 {
+		t.Fatalf("expected empty log, got %d entries", len(got))
+	}
+
+	for _, tool := range []string{"a", "b", "c", "d"} {
+		log.Record(Operation{Tool: tool})
+	}
+
+	got := log.Recent(0)
+	want := []string{"d", "c", "b"}
+	if len(got) != len(want) {
+		t.Fatalf("expected %d entries after wrap, got %d", len(want), len(got))
+	}
+	for i, op := range got {
+		if op.Tool != want[i] {
+			t.Fatalf("entry %d: expected %q, got %q", i, want[i], op.Tool)
+		}
+	}
+
+	if got := log.Recent(1); len(got) != 1 || got[0].Tool != "d" {
+		t.Fatalf("expected newest entry only, got %+v", got)
+	}
+
+	var nilLog *OperationLog
+	nilLog.Record(Operation{Tool: "ignored"})
+	if got := nilLog.Recent(10); got != nil {
+		t.Fatalf("expected nil log to return nothing, got %+v", got)
+	}
+}
+
+func TestOperationLogRecentMatching(t *testing.T) {
+	log := NewOperationLog(5)
+	for _, op := range []Operation{
+		{Tool: "a", Subject: "alice"},
+		{Tool: "b", Subject: "bob"},
+		{Tool: "c", Subject: "alice"},
+		{Tool: "d", Subject: "bob"},
+	} {
+		log.Record(op)
+	}
+
+	alice := func(op Operation) bool { return op.Subject == "alice" }
+	got := log.RecentMatching(0, alice)
+	if len(got) != 2 || got[0].Tool != "c" || got[1].Tool != "a" {
+		t.Fatalf("expected alice's operations newest first, got %+v", got)
+	}
+	if got := log.RecentMatching(1, alice); len(got) != 1 || got[0].Tool != "c" {
+		t.Fatalf("expected newest matching entry only, got %+v", got)
+	}
+}
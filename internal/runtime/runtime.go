@@ -3,9 +3,13 @@ package runtime
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log/slog"
 	"regexp"
+	"strings"
+	"time"
 
+	"github.com/k0rdent/mcp-k0rdent-server/internal/auth"
 	"github.com/k0rdent/mcp-k0rdent-server/internal/clusters"
 	"github.com/k0rdent/mcp-k0rdent-server/internal/config"
 	"github.com/k0rdent/mcp-k0rdent-server/internal/kube"
@@ -26,6 +30,7 @@ type Runtime struct {
 	logger           *slog.Logger
 	newEventProvider func(context.Context, kubernetes.Interface) (*eventsprovider.Provider, error)
 	newLogProvider   func(kubernetes.Interface) (*logsprovider.Provider, error)
+	operations       *OperationLog
 }
 
 // Session represents the per-connection runtime state.
@@ -37,7 +42,8 @@ type Session struct {
 	Logs            *logsprovider.Provider
 	Clients         Clients
 	Clusters        *clusters.Manager
-	ClusterMetrics  *metrics.ClusterMetrics
+	ClusterMetrics  metrics.ClusterRecorder
+	OperationLog    *OperationLog
 	factory         *kube.ClientFactory
 	settings        *config.Settings
 }
@@ -67,6 +73,7 @@ func New(settings *config.Settings, factory *kube.ClientFactory, logger *slog.Lo
 		newLogProvider: func(client kubernetes.Interface) (*logsprovider.Provider, error) {
 			return logsprovider.NewProvider(client)
 		},
+		operations: NewOperationLog(DefaultOperationLogSize),
 	}, nil
 }
 
@@ -82,6 +89,14 @@ func (r *Runtime) NewSession(ctx context.Context, token string) (*Session, error
 		log.Info("creating runtime session", "has_token", token != "")
 	}
 
+	token, err := r.resolveSessionToken(log, token)
+	if err != nil {
+		if log != nil {
+			log.Warn("rejecting runtime session", "auth_mode", r.settings.AuthMode, "error", err)
+		}
+		return nil, err
+	}
+
 	kubeClient, err := r.factory.KubernetesClient(token)
 	if err != nil {
 		if log != nil {
@@ -114,11 +129,14 @@ func (r *Runtime) NewSession(ctx context.Context, token string) (*Session, error
 	}
 
 	clusterManager, err := clusters.NewManager(clusters.Options{
-		DynamicClient:   dynamicClient,
-		NamespaceFilter: r.settings.NamespaceFilter,
-		GlobalNamespace: r.settings.Cluster.GlobalNamespace,
-		FieldOwner:      r.settings.Cluster.DeployFieldOwner,
-		Logger:          r.logger,
+		DynamicClient:          dynamicClient,
+		NamespaceFilter:        r.settings.NamespaceFilter,
+		GlobalNamespace:        r.settings.Cluster.GlobalNamespace,
+		DefaultNamespace:       r.settings.Cluster.DefaultNamespace,
+		FieldOwner:             r.settings.Cluster.DeployFieldOwner,
+		NamespaceConcurrency:   r.settings.Cluster.NamespaceConcurrency,
+		StableTemplateSelector: r.settings.Cluster.TemplateStableSelector,
+		Logger:                 r.logger,
 	})
 	if err != nil {
 		if log != nil {
@@ -145,11 +163,32 @@ func (r *Runtime) NewSession(ctx context.Context, token string) (*Session, error
 		},
 		Clusters:       clusterManager,
 		ClusterMetrics: clusterMetrics,
+		OperationLog:   r.operations,
 		factory:        r.factory,
 		settings:       r.settings,
 	}, nil
 }
 
+// resolveSessionToken reconciles the supplied bearer token with the configured auth mode.
+// OIDC_REQUIRED sessions must carry a token; DEV_ALLOW_ANY sessions always use the
+// kubeconfig credentials, so any supplied token is dropped.
+func (r *Runtime) resolveSessionToken(log *slog.Logger, token string) (string, error) {
+	switch r.settings.AuthMode {
+	case config.AuthModeOIDCRequired:
+		if token == "" {
+			return "", fmt.Errorf("%w: bearer token is required in %s mode", auth.ErrUnauthorized, config.AuthModeOIDCRequired)
+		}
+	case config.AuthModeDevAllowAny:
+		if token != "" {
+			if log != nil {
+				log.Debug("ignoring bearer token in dev mode; using kubeconfig credentials", "auth_mode", r.settings.AuthMode)
+			}
+			return "", nil
+		}
+	}
+	return token, nil
+}
+
 // IsDevMode returns true if the session is running in dev mode (DEV_ALLOW_ANY).
 func (s *Session) IsDevMode() bool {
 	if s == nil || s.settings == nil {
@@ -166,12 +205,13 @@ func (s *Session) GlobalNamespace() string {
 	return s.settings.Cluster.GlobalNamespace
 }
 
-// DefaultNamespaceDev returns the default namespace to use in dev mode.
-func (s *Session) DefaultNamespaceDev() string {
-	if s == nil || s.settings == nil {
+// DefaultNamespace returns the namespace tools target when none is given (DEFAULT_NAMESPACE,
+// falling back to kcm-system). Resolvers use it only when the namespace filter allows it.
+func (s *Session) DefaultNamespace() string {
+	if s == nil || s.settings == nil || s.settings.Cluster.DefaultNamespace == "" {
 		return "kcm-system"
 	}
-	return s.settings.Cluster.DefaultNamespaceDev
+	return s.settings.Cluster.DefaultNamespace
 }
 
 // DeployFieldOwner returns the field owner to use for cluster deployments.
@@ -182,6 +222,109 @@ func (s *Session) DeployFieldOwner() string {
 	return s.settings.Cluster.DeployFieldOwner
 }
 
+// Subject returns the "sub" claim of the session's bearer token, or an empty string when the
+// session uses kubeconfig credentials or an opaque token.
+func (s *Session) Subject() string {
+	if s == nil {
+		return ""
+	}
+	return auth.TokenSubject(s.Token)
+}
+
+// maxFieldManagerLength is the API server limit on server-side apply field manager names.
+const maxFieldManagerLength = 128
+
+// ServiceFieldOwner returns the field manager to use for cluster service apply and removal.
+// When per-subject owners are enabled and the session token carries a subject, the subject is
+// appended so conflicting applies can be traced to the responsible caller.
+func (s *Session) ServiceFieldOwner() string {
+	if s == nil || s.settings == nil || s.settings.Cluster.ServiceFieldOwner == "" {
+		return "mcp.services"
+	}
+	owner := s.settings.Cluster.ServiceFieldOwner
+	if !s.settings.Cluster.ServiceFieldOwnerPerSubject {
+		return owner
+	}
+	subject := sanitizeFieldManager(s.Subject())
+	if subject == "" {
+		return owner
+	}
+	owner = owner + "/" + subject
+	if len(owner) > maxFieldManagerLength {
+		owner = owner[:maxFieldManagerLength]
+	}
+	return owner
+}
+
+// sanitizeFieldManager drops characters that would make the field manager name unreadable in
+// managedFields (whitespace and control characters).
+func sanitizeFieldManager(value string) string {
+	var b strings.Builder
+	for _, r := range value {
+		if r > ' ' && r < 0x7f {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// ServiceDefaultValues returns the configured Helm values layered under every service apply.
+// The map is shared and must not be modified.
+func (s *Session) ServiceDefaultValues() map[string]any {
+	if s == nil || s.settings == nil {
+		return nil
+	}
+	return s.settings.Cluster.ServiceDefaultValues
+}
+
+// CatalogDeleteKinds returns the resource kinds the catalog delete tool may remove.
+func (s *Session) CatalogDeleteKinds() []string {
+	if s == nil || s.settings == nil || len(s.settings.Cluster.CatalogDeleteKinds) == 0 {
+		return strings.Split(config.DefaultCatalogDeleteKinds, ",")
+	}
+	return s.settings.Cluster.CatalogDeleteKinds
+}
+
+// NamespaceConcurrency returns the maximum number of namespaces processed in parallel.
+func (s *Session) NamespaceConcurrency() int {
+	if s == nil || s.settings == nil || s.settings.Cluster.NamespaceConcurrency <= 0 {
+		return config.DefaultNamespaceConcurrency
+	}
+	return s.settings.Cluster.NamespaceConcurrency
+}
+
+// RequireExplicitNamespace reports whether tools must be given a namespace instead of
+// enumerating every namespace the filter allows.
+func (s *Session) RequireExplicitNamespace() bool {
+	return s != nil && s.settings != nil && s.settings.Cluster.RequireExplicitNamespace
+}
+
+// StreamMaxUpdatesPerSecond returns the per-subscription notification cap for event and
+// pod log streams; zero means unlimited.
+func (s *Session) StreamMaxUpdatesPerSecond() int {
+	if s == nil || s.settings == nil || s.settings.Cluster.StreamMaxUpdatesPerSecond < 0 {
+		return 0
+	}
+	return s.settings.Cluster.StreamMaxUpdatesPerSecond
+}
+
+// KubeListTimeout returns how long a tool handler waits for one namespace or resource list.
+func (s *Session) KubeListTimeout() time.Duration {
+	if s == nil || s.settings == nil || s.settings.Cluster.KubeListTimeout <= 0 {
+		return config.DefaultKubeListTimeout
+	}
+	return s.settings.Cluster.KubeListTimeout
+}
+
+// Metrics returns the session's cluster metrics recorder, falling back to a no-op
+// recorder so handlers can record unconditionally.
+func (s *Session) Metrics() metrics.ClusterRecorder {
+	if s == nil || s.ClusterMetrics == nil {
+		return metrics.NoopClusterMetrics{}
+	}
+	return s.ClusterMetrics
+}
+
 // RESTConfig returns the REST config for the current session.
 func (s *Session) RESTConfig() (*rest.Config, error) {
 	if s == nil || s.factory == nil {
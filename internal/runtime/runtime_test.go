@@ -3,6 +3,8 @@ package runtime
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
+	"errors"
 	"io"
 	"log/slog"
 	"regexp"
@@ -10,11 +12,13 @@ import (
 	"testing"
 	"time"
 
+	"github.com/k0rdent/mcp-k0rdent-server/internal/auth"
 	"github.com/k0rdent/mcp-k0rdent-server/internal/config"
 	"github.com/k0rdent/mcp-k0rdent-server/internal/kube"
 	eventsprovider "github.com/k0rdent/mcp-k0rdent-server/internal/kube/events"
 	logsprovider "github.com/k0rdent/mcp-k0rdent-server/internal/kube/logs"
 	"github.com/k0rdent/mcp-k0rdent-server/internal/logging"
+	"github.com/k0rdent/mcp-k0rdent-server/internal/metrics"
 
 	apiruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/dynamic"
@@ -76,6 +80,63 @@ func TestNewSession(t *testing.T) {
 	}
 }
 
+func TestNewSessionAuthModes(t *testing.T) {
+	tests := []struct {
+		name      string
+		mode      config.AuthMode
+		token     string
+		wantErr   bool
+		wantToken string
+	}{
+		{name: "oidc with token", mode: config.AuthModeOIDCRequired, token: "token", wantToken: "token"},
+		{name: "oidc without token", mode: config.AuthModeOIDCRequired, token: "", wantErr: true},
+		{name: "dev with token", mode: config.AuthModeDevAllowAny, token: "token", wantToken: ""},
+		{name: "dev without token", mode: config.AuthModeDevAllowAny, token: "", wantToken: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			factory, err := kube.NewClientFactory(&rest.Config{Host: "https://example.com"}, slog.New(slog.NewJSONHandler(io.Discard, nil)))
+			if err != nil {
+				t.Fatalf("NewClientFactory returned error: %v", err)
+			}
+			factory.WithConstructors(
+				func(*rest.Config) (kubernetes.Interface, error) {
+					return fake.NewSimpleClientset(), nil
+				},
+				func(*rest.Config) (dynamic.Interface, error) {
+					return dynamicfake.NewSimpleDynamicClient(apiruntime.NewScheme()), nil
+				},
+			)
+
+			rt, err := New(&config.Settings{AuthMode: tt.mode}, factory, slog.New(slog.NewJSONHandler(io.Discard, nil)))
+			if err != nil {
+				t.Fatalf("New returned error: %v", err)
+			}
+			rt.newEventProvider = func(context.Context, kubernetes.Interface) (*eventsprovider.Provider, error) {
+				return &eventsprovider.Provider{}, nil
+			}
+			rt.newLogProvider = func(kubernetes.Interface) (*logsprovider.Provider, error) {
+				return &logsprovider.Provider{}, nil
+			}
+
+			session, err := rt.NewSession(context.Background(), tt.token)
+			if tt.wantErr {
+				if !errors.Is(err, auth.ErrUnauthorized) {
+					t.Fatalf("expected ErrUnauthorized, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewSession returned error: %v", err)
+			}
+			if session.Token != tt.wantToken {
+				t.Fatalf("expected session token %q, got %q", tt.wantToken, session.Token)
+			}
+		})
+	}
+}
+
 func TestRuntimeSessionLogs(t *testing.T) {
 	var buf bytes.Buffer
 	sink := &recordingSink{}
@@ -154,3 +215,92 @@ func waitUntil(fn func() bool, timeout time.Duration) {
 		time.Sleep(10 * time.Millisecond)
 	}
 }
+
+func TestSessionMetricsDefaultsToNoop(t *testing.T) {
+	var nilSession *Session
+	if _, ok := nilSession.Metrics().(metrics.NoopClusterMetrics); !ok {
+		t.Fatalf("expected no-op recorder for nil session, got %T", nilSession.Metrics())
+	}
+
+	session := &Session{}
+	if _, ok := session.Metrics().(metrics.NoopClusterMetrics); !ok {
+		t.Fatalf("expected no-op recorder when metrics are not wired, got %T", session.Metrics())
+	}
+	session.Metrics().RecordServiceApply(metrics.Labels{Tool: "test"}, metrics.OutcomeSuccess, time.Second)
+
+	recorder := metrics.NewClusterMetrics()
+	session.ClusterMetrics = recorder
+	session.Metrics().RecordDelete(metrics.Labels{Tool: "test"}, metrics.OutcomeSuccess, time.Second)
+	if got := recorder.GetDeleteTotal(metrics.OutcomeSuccess); got != 1 {
+		t.Fatalf("expected delete to be recorded on configured recorder, got %d", got)
+	}
+}
+
+func TestSessionServiceFieldOwner(t *testing.T) {
+	// Unsigned JWT with {"sub":"alice@example.com"} as the payload.
+	token := "eyJhbGciOiJub25lIn0." + base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"alice@example.com"}`)) + "."
+
+	tests := []struct {
+		name      string
+		settings  *config.Settings
+		token     string
+		wantOwner string
+	}{
+		{name: "unconfigured", wantOwner: "mcp.services"},
+		{
+			name:      "custom owner",
+			settings:  &config.Settings{Cluster: config.ClusterSettings{ServiceFieldOwner: "k0rdent-mcp"}},
+			token:     token,
+			wantOwner: "k0rdent-mcp",
+		},
+		{
+			name:      "per subject",
+			settings:  &config.Settings{Cluster: config.ClusterSettings{ServiceFieldOwner: "k0rdent-mcp", ServiceFieldOwnerPerSubject: true}},
+			token:     token,
+			wantOwner: "k0rdent-mcp/alice@example.com",
+		},
+		{
+			name:      "per subject without jwt",
+			settings:  &config.Settings{Cluster: config.ClusterSettings{ServiceFieldOwner: "k0rdent-mcp", ServiceFieldOwnerPerSubject: true}},
+			token:     "opaque-token",
+			wantOwner: "k0rdent-mcp",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			session := &Session{Token: tt.token, settings: tt.settings}
+			if got := session.ServiceFieldOwner(); got != tt.wantOwner {
+				t.Fatalf("expected field owner %q, got %q", tt.wantOwner, got)
+			}
+		})
+	}
+}
+
+func TestSessionRequireExplicitNamespace(t *testing.T) {
+	var nilSession *Session
+	if nilSession.RequireExplicitNamespace() {
+		t.Fatalf("expected nil session to allow namespace enumeration")
+	}
+	if (&Session{}).RequireExplicitNamespace() {
+		t.Fatalf("expected unconfigured session to allow namespace enumeration")
+	}
+	session := &Session{settings: &config.Settings{Cluster: config.ClusterSettings{RequireExplicitNamespace: true}}}
+	if !session.RequireExplicitNamespace() {
+		t.Fatalf("expected configured session to require explicit namespaces")
+	}
+}
+
+func TestSessionDefaultNamespace(t *testing.T) {
+	var nilSession *Session
+	if got := nilSession.DefaultNamespace(); got != "kcm-system" {
+		t.Fatalf("expected nil session to default to kcm-system, got %q", got)
+	}
+	if got := (&Session{settings: &config.Settings{}}).DefaultNamespace(); got != "kcm-system" {
+		t.Fatalf("expected unset default namespace to fall back to kcm-system, got %q", got)
+	}
+	session := &Session{settings: &config.Settings{Cluster: config.ClusterSettings{DefaultNamespace: "team-a"}}}
+	if got := session.DefaultNamespace(); got != "team-a" {
+		t.Fatalf("expected configured default namespace, got %q", got)
+	}
+}
@@ -187,6 +187,12 @@ func (a *App) handleStream(w http.ResponseWriter, r *http.Request) {
 	// Establish the session eagerly when the client hasn't provided an ID.
 	if r.Header.Get("Mcp-Session-Id") == "" {
 		if holder.serverInstance(ctx) == nil {
+			if errors.Is(holder.err, auth.ErrUnauthorized) {
+				reqLogger.Warn("MCP session rejected", "method", method, "path", path, "error", holder.err)
+				http.Error(recorder, holder.err.Error(), http.StatusUnauthorized)
+				logRequestCompleted(ctx, reqLogger, recorder, start, method, path)
+				return
+			}
 			reqLogger.Error("failed to initialize MCP session", "method", method, "path", path)
 			http.Error(recorder, "failed to initialize MCP session", http.StatusInternalServerError)
 			logRequestCompleted(ctx, reqLogger, recorder, start, method, path)
@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
+	"io"
 	"log/slog"
 	"net/http"
 	"net/http/httptest"
@@ -13,6 +15,7 @@ import (
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 
+	"github.com/k0rdent/mcp-k0rdent-server/internal/auth"
 	"github.com/k0rdent/mcp-k0rdent-server/internal/config"
 	"github.com/k0rdent/mcp-k0rdent-server/internal/logging"
 	"github.com/k0rdent/mcp-k0rdent-server/internal/mcpserver"
@@ -134,6 +137,32 @@ func TestHandleStreamUnauthorized(t *testing.T) {
 	}
 }
 
+func TestHandleStreamSessionRejected(t *testing.T) {
+	impl := &mcp.Implementation{Name: "test", Version: "1.0.0"}
+	factory, err := mcpserver.NewFactory(impl, nil, func(*mcp.Server, *mcpserver.SessionContext) error {
+		return fmt.Errorf("%w: bearer token is required", auth.ErrUnauthorized)
+	})
+	if err != nil {
+		t.Fatalf("NewFactory returned error: %v", err)
+	}
+
+	app, err := NewApp(Dependencies{
+		Settings:   &config.Settings{AuthMode: config.AuthModeDevAllowAny},
+		MCPFactory: factory,
+	}, Options{Logger: slog.New(slog.NewJSONHandler(io.Discard, nil))})
+	if err != nil {
+		t.Fatalf("NewApp returned error: %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/mcp", nil)
+	rr := httptest.NewRecorder()
+	app.Router().ServeHTTP(rr, req)
+
+	if rr.Code != http.StatusUnauthorized {
+		t.Fatalf("expected 401, got %d", rr.Code)
+	}
+}
+
 type recordingSink struct {
 	mu      sync.Mutex
 	entries []logging.Entry
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"slices"
 	"strings"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 	"sigs.k8s.io/yaml"
 
 	"github.com/k0rdent/mcp-k0rdent-server/internal/catalog"
+	"github.com/k0rdent/mcp-k0rdent-server/internal/clusters"
 	"github.com/k0rdent/mcp-k0rdent-server/internal/helm"
 	"github.com/k0rdent/mcp-k0rdent-server/internal/runtime"
 )
@@ -24,14 +26,69 @@ type catalogListTool struct {
 }
 
 type catalogListInput struct {
-	App     string `json:"app,omitempty"`
-	Refresh bool   `json:"refresh,omitempty"`
+	App      string   `json:"app,omitempty"`
+	Query    string   `json:"query,omitempty" jsonschema:"Case-insensitive substring matched against app slug, title, and summary; results are ordered by relevance"`
+	Tags     []string `json:"tags,omitempty" jsonschema:"Only return apps carrying all of these tags (case-insensitive)"`
+	Platform string   `json:"platform,omitempty" jsonschema:"Only return apps validated on this platform, e.g. aws (case-insensitive)"`
+	Refresh  bool     `json:"refresh,omitempty"`
 }
 
 type catalogListResult struct {
 	Entries []catalog.CatalogEntry `json:"entries"`
 }
 
+type catalogGetTool struct {
+	session *runtime.Session
+	manager *catalog.Manager
+}
+
+type catalogGetInput struct {
+	App     string `json:"app" jsonschema:"Application slug (from the catalog list)"`
+	Version string `json:"version,omitempty" jsonschema:"Version to resolve to manifest URLs and chart metadata (optional)"`
+}
+
+type catalogGetResult catalog.EntryDetail
+
+type catalogStatusTool struct {
+	session *runtime.Session
+	manager *catalog.Manager
+}
+
+type catalogStatusInput struct{}
+
+type catalogStatusResult catalog.Status
+
+type catalogSummaryTool struct {
+	session *runtime.Session
+	manager *catalog.Manager
+}
+
+type catalogSummaryInput struct{}
+
+type catalogSummaryResult catalog.Summary
+
+type catalogExportTool struct {
+	session *runtime.Session
+	manager *catalog.Manager
+}
+
+type catalogExportInput struct{}
+
+type catalogExportResult catalog.Export
+
+type catalogCheckAvailabilityTool struct {
+	session *runtime.Session
+	manager *catalog.Manager
+}
+
+type catalogCheckAvailabilityInput struct {
+	App      string `json:"app"`
+	Template string `json:"template"`
+	Version  string `json:"version"`
+}
+
+type catalogCheckAvailabilityResult catalog.AvailabilityReport
+
 type catalogInstallTool struct {
 	session *runtime.Session
 	manager *catalog.Manager
@@ -43,11 +100,33 @@ type catalogInstallInput struct {
 	Version       string `json:"version"`
 	Namespace     string `json:"namespace,omitempty"`
 	AllNamespaces bool   `json:"all_namespaces,omitempty"`
+	// IncludePrerelease lets version "latest" resolve to a pre-release version
+	IncludePrerelease bool `json:"include_prerelease,omitempty"`
+	// DryRun renders the kgst release with helm --dry-run instead of installing it
+	DryRun bool `json:"dryRun,omitempty" jsonschema:"Render the release with helm --dry-run and return the resources it would create without changing the cluster"`
 }
 
 type catalogInstallResult struct {
-	Applied []string `json:"applied"`
-	Status  string   `json:"status"`
+	Applied    []string                    `json:"applied"`
+	Version    string                      `json:"version"`
+	Status     string                      `json:"status"`
+	Failures   []clusters.NamespaceFailure `json:"failures,omitempty"`
+	Namespaces []namespaceOutcome          `json:"namespaces"`
+}
+
+// Per-namespace outcomes reported by batch ServiceTemplate operations.
+const (
+	namespaceOutcomeSuccess = "success"
+	namespaceOutcomeFailed  = "failed"
+	namespaceOutcomeSkipped = "skipped"
+)
+
+// namespaceOutcome records what a batch operation did in one target namespace, so callers
+// can retry only the namespaces that failed.
+type namespaceOutcome struct {
+	Namespace string `json:"namespace"`
+	Outcome   string `json:"outcome"`
+	Reason    string `json:"reason,omitempty"`
 }
 
 type catalogDeleteServiceTemplateTool struct {
@@ -61,22 +140,26 @@ type catalogDeleteInput struct {
 	Version       string `json:"version"`
 	Namespace     string `json:"namespace,omitempty"`
 	AllNamespaces bool   `json:"all_namespaces,omitempty"`
+	IncludeGlobal bool   `json:"includeGlobal,omitempty"`
 }
 
 type catalogDeleteResult struct {
-	Deleted []string `json:"deleted"`
-	Status  string   `json:"status"`
+	Deleted    []string                    `json:"deleted"`
+	Status     string                      `json:"status"`
+	Warnings   []string                    `json:"warnings,omitempty"`
+	Failures   []clusters.NamespaceFailure `json:"failures,omitempty"`
+	Namespaces []namespaceOutcome          `json:"namespaces"`
 }
 
-func registerCatalog(server *mcp.Server, session *runtime.Session, manager *catalog.Manager) error {
+func registerCatalog(reg *toolRegistry, session *runtime.Session, manager *catalog.Manager) error {
 	if manager == nil {
 		return fmt.Errorf("catalog manager is required")
 	}
 
 	listTool := &catalogListTool{session: session, manager: manager}
-	mcp.AddTool(server, &mcp.Tool{
+	addTool(reg, &mcp.Tool{
 		Name:        "k0rdent.catalog.serviceTemplates.list",
-		Description: "List available ServiceTemplates from the k0rdent catalog",
+		Description: "List available ServiceTemplates from the k0rdent catalog. Search with query (matches slug, title, and summary, ranked by relevance) and filter by tags or validated platform.",
 		Meta: mcp.Meta{
 			"plane":    "catalog",
 			"category": "serviceTemplates",
@@ -84,10 +167,65 @@ func registerCatalog(server *mcp.Server, session *runtime.Session, manager *cata
 		},
 	}, listTool.list)
 
+	getTool := &catalogGetTool{session: session, manager: manager}
+	addTool(reg, &mcp.Tool{
+		Name:        "k0rdent.catalog.serviceTemplates.get",
+		Description: "Get one app from the k0rdent catalog. With version, also resolve each ServiceTemplate at that version to its manifest URLs and the Helm chart it references. Fails with not found if the app or version is absent.",
+		Meta: mcp.Meta{
+			"plane":    "catalog",
+			"category": "serviceTemplates",
+			"action":   "get",
+		},
+	}, getTool.get)
+
+	statusTool := &catalogStatusTool{session: session, manager: manager}
+	addTool(reg, &mcp.Tool{
+		Name:        "k0rdent.catalog.status",
+		Description: "Report catalog cache freshness: index timestamp, last rebuild and refresh times, entry counts, and whether the cache is past its TTL. Does not contact the catalog host.",
+		Meta: mcp.Meta{
+			"plane":    "catalog",
+			"category": "catalog",
+			"action":   "status",
+		},
+	}, statusTool.status)
+
+	summaryTool := &catalogSummaryTool{session: session, manager: manager}
+	addTool(reg, &mcp.Tool{
+		Name:        "k0rdent.catalog.summary",
+		Description: "Overview of the k0rdent catalog: total app count, total ServiceTemplate versions, and the number of apps per tag. Reads the cached index; use it to orient before a detailed search.",
+		Meta: mcp.Meta{
+			"plane":    "catalog",
+			"category": "catalog",
+			"action":   "summary",
+		},
+	}, summaryTool.summary)
+
+	exportTool := &catalogExportTool{session: session, manager: manager}
+	addTool(reg, &mcp.Tool{
+		Name:        "k0rdent.catalog.export",
+		Description: "Export the cached k0rdent catalog index as one JSON document: every app with all of its ServiceTemplate versions, plus the index_timestamp and sha identifying the snapshot. Use it to seed an offline mirror for air-gapped environments. Reads the cached index; the catalog host is only contacted when the cache is missing or stale.",
+		Meta: mcp.Meta{
+			"plane":    "catalog",
+			"category": "catalog",
+			"action":   "export",
+		},
+	}, exportTool.export)
+
+	availabilityTool := &catalogCheckAvailabilityTool{session: session, manager: manager}
+	addTool(reg, &mcp.Tool{
+		Name:        "k0rdent.catalog.serviceTemplates.checkAvailability",
+		Description: "Pre-flight a catalog install: check that the ServiceTemplate and HelmRepository manifests for an app/template/version can be fetched, reporting reachability, HTTP status, and size for each URL. Nothing is installed.",
+		Meta: mcp.Meta{
+			"plane":    "catalog",
+			"category": "serviceTemplates",
+			"action":   "checkAvailability",
+		},
+	}, availabilityTool.check)
+
 	installTool := &catalogInstallTool{session: session, manager: manager}
-	mcp.AddTool(server, &mcp.Tool{
+	addTool(reg, &mcp.Tool{
 		Name:        "k0rdent.mgmt.serviceTemplates.install_from_catalog",
-		Description: "Install a ServiceTemplate from the k0rdent catalog. In DEV_ALLOW_ANY mode (uses kubeconfig), installs to kcm-system by default. In OIDC_REQUIRED mode (uses bearer token), requires explicit namespace or all_namespaces flag. This installation uses the official kgst (k0rdent Generic Service Template) Helm chart which provides pre-install verification, proper resource ordering, and dependency resolution.",
+		Description: "Install a ServiceTemplate from the k0rdent catalog. In DEV_ALLOW_ANY mode (uses kubeconfig), installs to DEFAULT_NAMESPACE (kcm-system unless set) by default. In OIDC_REQUIRED mode (uses bearer token), requires explicit namespace or all_namespaces flag. Pass version 'latest' to install the highest stable semver of the template (include_prerelease also considers pre-releases); the installed version is returned in the version field. This installation uses the official kgst (k0rdent Generic Service Template) Helm chart which provides pre-install verification, proper resource ordering, and dependency resolution. Every target namespace is attempted; the namespaces field reports success or failed per namespace and status is partial when only some succeed. Set dryRun to render the release with helm --dry-run: applied lists the resources that would be created, nothing is changed, and status is dry-run.",
 		Meta: mcp.Meta{
 			"plane":    "mgmt",
 			"category": "serviceTemplates",
@@ -96,9 +234,9 @@ func registerCatalog(server *mcp.Server, session *runtime.Session, manager *cata
 	}, installTool.install)
 
 	deleteTool := &catalogDeleteServiceTemplateTool{session: session, manager: manager}
-	mcp.AddTool(server, &mcp.Tool{
+	addTool(reg, &mcp.Tool{
 		Name:        "k0rdent.mgmt.serviceTemplates.delete",
-		Description: "Delete a ServiceTemplate and optionally its HelmRepository from k0rdent catalog. Follows same authentication modes as install (DEV_ALLOW_ANY, OIDC_REQUIRED). Returns success even if resource not found (idempotent).",
+		Description: "Delete a ServiceTemplate and optionally its HelmRepository from k0rdent catalog. Only resource kinds in the server's CATALOG_DELETE_KINDS allowlist (default ServiceTemplate, HelmRepository) are removed; other kinds in the catalog manifests are skipped. Follows same authentication modes as install (DEV_ALLOW_ANY, OIDC_REQUIRED). Returns success even if resource not found (idempotent). With all_namespaces, the global management namespace (kcm-system) is skipped unless includeGlobal is set, because the management plane depends on its templates. Every target namespace is attempted; the namespaces field reports success, failed, or skipped (with a reason) per namespace and status is partial when only some succeed.",
 		Meta: mcp.Meta{
 			"plane":    "mgmt",
 			"category": "serviceTemplates",
@@ -106,6 +244,39 @@ func registerCatalog(server *mcp.Server, session *runtime.Session, manager *cata
 		},
 	}, deleteTool.delete)
 
+	manifestTool := &serviceTemplateManifestInstallTool{session: session}
+	addTool(reg, &mcp.Tool{
+		Name:        "k0rdent.mgmt.serviceTemplates.install_from_manifest",
+		Description: "Install a custom ServiceTemplate from raw YAML (single or multi-document). Only ServiceTemplate and HelmRepository resources are accepted; they are applied via server-side apply to the resolved namespace(s). Follows same namespace rules as install_from_catalog (DEV_ALLOW_ANY defaults to DEFAULT_NAMESPACE, kcm-system unless set, OIDC_REQUIRED requires namespace or all_namespaces). Supports dryRun to validate without persisting.",
+		Meta: mcp.Meta{
+			"plane":    "mgmt",
+			"category": "serviceTemplates",
+			"action":   "install_from_manifest",
+		},
+	}, manifestTool.install)
+
+	releasesTool := &serviceTemplateReleasesTool{session: session}
+	addTool(reg, &mcp.Tool{
+		Name:        "k0rdent.mgmt.serviceTemplates.listReleases",
+		Description: "List the kgst Helm releases created by install_from_catalog, with status and revision, across all namespaces allowed by the namespace filter (or a single namespace). Includes failed and pending releases left behind by interrupted installs; set unhealthyOnly to see just those, e.g. to pick releases to clean up.",
+		Meta: mcp.Meta{
+			"plane":    "mgmt",
+			"category": "serviceTemplates",
+			"action":   "listReleases",
+		},
+	}, releasesTool.list)
+
+	uninstallTool := &serviceTemplateUninstallTool{session: session}
+	addTool(reg, &mcp.Tool{
+		Name:        "k0rdent.mgmt.serviceTemplates.uninstall",
+		Description: "Uninstall the kgst Helm release of a catalog install (helm uninstall), removing the ServiceTemplate and HelmRepository it created together with the Helm bookkeeping. Unlike delete, which only removes the CRs, this cleans up failed or pending releases too. Identify the release by template (the release name used by install_from_catalog) or releaseName. Follows the same namespace rules as delete, including skipping the global namespace with all_namespaces unless includeGlobal is set. keepHistory retains the release history. Missing releases are reported as skipped.",
+		Meta: mcp.Meta{
+			"plane":    "mgmt",
+			"category": "serviceTemplates",
+			"action":   "uninstall",
+		},
+	}, uninstallTool.uninstall)
+
 	return nil
 }
 
@@ -114,9 +285,27 @@ func (t *catalogListTool) list(ctx context.Context, req *mcp.CallToolRequest, in
 	ctx, logger := toolContext(ctx, t.session, name, "tool.catalog")
 	start := time.Now()
 
-	logger.Debug("listing catalog entries", "tool", name, "app", input.App, "refresh", input.Refresh)
+	logger.Debug("listing catalog entries",
+		"tool", name,
+		"app", input.App,
+		"query", input.Query,
+		"tags", input.Tags,
+		"platform", input.Platform,
+		"refresh", input.Refresh,
+	)
 
-	entries, err := t.manager.List(ctx, input.App, input.Refresh)
+	var entries []catalog.CatalogEntry
+	var err error
+	if input.Query != "" || len(input.Tags) > 0 || input.Platform != "" {
+		entries, err = t.manager.Search(ctx, catalog.SearchOptions{
+			App:      input.App,
+			Query:    input.Query,
+			Tags:     input.Tags,
+			Platform: input.Platform,
+		}, input.Refresh)
+	} else {
+		entries, err = t.manager.List(ctx, input.App, input.Refresh)
+	}
 	if err != nil {
 		logger.Error("list catalog entries failed", "tool", name, "error", err)
 		return nil, catalogListResult{}, fmt.Errorf("list catalog: %w", err)
@@ -131,6 +320,132 @@ func (t *catalogListTool) list(ctx context.Context, req *mcp.CallToolRequest, in
 	return nil, catalogListResult{Entries: entries}, nil
 }
 
+func (t *catalogGetTool) get(ctx context.Context, req *mcp.CallToolRequest, input catalogGetInput) (*mcp.CallToolResult, catalogGetResult, error) {
+	name := toolName(req)
+	ctx, logger := toolContext(ctx, t.session, name, "tool.catalog")
+	start := time.Now()
+
+	if input.App == "" {
+		return nil, catalogGetResult{}, fmt.Errorf("app is required")
+	}
+
+	detail, err := t.manager.Get(ctx, input.App, input.Version)
+	if err != nil {
+		logger.Error("get catalog entry failed", "tool", name, "app", input.App, "version", input.Version, "error", err)
+		return nil, catalogGetResult{}, fmt.Errorf("get catalog entry: %w", err)
+	}
+
+	logger.Info("catalog entry retrieved",
+		"tool", name,
+		"app", input.App,
+		"version", input.Version,
+		"template_count", len(detail.Templates),
+		"duration_ms", time.Since(start).Milliseconds(),
+	)
+
+	return nil, catalogGetResult(detail), nil
+}
+
+func (t *catalogStatusTool) status(ctx context.Context, req *mcp.CallToolRequest, _ catalogStatusInput) (*mcp.CallToolResult, catalogStatusResult, error) {
+	name := toolName(req)
+	ctx, logger := toolContext(ctx, t.session, name, "tool.catalog")
+	start := time.Now()
+
+	status, err := t.manager.Status(ctx)
+	if err != nil {
+		logger.Error("get catalog status failed", "tool", name, "error", err)
+		return nil, catalogStatusResult{}, fmt.Errorf("get catalog status: %w", err)
+	}
+
+	logger.Info("catalog status retrieved",
+		"tool", name,
+		"index_timestamp", status.IndexTimestamp,
+		"app_count", status.AppCount,
+		"template_count", status.TemplateCount,
+		"stale", status.Stale,
+		"duration_ms", time.Since(start).Milliseconds(),
+	)
+
+	return nil, catalogStatusResult(status), nil
+}
+
+func (t *catalogSummaryTool) summary(ctx context.Context, req *mcp.CallToolRequest, _ catalogSummaryInput) (*mcp.CallToolResult, catalogSummaryResult, error) {
+	name := toolName(req)
+	ctx, logger := toolContext(ctx, t.session, name, "tool.catalog")
+	start := time.Now()
+
+	summary, err := t.manager.Summary(ctx)
+	if err != nil {
+		logger.Error("summarize catalog failed", "tool", name, "error", err)
+		return nil, catalogSummaryResult{}, fmt.Errorf("summarize catalog: %w", err)
+	}
+
+	logger.Info("catalog summarized",
+		"tool", name,
+		"app_count", summary.AppCount,
+		"template_count", summary.TemplateCount,
+		"tag_count", len(summary.Tags),
+		"duration_ms", time.Since(start).Milliseconds(),
+	)
+
+	return nil, catalogSummaryResult(summary), nil
+}
+
+func (t *catalogExportTool) export(ctx context.Context, req *mcp.CallToolRequest, _ catalogExportInput) (*mcp.CallToolResult, catalogExportResult, error) {
+	name := toolName(req)
+	ctx, logger := toolContext(ctx, t.session, name, "tool.catalog")
+	start := time.Now()
+
+	export, err := t.manager.Export(ctx)
+	if err != nil {
+		logger.Error("export catalog failed", "tool", name, "error", err)
+		return nil, catalogExportResult{}, fmt.Errorf("export catalog: %w", err)
+	}
+
+	logger.Info("catalog exported",
+		"tool", name,
+		"index_timestamp", export.IndexTimestamp,
+		"app_count", export.AppCount,
+		"template_count", export.TemplateCount,
+		"duration_ms", time.Since(start).Milliseconds(),
+	)
+
+	return nil, catalogExportResult(export), nil
+}
+
+func (t *catalogCheckAvailabilityTool) check(ctx context.Context, req *mcp.CallToolRequest, input catalogCheckAvailabilityInput) (*mcp.CallToolResult, catalogCheckAvailabilityResult, error) {
+	name := toolName(req)
+	ctx, logger := toolContext(ctx, t.session, name, "tool.catalog")
+	start := time.Now()
+
+	if input.App == "" {
+		return nil, catalogCheckAvailabilityResult{}, fmt.Errorf("app is required")
+	}
+	if input.Template == "" {
+		return nil, catalogCheckAvailabilityResult{}, fmt.Errorf("template is required")
+	}
+	if input.Version == "" {
+		return nil, catalogCheckAvailabilityResult{}, fmt.Errorf("version is required")
+	}
+
+	report, err := t.manager.CheckAvailability(ctx, input.App, input.Template, input.Version)
+	if err != nil {
+		logger.Error("check catalog availability failed", "tool", name, "error", err)
+		return nil, catalogCheckAvailabilityResult{}, fmt.Errorf("check availability: %w", err)
+	}
+
+	logger.Info("catalog availability checked",
+		"tool", name,
+		"app", input.App,
+		"template", input.Template,
+		"version", input.Version,
+		"available", report.Available,
+		"duration_ms", time.Since(start).Milliseconds(),
+	)
+
+	return nil, catalogCheckAvailabilityResult(report), nil
+}
+
 func (t *catalogInstallTool) install(ctx context.Context, req *mcp.CallToolRequest, input catalogInstallInput) (*mcp.CallToolResult, catalogInstallResult, error) {
 	name := toolName(req)
 	ctx, logger := toolContext(ctx, t.session, name, "tool.catalog")
@@ -143,6 +458,7 @@ func (t *catalogInstallTool) install(ctx context.Context, req *mcp.CallToolReque
 		"version", input.Version,
 		"namespace", input.Namespace,
 		"all_namespaces", input.AllNamespaces,
+		"dry_run", input.DryRun,
 	)
 
 	// Validate required fields
@@ -156,6 +472,16 @@ func (t *catalogInstallTool) install(ctx context.Context, req *mcp.CallToolReque
 		return nil, catalogInstallResult{}, fmt.Errorf("version is required")
 	}
 
+	if strings.EqualFold(input.Version, catalog.VersionLatest) {
+		resolved, err := t.manager.LatestVersion(ctx, input.App, input.Template, input.IncludePrerelease)
+		if err != nil {
+			logger.Error("failed to resolve latest catalog version", "tool", name, "error", err)
+			return nil, catalogInstallResult{}, fmt.Errorf("resolve latest version: %w", err)
+		}
+		logger.Debug("resolved latest catalog version", "tool", name, "version", resolved)
+		input.Version = resolved
+	}
+
 	// Verify template exists with catalog manager 
 	// For now we just list entries and check if template exists
 	entries, err := t.manager.List(ctx, input.App, false)
@@ -192,33 +518,35 @@ func (t *catalogInstallTool) install(ctx context.Context, req *mcp.CallToolReque
 
 	logger.Debug("resolved target namespaces", "tool", name, "namespaces", targetNamespaces)
 
-	// Install kgst chart in each target namespace
-	var applied []string
-	var installedCount int
-	var updatedCount int
+	// Install kgst chart in each target namespace, bounded by the session's namespace concurrency
+	type namespaceInstall struct {
+		resources []string
+		state     string // "created", "updated", "dry-run", or "" for unexpected release states
+	}
+	installs := make([]namespaceInstall, len(targetNamespaces))
 
-	for _, targetNS := range targetNamespaces {
+	errs := clusters.ForEachNamespace(ctx, targetNamespaces, t.session.NamespaceConcurrency(), func(ctx context.Context, i int, targetNS string) error {
 		logger.Debug("installing to namespace via kgst", "tool", name, "namespace", targetNS)
 
 		// Create Helm client for this namespace
 		restConfig, err := t.session.RESTConfig()
 		if err != nil {
 			logger.Error("failed to get REST config", "tool", name, "namespace", targetNS, "error", err)
-			return nil, catalogInstallResult{}, fmt.Errorf("get REST config: %w", err)
+			return fmt.Errorf("get REST config: %w", err)
 		}
-		
+
 		helmClient, err := helm.NewClient(restConfig, targetNS, logger)
 		if err != nil {
 			logger.Error("failed to create Helm client", "tool", name, "namespace", targetNS, "error", err)
-			return nil, catalogInstallResult{}, fmt.Errorf("create Helm client for namespace %s: %w", targetNS, err)
+			return fmt.Errorf("create Helm client for namespace %s: %w", targetNS, err)
 		}
 		defer helmClient.Close()
 
-		// Validate kgst chart reference
+		// Resolve the kgst chart source (local path, override ref, or default OCI chart)
 		kgstChartRef, err := helmClient.LoadKGSTChart(ctx, "") // Use default kgst version
 		if err != nil {
-			logger.Error("failed to validate kgst chart", "tool", name, "namespace", targetNS, "error", err)
-			return nil, catalogInstallResult{}, fmt.Errorf("validate kgst chart: %w", err)
+			logger.Error("failed to load kgst chart", "tool", name, "namespace", targetNS, "error", err)
+			return err
 		}
 
 		// Build kgst values
@@ -227,6 +555,21 @@ func (t *catalogInstallTool) install(ctx context.Context, req *mcp.CallToolReque
 		// Use template name as release name (consistent with catalog conventions)
 		releaseName := input.Template
 
+		if input.DryRun {
+			release, err := helmClient.InstallOrUpgradeDryRun(ctx, releaseName, kgstChartRef, values)
+			if err != nil {
+				logger.Error("kgst dry run failed",
+					"tool", name,
+					"release_name", releaseName,
+					"namespace", targetNS,
+					"error", err)
+				return err
+			}
+			installs[i].resources = helmClient.ExtractAppliedResources(release)
+			installs[i].state = "dry-run"
+			return nil
+		}
+
 		// Install or upgrade the chart via CLI
 		release, err := helmClient.InstallOrUpgrade(ctx, releaseName, kgstChartRef, values)
 		if err != nil {
@@ -237,24 +580,23 @@ func (t *catalogInstallTool) install(ctx context.Context, req *mcp.CallToolReque
 				"template", input.Template,
 				"version", input.Version,
 				"error", err)
-			return nil, catalogInstallResult{}, err
+			return err
 		}
 
 		// Extract applied resources from the release
-		resources := helmClient.ExtractAppliedResources(release)
-		applied = append(applied, resources...)
+		installs[i].resources = helmClient.ExtractAppliedResources(release)
 		
 		// Track operation status
 		if release.Info.Status == "deployed" {
 			if release.Version > 1 {
-				updatedCount++
+				installs[i].state = "updated"
 				logger.Info("kgst release updated", 
 					"tool", name,
 					"release_name", releaseName,
 					"namespace", targetNS,
 					"version", release.Version)
 			} else {
-				installedCount++
+				installs[i].state = "created"
 				logger.Info("kgst release created", 
 					"tool", name,
 					"release_name", releaseName,
@@ -268,6 +610,38 @@ func (t *catalogInstallTool) install(ctx context.Context, req *mcp.CallToolReque
 				"status", release.Info.Status,
 				"description", release.Info.Description)
 		}
+		return nil
+	})
+
+	// Merge per-namespace outcomes in namespace order so output is stable
+	var applied []string
+	var installedCount int
+	var updatedCount int
+	var failures []clusters.NamespaceFailure
+	outcomes := make([]namespaceOutcome, 0, len(targetNamespaces))
+	for i, targetNS := range targetNamespaces {
+		if errs[i] != nil {
+			failures = append(failures, clusters.NamespaceFailure{Namespace: targetNS, Error: errs[i].Error()})
+			outcomes = append(outcomes, namespaceOutcome{Namespace: targetNS, Outcome: namespaceOutcomeFailed, Reason: errs[i].Error()})
+			continue
+		}
+		outcomes = append(outcomes, namespaceOutcome{Namespace: targetNS, Outcome: namespaceOutcomeSuccess, Reason: installs[i].state})
+		applied = append(applied, installs[i].resources...)
+		switch installs[i].state {
+		case "created":
+			installedCount++
+		case "updated":
+			updatedCount++
+		}
+	}
+
+	// Abort only when no namespace succeeded
+	if len(failures) == len(targetNamespaces) {
+		for _, err := range errs {
+			if err != nil {
+				return nil, catalogInstallResult{}, err
+			}
+		}
 	}
 
 	// Determine overall status
@@ -277,10 +651,21 @@ func (t *catalogInstallTool) install(ctx context.Context, req *mcp.CallToolReque
 	} else if updatedCount > 0 && installedCount > 0 {
 		status = "mixed"
 	}
+	if len(failures) > 0 {
+		status = "partial"
+		logger.Warn("catalog install failed in some namespaces", "tool", name, "failed_namespaces", len(failures))
+	}
+	// A dry run changes nothing, so it is reported as such even when some namespaces failed
+	if input.DryRun {
+		status = "dry-run"
+	}
 
 	result := catalogInstallResult{
-		Applied: applied,
-		Status:  status,
+		Applied:    applied,
+		Version:    input.Version,
+		Status:     status,
+		Failures:   failures,
+		Namespaces: outcomes,
 	}
 
 	logger.Info("catalog template installed via kgst",
@@ -291,6 +676,7 @@ func (t *catalogInstallTool) install(ctx context.Context, req *mcp.CallToolReque
 		"installed_count", installedCount,
 		"updated_count", updatedCount,
 		"applied_count", len(applied),
+		"dry_run", input.DryRun,
 		"duration_ms", time.Since(start).Milliseconds(),
 	)
 
@@ -323,7 +709,12 @@ func (t *catalogDeleteServiceTemplateTool) delete(ctx context.Context, req *mcp.
 	}
 
 	// Resolve target namespaces using the same logic as install
-	targetNamespaces, err := t.resolveTargetNamespaces(ctx, input, logger)
+	resolvedNamespaces, err := t.resolveTargetNamespaces(ctx, input, logger)
+	if err != nil {
+		return nil, catalogDeleteResult{}, err
+	}
+
+	targetNamespaces, warnings, err := applyGlobalNamespaceProtection(t.session, resolvedNamespaces, input.AllNamespaces, input.IncludeGlobal, logger)
 	if err != nil {
 		return nil, catalogDeleteResult{}, err
 	}
@@ -339,196 +730,255 @@ func (t *catalogDeleteServiceTemplateTool) delete(ctx context.Context, req *mcp.
 
 	logger.Debug("manifests retrieved for deletion", "tool", name, "manifest_count", len(manifests))
 
-	// Delete resources from each target namespace
+	// Parse manifests once; only allowlisted namespace-scoped kinds are deleted
+	targets, err := catalogDeleteTargets(manifests, t.session.CatalogDeleteKinds(), name, logger)
+	if err != nil {
+		return nil, catalogDeleteResult{}, err
+	}
+
+	result, notFoundCount, err := t.deleteAcrossNamespaces(ctx, name, resolvedNamespaces, targetNamespaces, targets, logger)
+	if err != nil {
+		return nil, catalogDeleteResult{}, err
+	}
+	result.Warnings = warnings
+
+	logger.Info("catalog template deleted",
+		"tool", name,
+		"app", input.App,
+		"template", input.Template,
+		"version", input.Version,
+		"deleted_count", len(result.Deleted),
+		"not_found_count", notFoundCount,
+		"duration_ms", time.Since(start).Milliseconds(),
+	)
+
+	return nil, result, nil
+}
+
+// deleteAcrossNamespaces deletes targets from every target namespace, continuing past failures
+// so the result reports an outcome for each resolved namespace. Resolved namespaces that are not
+// targets were excluded by the global-namespace rule and are reported as skipped. An error is
+// returned only when every target namespace failed.
+func (t *catalogDeleteServiceTemplateTool) deleteAcrossNamespaces(ctx context.Context, name string, resolvedNamespaces, targetNamespaces []string, targets []*unstructured.Unstructured, logger *slog.Logger) (catalogDeleteResult, int, error) {
 	var deleted []string
 	var notFoundCount int
+	var failures []clusters.NamespaceFailure
+	outcomes := make([]namespaceOutcome, 0, len(resolvedNamespaces))
+	var firstErr error
+
+	for _, ns := range resolvedNamespaces {
+		if !slices.Contains(targetNamespaces, ns) {
+			outcomes = append(outcomes, namespaceOutcome{Namespace: ns, Outcome: namespaceOutcomeSkipped, Reason: "global namespace excluded; set includeGlobal to delete from it"})
+		}
+	}
 
 	for _, targetNS := range targetNamespaces {
-		logger.Debug("deleting from namespace", "tool", name, "namespace", targetNS)
-
-		for i, manifest := range manifests {
-			// Parse YAML to unstructured
-			obj := &unstructured.Unstructured{}
-			if err := yaml.Unmarshal(manifest, &obj.Object); err != nil {
-				logger.Error("failed to parse manifest", "tool", name, "manifest_index", i, "error", err)
-				return nil, catalogDeleteResult{}, fmt.Errorf("parse manifest %d: %w", i, err)
+		nsDeleted, nsNotFound, nsErr := t.deleteFromNamespace(ctx, name, targetNS, targets, logger)
+		deleted = append(deleted, nsDeleted...)
+		notFoundCount += nsNotFound
+
+		switch {
+		case nsErr != nil:
+			if firstErr == nil {
+				firstErr = nsErr
 			}
+			failures = append(failures, clusters.NamespaceFailure{Namespace: targetNS, Error: nsErr.Error()})
+			outcomes = append(outcomes, namespaceOutcome{Namespace: targetNS, Outcome: namespaceOutcomeFailed, Reason: nsErr.Error()})
+		case len(nsDeleted) == 0:
+			outcomes = append(outcomes, namespaceOutcome{Namespace: targetNS, Outcome: namespaceOutcomeSkipped, Reason: "not found"})
+		default:
+			outcomes = append(outcomes, namespaceOutcome{Namespace: targetNS, Outcome: namespaceOutcomeSuccess})
+		}
+	}
 
-			// Get GVK for processing
-			gvk := obj.GroupVersionKind()
+	// Abort only when no namespace succeeded
+	if len(failures) > 0 && len(failures) == len(targetNamespaces) {
+		return catalogDeleteResult{}, notFoundCount, firstErr
+	}
 
-			// Convert v1alpha1 to v1beta1 if needed (catalog uses v1alpha1, clusters use v1beta1)
-			if obj.GetAPIVersion() == "k0rdent.mirantis.com/v1alpha1" {
-				obj.SetAPIVersion("k0rdent.mirantis.com/v1beta1")
-				gvk = obj.GroupVersionKind()
-				logger.Debug("converted API version for deletion", "tool", name, "from", "v1alpha1", "to", "v1beta1")
-			}
+	// Determine status
+	status := "deleted"
+	if len(deleted) == 0 && notFoundCount > 0 {
+		status = "not_found"
+	}
+	if len(failures) > 0 {
+		status = "partial"
+		logger.Warn("catalog delete failed in some namespaces", "tool", name, "failed_namespaces", len(failures))
+	}
 
-			// Only delete ServiceTemplates and HelmRepositories (namespace-scoped)
-			if gvk.Kind != "ServiceTemplate" && gvk.Kind != "HelmRepository" {
-				logger.Debug("skipping non-deletable resource", "tool", name, "kind", gvk.Kind)
-				continue
-			}
+	result := catalogDeleteResult{
+		Deleted:    deleted,
+		Status:     status,
+		Failures:   failures,
+		Namespaces: outcomes,
+	}
 
-			// Determine GVR from GVK
-			gvr := schema.GroupVersionResource{
-				Group:    gvk.Group,
-				Version:  gvk.Version,
-				Resource: pluralize(gvk.Kind),
-			}
+	return result, notFoundCount, nil
+}
 
-			resourceName := obj.GetName()
+// catalogDeleteTargets parses catalog manifests and keeps the objects whose kind is in the
+// delete allowlist; other kinds are logged and skipped.
+func catalogDeleteTargets(manifests [][]byte, deletableKinds []string, name string, logger *slog.Logger) ([]*unstructured.Unstructured, error) {
+	var targets []*unstructured.Unstructured
+	for i, manifest := range manifests {
+		obj := &unstructured.Unstructured{}
+		if err := yaml.Unmarshal(manifest, &obj.Object); err != nil {
+			logger.Error("failed to parse manifest", "tool", name, "manifest_index", i, "error", err)
+			return nil, fmt.Errorf("parse manifest %d: %w", i, err)
+		}
 
-			logger.Debug("deleting resource",
+		// Convert v1alpha1 to v1beta1 if needed (catalog uses v1alpha1, clusters use v1beta1)
+		if obj.GetAPIVersion() == "k0rdent.mirantis.com/v1alpha1" {
+			obj.SetAPIVersion("k0rdent.mirantis.com/v1beta1")
+			logger.Debug("converted API version for deletion", "tool", name, "from", "v1alpha1", "to", "v1beta1")
+		}
+
+		if kind := obj.GetKind(); !slices.Contains(deletableKinds, kind) {
+			logger.Info("skipping resource kind not in delete allowlist",
 				"tool", name,
-				"kind", gvk.Kind,
-				"name", resourceName,
-				"namespace", targetNS,
+				"kind", kind,
+				"resource", obj.GetName(),
+				"allowed_kinds", deletableKinds,
 			)
+			continue
+		}
+		targets = append(targets, obj)
+	}
+	return targets, nil
+}
 
-			// Delete the resource
-			resourceClient := t.session.Clients.Dynamic.Resource(gvr).Namespace(targetNS)
-			err := resourceClient.Delete(ctx, resourceName, metav1.DeleteOptions{})
+// deleteFromNamespace deletes the catalog resources in targets from one namespace. It stops at
+// the first failure and returns the resources deleted so far and the number already absent.
+func (t *catalogDeleteServiceTemplateTool) deleteFromNamespace(ctx context.Context, name, targetNS string, targets []*unstructured.Unstructured, logger *slog.Logger) ([]string, int, error) {
+	logger.Debug("deleting from namespace", "tool", name, "namespace", targetNS)
 
-			if err != nil {
-				// Check if error is NotFound - this is OK (idempotent)
-				if strings.Contains(err.Error(), "not found") {
-					logger.Debug("resource not found (already deleted)",
-						"tool", name,
-						"kind", gvk.Kind,
-						"name", resourceName,
-						"namespace", targetNS,
-					)
-					notFoundCount++
-					continue
-				}
+	var deleted []string
+	var notFoundCount int
+	for _, obj := range targets {
+		gvk := obj.GroupVersionKind()
+
+		// Determine GVR from GVK
+		gvr := schema.GroupVersionResource{
+			Group:    gvk.Group,
+			Version:  gvk.Version,
+			Resource: pluralize(gvk.Kind),
+		}
 
-				logger.Error("failed to delete resource",
+		resourceName := obj.GetName()
+
+		logger.Debug("deleting resource",
+			"tool", name,
+			"kind", gvk.Kind,
+			"name", resourceName,
+			"namespace", targetNS,
+		)
+
+		// Delete the resource
+		resourceClient := t.session.Clients.Dynamic.Resource(gvr).Namespace(targetNS)
+		err := resourceClient.Delete(ctx, resourceName, metav1.DeleteOptions{})
+
+		if err != nil {
+			// Check if error is NotFound - this is OK (idempotent)
+			if strings.Contains(err.Error(), "not found") {
+				logger.Debug("resource not found (already deleted)",
 					"tool", name,
 					"kind", gvk.Kind,
 					"name", resourceName,
 					"namespace", targetNS,
-					"error", err,
 				)
-				return nil, catalogDeleteResult{}, fmt.Errorf("delete %s %s in namespace %s: %w", gvk.Kind, resourceName, targetNS, err)
+				notFoundCount++
+				continue
 			}
 
-			deletedResourceName := fmt.Sprintf("%s/%s/%s", targetNS, gvk.Kind, resourceName)
-			deleted = append(deleted, deletedResourceName)
-
-			logger.Debug("resource deleted",
+			logger.Error("failed to delete resource",
 				"tool", name,
 				"kind", gvk.Kind,
 				"name", resourceName,
 				"namespace", targetNS,
+				"error", err,
 			)
+			return deleted, notFoundCount, fmt.Errorf("delete %s %s in namespace %s: %w", gvk.Kind, resourceName, targetNS, err)
 		}
-	}
 
-	// Determine status
-	status := "deleted"
-	if len(deleted) == 0 && notFoundCount > 0 {
-		status = "not_found"
-	}
+		deleted = append(deleted, fmt.Sprintf("%s/%s/%s", targetNS, gvk.Kind, resourceName))
 
-	result := catalogDeleteResult{
-		Deleted: deleted,
-		Status:  status,
+		logger.Debug("resource deleted",
+			"tool", name,
+			"kind", gvk.Kind,
+			"name", resourceName,
+			"namespace", targetNS,
+		)
 	}
-
-	logger.Info("catalog template deleted",
-		"tool", name,
-		"app", input.App,
-		"template", input.Template,
-		"version", input.Version,
-		"deleted_count", len(deleted),
-		"not_found_count", notFoundCount,
-		"duration_ms", time.Since(start).Milliseconds(),
-	)
-
-	return nil, result, nil
+	return deleted, notFoundCount, nil
 }
 
 // resolveTargetNamespaces determines which namespace(s) to operate on for the delete tool
 func (t *catalogDeleteServiceTemplateTool) resolveTargetNamespaces(ctx context.Context, input catalogDeleteInput, logger *slog.Logger) ([]string, error) {
-	// If both namespace and all_namespaces are specified, return error
-	if input.Namespace != "" && input.AllNamespaces {
-		return nil, fmt.Errorf("cannot specify both 'namespace' and 'all_namespaces'")
-	}
+	return resolveServiceTemplateNamespaces(ctx, t.session, input.Namespace, input.AllNamespaces, logger)
+}
 
-	// Case 1: Delete from all allowed namespaces
-	if input.AllNamespaces {
-		namespaces, err := t.getAllowedNamespaces(ctx, logger)
-		if err != nil {
-			return nil, fmt.Errorf("get allowed namespaces: %w", err)
-		}
-		if len(namespaces) == 0 {
-			return nil, fmt.Errorf("no allowed namespaces found")
-		}
-		return namespaces, nil
+// applyGlobalNamespaceProtection applies the all_namespaces delete rules for the global
+// namespace: it is skipped unless includeGlobal is set, and including it yields a warning.
+// It returns the remaining namespaces and any warnings for the caller.
+func applyGlobalNamespaceProtection(session *runtime.Session, targetNamespaces []string, allNamespaces, includeGlobal bool, logger *slog.Logger) ([]string, []string, error) {
+	if !allNamespaces {
+		return targetNamespaces, nil, nil
 	}
 
-	// Case 2: Specific namespace provided
-	if input.Namespace != "" {
-		// Validate against namespace filter
-		if t.session.NamespaceFilter != nil && !t.session.NamespaceFilter.MatchString(input.Namespace) {
-			return nil, fmt.Errorf("namespace %q not allowed by namespace filter", input.Namespace)
+	var warnings []string
+	globalNamespace := session.GlobalNamespace()
+	if includeGlobal {
+		if slices.Contains(targetNamespaces, globalNamespace) {
+			warning := fmt.Sprintf("WARNING: includeGlobal is set; deleting from global namespace %q, which the management plane depends on", globalNamespace)
+			logger.Warn("deleting from global namespace", "namespace", globalNamespace)
+			warnings = append(warnings, warning)
 		}
-		return []string{input.Namespace}, nil
+		return targetNamespaces, warnings, nil
 	}
 
-	// Case 3: No namespace specified - determine default behavior
-	// DEV_ALLOW_ANY mode (no filter or matches all): default to kcm-system
-	// OIDC_REQUIRED mode (restricted filter): require explicit namespace
-	if t.session.NamespaceFilter == nil || t.session.NamespaceFilter.MatchString("kcm-system") {
-		// DEV_ALLOW_ANY mode - default to kcm-system
-		logger.Debug("defaulting to kcm-system namespace (DEV_ALLOW_ANY mode)")
-		return []string{"kcm-system"}, nil
-	}
-
-	// OIDC_REQUIRED mode - require explicit namespace
-	return nil, fmt.Errorf("namespace must be specified in OIDC_REQUIRED mode (use 'namespace' parameter or 'all_namespaces: true')")
-}
-
-// getAllowedNamespaces returns all namespaces that match the namespace filter for delete operations
-func (t *catalogDeleteServiceTemplateTool) getAllowedNamespaces(ctx context.Context, logger *slog.Logger) ([]string, error) {
-	// List all namespaces from the cluster
-	nsGVR := schema.GroupVersionResource{
-		Group:    "",
-		Version:  "v1",
-		Resource: "namespaces",
+	targetNamespaces, excluded := protectGlobalNamespace(targetNamespaces, globalNamespace)
+	if excluded {
+		logger.Warn("global namespace excluded from all_namespaces delete", "namespace", globalNamespace)
+		warnings = append(warnings, fmt.Sprintf("global namespace %q was skipped; set includeGlobal to delete from it", globalNamespace))
 	}
-
-	nsList, err := t.session.Clients.Dynamic.Resource(nsGVR).List(ctx, metav1.ListOptions{})
-	if err != nil {
-		return nil, fmt.Errorf("list namespaces: %w", err)
+	if len(targetNamespaces) == 0 {
+		return nil, warnings, fmt.Errorf("no namespaces to delete from after excluding global namespace %q (set includeGlobal to include it)", globalNamespace)
 	}
+	return targetNamespaces, warnings, nil
+}
 
-	var allowed []string
-	for _, ns := range nsList.Items {
-		nsName := ns.GetName()
-		// If no filter, all namespaces are allowed
-		if t.session.NamespaceFilter == nil {
-			allowed = append(allowed, nsName)
-		} else if t.session.NamespaceFilter.MatchString(nsName) {
-			allowed = append(allowed, nsName)
+// protectGlobalNamespace removes the global namespace from an all_namespaces target list
+// so destructive operations do not touch resources the management plane depends on.
+// It reports whether the global namespace was present.
+func protectGlobalNamespace(namespaces []string, globalNamespace string) ([]string, bool) {
+	filtered := make([]string, 0, len(namespaces))
+	excluded := false
+	for _, ns := range namespaces {
+		if ns == globalNamespace {
+			excluded = true
+			continue
 		}
+		filtered = append(filtered, ns)
 	}
-
-	logger.Debug("found allowed namespaces", "count", len(allowed), "namespaces", allowed)
-	return allowed, nil
+	return filtered, excluded
 }
 
 // resolveTargetNamespaces determines which namespace(s) to install the ServiceTemplate into
 func (t *catalogInstallTool) resolveTargetNamespaces(ctx context.Context, input catalogInstallInput, logger *slog.Logger) ([]string, error) {
+	return resolveServiceTemplateNamespaces(ctx, t.session, input.Namespace, input.AllNamespaces, logger)
+}
+
+// resolveServiceTemplateNamespaces is the shared namespace resolution used by the
+// ServiceTemplate install and delete tools.
+func resolveServiceTemplateNamespaces(ctx context.Context, session *runtime.Session, namespace string, allNamespaces bool, logger *slog.Logger) ([]string, error) {
 	// If both namespace and all_namespaces are specified, return error
-	if input.Namespace != "" && input.AllNamespaces {
+	if namespace != "" && allNamespaces {
 		return nil, fmt.Errorf("cannot specify both 'namespace' and 'all_namespaces'")
 	}
 
-	// Case 1: Install to all allowed namespaces
-	if input.AllNamespaces {
-		namespaces, err := t.getAllowedNamespaces(ctx, logger)
+	// Case 1: Operate on all allowed namespaces
+	if allNamespaces {
+		namespaces, err := getAllowedNamespacesHelper(ctx, session, logger)
 		if err != nil {
 			return nil, fmt.Errorf("get allowed namespaces: %w", err)
 		}
@@ -539,56 +989,27 @@ func (t *catalogInstallTool) resolveTargetNamespaces(ctx context.Context, input
 	}
 
 	// Case 2: Specific namespace provided
-	if input.Namespace != "" {
+	if namespace != "" {
 		// Validate against namespace filter
-		if t.session.NamespaceFilter != nil && !t.session.NamespaceFilter.MatchString(input.Namespace) {
-			return nil, fmt.Errorf("namespace %q not allowed by namespace filter", input.Namespace)
+		if session.NamespaceFilter != nil && !session.NamespaceFilter.MatchString(namespace) {
+			return nil, fmt.Errorf("namespace %q not allowed by namespace filter", namespace)
 		}
-		return []string{input.Namespace}, nil
+		return []string{namespace}, nil
 	}
 
 	// Case 3: No namespace specified - determine default behavior
-	// DEV_ALLOW_ANY mode (no filter or matches all): default to kcm-system
+	// DEV_ALLOW_ANY mode (no filter or matches the default): default to DEFAULT_NAMESPACE (kcm-system)
 	// OIDC_REQUIRED mode (restricted filter): require explicit namespace
-	if t.session.NamespaceFilter == nil || t.session.NamespaceFilter.MatchString("kcm-system") {
-		// DEV_ALLOW_ANY mode - default to kcm-system
-		logger.Debug("defaulting to kcm-system namespace (DEV_ALLOW_ANY mode)")
-		return []string{"kcm-system"}, nil
+	defaultNamespace := session.DefaultNamespace()
+	if session.NamespaceFilter == nil || session.NamespaceFilter.MatchString(defaultNamespace) {
+		logger.Debug("defaulting to configured namespace (DEV_ALLOW_ANY mode)", "namespace", defaultNamespace)
+		return []string{defaultNamespace}, nil
 	}
 
 	// OIDC_REQUIRED mode - require explicit namespace
 	return nil, fmt.Errorf("namespace must be specified in OIDC_REQUIRED mode (use 'namespace' parameter or 'all_namespaces: true')")
 }
 
-// getAllowedNamespaces returns all namespaces that match the namespace filter
-func (t *catalogInstallTool) getAllowedNamespaces(ctx context.Context, logger *slog.Logger) ([]string, error) {
-	// List all namespaces from the cluster
-	nsGVR := schema.GroupVersionResource{
-		Group:    "",
-		Version:  "v1",
-		Resource: "namespaces",
-	}
-
-	nsList, err := t.session.Clients.Dynamic.Resource(nsGVR).List(ctx, metav1.ListOptions{})
-	if err != nil {
-		return nil, fmt.Errorf("list namespaces: %w", err)
-	}
-
-	var allowed []string
-	for _, ns := range nsList.Items {
-		nsName := ns.GetName()
-		// If no filter, all namespaces are allowed
-		if t.session.NamespaceFilter == nil {
-			allowed = append(allowed, nsName)
-		} else if t.session.NamespaceFilter.MatchString(nsName) {
-			allowed = append(allowed, nsName)
-		}
-	}
-
-	logger.Debug("found allowed namespaces", "count", len(allowed), "namespaces", allowed)
-	return allowed, nil
-}
-
 // pluralize converts a Kubernetes Kind to its resource name (plural form).
 // This is a simple implementation that handles most common cases.
 func pluralize(kind string) string {
@@ -0,0 +1,196 @@
+package core
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/yaml"
+
+	"github.com/k0rdent/mcp-k0rdent-server/internal/runtime"
+)
+
+const (
+	// serviceTemplateManifestFieldOwner is the server-side apply field manager for manifest installs.
+	serviceTemplateManifestFieldOwner = "mcp.servicetemplates"
+)
+
+// serviceTemplateManifestGroups maps each kind accepted in a manifest to its required API group.
+var serviceTemplateManifestGroups = map[string]string{
+	"ServiceTemplate": "k0rdent.mirantis.com",
+	"HelmRepository":  "source.toolkit.fluxcd.io",
+}
+
+type serviceTemplateManifestInstallTool struct {
+	session *runtime.Session
+}
+
+type serviceTemplateManifestInstallInput struct {
+	Manifest      string `json:"manifest" jsonschema:"Raw YAML containing ServiceTemplate and/or HelmRepository documents (multi-document supported)"`
+	Namespace     string `json:"namespace,omitempty" jsonschema:"Target namespace (defaults to DEFAULT_NAMESPACE, kcm-system unless set, in DEV_ALLOW_ANY mode)"`
+	AllNamespaces bool   `json:"all_namespaces,omitempty" jsonschema:"Install into every namespace allowed by the namespace filter"`
+	DryRun        bool   `json:"dryRun,omitempty" jsonschema:"Validate the manifest with a server-side dry run without persisting changes"`
+}
+
+type serviceTemplateManifestInstallResult struct {
+	Applied []string `json:"applied"`
+	Status  string   `json:"status"`
+	DryRun  bool     `json:"dryRun,omitempty"`
+}
+
+func (t *serviceTemplateManifestInstallTool) install(ctx context.Context, req *mcp.CallToolRequest, input serviceTemplateManifestInstallInput) (*mcp.CallToolResult, serviceTemplateManifestInstallResult, error) {
+	name := toolName(req)
+	ctx, logger := toolContext(ctx, t.session, name, "tool.catalog")
+	start := time.Now()
+
+	logger.Debug("installing service template from manifest",
+		"tool", name,
+		"namespace", input.Namespace,
+		"all_namespaces", input.AllNamespaces,
+		"dry_run", input.DryRun,
+		"manifest_bytes", len(input.Manifest),
+	)
+
+	if strings.TrimSpace(input.Manifest) == "" {
+		return nil, serviceTemplateManifestInstallResult{}, fmt.Errorf("manifest is required")
+	}
+
+	objects, err := parseServiceTemplateManifest(input.Manifest)
+	if err != nil {
+		logger.Warn("invalid service template manifest", "tool", name, "error", err)
+		return nil, serviceTemplateManifestInstallResult{}, err
+	}
+
+	targetNamespaces, err := resolveServiceTemplateNamespaces(ctx, t.session, input.Namespace, input.AllNamespaces, logger)
+	if err != nil {
+		return nil, serviceTemplateManifestInstallResult{}, err
+	}
+
+	logger.Debug("resolved target namespaces", "tool", name, "namespaces", targetNamespaces, "object_count", len(objects))
+
+	applyOptions := metav1.ApplyOptions{
+		FieldManager: serviceTemplateManifestFieldOwner,
+		Force:        true,
+	}
+	if input.DryRun {
+		applyOptions.DryRun = []string{metav1.DryRunAll}
+	}
+
+	var applied []string
+	for _, targetNS := range targetNamespaces {
+		for _, obj := range objects {
+			payload := obj.DeepCopy()
+			payload.SetNamespace(targetNS)
+
+			gvk := payload.GroupVersionKind()
+			gvr := schema.GroupVersionResource{
+				Group:    gvk.Group,
+				Version:  gvk.Version,
+				Resource: pluralize(gvk.Kind),
+			}
+
+			logger.Debug("applying manifest resource",
+				"tool", name,
+				"kind", gvk.Kind,
+				"name", payload.GetName(),
+				"namespace", targetNS,
+			)
+
+			_, err := t.session.Clients.Dynamic.Resource(gvr).Namespace(targetNS).Apply(ctx, payload.GetName(), payload, applyOptions)
+			if err != nil {
+				logger.Error("failed to apply manifest resource",
+					"tool", name,
+					"kind", gvk.Kind,
+					"name", payload.GetName(),
+					"namespace", targetNS,
+					"error", err,
+				)
+				return nil, serviceTemplateManifestInstallResult{}, fmt.Errorf("apply %s %s in namespace %s: %w", gvk.Kind, payload.GetName(), targetNS, err)
+			}
+
+			applied = append(applied, fmt.Sprintf("%s/%s/%s", targetNS, gvk.Kind, payload.GetName()))
+		}
+	}
+
+	status := "applied"
+	if input.DryRun {
+		status = "dry_run"
+	}
+
+	logger.Info("service template manifest installed",
+		"tool", name,
+		"applied_count", len(applied),
+		"namespace_count", len(targetNamespaces),
+		"dry_run", input.DryRun,
+		"duration_ms", time.Since(start).Milliseconds(),
+	)
+
+	return nil, serviceTemplateManifestInstallResult{
+		Applied: applied,
+		Status:  status,
+		DryRun:  input.DryRun,
+	}, nil
+}
+
+// parseServiceTemplateManifest splits raw YAML into objects and verifies that every
+// document is a k0rdent ServiceTemplate or Flux HelmRepository. Empty documents are skipped.
+func parseServiceTemplateManifest(raw string) ([]*unstructured.Unstructured, error) {
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(strings.NewReader(raw)))
+
+	var objects []*unstructured.Unstructured
+	for index := 0; ; index++ {
+		doc, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read manifest document %d: %w", index, err)
+		}
+		if len(bytes.TrimSpace(doc)) == 0 {
+			continue
+		}
+
+		obj := &unstructured.Unstructured{}
+		if err := yaml.Unmarshal(doc, &obj.Object); err != nil {
+			return nil, fmt.Errorf("parse manifest document %d: %w", index, err)
+		}
+		if len(obj.Object) == 0 {
+			continue
+		}
+
+		// Catalog manifests still use v1alpha1; the management cluster serves v1beta1.
+		if obj.GetAPIVersion() == "k0rdent.mirantis.com/v1alpha1" {
+			obj.SetAPIVersion("k0rdent.mirantis.com/v1beta1")
+		}
+
+		kind := obj.GetKind()
+		group, ok := serviceTemplateManifestGroups[kind]
+		if !ok {
+			return nil, fmt.Errorf("manifest document %d has unsupported kind %q (only ServiceTemplate and HelmRepository are allowed)", index, kind)
+		}
+		if gvk := obj.GroupVersionKind(); gvk.Group != group || gvk.Version == "" {
+			return nil, fmt.Errorf("manifest document %d (%s) has unsupported apiVersion %q (expected group %s)", index, kind, obj.GetAPIVersion(), group)
+		}
+		if obj.GetName() == "" {
+			return nil, fmt.Errorf("manifest document %d (%s) is missing metadata.name", index, kind)
+		}
+
+		objects = append(objects, obj)
+	}
+
+	if len(objects) == 0 {
+		return nil, fmt.Errorf("manifest contains no resources")
+	}
+
+	return objects, nil
+}
@@ -0,0 +1,109 @@
+package core
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic/fake"
+
+	mcpRuntime "github.com/k0rdent/mcp-k0rdent-server/internal/runtime"
+)
+
+func TestParseServiceTemplateManifest_MultiDocument(t *testing.T) {
+	raw := testHelmRepository + "\n---\n" + strings.Replace(testServiceTemplate, "v1beta1", "v1alpha1", 1) + "\n---\n"
+
+	objects, err := parseServiceTemplateManifest(raw)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(objects) != 2 {
+		t.Fatalf("expected 2 objects, got %d", len(objects))
+	}
+	if objects[0].GetKind() != "HelmRepository" {
+		t.Errorf("expected HelmRepository first, got %q", objects[0].GetKind())
+	}
+	if objects[1].GetAPIVersion() != "k0rdent.mirantis.com/v1beta1" {
+		t.Errorf("expected v1alpha1 to be converted to v1beta1, got %q", objects[1].GetAPIVersion())
+	}
+}
+
+func TestParseServiceTemplateManifest_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		manifest string
+		wantErr  string
+	}{
+		{name: "unsupported kind", manifest: testClusterScopedResource, wantErr: `unsupported kind "Namespace"`},
+		{name: "invalid yaml", manifest: testInvalidYAML, wantErr: "parse manifest document 0"},
+		{name: "only separators", manifest: "---\n---\n", wantErr: "manifest contains no resources"},
+		{name: "foreign group", manifest: "apiVersion: example.com/v1\nkind: ServiceTemplate\nmetadata:\n  name: evil\n", wantErr: `unsupported apiVersion "example.com/v1" (expected group k0rdent.mirantis.com)`},
+		{name: "helm repository outside flux", manifest: "apiVersion: k0rdent.mirantis.com/v1beta1\nkind: HelmRepository\nmetadata:\n  name: repo\n", wantErr: "expected group source.toolkit.fluxcd.io"},
+		{name: "missing name", manifest: "apiVersion: k0rdent.mirantis.com/v1beta1\nkind: ServiceTemplate\nspec: {}\n", wantErr: "missing metadata.name"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseServiceTemplateManifest(tt.manifest)
+			if err == nil {
+				t.Fatal("expected error, got nil")
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestServiceTemplateManifestInstall_MissingManifest(t *testing.T) {
+	tool := &serviceTemplateManifestInstallTool{session: &mcpRuntime.Session{}}
+
+	_, _, err := tool.install(context.Background(), nil, serviceTemplateManifestInstallInput{})
+	if err == nil || err.Error() != "manifest is required" {
+		t.Fatalf("expected manifest is required error, got %v", err)
+	}
+}
+
+func TestServiceTemplateManifestInstall_NamespaceFilterBlocked(t *testing.T) {
+	session := &mcpRuntime.Session{
+		Clients: mcpRuntime.Clients{
+			Dynamic: fake.NewSimpleDynamicClient(runtime.NewScheme()),
+		},
+		NamespaceFilter: regexp.MustCompile("^allowed-"),
+	}
+	tool := &serviceTemplateManifestInstallTool{session: session}
+
+	_, _, err := tool.install(context.Background(), nil, serviceTemplateManifestInstallInput{
+		Manifest:  testServiceTemplate,
+		Namespace: "kcm-system",
+	})
+	if err == nil {
+		t.Fatal("expected namespace filter error, got nil")
+	}
+	if err.Error() != `namespace "kcm-system" not allowed by namespace filter` {
+		t.Errorf("unexpected error message: %v", err)
+	}
+}
+
+func TestServiceTemplateManifestInstall_OIDCRequiredMode(t *testing.T) {
+	session := &mcpRuntime.Session{
+		Clients: mcpRuntime.Clients{
+			Dynamic: fake.NewSimpleDynamicClient(runtime.NewScheme()),
+		},
+		NamespaceFilter: regexp.MustCompile("^user-"),
+	}
+	tool := &serviceTemplateManifestInstallTool{session: session}
+
+	_, _, err := tool.install(context.Background(), nil, serviceTemplateManifestInstallInput{
+		Manifest: testServiceTemplate,
+	})
+	if err == nil {
+		t.Fatal("expected error requiring explicit namespace, got nil")
+	}
+	expected := "namespace must be specified in OIDC_REQUIRED mode (use 'namespace' parameter or 'all_namespaces: true')"
+	if err.Error() != expected {
+		t.Errorf("expected error %q, got %q", expected, err.Error())
+	}
+}
@@ -0,0 +1,218 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/k0rdent/mcp-k0rdent-server/internal/clusters"
+	"github.com/k0rdent/mcp-k0rdent-server/internal/helm"
+	"github.com/k0rdent/mcp-k0rdent-server/internal/runtime"
+)
+
+type serviceTemplateReleasesTool struct {
+	session *runtime.Session
+}
+
+type serviceTemplateReleasesInput struct {
+	Namespace     string `json:"namespace,omitempty" jsonschema:"List releases in this namespace only (defaults to every namespace allowed by the namespace filter)"`
+	UnhealthyOnly bool   `json:"unhealthyOnly,omitempty" jsonschema:"Only return releases that are not deployed (failed, pending-*, uninstalling, unknown)"`
+}
+
+type serviceTemplateReleasesResult struct {
+	Releases  []helm.ReleaseSummary       `json:"releases"`
+	Unhealthy int                         `json:"unhealthy"`
+	Failures  []clusters.NamespaceFailure `json:"failures,omitempty"`
+}
+
+func (t *serviceTemplateReleasesTool) list(ctx context.Context, req *mcp.CallToolRequest, input serviceTemplateReleasesInput) (*mcp.CallToolResult, serviceTemplateReleasesResult, error) {
+	name := toolName(req)
+	ctx, logger := toolContext(ctx, t.session, name, "tool.catalog")
+	start := time.Now()
+
+	namespaces, err := resolveServiceTemplateNamespaces(ctx, t.session, input.Namespace, input.Namespace == "", logger)
+	if err != nil {
+		return nil, serviceTemplateReleasesResult{}, err
+	}
+
+	restConfig, err := t.session.RESTConfig()
+	if err != nil {
+		logger.Error("failed to get REST config", "tool", name, "error", err)
+		return nil, serviceTemplateReleasesResult{}, fmt.Errorf("get REST config: %w", err)
+	}
+
+	perNamespace := make([][]helm.ReleaseSummary, len(namespaces))
+	errs := clusters.ForEachNamespace(ctx, namespaces, t.session.NamespaceConcurrency(), func(ctx context.Context, i int, namespace string) error {
+		helmClient, err := helm.NewClient(restConfig, namespace, logger)
+		if err != nil {
+			return fmt.Errorf("create Helm client for namespace %s: %w", namespace, err)
+		}
+		defer helmClient.Close()
+
+		releases, err := helmClient.ListKGSTReleases(ctx)
+		if err != nil {
+			return err
+		}
+		perNamespace[i] = releases
+		return nil
+	})
+
+	result := serviceTemplateReleasesResult{Releases: []helm.ReleaseSummary{}}
+	for i, namespace := range namespaces {
+		if errs[i] != nil {
+			logger.Warn("failed to list kgst releases", "tool", name, "namespace", namespace, "error", errs[i])
+			result.Failures = append(result.Failures, clusters.NamespaceFailure{Namespace: namespace, Error: errs[i].Error()})
+			continue
+		}
+		for _, release := range perNamespace[i] {
+			if !release.Healthy() {
+				result.Unhealthy++
+			} else if input.UnhealthyOnly {
+				continue
+			}
+			result.Releases = append(result.Releases, release)
+		}
+	}
+	if len(result.Failures) == len(namespaces) {
+		return nil, serviceTemplateReleasesResult{}, fmt.Errorf("list kgst releases: %s", result.Failures[0].Error)
+	}
+
+	logger.Info("kgst releases listed",
+		"tool", name,
+		"namespace_count", len(namespaces),
+		"release_count", len(result.Releases),
+		"unhealthy", result.Unhealthy,
+		"failed_namespaces", len(result.Failures),
+		"duration_ms", time.Since(start).Milliseconds(),
+	)
+
+	return nil, result, nil
+}
+
+type serviceTemplateUninstallTool struct {
+	session *runtime.Session
+}
+
+type serviceTemplateUninstallInput struct {
+	App           string `json:"app,omitempty" jsonschema:"Catalog app the template was installed from (informational)"`
+	Template      string `json:"template,omitempty" jsonschema:"Template name; install_from_catalog uses it as the kgst release name"`
+	ReleaseName   string `json:"releaseName,omitempty" jsonschema:"kgst release name, as reported by listReleases (alternative to template)"`
+	Namespace     string `json:"namespace,omitempty"`
+	AllNamespaces bool   `json:"all_namespaces,omitempty"`
+	IncludeGlobal bool   `json:"includeGlobal,omitempty" jsonschema:"With all_namespaces, also uninstall from the global management namespace"`
+	KeepHistory   bool   `json:"keepHistory,omitempty" jsonschema:"Keep the Helm release history (helm uninstall --keep-history)"`
+}
+
+type serviceTemplateUninstallResult struct {
+	Release     string                      `json:"release"`
+	Uninstalled []string                    `json:"uninstalled"`
+	Status      string                      `json:"status"`
+	Warnings    []string                    `json:"warnings,omitempty"`
+	Failures    []clusters.NamespaceFailure `json:"failures,omitempty"`
+	Namespaces  []namespaceOutcome          `json:"namespaces"`
+}
+
+func (t *serviceTemplateUninstallTool) uninstall(ctx context.Context, req *mcp.CallToolRequest, input serviceTemplateUninstallInput) (*mcp.CallToolResult, serviceTemplateUninstallResult, error) {
+	name := toolName(req)
+	ctx, logger := toolContext(ctx, t.session, name, "tool.catalog")
+	start := time.Now()
+
+	releaseName := input.ReleaseName
+	if releaseName == "" {
+		releaseName = input.Template
+	} else if input.Template != "" && input.Template != releaseName {
+		return nil, serviceTemplateUninstallResult{}, fmt.Errorf("template %q and releaseName %q refer to different releases; specify one", input.Template, releaseName)
+	}
+	if releaseName == "" {
+		return nil, serviceTemplateUninstallResult{}, fmt.Errorf("template or releaseName is required")
+	}
+
+	logger.Debug("uninstalling kgst release",
+		"tool", name,
+		"app", input.App,
+		"release_name", releaseName,
+		"namespace", input.Namespace,
+		"all_namespaces", input.AllNamespaces,
+		"keep_history", input.KeepHistory,
+	)
+
+	resolvedNamespaces, err := resolveServiceTemplateNamespaces(ctx, t.session, input.Namespace, input.AllNamespaces, logger)
+	if err != nil {
+		return nil, serviceTemplateUninstallResult{}, err
+	}
+	targetNamespaces, warnings, err := applyGlobalNamespaceProtection(t.session, resolvedNamespaces, input.AllNamespaces, input.IncludeGlobal, logger)
+	if err != nil {
+		return nil, serviceTemplateUninstallResult{}, err
+	}
+
+	restConfig, err := t.session.RESTConfig()
+	if err != nil {
+		logger.Error("failed to get REST config", "tool", name, "error", err)
+		return nil, serviceTemplateUninstallResult{}, fmt.Errorf("get REST config: %w", err)
+	}
+
+	errs := clusters.ForEachNamespace(ctx, targetNamespaces, t.session.NamespaceConcurrency(), func(ctx context.Context, _ int, namespace string) error {
+		helmClient, err := helm.NewClient(restConfig, namespace, logger)
+		if err != nil {
+			return fmt.Errorf("create Helm client for namespace %s: %w", namespace, err)
+		}
+		defer helmClient.Close()
+		return helmClient.Uninstall(ctx, releaseName, input.KeepHistory)
+	})
+
+	result := serviceTemplateUninstallResult{Release: releaseName, Uninstalled: []string{}, Warnings: warnings}
+	for _, ns := range resolvedNamespaces {
+		if !slices.Contains(targetNamespaces, ns) {
+			result.Namespaces = append(result.Namespaces, namespaceOutcome{Namespace: ns, Outcome: namespaceOutcomeSkipped, Reason: "global namespace excluded; set includeGlobal to uninstall from it"})
+		}
+	}
+	notFound := 0
+	var firstErr error
+	for i, ns := range targetNamespaces {
+		switch {
+		case errors.Is(errs[i], helm.ErrReleaseNotFound):
+			notFound++
+			result.Namespaces = append(result.Namespaces, namespaceOutcome{Namespace: ns, Outcome: namespaceOutcomeSkipped, Reason: "not found"})
+		case errs[i] != nil:
+			if firstErr == nil {
+				firstErr = errs[i]
+			}
+			logger.Warn("kgst uninstall failed", "tool", name, "namespace", ns, "release_name", releaseName, "error", errs[i])
+			result.Failures = append(result.Failures, clusters.NamespaceFailure{Namespace: ns, Error: errs[i].Error()})
+			result.Namespaces = append(result.Namespaces, namespaceOutcome{Namespace: ns, Outcome: namespaceOutcomeFailed, Reason: errs[i].Error()})
+		default:
+			result.Uninstalled = append(result.Uninstalled, ns+"/"+releaseName)
+			result.Namespaces = append(result.Namespaces, namespaceOutcome{Namespace: ns, Outcome: namespaceOutcomeSuccess})
+		}
+	}
+
+	// Abort only when no namespace succeeded
+	if len(result.Failures) > 0 && len(result.Failures) == len(targetNamespaces) {
+		return nil, serviceTemplateUninstallResult{}, firstErr
+	}
+
+	result.Status = "uninstalled"
+	if len(result.Uninstalled) == 0 && notFound > 0 {
+		result.Status = "not_found"
+	}
+	if len(result.Failures) > 0 {
+		result.Status = "partial"
+	}
+
+	logger.Info("kgst release uninstalled",
+		"tool", name,
+		"app", input.App,
+		"release_name", releaseName,
+		"keep_history", input.KeepHistory,
+		"uninstalled_count", len(result.Uninstalled),
+		"not_found_count", notFound,
+		"failed_namespaces", len(result.Failures),
+		"duration_ms", time.Since(start).Milliseconds(),
+	)
+
+	return nil, result, nil
+}
@@ -0,0 +1,20 @@
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/k0rdent/mcp-k0rdent-server/internal/runtime"
+)
+
+func TestServiceTemplateUninstallValidatesRelease(t *testing.T) {
+	tool := &serviceTemplateUninstallTool{session: &runtime.Session{}}
+
+	_, _, err := tool.uninstall(context.Background(), nil, serviceTemplateUninstallInput{Namespace: "team-a"})
+	require.ErrorContains(t, err, "template or releaseName is required")
+
+	_, _, err = tool.uninstall(context.Background(), nil, serviceTemplateUninstallInput{Template: "minio", ReleaseName: "valkey", Namespace: "team-a"})
+	require.ErrorContains(t, err, "refer to different releases")
+}
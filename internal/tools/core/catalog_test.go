@@ -2,6 +2,7 @@ package core
 
 import (
 	"context"
+	"errors"
 	"io"
 	"log/slog"
 	"net/http"
@@ -9,11 +10,16 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"strings"
 	"testing"
 	"time"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/dynamic/fake"
+	clienttesting "k8s.io/client-go/testing"
 
 	"github.com/k0rdent/mcp-k0rdent-server/internal/catalog"
 	mcpRuntime "github.com/k0rdent/mcp-k0rdent-server/internal/runtime"
@@ -142,6 +148,62 @@ func TestCatalogList_WithAppFilter(t *testing.T) {
 	}
 }
 
+// TestCatalogList_WithSearch tests query and tag filters
+func TestCatalogList_WithSearch(t *testing.T) {
+	ts, manager := createTestCatalogManager(t)
+	defer ts.Close()
+
+	tool := &catalogListTool{
+		session: &mcpRuntime.Session{},
+		manager: manager,
+	}
+
+	_, result, err := tool.list(context.Background(), nil, catalogListInput{Query: "data", Tags: []string{"cache"}})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(result.Entries) != 1 || result.Entries[0].Slug != "redis" {
+		t.Fatalf("expected only redis, got %+v", result.Entries)
+	}
+
+	_, result, err = tool.list(context.Background(), nil, catalogListInput{Platform: "aws"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(result.Entries) != 0 {
+		t.Errorf("expected no entries validated on aws in test index, got %d", len(result.Entries))
+	}
+}
+
+// TestCatalogGet tests single-app lookup and not-found errors
+func TestCatalogGet(t *testing.T) {
+	ts, manager := createTestCatalogManager(t)
+	defer ts.Close()
+
+	tool := &catalogGetTool{
+		session: &mcpRuntime.Session{},
+		manager: manager,
+	}
+
+	_, result, err := tool.get(context.Background(), nil, catalogGetInput{App: "redis"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result.Entry.Slug != "redis" || len(result.Entry.Versions) == 0 {
+		t.Errorf("expected redis entry with versions, got %+v", result.Entry)
+	}
+
+	if _, _, err := tool.get(context.Background(), nil, catalogGetInput{}); err == nil {
+		t.Error("expected error for missing app")
+	}
+	if _, _, err := tool.get(context.Background(), nil, catalogGetInput{App: "nonexistent"}); !errors.Is(err, catalog.ErrNotFound) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+	if _, _, err := tool.get(context.Background(), nil, catalogGetInput{App: "redis", Version: "0.0.0"}); !errors.Is(err, catalog.ErrNotFound) {
+		t.Errorf("expected not found error for unknown version, got %v", err)
+	}
+}
+
 // TestCatalogList_WithRefresh tests refresh flag
 func TestCatalogList_WithRefresh(t *testing.T) {
 	ts, manager := createTestCatalogManager(t)
@@ -258,6 +320,29 @@ func TestCatalogInstall_MissingVersion(t *testing.T) {
 	}
 }
 
+// TestCatalogInstall_LatestUnknownApp tests that the latest sentinel is resolved against the catalog
+func TestCatalogInstall_LatestUnknownApp(t *testing.T) {
+	ts, manager := createTestCatalogManager(t)
+	defer ts.Close()
+
+	tool := &catalogInstallTool{
+		session: &mcpRuntime.Session{
+			Clients:         mcpRuntime.Clients{Dynamic: fake.NewSimpleDynamicClient(runtime.NewScheme())},
+			NamespaceFilter: regexp.MustCompile(".*"),
+		},
+		manager: manager,
+	}
+
+	_, _, err := tool.install(context.Background(), nil, catalogInstallInput{
+		App:      "nonexistent",
+		Template: "postgresql",
+		Version:  "latest",
+	})
+	if err == nil || !strings.Contains(err.Error(), "resolve latest version: app nonexistent not found in catalog") {
+		t.Fatalf("expected latest resolution error, got %v", err)
+	}
+}
+
 // TestCatalogInstall_NamespaceFilterBlocked tests namespace filter rejection
 func TestCatalogInstall_NamespaceFilterBlocked(t *testing.T) {
 	ts, manager := createTestCatalogManager(t)
@@ -784,3 +869,151 @@ func TestCatalogDelete_BothNamespaceAndAllNamespaces(t *testing.T) {
 		t.Errorf("expected error %q, got %q", expectedError, err.Error())
 	}
 }
+
+// TestCatalogDelete_AllNamespacesSkipsGlobal tests the global namespace is protected by default
+func TestCatalogDelete_AllNamespacesSkipsGlobal(t *testing.T) {
+	ts, manager := createTestCatalogManager(t)
+	defer ts.Close()
+
+	nsGVR := schema.GroupVersionResource{Version: "v1", Resource: "namespaces"}
+	globalNS := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Namespace",
+		"metadata":   map[string]interface{}{"name": "kcm-system"},
+	}}
+	session := &mcpRuntime.Session{
+		Clients: mcpRuntime.Clients{
+			Dynamic: fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+				map[schema.GroupVersionResource]string{nsGVR: "NamespaceList"}, globalNS),
+		},
+	}
+
+	tool := &catalogDeleteServiceTemplateTool{
+		session: session,
+		manager: manager,
+	}
+
+	input := catalogDeleteInput{
+		App:           "minio",
+		Template:      "minio",
+		Version:       "14.1.2",
+		AllNamespaces: true,
+	}
+
+	_, _, err := tool.delete(context.Background(), nil, input)
+	if err == nil {
+		t.Fatal("expected error when only the global namespace is targeted, got nil")
+	}
+	if !strings.Contains(err.Error(), "includeGlobal") {
+		t.Errorf("expected error to mention includeGlobal, got %q", err.Error())
+	}
+}
+
+func TestProtectGlobalNamespace(t *testing.T) {
+	filtered, excluded := protectGlobalNamespace([]string{"kcm-system", "team-a", "team-b"}, "kcm-system")
+	if !excluded {
+		t.Error("expected global namespace to be reported as excluded")
+	}
+	if len(filtered) != 2 || filtered[0] != "team-a" || filtered[1] != "team-b" {
+		t.Errorf("unexpected filtered namespaces: %v", filtered)
+	}
+
+	filtered, excluded = protectGlobalNamespace([]string{"team-a"}, "kcm-system")
+	if excluded || len(filtered) != 1 {
+		t.Errorf("expected namespaces unchanged, got %v (excluded=%v)", filtered, excluded)
+	}
+}
+
+// TestCatalogDelete_AcrossNamespacesPartial tests that a failing namespace does not stop the others
+func TestCatalogDelete_AcrossNamespacesPartial(t *testing.T) {
+	stGVR := schema.GroupVersionResource{Group: "k0rdent.mirantis.com", Version: "v1beta1", Resource: "servicetemplates"}
+	newTemplate := func(namespace string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "k0rdent.mirantis.com/v1beta1",
+			"kind":       "ServiceTemplate",
+			"metadata":   map[string]interface{}{"name": "minio-14-1-2", "namespace": namespace},
+		}}
+	}
+	client := fake.NewSimpleDynamicClient(runtime.NewScheme(), newTemplate("team-a"), newTemplate("team-b"))
+	client.PrependReactor("delete", "servicetemplates", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		if action.GetNamespace() == "team-b" {
+			return true, nil, apierrors.NewForbidden(stGVR.GroupResource(), "minio-14-1-2", errors.New("denied"))
+		}
+		return false, nil, nil
+	})
+
+	tool := &catalogDeleteServiceTemplateTool{session: &mcpRuntime.Session{
+		Clients: mcpRuntime.Clients{Dynamic: client},
+	}}
+	targets := []*unstructured.Unstructured{newTemplate("")}
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	result, _, err := tool.deleteAcrossNamespaces(context.Background(), "test",
+		[]string{"kcm-system", "team-a", "team-b", "team-c"}, []string{"team-a", "team-b", "team-c"}, targets, logger)
+	if err != nil {
+		t.Fatalf("expected partial result, got error: %v", err)
+	}
+	if result.Status != "partial" {
+		t.Errorf("expected status partial, got %q", result.Status)
+	}
+	if len(result.Deleted) != 1 || result.Deleted[0] != "team-a/ServiceTemplate/minio-14-1-2" {
+		t.Errorf("unexpected deleted resources: %v", result.Deleted)
+	}
+	if len(result.Failures) != 1 || result.Failures[0].Namespace != "team-b" {
+		t.Errorf("unexpected failures: %+v", result.Failures)
+	}
+
+	want := map[string]string{
+		"kcm-system": namespaceOutcomeSkipped,
+		"team-a":     namespaceOutcomeSuccess,
+		"team-b":     namespaceOutcomeFailed,
+		"team-c":     namespaceOutcomeSkipped,
+	}
+	if len(result.Namespaces) != len(want) {
+		t.Fatalf("expected %d namespace outcomes, got %+v", len(want), result.Namespaces)
+	}
+	for _, outcome := range result.Namespaces {
+		if outcome.Outcome != want[outcome.Namespace] {
+			t.Errorf("namespace %s: expected outcome %q, got %q (%s)", outcome.Namespace, want[outcome.Namespace], outcome.Outcome, outcome.Reason)
+		}
+	}
+
+	// Every namespace failing is still an error
+	_, _, err = tool.deleteAcrossNamespaces(context.Background(), "test", []string{"team-b"}, []string{"team-b"}, targets, logger)
+	if err == nil || !apierrors.IsForbidden(errors.Unwrap(err)) {
+		t.Errorf("expected forbidden error when every namespace fails, got %v", err)
+	}
+}
+
+func TestCatalogDeleteTargets_Allowlist(t *testing.T) {
+	manifests := [][]byte{
+		[]byte("apiVersion: k0rdent.mirantis.com/v1alpha1\nkind: ServiceTemplate\nmetadata:\n  name: minio-14-1-2\n"),
+		[]byte("apiVersion: source.toolkit.fluxcd.io/v1\nkind: HelmRepository\nmetadata:\n  name: k0rdent-catalog\n"),
+		[]byte("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: minio-defaults\n"),
+	}
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	targets, err := catalogDeleteTargets(manifests, []string{"ServiceTemplate", "HelmRepository"}, "test", logger)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(targets) != 2 || targets[0].GetAPIVersion() != "k0rdent.mirantis.com/v1beta1" {
+		t.Fatalf("expected ServiceTemplate (converted to v1beta1) and HelmRepository, got %v", targets)
+	}
+
+	targets, err = catalogDeleteTargets(manifests, []string{"ServiceTemplate"}, "test", logger)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(targets) != 1 || targets[0].GetKind() != "ServiceTemplate" {
+		t.Fatalf("expected only the ServiceTemplate, got %v", targets)
+	}
+
+	targets, err = catalogDeleteTargets(manifests, []string{"ServiceTemplate", "HelmRepository", "ConfigMap"}, "test", logger)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(targets) != 3 {
+		t.Fatalf("expected the extended allowlist to include the ConfigMap, got %v", targets)
+	}
+}
@@ -0,0 +1,723 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/k0rdent/mcp-k0rdent-server/internal/clusters"
+	clustermonitor "github.com/k0rdent/mcp-k0rdent-server/internal/kube/cluster_monitor"
+	logsprovider "github.com/k0rdent/mcp-k0rdent-server/internal/kube/logs"
+	"github.com/k0rdent/mcp-k0rdent-server/internal/logging"
+	"github.com/k0rdent/mcp-k0rdent-server/internal/runtime"
+)
+
+const (
+	clusterLogsHost        = "cluster-logs"
+	clusterLogsURITemplate = "k0rdent://cluster-logs/{namespace}/{name}{?grep}"
+	clusterLogsMIMEType    = "application/json"
+
+	// controllerPodMarker identifies kcm and CAPI provider controller pods in the global namespace.
+	controllerPodMarker      = "controller-manager"
+	maxClusterLogControllers = 20
+	defaultClusterLogTail    = 500
+	// Each subscription streams from up to maxClusterLogControllers pods, and every grep
+	// expression is a separate subscription, so subscriptions are capped like cluster monitors.
+	maxClusterLogsPerSession = 10
+	maxClusterLogsGlobal     = 100
+
+	// clusterLogsToolName is the companion tool subscribers use to fetch suppressed lines.
+	clusterLogsToolName = "k0rdent.mgmt.clusterDeployments.logs"
+)
+
+var (
+	globalClusterLogsMu     sync.Mutex
+	globalClusterLogsActive int
+)
+
+// ClusterLogManager streams controller log lines that mention a ClusterDeployment
+// until the cluster reaches a terminal phase.
+type ClusterLogManager struct {
+	mu      sync.Mutex
+	server  *mcp.Server
+	session *runtime.Session
+	streams map[string]*clusterLogSubscription
+}
+
+type clusterLogSubscription struct {
+	namespace  string
+	name       string
+	ctx        context.Context
+	cancel     context.CancelFunc
+	done       chan struct{}
+	lines      <-chan clusterLogLine
+	clusterCh  <-chan clusterDelta
+	clusterErr <-chan error
+	seq        int64
+	// limiter caps published lines; suppressed lines still consume a sequence number
+	limiter *streamLimiter
+}
+
+// controllerContainer is a controller pod container whose logs are searched.
+type controllerContainer struct {
+	Pod       string
+	Container string
+}
+
+// clusterLogLine is a controller log line that mentions the cluster.
+type clusterLogLine struct {
+	Pod       string `json:"pod"`
+	Container string `json:"container"`
+	Line      string `json:"line"`
+}
+
+// NewClusterLogManager returns a manager ready for binding.
+func NewClusterLogManager() *ClusterLogManager {
+	return &ClusterLogManager{streams: make(map[string]*clusterLogSubscription)}
+}
+
+// Bind associates the underlying runtime dependencies.
+func (m *ClusterLogManager) Bind(server *mcp.Server, session *runtime.Session) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.server = server
+	m.session = session
+}
+
+// Subscribe starts streaming controller logs for the requested cluster.
+func (m *ClusterLogManager) Subscribe(ctx context.Context, req *mcp.SubscribeRequest) error {
+	if m == nil {
+		return errors.New("cluster log manager not configured")
+	}
+	namespace, name, grep, uri, err := parseClusterLogsURI(req.Params.URI)
+	if err != nil {
+		return err
+	}
+	ctx = logging.WithNamespace(ctx, namespace)
+	ctx, logger := toolContext(ctx, m.session, "k0rdent.clusterLogs.follow", "tool.cluster-logs")
+	logger = logger.With("cluster", name, "uri", uri)
+	logger.Info("subscribing to cluster log stream")
+
+	if _, err := m.ensureStream(ctx, uri, namespace, name, grep); err != nil {
+		logger.Error("failed to subscribe to cluster logs", "error", err)
+		return err
+	}
+	logger.Info("cluster log stream active")
+	return nil
+}
+
+// Unsubscribe stops the stream for the requested cluster.
+func (m *ClusterLogManager) Unsubscribe(ctx context.Context, req *mcp.UnsubscribeRequest) error {
+	if m == nil {
+		return errors.New("cluster log manager not configured")
+	}
+	namespace, name, _, uri, err := parseClusterLogsURI(req.Params.URI)
+	if err != nil {
+		return err
+	}
+	ctx = logging.WithNamespace(ctx, namespace)
+	_, logger := toolContext(ctx, m.session, "k0rdent.clusterLogs.unfollow", "tool.cluster-logs")
+	logger = logger.With("cluster", name, "uri", uri)
+
+	m.mu.Lock()
+	sub, ok := m.streams[uri]
+	if ok {
+		delete(m.streams, uri)
+	}
+	m.mu.Unlock()
+
+	if !ok {
+		return nil
+	}
+	sub.cancel()
+	<-sub.done
+	logger.Info("cluster log stream terminated")
+	return nil
+}
+
+// EnsureStream starts a stream for the cluster if one is not already running and returns its URI.
+func (m *ClusterLogManager) EnsureStream(ctx context.Context, namespace, name string, grep *regexp.Regexp) (string, error) {
+	uri := buildClusterLogsURI(namespace, name, grep)
+	if _, err := m.ensureStream(ctx, uri, namespace, name, grep); err != nil {
+		return "", err
+	}
+	return uri, nil
+}
+
+// ensureStream starts a stream keyed by uri; when grep is set, only lines that also match it
+// are published. The API calls that start the stream run without holding m.mu.
+func (m *ClusterLogManager) ensureStream(ctx context.Context, uri, namespace, name string, grep *regexp.Regexp) (*clusterLogSubscription, error) {
+	m.mu.Lock()
+	session, server := m.session, m.server
+	if session == nil || session.Logs == nil || server == nil {
+		m.mu.Unlock()
+		return nil, errors.New("cluster log manager not bound to session")
+	}
+	if session.Clients.Kubernetes == nil || session.Clients.Dynamic == nil {
+		m.mu.Unlock()
+		return nil, errors.New("kubernetes clients not configured")
+	}
+	if err := authorizeClusterLogsNamespace(session, namespace); err != nil {
+		m.mu.Unlock()
+		return nil, err
+	}
+	if existing, ok := m.streams[uri]; ok {
+		m.mu.Unlock()
+		return existing, nil
+	}
+	if len(m.streams) >= maxClusterLogsPerSession {
+		m.mu.Unlock()
+		return nil, fmt.Errorf("per-client subscription limit exceeded (max: %d)", maxClusterLogsPerSession)
+	}
+	m.mu.Unlock()
+
+	if !acquireClusterLogsSlot() {
+		return nil, fmt.Errorf("server subscription limit exceeded (max: %d)", maxClusterLogsGlobal)
+	}
+	sub, err := startClusterLogStream(ctx, session, namespace, name, grep)
+	if err != nil {
+		releaseClusterLogsSlot()
+		return nil, err
+	}
+
+	m.mu.Lock()
+	existing, ok := m.streams[uri]
+	if !ok && len(m.streams) >= maxClusterLogsPerSession {
+		m.mu.Unlock()
+		sub.cancel()
+		releaseClusterLogsSlot()
+		return nil, fmt.Errorf("per-client subscription limit exceeded (max: %d)", maxClusterLogsPerSession)
+	}
+	if ok {
+		// A concurrent subscribe for the same URI won the race; share its stream.
+		m.mu.Unlock()
+		sub.cancel()
+		releaseClusterLogsSlot()
+		return existing, nil
+	}
+	m.streams[uri] = sub
+	m.mu.Unlock()
+
+	go m.consume(server, uri, sub)
+	return sub, nil
+}
+
+// startClusterLogStream verifies the ClusterDeployment exists, then starts its watch and a
+// log stream per controller container.
+func startClusterLogStream(ctx context.Context, session *runtime.Session, namespace, name string, grep *regexp.Regexp) (*clusterLogSubscription, error) {
+	if _, err := session.Clients.Dynamic.Resource(clusters.ClusterDeploymentsGVR).Namespace(namespace).Get(ctx, name, metav1.GetOptions{}); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("clusterdeployment %s/%s not found", namespace, name)
+		}
+		return nil, fmt.Errorf("get clusterdeployment: %w", err)
+	}
+
+	targets, err := listControllerContainers(ctx, session.Clients.Kubernetes, session.GlobalNamespace())
+	if err != nil {
+		return nil, err
+	}
+
+	streamCtx, cancel := context.WithTimeout(context.Background(), defaultClusterMonitorTimeout)
+	clusterCh, clusterErr, err := watchClusterDeployment(streamCtx, session.Clients.Dynamic, namespace, name)
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("watch clusterdeployment: %w", err)
+	}
+
+	matcher := newClusterLogMatcher(namespace, name)
+	lines := make(chan clusterLogLine)
+	var readers sync.WaitGroup
+	started := 0
+	for _, target := range targets {
+		stream, errCh, err := session.Logs.Stream(streamCtx, session.GlobalNamespace(), target.Pod, logsprovider.StreamOptions{
+			Options: logsprovider.Options{Container: target.Container, TailLines: logsprovider.ToPointer(0)},
+		})
+		if err != nil {
+			// A controller restarting mid-subscription should not block the others.
+			continue
+		}
+		started++
+		readers.Add(1)
+		go func(target controllerContainer) {
+			defer readers.Done()
+			for line := range stream {
+				if !clusterLogLineSelected(matcher, grep, line) {
+					continue
+				}
+				select {
+				case lines <- clusterLogLine{Pod: target.Pod, Container: target.Container, Line: line}:
+				case <-streamCtx.Done():
+					return
+				}
+			}
+			<-errCh
+		}(target)
+	}
+	if started == 0 {
+		cancel()
+		return nil, fmt.Errorf("could not stream logs from any controller pod in namespace %q", session.GlobalNamespace())
+	}
+	go func() {
+		readers.Wait()
+		close(lines)
+	}()
+
+	return &clusterLogSubscription{
+		namespace:  namespace,
+		name:       name,
+		ctx:        streamCtx,
+		cancel:     cancel,
+		done:       make(chan struct{}),
+		lines:      lines,
+		clusterCh:  clusterCh,
+		clusterErr: clusterErr,
+		limiter:    newStreamLimiter(session.StreamMaxUpdatesPerSecond()),
+	}, nil
+}
+
+// consume forwards matching lines until the cluster reaches a terminal phase, is deleted,
+// or the subscription is cancelled.
+func (m *ClusterLogManager) consume(server *mcp.Server, uri string, sub *clusterLogSubscription) {
+	defer func() {
+		m.mu.Lock()
+		if current, ok := m.streams[uri]; ok && current == sub {
+			delete(m.streams, uri)
+		}
+		m.mu.Unlock()
+		sub.cancel()
+		releaseClusterLogsSlot()
+		close(sub.done)
+	}()
+
+	ctx, lines, clusterCh, clusterErr := sub.ctx, sub.lines, sub.clusterCh, sub.clusterErr
+	ticks, stop := sub.limiter.ticks()
+	defer stop()
+
+	for {
+		select {
+		case <-ticks:
+			flushSuppressedClusterLogs(server, uri, sub)
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				publishClusterLogs(server, uri, map[string]any{"type": "end", "reason": "timeout"})
+			}
+			return
+		case line, ok := <-lines:
+			if !ok {
+				publishClusterLogs(server, uri, map[string]any{"type": "end", "reason": "controller log streams closed"})
+				return
+			}
+			flushSuppressedClusterLogs(server, uri, sub)
+			sub.seq++
+			if !sub.limiter.allow() {
+				continue
+			}
+			publishClusterLogs(server, uri, map[string]any{
+				"type":      "line",
+				"sequence":  sub.seq,
+				"timestamp": time.Now().UTC().Format(time.RFC3339Nano),
+				"pod":       line.Pod,
+				"container": line.Container,
+				"line":      line.Line,
+			})
+		case delta, ok := <-clusterCh:
+			if !ok {
+				clusterCh = nil
+				continue
+			}
+			if delta.Type == watch.Deleted {
+				publishClusterLogs(server, uri, map[string]any{"type": "end", "reason": "cluster deleted"})
+				return
+			}
+			if phase := clustermonitor.DetectPhase(delta.Object, nil); phase == clustermonitor.PhaseReady || phase == clustermonitor.PhaseFailed {
+				publishClusterLogs(server, uri, map[string]any{"type": "end", "reason": "cluster reached terminal phase", "phase": phase})
+				return
+			}
+		case err, ok := <-clusterErr:
+			if ok && err != nil {
+				publishClusterLogs(server, uri, map[string]any{"type": "error", "error": err.Error()})
+			}
+			clusterErr = nil
+		}
+	}
+}
+
+func acquireClusterLogsSlot() bool {
+	globalClusterLogsMu.Lock()
+	defer globalClusterLogsMu.Unlock()
+	if globalClusterLogsActive >= maxClusterLogsGlobal {
+		return false
+	}
+	globalClusterLogsActive++
+	return true
+}
+
+func releaseClusterLogsSlot() {
+	globalClusterLogsMu.Lock()
+	defer globalClusterLogsMu.Unlock()
+	if globalClusterLogsActive > 0 {
+		globalClusterLogsActive--
+	}
+}
+
+// flushSuppressedClusterLogs publishes a summary of the lines dropped by the rate cap: their
+// sequence range and a resume marker for fetching them through the cluster logs tool.
+func flushSuppressedClusterLogs(server *mcp.Server, uri string, sub *clusterLogSubscription) {
+	gap, ok := sub.limiter.flush()
+	if !ok {
+		return
+	}
+	publishClusterLogs(server, uri, map[string]any{
+		"type":         "suppressed",
+		"suppressed":   gap.Suppressed,
+		"fromSequence": sub.seq - int64(gap.Suppressed) + 1,
+		"toSequence":   sub.seq,
+		"resume":       gap.resumeMarker(clusterLogsToolName, time.Now()),
+	})
+}
+
+func publishClusterLogs(server *mcp.Server, uri string, payload map[string]any) {
+	if server == nil {
+		return
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return
+	}
+	_ = server.ResourceUpdated(context.Background(), &mcp.ResourceUpdatedNotificationParams{
+		URI:  uri,
+		Meta: mcp.Meta{"delta": json.RawMessage(data)},
+	})
+}
+
+// listControllerContainers returns the manager container of each running controller pod
+// in namespace, sorted by pod name and capped at maxClusterLogControllers.
+func listControllerContainers(ctx context.Context, client kubernetes.Interface, namespace string) ([]controllerContainer, error) {
+	pods, err := client.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("list controller pods: %w", err)
+	}
+
+	var targets []controllerContainer
+	for _, pod := range pods.Items {
+		if !strings.Contains(pod.Name, controllerPodMarker) || pod.Status.Phase != corev1.PodRunning || len(pod.Spec.Containers) == 0 {
+			continue
+		}
+		container := pod.Spec.Containers[0].Name
+		for _, c := range pod.Spec.Containers {
+			if c.Name == "manager" {
+				container = c.Name
+				break
+			}
+		}
+		targets = append(targets, controllerContainer{Pod: pod.Name, Container: container})
+	}
+	if len(targets) == 0 {
+		return nil, fmt.Errorf("no running controller pods found in namespace %q", namespace)
+	}
+	sort.Slice(targets, func(i, j int) bool { return targets[i].Pod < targets[j].Pod })
+	if len(targets) > maxClusterLogControllers {
+		targets = targets[:maxClusterLogControllers]
+	}
+	return targets, nil
+}
+
+// clusterLogLineSelected reports whether line belongs to the matcher's cluster and, when grep
+// is set, also matches it. Reads, tool calls, and subscriptions share it so the same query
+// returns the same lines from every entry point.
+func clusterLogLineSelected(matcher clusterLogMatcher, grep *regexp.Regexp, line string) bool {
+	return matcher.Matches(line) && (grep == nil || grep.MatchString(line))
+}
+
+// clusterLogMatcher recognizes controller log lines about one ClusterDeployment from the
+// structured fields controllers log: a "namespace/name" reference such as
+// ClusterDeployment="ns/name" or Cluster="ns/name", an object with exact name and namespace
+// keys, or top-level namespace and name keys. Values are compared exactly, so "dev" does not
+// match "dev-2", "devops", or a "dev" cluster in another namespace.
+type clusterLogMatcher struct {
+	namespace string
+	name      string
+	ref       *regexp.Regexp
+	keys      [2]*regexp.Regexp
+}
+
+func newClusterLogMatcher(namespace, name string) clusterLogMatcher {
+	if namespace == "" || name == "" {
+		return clusterLogMatcher{}
+	}
+	return clusterLogMatcher{
+		namespace: namespace,
+		name:      name,
+		ref:       regexp.MustCompile(`(^|[\s"'=:{,\[])` + regexp.QuoteMeta(namespace+"/"+name) + `($|[\s"',}\]])`),
+		keys: [2]*regexp.Regexp{
+			clusterLogKeyPattern("namespace", namespace),
+			clusterLogKeyPattern("name", name),
+		},
+	}
+}
+
+// clusterLogKeyPattern matches key=value or "key":"value" with value bounded by delimiters.
+func clusterLogKeyPattern(key, value string) *regexp.Regexp {
+	return regexp.MustCompile(`(^|[\s{,])"?` + key + `"?\s*[=:]\s*"?` + regexp.QuoteMeta(value) + `"?($|[\s,}])`)
+}
+
+// Matches reports whether line refers to the matcher's cluster.
+func (m clusterLogMatcher) Matches(line string) bool {
+	if m.ref == nil {
+		return false
+	}
+	if m.ref.MatchString(line) {
+		return true
+	}
+	// JSON-encoded fields, either the whole line or the trailing context of a console line.
+	if i := strings.IndexByte(line, '{'); i >= 0 {
+		var fields map[string]any
+		if err := json.Unmarshal([]byte(line[i:]), &fields); err == nil {
+			return m.matchesFields(fields)
+		}
+	}
+	return m.keys[0].MatchString(line) && m.keys[1].MatchString(line)
+}
+
+func (m clusterLogMatcher) matchesFields(fields map[string]any) bool {
+	if m.isObject(fields) {
+		return true
+	}
+	for _, value := range fields {
+		switch v := value.(type) {
+		case string:
+			if v == m.namespace+"/"+m.name {
+				return true
+			}
+		case map[string]any:
+			if m.isObject(v) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (m clusterLogMatcher) isObject(fields map[string]any) bool {
+	namespace, _ := fields["namespace"].(string)
+	name, _ := fields["name"].(string)
+	return namespace == m.namespace && name == m.name
+}
+
+func authorizeClusterLogsNamespace(session *runtime.Session, namespace string) error {
+	if session.IsDevMode() || session.NamespaceFilter == nil {
+		return nil
+	}
+	if !session.NamespaceFilter.MatchString(namespace) {
+		return fmt.Errorf("namespace %q not allowed by session filter", namespace)
+	}
+	return nil
+}
+
+func parseClusterLogsURI(raw string) (namespace, name string, grep *regexp.Regexp, uri string, err error) {
+	parsed, err := url.Parse(raw)
+	if err != nil {
+		return "", "", nil, "", fmt.Errorf("invalid cluster logs URI: %w", err)
+	}
+	if parsed.Scheme != clusterMonitorScheme || !strings.EqualFold(parsed.Host, clusterLogsHost) {
+		return "", "", nil, "", fmt.Errorf("unexpected cluster logs URI %q", raw)
+	}
+	parts := strings.Split(strings.Trim(parsed.Path, "/"), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", nil, "", errors.New("cluster logs URI must be in the form k0rdent://cluster-logs/{namespace}/{name}")
+	}
+	grep, err = compileLogGrep(parsed.Query().Get(logGrepParam))
+	if err != nil {
+		return "", "", nil, "", err
+	}
+	return parts[0], parts[1], grep, buildClusterLogsURI(parts[0], parts[1], grep), nil
+}
+
+func buildClusterLogsURI(namespace, name string, grep *regexp.Regexp) string {
+	uri := fmt.Sprintf("%s://%s/%s/%s", clusterMonitorScheme, clusterLogsHost, namespace, name)
+	if grep != nil {
+		uri += "?" + url.Values{logGrepParam: {grep.String()}}.Encode()
+	}
+	return uri
+}
+
+type clusterLogsTool struct {
+	session *runtime.Session
+	manager *ClusterLogManager
+}
+
+type clusterLogsInput struct {
+	Namespace    string `json:"namespace,omitempty" jsonschema:"ClusterDeployment namespace (defaults to DEFAULT_NAMESPACE, kcm-system unless set)"`
+	Name         string `json:"name" jsonschema:"ClusterDeployment name"`
+	TailLines    *int   `json:"tailLines,omitempty" jsonschema:"Lines to read from the end of each controller log before filtering (default 500)"`
+	SinceSeconds *int64 `json:"sinceSeconds,omitempty" jsonschema:"Only read controller log lines from the last sinceSeconds seconds, e.g. the resume marker of a suppressed summary"`
+	Grep         string `json:"grep,omitempty" jsonschema:"Regular expression; only return lines that also match it, including lines streamed by follow"`
+	Follow       bool   `json:"follow,omitempty" jsonschema:"Start a subscription that streams new matching lines until the cluster is Ready, Failed, or deleted"`
+}
+
+type clusterLogsResult struct {
+	Lines       []clusterLogLine `json:"lines"`
+	Controllers []string         `json:"controllers"`
+	FollowURI   string           `json:"followUri,omitempty"`
+	Following   bool             `json:"following"`
+}
+
+func registerClusterLogs(reg *toolRegistry, session *runtime.Session, manager *ClusterLogManager) error {
+	if session == nil || session.Logs == nil {
+		return errors.New("session log provider is not configured")
+	}
+
+	if manager != nil {
+		manager.Bind(reg.server, session)
+	}
+
+	tool := &clusterLogsTool{session: session, manager: manager}
+	addTool(reg, &mcp.Tool{
+		Name:        clusterLogsToolName,
+		Description: "Get kcm and CAPI controller log lines that mention a ClusterDeployment, for provisioning diagnostics. With follow, returns a k0rdent://cluster-logs/{namespace}/{name} URI that streams new matching lines until the cluster reaches Ready or Failed, or is deleted.",
+		Meta: mcp.Meta{
+			"plane":    "mgmt",
+			"category": "clusterDeployments",
+			"action":   "logs",
+		},
+	}, tool.logs)
+
+	reg.addResourceTemplate(&mcp.ResourceTemplate{
+		Name:        "k0rdent.cluster.logs",
+		Title:       "Cluster provisioning controller logs",
+		Description: "Streaming kcm/CAPI controller log lines mentioning a ClusterDeployment, until it reaches a terminal phase",
+		URITemplate: clusterLogsURITemplate,
+		MIMEType:    clusterLogsMIMEType,
+		Meta: mcp.Meta{
+			resourceQueryParamsMetaKey: []resourceQueryParam{
+				{Name: logGrepParam, Description: "Regular expression; reads and subscriptions only return matching lines"},
+			},
+		},
+	}, func(ctx context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
+		namespace, name, grep, uri, err := parseClusterLogsURI(req.Params.URI)
+		if err != nil {
+			return nil, err
+		}
+		input := clusterLogsInput{Namespace: namespace, Name: name}
+		if grep != nil {
+			input.Grep = grep.String()
+		}
+		_, result, err := tool.logs(ctx, &mcp.CallToolRequest{Params: &mcp.CallToolParamsRaw{Name: clusterLogsToolName}}, input)
+		if err != nil {
+			return nil, err
+		}
+		payload, err := json.Marshal(result)
+		if err != nil {
+			return nil, err
+		}
+		return &mcp.ReadResourceResult{
+			Contents: []*mcp.ResourceContents{{
+				URI:      uri,
+				MIMEType: clusterLogsMIMEType,
+				Blob:     payload,
+			}},
+		}, nil
+	})
+
+	return nil
+}
+
+func (t *clusterLogsTool) logs(ctx context.Context, req *mcp.CallToolRequest, input clusterLogsInput) (*mcp.CallToolResult, clusterLogsResult, error) {
+	clusterName := strings.TrimSpace(input.Name)
+	if clusterName == "" {
+		return nil, clusterLogsResult{}, errors.New("cluster name is required")
+	}
+	namespace := strings.TrimSpace(input.Namespace)
+	if namespace == "" {
+		namespace = t.session.DefaultNamespace()
+	}
+	if err := authorizeClusterLogsNamespace(t.session, namespace); err != nil {
+		return nil, clusterLogsResult{}, err
+	}
+	grep, err := compileLogGrep(input.Grep)
+	if err != nil {
+		return nil, clusterLogsResult{}, err
+	}
+
+	name := toolName(req)
+	ctx = logging.WithNamespace(ctx, namespace)
+	ctx, logger := toolContext(ctx, t.session, name, "tool.cluster-logs")
+	logger = logger.With("cluster", clusterName, "follow", input.Follow)
+	start := time.Now()
+
+	if _, err := t.session.Clients.Dynamic.Resource(clusters.ClusterDeploymentsGVR).Namespace(namespace).Get(ctx, clusterName, metav1.GetOptions{}); err != nil {
+		logger.Error("failed to get cluster deployment", "tool", name, "error", err)
+		return nil, clusterLogsResult{}, fmt.Errorf("get clusterdeployment: %w", err)
+	}
+
+	targets, err := listControllerContainers(ctx, t.session.Clients.Kubernetes, t.session.GlobalNamespace())
+	if err != nil {
+		logger.Error("failed to list controller pods", "tool", name, "error", err)
+		return nil, clusterLogsResult{}, err
+	}
+
+	tail := defaultClusterLogTail
+	if input.TailLines != nil && *input.TailLines > 0 {
+		tail = *input.TailLines
+	}
+
+	matcher := newClusterLogMatcher(namespace, clusterName)
+	result := clusterLogsResult{Lines: []clusterLogLine{}, Controllers: make([]string, 0, len(targets))}
+	for _, target := range targets {
+		logs, err := t.session.Logs.Get(ctx, t.session.GlobalNamespace(), target.Pod, logsprovider.Options{
+			Container:    target.Container,
+			TailLines:    logsprovider.ToPointer(tail),
+			SinceSeconds: input.SinceSeconds,
+		})
+		if err != nil {
+			logger.Warn("failed to read controller logs", "tool", name, "pod", target.Pod, "error", err)
+			continue
+		}
+		result.Controllers = append(result.Controllers, target.Pod+"/"+target.Container)
+		for _, line := range strings.Split(logs, "\n") {
+			if clusterLogLineSelected(matcher, grep, line) {
+				result.Lines = append(result.Lines, clusterLogLine{Pod: target.Pod, Container: target.Container, Line: line})
+			}
+		}
+	}
+
+	if input.Follow {
+		if t.manager == nil {
+			return nil, clusterLogsResult{}, errors.New("follow not available")
+		}
+		followURI, err := t.manager.EnsureStream(ctx, namespace, clusterName, grep)
+		if err != nil {
+			logger.Error("failed to start cluster log stream", "tool", name, "error", err)
+			return nil, clusterLogsResult{}, err
+		}
+		result.FollowURI = followURI
+		result.Following = true
+	}
+
+	logger.Info("cluster controller logs retrieved",
+		"tool", name,
+		"controllers", len(result.Controllers),
+		"lines", len(result.Lines),
+		"following", result.Following,
+		"duration_ms", time.Since(start).Milliseconds(),
+	)
+
+	return nil, result, nil
+}
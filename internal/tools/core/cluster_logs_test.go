@@ -0,0 +1,287 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	apiruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/rest"
+	fakerest "k8s.io/client-go/rest/fake"
+
+	"github.com/k0rdent/mcp-k0rdent-server/internal/clusters"
+	logsprovider "github.com/k0rdent/mcp-k0rdent-server/internal/kube/logs"
+	"github.com/k0rdent/mcp-k0rdent-server/internal/runtime"
+	testdynamic "github.com/k0rdent/mcp-k0rdent-server/internal/testutil/dynamic"
+)
+
+func TestParseClusterLogsURI(t *testing.T) {
+	namespace, name, grep, uri, err := parseClusterLogsURI("k0rdent://cluster-logs/team-a/demo/")
+	require.NoError(t, err)
+	require.Equal(t, "team-a", namespace)
+	require.Equal(t, "demo", name)
+	require.Nil(t, grep)
+	require.Equal(t, "k0rdent://cluster-logs/team-a/demo", uri)
+
+	_, _, _, _, err = parseClusterLogsURI("k0rdent://cluster-logs/team-a")
+	require.Error(t, err)
+	_, _, _, _, err = parseClusterLogsURI("k0rdent://podlogs/team-a/demo")
+	require.Error(t, err)
+}
+
+func TestParseClusterLogsURIGrep(t *testing.T) {
+	_, _, grep, uri, err := parseClusterLogsURI("k0rdent://cluster-logs/team-a/demo?grep=error%7Cfailed")
+	require.NoError(t, err)
+	require.NotNil(t, grep)
+	require.True(t, grep.MatchString("reconcile failed"))
+	require.False(t, grep.MatchString("reconcile succeeded"))
+	require.Equal(t, "k0rdent://cluster-logs/team-a/demo?grep=error%7Cfailed", uri)
+
+	_, _, _, _, err = parseClusterLogsURI("k0rdent://cluster-logs/team-a/demo?grep=%28unclosed")
+	require.ErrorContains(t, err, "invalid grep regular expression")
+}
+
+func TestListControllerContainers(t *testing.T) {
+	pod := func(name string, phase corev1.PodPhase, containers ...string) *corev1.Pod {
+		p := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "kcm-system"},
+			Status:     corev1.PodStatus{Phase: phase},
+		}
+		for _, c := range containers {
+			p.Spec.Containers = append(p.Spec.Containers, corev1.Container{Name: c})
+		}
+		return p
+	}
+	client := kubefake.NewSimpleClientset(
+		pod("kcm-controller-manager-abc", corev1.PodRunning, "kube-rbac-proxy", "manager"),
+		pod("capa-controller-manager-def", corev1.PodRunning, "controller"),
+		pod("capz-controller-manager-old", corev1.PodPending, "manager"),
+		pod("kcm-velero-123", corev1.PodRunning, "velero"),
+	)
+
+	targets, err := listControllerContainers(context.Background(), client, "kcm-system")
+	require.NoError(t, err)
+	require.Equal(t, []controllerContainer{
+		{Pod: "capa-controller-manager-def", Container: "controller"},
+		{Pod: "kcm-controller-manager-abc", Container: "manager"},
+	}, targets)
+
+	_, err = listControllerContainers(context.Background(), client, "empty")
+	require.Error(t, err)
+}
+
+func TestClusterLogMatcher(t *testing.T) {
+	matcher := newClusterLogMatcher("team-a", "dev")
+
+	require.True(t, matcher.Matches(`I0101 reconciler.go:120] "Reconciling" ClusterDeployment="team-a/dev"`))
+	require.True(t, matcher.Matches(`I0101 machine.go:88] "Machine ready" Machine="team-a/dev-md-x7k2" Cluster="team-a/dev"`))
+	require.True(t, matcher.Matches(`"Reconciling" controller="clusterdeployment" ClusterDeployment={"name":"dev","namespace":"team-a"} namespace="team-a" name="dev"`))
+	require.True(t, matcher.Matches(`{"level":"info","msg":"Reconciling","ClusterDeployment":{"name":"dev","namespace":"team-a"},"namespace":"team-a","name":"dev"}`))
+	require.True(t, matcher.Matches("2024-01-01T00:00:00Z\tINFO\tReconciling\t{\"controller\": \"clusterdeployment\", \"namespace\": \"team-a\", \"name\": \"dev\"}"))
+
+	require.False(t, matcher.Matches(`machine dev-cp-x7k2 ready`))
+	require.False(t, matcher.Matches(`"Reconciling ClusterDeployment" name="dev"`))
+	require.False(t, matcher.Matches(`anything`))
+	require.False(t, newClusterLogMatcher("team-a", "").Matches(`ClusterDeployment="team-a/"`))
+}
+
+func TestClusterLogMatcherPrefixCollisions(t *testing.T) {
+	matcher := newClusterLogMatcher("team-a", "dev")
+
+	for _, other := range []string{"dev-2", "devops"} {
+		require.False(t, matcher.Matches(`"Reconciling" ClusterDeployment="team-a/`+other+`"`), other)
+		require.False(t, matcher.Matches(`"Machine ready" Machine="team-a/dev-md-1" Cluster="team-a/`+other+`"`), other)
+		require.False(t, matcher.Matches(`"Reconciling" namespace="team-a" name="`+other+`"`), other)
+		require.False(t, matcher.Matches(`{"msg":"Reconciling","ClusterDeployment":{"name":"`+other+`","namespace":"team-a"},"namespace":"team-a","name":"`+other+`"}`), other)
+	}
+}
+
+func TestClusterLogMatcherSameNameOtherNamespace(t *testing.T) {
+	teamA := newClusterLogMatcher("team-a", "dev")
+	teamB := newClusterLogMatcher("team-b", "dev")
+
+	lines := []string{
+		`"Reconciling" ClusterDeployment="team-b/dev"`,
+		`"Reconciling" ClusterDeployment={"name":"dev","namespace":"team-b"} namespace="team-b" name="dev"`,
+		`{"msg":"Reconciling","ClusterDeployment":{"name":"dev","namespace":"team-b"},"namespace":"team-b","name":"dev"}`,
+	}
+	for _, line := range lines {
+		require.False(t, teamA.Matches(line), line)
+		require.True(t, teamB.Matches(line), line)
+	}
+	require.False(t, teamA.Matches(`"Reconciling" ClusterDeployment="other-team-a/dev"`))
+}
+
+func TestClusterLogManagerSubscriptionLimits(t *testing.T) {
+	kubeClient := kubefake.NewSimpleClientset()
+	logs, err := logsprovider.NewProvider(kubeClient)
+	require.NoError(t, err)
+	manager := NewClusterLogManager()
+	manager.Bind(mcp.NewServer(&mcp.Implementation{Name: "test", Version: "v0.0.0"}, nil), &runtime.Session{
+		Logs:    logs,
+		Clients: runtime.Clients{Kubernetes: kubeClient, Dynamic: testdynamic.NewFakeDynamicClient()},
+	})
+
+	existing := &clusterLogSubscription{namespace: "team-a", name: "demo"}
+	manager.streams[buildClusterLogsURI("team-a", "demo", nil)] = existing
+	for i := 1; i < maxClusterLogsPerSession; i++ {
+		manager.streams[buildClusterLogsURI("team-a", fmt.Sprintf("demo-%d", i), nil)] = &clusterLogSubscription{}
+	}
+
+	sub, err := manager.ensureStream(context.Background(), buildClusterLogsURI("team-a", "demo", nil), "team-a", "demo", nil)
+	require.NoError(t, err)
+	require.Same(t, existing, sub)
+
+	_, err = manager.ensureStream(context.Background(), buildClusterLogsURI("team-a", "other", nil), "team-a", "other", nil)
+	require.ErrorContains(t, err, "per-client subscription limit exceeded")
+
+	globalClusterLogsMu.Lock()
+	saved := globalClusterLogsActive
+	globalClusterLogsActive = maxClusterLogsGlobal
+	globalClusterLogsMu.Unlock()
+	t.Cleanup(func() {
+		globalClusterLogsMu.Lock()
+		globalClusterLogsActive = saved
+		globalClusterLogsMu.Unlock()
+	})
+	delete(manager.streams, buildClusterLogsURI("team-a", "demo-1", nil))
+	_, err = manager.ensureStream(context.Background(), buildClusterLogsURI("team-a", "other", nil), "team-a", "other", nil)
+	require.ErrorContains(t, err, "server subscription limit exceeded")
+}
+
+func TestClusterLogConsumeAppliesRateLimit(t *testing.T) {
+	m := NewClusterLogManager()
+	limiter := newStreamLimiter(2)
+	clock := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	limiter.now = func() time.Time { return clock }
+	lines := make(chan clusterLogLine, 5)
+	for i := 0; i < 5; i++ {
+		lines <- clusterLogLine{Pod: "kcm-controller-manager-abc", Container: "manager", Line: "line"}
+	}
+	close(lines)
+	ctx, cancel := context.WithCancel(context.Background())
+	sub := &clusterLogSubscription{ctx: ctx, cancel: cancel, done: make(chan struct{}), lines: lines, limiter: limiter}
+
+	m.consume(nil, "k0rdent://cluster-logs/team-a/demo", sub)
+	require.Equal(t, int64(5), sub.seq, "suppressed lines still consume sequence numbers")
+	require.Equal(t, 3, limiter.suppressed)
+}
+
+// controllerLogsClient serves fixed log bodies from GetLogs, which the fake clientset
+// otherwise answers with "fake logs".
+type controllerLogsClient struct {
+	kubernetes.Interface
+	logs string
+}
+
+func (c controllerLogsClient) CoreV1() typedcorev1.CoreV1Interface {
+	return controllerLogsCoreV1{CoreV1Interface: c.Interface.CoreV1(), logs: c.logs}
+}
+
+type controllerLogsCoreV1 struct {
+	typedcorev1.CoreV1Interface
+	logs string
+}
+
+func (c controllerLogsCoreV1) Pods(namespace string) typedcorev1.PodInterface {
+	return controllerLogsPods{PodInterface: c.CoreV1Interface.Pods(namespace), logs: c.logs}
+}
+
+type controllerLogsPods struct {
+	typedcorev1.PodInterface
+	logs string
+}
+
+func (p controllerLogsPods) GetLogs(name string, opts *corev1.PodLogOptions) *rest.Request {
+	client := &fakerest.RESTClient{
+		Client: fakerest.CreateHTTPClient(func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(p.logs))}, nil
+		}),
+		NegotiatedSerializer: scheme.Codecs.WithoutConversion(),
+		GroupVersion:         corev1.SchemeGroupVersion,
+	}
+	return client.Request()
+}
+
+func newClusterLogsTestSession(t *testing.T) *runtime.Session {
+	t.Helper()
+	controller := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "kcm-controller-manager-abc", Namespace: "kcm-system"},
+		Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "manager"}}},
+		Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+	kubeClient := controllerLogsClient{Interface: kubefake.NewSimpleClientset(controller), logs: strings.Join([]string{
+		`I0101 reconciler.go:120] "Reconciling" ClusterDeployment="team-a/demo"`,
+		`E0101 reconciler.go:140] "Reconcile failed" ClusterDeployment="team-a/demo"`,
+		`E0101 reconciler.go:140] "Reconcile failed" ClusterDeployment="team-a/other"`,
+	}, "\n")}
+	logs, err := logsprovider.NewProvider(kubeClient)
+	require.NoError(t, err)
+	cluster := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "k0rdent.mirantis.com/v1beta1",
+		"kind":       "ClusterDeployment",
+		"metadata":   map[string]any{"name": "demo", "namespace": "team-a"},
+	}}
+	return &runtime.Session{
+		Logs: logs,
+		Clients: runtime.Clients{Kubernetes: kubeClient, Dynamic: dynamicfake.NewSimpleDynamicClientWithCustomListKinds(apiruntime.NewScheme(), map[schema.GroupVersionResource]string{
+			clusters.ClusterDeploymentsGVR: "ClusterDeploymentList",
+		}, cluster)},
+	}
+}
+
+func TestClusterLogsToolAppliesGrep(t *testing.T) {
+	tool := &clusterLogsTool{session: newClusterLogsTestSession(t)}
+	req := &mcp.CallToolRequest{Params: &mcp.CallToolParamsRaw{Name: clusterLogsToolName}}
+
+	_, result, err := tool.logs(context.Background(), req, clusterLogsInput{Namespace: "team-a", Name: "demo"})
+	require.NoError(t, err)
+	require.Len(t, result.Lines, 2)
+
+	_, result, err = tool.logs(context.Background(), req, clusterLogsInput{Namespace: "team-a", Name: "demo", Grep: "failed"})
+	require.NoError(t, err)
+	require.Len(t, result.Lines, 1)
+	require.Contains(t, result.Lines[0].Line, `"Reconcile failed" ClusterDeployment="team-a/demo"`)
+
+	_, _, err = tool.logs(context.Background(), req, clusterLogsInput{Namespace: "team-a", Name: "demo", Grep: "(unclosed"})
+	require.ErrorContains(t, err, "invalid grep regular expression")
+}
+
+func TestClusterLogsResourceReadAppliesGrep(t *testing.T) {
+	server := mcp.NewServer(&mcp.Implementation{Name: "test", Version: "v0.0.0"}, nil)
+	reg := newToolRegistry(server)
+	require.NoError(t, registerClusterLogs(reg, newClusterLogsTestSession(t), nil))
+
+	ctx := context.Background()
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	serverSession, err := server.Connect(ctx, serverTransport, nil)
+	require.NoError(t, err)
+	defer serverSession.Close()
+	clientSession, err := mcp.NewClient(&mcp.Implementation{Name: "test-client", Version: "v0.0.0"}, nil).Connect(ctx, clientTransport, nil)
+	require.NoError(t, err)
+	defer clientSession.Close()
+
+	read, err := clientSession.ReadResource(ctx, &mcp.ReadResourceParams{URI: "k0rdent://cluster-logs/team-a/demo?grep=failed"})
+	require.NoError(t, err)
+	require.Len(t, read.Contents, 1)
+	var result clusterLogsResult
+	require.NoError(t, json.Unmarshal(read.Contents[0].Blob, &result))
+	require.Len(t, result.Lines, 1)
+	require.Contains(t, result.Lines[0].Line, `"Reconcile failed" ClusterDeployment="team-a/demo"`)
+}
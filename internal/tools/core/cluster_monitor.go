@@ -31,6 +31,7 @@ const (
 	clusterMonitorScheme      = "k0rdent"
 	clusterMonitorHost        = "cluster-monitor"
 	clusterMonitorURITemplate = "k0rdent://cluster-monitor/{namespace}/{name}"
+	clusterMonitorWildcard    = "*"
 	clusterMonitorMIMEType    = "application/json"
 
 	defaultClusterMonitorTimeout = time.Hour
@@ -39,6 +40,11 @@ const (
 	maxClusterMonitorGlobal      = 100
 	recentEventLimit             = 50
 	eventRetentionWindow         = 2 * time.Minute
+	// maxEventWatchRestarts bounds consecutive event watch restarts without receiving an event.
+	maxEventWatchRestarts = 5
+	// maxClusterWatchRestarts bounds consecutive ClusterDeployment watch restarts without
+	// receiving an event or bookmark.
+	maxClusterWatchRestarts = 5
 )
 
 var (
@@ -53,6 +59,7 @@ type ClusterMonitorManager struct {
 	session       *runtime.Session
 	subscriptions map[string]*clusterSubscription
 	clock         func() time.Time
+	watchHub      *ClusterWatchHub
 }
 
 type clusterSubscription struct {
@@ -68,14 +75,31 @@ type clusterSubscription struct {
 	eventFilter  *clustermonitor.EventFilter
 	recentEvents []eventsprovider.Event
 
+	// watchCtx scopes the subscription's watches. eventResourceVersion is the last event
+	// version seen, so a restarted event watch resumes after it instead of replaying
+	// events that were already published.
+	watchCtx             context.Context
+	eventResourceVersion string
+	eventWatchExpired    bool
+	eventWatchRestarts   int
+
 	currentPhase clustermonitor.ProvisioningPhase
 	lastMessage  string
 	lastReason   string
+	// conditions is the bounded condition history attached to single-cluster updates.
+	conditions clustermonitor.ConditionHistory
+
+	// fleet subscriptions watch every ClusterDeployment matching the target and
+	// publish per-cluster phase changes; phases tracks the last phase per cluster.
+	fleet  bool
+	phases map[string]clustermonitor.ProvisioningPhase
 
 	timeout       time.Duration
 	deadline      time.Time
 	timeoutWarned bool
-	logger        *slog.Logger
+	// summary is the final system message, recorded once it has been published.
+	summary string
+	logger  *slog.Logger
 }
 
 type clusterDelta struct {
@@ -89,6 +113,12 @@ type clusterMonitorTarget struct {
 	Timeout   time.Duration
 }
 
+// IsFleet reports whether the target selects all clusters (name "*") in a namespace,
+// or in every allowed namespace when the namespace is also "*".
+func (t clusterMonitorTarget) IsFleet() bool {
+	return t.Name == clusterMonitorWildcard
+}
+
 type clusterMonitorTool struct {
 	session *runtime.Session
 }
@@ -121,6 +151,17 @@ func (m *ClusterMonitorManager) Bind(server *mcp.Server, session *runtime.Sessio
 	m.session = session
 }
 
+// UseSharedWatches makes single-cluster subscriptions join watches shared through hub
+// instead of opening their own ClusterDeployment watch.
+func (m *ClusterMonitorManager) UseSharedWatches(hub *ClusterWatchHub) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.watchHub = hub
+}
+
 // Subscribe creates (or reuses) a monitoring stream for the requested cluster.
 func (m *ClusterMonitorManager) Subscribe(ctx context.Context, req *mcp.SubscribeRequest) error {
 	if m == nil {
@@ -130,8 +171,13 @@ func (m *ClusterMonitorManager) Subscribe(ctx context.Context, req *mcp.Subscrib
 	if err != nil {
 		return err
 	}
-	if err := m.authorizeNamespace(target.Namespace); err != nil {
-		return err
+	// An all-namespaces fleet subscription filters clusters per namespace instead.
+	if target.Namespace != clusterMonitorWildcard {
+		if err := m.authorizeNamespace(target.Namespace); err != nil {
+			return err
+		}
+	} else if m.session == nil {
+		return errors.New("session not bound")
 	}
 
 	ctx = logging.WithNamespace(ctx, target.Namespace)
@@ -211,6 +257,9 @@ func (m *ClusterMonitorManager) Unsubscribe(ctx context.Context, req *mcp.Unsubs
 }
 
 func (m *ClusterMonitorManager) newSubscription(ctx context.Context, uri string, target clusterMonitorTarget, logger *slog.Logger) (*clusterSubscription, error) {
+	if target.IsFleet() {
+		return m.newFleetSubscription(ctx, uri, target, logger)
+	}
 	session := m.session
 	client := session.Clients.Dynamic.Resource(clusters.ClusterDeploymentsGVR).Namespace(target.Namespace)
 	obj, err := client.Get(ctx, target.Name, v1.GetOptions{})
@@ -226,13 +275,24 @@ func (m *ClusterMonitorManager) newSubscription(ctx context.Context, uri string,
 		timeout = defaultClusterMonitorTimeout
 	}
 
-	watchCtx, cancel := context.WithCancel(context.Background())
-	clusterCh, clusterErr, err := watchClusterDeployment(watchCtx, session.Clients.Dynamic, target.Namespace, target.Name)
+	watchCtx, cancelWatch := context.WithCancel(context.Background())
+	clusterCh, clusterErr, release, err := m.watchCluster(watchCtx, target.Namespace, target.Name)
 	if err != nil {
-		cancel()
+		cancelWatch()
 		return nil, fmt.Errorf("watch clusterdeployment: %w", err)
 	}
-	eventCh, eventErr, err := session.Events.WatchNamespace(watchCtx, target.Namespace, eventsprovider.WatchOptions{})
+	// Cancel before releasing so the run loop sees the shared channels close as a stop.
+	cancel := func() {
+		cancelWatch()
+		release()
+	}
+
+	// List before watching so the watch starts after the snapshot instead of replaying it.
+	events, eventsVersion, listErr := session.Events.ListWithResourceVersion(ctx, target.Namespace, eventsprovider.ListOptions{})
+	if listErr != nil {
+		logger.Warn("failed to list namespace events for snapshot", "error", listErr)
+	}
+	eventCh, eventErr, err := session.Events.WatchNamespace(watchCtx, target.Namespace, eventsprovider.WatchOptions{ResourceVersion: eventsVersion})
 	if err != nil {
 		cancel()
 		return nil, fmt.Errorf("watch namespace events: %w", err)
@@ -254,15 +314,72 @@ func (m *ClusterMonitorManager) newSubscription(ctx context.Context, uri string,
 		timeout:      timeout,
 		deadline:     m.clock().Add(timeout),
 		logger:       logger,
+
+		watchCtx:             watchCtx,
+		eventResourceVersion: eventsVersion,
 	}
 	sub.eventFilter.WithClock(m.clock)
 
 	// Emit initial snapshot immediately.
 	m.processClusterDelta(sub, clusterDelta{Object: obj.DeepCopy(), Type: watch.Added})
-	m.publishRecentEventsSnapshot(ctx, sub)
+	m.publishRecentEventsSnapshot(sub, events)
 	return sub, nil
 }
 
+// watchCluster opens the ClusterDeployment watch for a single-cluster subscription, joining
+// the shared watch when a hub is configured. release drops the subscription's interest.
+func (m *ClusterMonitorManager) watchCluster(ctx context.Context, namespace, name string) (<-chan clusterDelta, <-chan error, func(), error) {
+	m.mu.Lock()
+	hub := m.watchHub
+	m.mu.Unlock()
+	if hub != nil {
+		return hub.Subscribe(m.session.Clients.Dynamic, namespace, name)
+	}
+	clusterCh, clusterErr, err := watchClusterDeployment(ctx, m.session.Clients.Dynamic, namespace, name)
+	return clusterCh, clusterErr, func() {}, err
+}
+
+// newFleetSubscription watches all ClusterDeployments in the target namespace, or in every
+// allowed namespace for "*". A fleet subscription counts as one slot however many watches
+// it opens.
+func (m *ClusterMonitorManager) newFleetSubscription(ctx context.Context, uri string, target clusterMonitorTarget, logger *slog.Logger) (*clusterSubscription, error) {
+	timeout := target.Timeout
+	if timeout <= 0 {
+		timeout = defaultClusterMonitorTimeout
+	}
+
+	watchCtx, cancel := context.WithCancel(context.Background())
+	var clusterCh <-chan clusterDelta
+	var clusterErr <-chan error
+	var err error
+	if target.Namespace == clusterMonitorWildcard {
+		clusterCh, clusterErr, err = watchAllowedClusterDeployments(ctx, watchCtx, m.session, logger)
+	} else {
+		clusterCh, clusterErr, err = watchClusterDeployment(watchCtx, m.session.Clients.Dynamic, target.Namespace, "")
+	}
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("watch clusterdeployments: %w", err)
+	}
+
+	return &clusterSubscription{
+		namespace:    target.Namespace,
+		name:         target.Name,
+		uri:          uri,
+		cancel:       cancel,
+		done:         make(chan struct{}),
+		clusterCh:    clusterCh,
+		clusterErr:   clusterErr,
+		currentPhase: clustermonitor.PhaseUnknown,
+		fleet:        true,
+		phases:       make(map[string]clustermonitor.ProvisioningPhase),
+		timeout:      timeout,
+		deadline:     m.clock().Add(timeout),
+		logger:       logger,
+		watchCtx:     watchCtx,
+	}, nil
+}
+
 func (m *ClusterMonitorManager) runSubscription(sub *clusterSubscription) {
 	defer func() {
 		releaseClusterMonitorSlot()
@@ -274,14 +391,30 @@ func (m *ClusterMonitorManager) runSubscription(sub *clusterSubscription) {
 	ticker := time.NewTicker(30 * time.Second)
 	defer ticker.Stop()
 
+	// stopped fires when the subscription is cancelled by Unsubscribe or a terminal event.
+	var stopped <-chan struct{}
+	if sub.watchCtx != nil {
+		stopped = sub.watchCtx.Done()
+	}
+
 	for {
 		select {
+		case <-stopped:
+			return
 		case delta, ok := <-sub.clusterCh:
 			if !ok {
+				if sub.watchCtx != nil && sub.watchCtx.Err() != nil {
+					return
+				}
 				m.publishSystemMessage(sub, clustermonitor.SeverityWarning, "Cluster watch closed", true)
 				return
 			}
+			if sub.fleet {
+				m.processFleetDelta(sub, delta)
+				continue
+			}
 			if m.processClusterDelta(sub, delta) {
+				m.publishMonitoringComplete(sub, delta.Type == watch.Deleted)
 				return
 			}
 		case err, ok := <-sub.clusterErr:
@@ -291,13 +424,17 @@ func (m *ClusterMonitorManager) runSubscription(sub *clusterSubscription) {
 			return
 		case delta, ok := <-sub.eventCh:
 			if !ok {
-				sub.eventCh = nil
+				m.restartEventWatch(sub)
 				continue
 			}
+			if delta.Event.ResourceVersion != "" {
+				sub.eventResourceVersion = delta.Event.ResourceVersion
+			}
+			sub.eventWatchRestarts = 0
 			m.handleEventDelta(sub, delta.Event)
 		case err, ok := <-sub.eventErr:
-			if ok && err != nil {
-				m.publishSystemMessage(sub, clustermonitor.SeverityWarning, fmt.Sprintf("Event watch error: %v", err), false)
+			if ok {
+				sub.noteEventWatchError(err)
 			}
 			sub.eventErr = nil
 		case <-ticker.C:
@@ -314,6 +451,8 @@ func (m *ClusterMonitorManager) processClusterDelta(sub *clusterSubscription, de
 	}
 	update := buildClusterProgress(delta.Object, sub.recentEvents)
 	update.Timestamp = m.clock().UTC()
+	conditionsChanged := sub.conditions.Observe(update.Conditions, update.Timestamp)
+	update.ConditionTransitions = sub.conditions.Transitions()
 
 	if delta.Type == watch.Deleted {
 		update.Terminal = true
@@ -331,7 +470,7 @@ func (m *ClusterMonitorManager) processClusterDelta(sub *clusterSubscription, de
 		update.Message = fmt.Sprintf("Cluster phase: %s", update.Phase)
 	}
 
-	if m.shouldPublishClusterUpdate(sub, update, phaseChanged) {
+	if m.shouldPublishClusterUpdate(sub, update, phaseChanged || conditionsChanged) {
 		m.publishUpdate(sub.uri, update)
 		sub.lastMessage = update.Message
 		sub.lastReason = update.Reason
@@ -340,14 +479,65 @@ func (m *ClusterMonitorManager) processClusterDelta(sub *clusterSubscription, de
 	return update.Terminal
 }
 
-func (m *ClusterMonitorManager) shouldPublishClusterUpdate(sub *clusterSubscription, update clustermonitor.ProgressUpdate, phaseChanged bool) bool {
+// processFleetDelta publishes an update when a watched cluster first appears, changes
+// phase, or is deleted. Clusters in namespaces outside the session filter are skipped.
+// It reports whether an update was published.
+func (m *ClusterMonitorManager) processFleetDelta(sub *clusterSubscription, delta clusterDelta) bool {
+	update, publish := m.fleetUpdate(sub, delta)
+	if publish {
+		m.publishUpdate(sub.uri, update)
+	}
+	return publish
+}
+
+// fleetUpdate records the cluster's phase and returns the update processFleetDelta publishes.
+// A cluster reaching Ready or Failed or being deleted (PhaseDeleted) is not Terminal; Terminal
+// stays reserved for the end of the subscription, since the rest of the fleet is still watched.
+func (m *ClusterMonitorManager) fleetUpdate(sub *clusterSubscription, delta clusterDelta) (clustermonitor.ProgressUpdate, bool) {
+	if delta.Object == nil {
+		return clustermonitor.ProgressUpdate{}, false
+	}
+	if sub.namespace == clusterMonitorWildcard && m.authorizeNamespace(delta.Object.GetNamespace()) != nil {
+		return clustermonitor.ProgressUpdate{}, false
+	}
+
+	key := subscriptionKey(delta.Object.GetNamespace(), delta.Object.GetName())
+	update := buildClusterProgress(delta.Object, nil)
+	update.Timestamp = m.clock().UTC()
+	update.Terminal = false
+	update.RelatedObject = &clustermonitor.ObjectReference{
+		Kind:      "ClusterDeployment",
+		Name:      delta.Object.GetName(),
+		Namespace: delta.Object.GetNamespace(),
+		UID:       string(delta.Object.GetUID()),
+	}
+
+	if delta.Type == watch.Deleted {
+		delete(sub.phases, key)
+		update.Phase = clustermonitor.PhaseDeleted
+		update.Severity = clustermonitor.SeverityWarning
+		update.Message = fmt.Sprintf("Cluster %s deleted", key)
+		update.Source = clustermonitor.SourceSystem
+		return update, true
+	}
+
+	if previous, seen := sub.phases[key]; seen && previous == update.Phase {
+		return clustermonitor.ProgressUpdate{}, false
+	}
+	sub.phases[key] = update.Phase
+	return update, true
+}
+
+// shouldPublishClusterUpdate reports whether a cluster update differs enough from the last
+// published one; changed is set when the phase or a condition status changed.
+func (m *ClusterMonitorManager) shouldPublishClusterUpdate(sub *clusterSubscription, update clustermonitor.ProgressUpdate, changed bool) bool {
 	if sub.lastMessage == "" && sub.lastReason == "" {
 		return true
 	}
 	if update.Terminal {
 		return true
 	}
-	if phaseChanged {
+	if changed {
 		return true
 	}
 	if update.Message != "" && update.Message != sub.lastMessage {
@@ -368,6 +558,7 @@ func (m *ClusterMonitorManager) handleEventDelta(sub *clusterSubscription, event
 			if update.Timestamp.IsZero() {
 				update.Timestamp = now.UTC()
 			}
+			update.ConditionTransitions = sub.conditions.Transitions()
 			m.publishUpdate(sub.uri, update)
 			if update.Phase != clustermonitor.PhaseUnknown && update.Phase != sub.currentPhase {
 				sub.currentPhase = update.Phase
@@ -413,17 +604,87 @@ func (m *ClusterMonitorManager) publishSystemMessage(sub *clusterSubscription, s
 	m.publishUpdate(sub.uri, update)
 }
 
-func (m *ClusterMonitorManager) publishRecentEventsSnapshot(ctx context.Context, sub *clusterSubscription) {
-	if m.session == nil || m.session.Events == nil {
+// publishMonitoringComplete sends the final system message of a subscription that ended
+// because its cluster reached a terminal phase or was deleted, so clients can close the
+// stream deterministically. A deleted cluster never finished provisioning, so it gets an
+// aborted summary instead of "Monitoring complete". It publishes at most once per subscription.
+func (m *ClusterMonitorManager) publishMonitoringComplete(sub *clusterSubscription, deleted bool) {
+	if sub.summary != "" {
 		return
 	}
-	events, err := m.session.Events.List(ctx, sub.namespace, eventsprovider.ListOptions{})
-	if err != nil {
-		if sub.logger != nil {
-			sub.logger.Warn("failed to list namespace events for snapshot", "error", err)
+	severity := clustermonitor.SeverityInfo
+	sub.summary = fmt.Sprintf("Monitoring complete: %s", sub.currentPhase)
+	if deleted {
+		severity = clustermonitor.SeverityWarning
+		sub.summary = fmt.Sprintf("Monitoring aborted: cluster %s was deleted (last phase: %s)", sub.name, sub.currentPhase)
+	}
+	m.publishSystemMessage(sub, severity, sub.summary, true)
+}
+
+// restartEventWatch resumes a closed event watch after the last event version seen, so
+// events that were already published are not replayed as new progress. When that version
+// has expired, the namespace is re-listed to resume from the current state; events in the
+// gap are skipped rather than duplicated.
+func (m *ClusterMonitorManager) restartEventWatch(sub *clusterSubscription) {
+	// The provider reports why the watch ended just before closing it.
+	if sub.eventErr != nil {
+		select {
+		case err, ok := <-sub.eventErr:
+			if ok {
+				sub.noteEventWatchError(err)
+			}
+		default:
 		}
+	}
+	sub.eventCh = nil
+	sub.eventErr = nil
+	if sub.watchCtx == nil || sub.watchCtx.Err() != nil || m.session == nil || m.session.Events == nil {
 		return
 	}
+
+	sub.eventWatchRestarts++
+	if sub.eventWatchRestarts > maxEventWatchRestarts {
+		m.publishSystemMessage(sub, clustermonitor.SeverityWarning, "Event watch closed repeatedly; event updates stopped", false)
+		return
+	}
+
+	if sub.eventWatchExpired {
+		_, version, err := m.session.Events.ListWithResourceVersion(sub.watchCtx, sub.namespace, eventsprovider.ListOptions{})
+		if err != nil {
+			m.publishSystemMessage(sub, clustermonitor.SeverityWarning, fmt.Sprintf("Event watch error: %v", err), false)
+			return
+		}
+		sub.eventResourceVersion = version
+		sub.eventWatchExpired = false
+	}
+
+	eventCh, eventErr, err := m.session.Events.WatchNamespace(sub.watchCtx, sub.namespace, eventsprovider.WatchOptions{ResourceVersion: sub.eventResourceVersion})
+	if err != nil {
+		m.publishSystemMessage(sub, clustermonitor.SeverityWarning, fmt.Sprintf("Event watch error: %v", err), false)
+		return
+	}
+	sub.eventCh = eventCh
+	sub.eventErr = eventErr
+	if sub.logger != nil {
+		sub.logger.Debug("event watch resumed", "resource_version", sub.eventResourceVersion, "restarts", sub.eventWatchRestarts)
+	}
+}
+
+// noteEventWatchError records whether the event watch ended because its resourceVersion expired.
+func (s *clusterSubscription) noteEventWatchError(err error) {
+	if err == nil {
+		return
+	}
+	if apierrors.IsResourceExpired(err) || apierrors.IsGone(err) {
+		s.eventWatchExpired = true
+	}
+	if s.logger != nil {
+		s.logger.Debug("event watch error", "error", err, "resource_version", s.eventResourceVersion)
+	}
+}
+
+// publishRecentEventsSnapshot seeds a new subscription with the most recent in-scope events.
+func (m *ClusterMonitorManager) publishRecentEventsSnapshot(sub *clusterSubscription, events []eventsprovider.Event) {
 	if len(events) == 0 {
 		return
 	}
@@ -577,6 +838,9 @@ func parseClusterMonitorURI(raw string) (clusterMonitorTarget, error) {
 	}
 	target.Namespace = parts[0]
 	target.Name = parts[1]
+	if target.Namespace == clusterMonitorWildcard && target.Name != clusterMonitorWildcard {
+		return target, errors.New("a wildcard namespace requires a wildcard name (k0rdent://cluster-monitor/*/*)")
+	}
 	target.Timeout = defaultClusterMonitorTimeout
 
 	if timeoutStr := parsed.Query().Get("timeout"); timeoutStr != "" {
@@ -593,14 +857,25 @@ func subscriptionKey(namespace, name string) string {
 	return namespace + "/" + name
 }
 
+// watchClusterDeployment streams changes to a single ClusterDeployment, or to every
+// ClusterDeployment in namespace (all namespaces when empty) when name is empty.
+// The API server ends watches routinely, so a closed watch is re-established from the last
+// resourceVersion seen, bookmarks included. When that version has expired the watch restarts
+// from the current state, replaying existing objects as ADDED. The error channel reports why
+// the stream ended when the watch could not be re-established.
 func watchClusterDeployment(ctx context.Context, client dynamic.Interface, namespace, name string) (<-chan clusterDelta, <-chan error, error) {
 	if client == nil {
 		return nil, nil, errors.New("dynamic client is nil")
 	}
-	watcher, err := client.Resource(clusters.ClusterDeploymentsGVR).Namespace(namespace).Watch(ctx, v1.ListOptions{
-		FieldSelector:       fields.OneTermEqualSelector("metadata.name", name).String(),
-		AllowWatchBookmarks: true,
-	})
+	resource := client.Resource(clusters.ClusterDeploymentsGVR).Namespace(namespace)
+	watchOptions := func(resourceVersion string) v1.ListOptions {
+		opts := v1.ListOptions{AllowWatchBookmarks: true, ResourceVersion: resourceVersion}
+		if name != "" {
+			opts.FieldSelector = fields.OneTermEqualSelector("metadata.name", name).String()
+		}
+		return opts
+	}
+	watcher, err := resource.Watch(ctx, watchOptions(""))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -611,30 +886,62 @@ func watchClusterDeployment(ctx context.Context, client dynamic.Interface, names
 	go func() {
 		defer close(out)
 		defer close(errCh)
-		defer watcher.Stop()
+		defer func() { watcher.Stop() }()
 
+		resourceVersion := ""
+		restarts := 0
 		for {
 			select {
 			case <-ctx.Done():
 				return
 			case event, ok := <-watcher.ResultChan():
 				if !ok {
-					errCh <- errors.New("cluster watch channel closed")
-					return
-				}
-				if event.Type == watch.Bookmark {
+					restarts++
+					if restarts > maxClusterWatchRestarts {
+						errCh <- errors.New("cluster watch closed repeatedly")
+						return
+					}
+					next, err := resource.Watch(ctx, watchOptions(resourceVersion))
+					if err != nil && resourceVersion != "" && (apierrors.IsResourceExpired(err) || apierrors.IsGone(err)) {
+						resourceVersion = ""
+						next, err = resource.Watch(ctx, watchOptions(""))
+					}
+					if err != nil {
+						if ctx.Err() == nil {
+							errCh <- fmt.Errorf("re-establish cluster watch: %w", err)
+						}
+						return
+					}
+					watcher = next
 					continue
 				}
+				if event.Type == watch.Error {
+					err := apierrors.FromObject(event.Object)
+					if apierrors.IsResourceExpired(err) || apierrors.IsGone(err) {
+						// The server closes the watch next; restart from the current state.
+						resourceVersion = ""
+						continue
+					}
+					errCh <- fmt.Errorf("cluster watch: %w", err)
+					return
+				}
 				obj, ok := event.Object.(*unstructured.Unstructured)
 				if !ok {
 					continue
 				}
+				restarts = 0
+				if version := obj.GetResourceVersion(); version != "" {
+					resourceVersion = version
+				}
+				if event.Type == watch.Bookmark {
+					continue
+				}
 				select {
 				case <-ctx.Done():
 					return
 				case out <- clusterDelta{Object: obj.DeepCopy(), Type: event.Type}:
 				}
-				if event.Type == watch.Deleted {
+				if event.Type == watch.Deleted && name != "" {
 					return
 				}
 			}
@@ -644,6 +951,77 @@ func watchClusterDeployment(ctx context.Context, client dynamic.Interface, names
 	return out, errCh, nil
 }
 
+// watchAllowedClusterDeployments watches ClusterDeployments in every namespace the session may
+// see. Without a namespace filter it opens one cluster-wide watch, falling back to a watch per
+// namespace when that is forbidden; with a filter it watches each allowed namespace, so
+// clusters outside the filter never reach the server. ctx bounds the namespace list; the
+// watches run until watchCtx ends.
+func watchAllowedClusterDeployments(ctx, watchCtx context.Context, session *runtime.Session, logger *slog.Logger) (<-chan clusterDelta, <-chan error, error) {
+	if session.IsDevMode() || session.NamespaceFilter == nil {
+		clusterCh, clusterErr, err := watchClusterDeployment(watchCtx, session.Clients.Dynamic, "", "")
+		if err == nil || !apierrors.IsForbidden(err) {
+			return clusterCh, clusterErr, err
+		}
+		logger.Info("cluster-wide clusterdeployment watch forbidden; watching each namespace", "error", err)
+	}
+	namespaces, err := getAllowedNamespacesHelper(ctx, session, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(namespaces) == 0 {
+		return nil, nil, errors.New("no namespaces allowed by session filter")
+	}
+	return watchClusterDeploymentNamespaces(watchCtx, session.Clients.Dynamic, namespaces)
+}
+
+// watchClusterDeploymentNamespaces opens a ClusterDeployment watch per namespace and merges
+// them. The first watch error is reported on the error channel; the delta channel closes
+// once every watch has ended.
+func watchClusterDeploymentNamespaces(ctx context.Context, client dynamic.Interface, namespaces []string) (<-chan clusterDelta, <-chan error, error) {
+	type namespaceWatch struct {
+		namespace string
+		deltas    <-chan clusterDelta
+		errs      <-chan error
+	}
+	watches := make([]namespaceWatch, 0, len(namespaces))
+	for _, namespace := range namespaces {
+		deltas, errs, err := watchClusterDeployment(ctx, client, namespace, "")
+		if err != nil {
+			return nil, nil, fmt.Errorf("namespace %s: %w", namespace, err)
+		}
+		watches = append(watches, namespaceWatch{namespace: namespace, deltas: deltas, errs: errs})
+	}
+
+	out := make(chan clusterDelta)
+	errCh := make(chan error, 1)
+	var wg sync.WaitGroup
+	for _, w := range watches {
+		wg.Add(1)
+		go func(w namespaceWatch) {
+			defer wg.Done()
+			for delta := range w.deltas {
+				select {
+				case out <- delta:
+				case <-ctx.Done():
+					return
+				}
+			}
+			if err, ok := <-w.errs; ok && err != nil {
+				select {
+				case errCh <- fmt.Errorf("namespace %s: %w", w.namespace, err):
+				default:
+				}
+			}
+		}(w)
+	}
+	go func() {
+		wg.Wait()
+		close(out)
+		close(errCh)
+	}()
+	return out, errCh, nil
+}
+
 func (t *clusterMonitorTool) state(ctx context.Context, req *mcp.CallToolRequest, input clusterMonitorStateInput) (*mcp.CallToolResult, clusterMonitorStateResult, error) {
 	if t == nil || t.session == nil {
 		return nil, clusterMonitorStateResult{}, fmt.Errorf("cluster monitor tool not configured")
@@ -652,23 +1030,17 @@ func (t *clusterMonitorTool) state(ctx context.Context, req *mcp.CallToolRequest
 	if name == "" {
 		return nil, clusterMonitorStateResult{}, fmt.Errorf("cluster name is required")
 	}
-	namespace := strings.TrimSpace(input.Namespace)
-	if namespace == "" {
-		namespace = t.session.GlobalNamespace()
-	}
-	if namespace == "" {
-		return nil, clusterMonitorStateResult{}, fmt.Errorf("namespace is required")
-	}
-	if !t.session.IsDevMode() && t.session.NamespaceFilter != nil && !t.session.NamespaceFilter.MatchString(namespace) {
-		return nil, clusterMonitorStateResult{}, fmt.Errorf("namespace %q not allowed by filter", namespace)
-	}
 	if t.session.Clients.Dynamic == nil {
 		return nil, clusterMonitorStateResult{}, fmt.Errorf("dynamic client not configured")
 	}
 
-	ctx = logging.WithNamespace(ctx, namespace)
 	toolID := toolName(req)
 	ctx, logger := toolContext(ctx, t.session, toolID, "tool.cluster-monitor")
+	namespace, err := resolveClusterNamespace(ctx, t.session, strings.TrimSpace(input.Namespace), logger)
+	if err != nil {
+		return nil, clusterMonitorStateResult{}, fmt.Errorf("resolve namespace: %w", err)
+	}
+	ctx = logging.WithNamespace(ctx, namespace)
 	logger = logger.With("namespace", namespace, "cluster", name)
 	logger.Info("fetching cluster monitor state")
 
@@ -695,6 +1067,7 @@ func buildClusterProgress(obj *unstructured.Unstructured, events []eventsprovide
 	summary := clusters.SummarizeClusterDeployment(obj)
 	phase := clustermonitor.DetectPhase(obj, events)
 	progress := clustermonitor.EstimateProgress(phase, summary.Conditions)
+	eta := clustermonitor.EstimateETA(progress, summary.CreatedAt, summary.Conditions)
 	message := summary.Message
 	if message == "" {
 		message = fmt.Sprintf("Cluster phase: %s", phase)
@@ -734,6 +1107,7 @@ func buildClusterProgress(obj *unstructured.Unstructured, events []eventsprovide
 	return clustermonitor.ProgressUpdate{
 		Phase:      phase,
 		Progress:   progress,
+		ETA:        eta,
 		Message:    message,
 		Reason:     summary.Phase,
 		Source:     clustermonitor.SourceCondition,
@@ -758,7 +1132,7 @@ func monitorEventTimestamp(event eventsprovider.Event) time.Time {
 	}
 }
 
-func registerClusterMonitor(server *mcp.Server, session *runtime.Session, manager *ClusterMonitorManager) error {
+func registerClusterMonitor(reg *toolRegistry, session *runtime.Session, manager *ClusterMonitorManager) error {
 	if session == nil {
 		return errors.New("session is required")
 	}
@@ -767,13 +1141,13 @@ func registerClusterMonitor(server *mcp.Server, session *runtime.Session, manage
 	}
 
 	if manager != nil {
-		manager.Bind(server, session)
+		manager.Bind(reg.server, session)
 	}
 
 	tool := &clusterMonitorTool{session: session}
-	mcp.AddTool(server, &mcp.Tool{
+	addTool(reg, &mcp.Tool{
 		Name:        "k0rdent.mgmt.clusterDeployments.getState",
-		Description: "Fetch the latest ClusterDeployment monitoring state",
+		Description: "Fetch the latest ClusterDeployment monitoring state: provisioning phase, numeric progress (0-100), an estimated completion time (eta) when it can be derived from condition transition times, conditions, and service states",
 		Meta: mcp.Meta{
 			"plane":    "mgmt",
 			"category": "clusterDeployments",
@@ -781,17 +1155,25 @@ func registerClusterMonitor(server *mcp.Server, session *runtime.Session, manage
 		},
 	}, tool.state)
 
-	server.AddResourceTemplate(&mcp.ResourceTemplate{
+	reg.addResourceTemplate(&mcp.ResourceTemplate{
 		Name:        "k0rdent.cluster.monitor",
 		Title:       "Cluster deployment monitoring",
-		Description: "Streaming progress updates for ClusterDeployment resources",
+		Description: "Streaming progress updates for ClusterDeployment resources. Use {name}=* to stream phase changes for every cluster in a namespace, or k0rdent://cluster-monitor/*/* for all allowed namespaces.",
 		URITemplate: clusterMonitorURITemplate,
 		MIMEType:    clusterMonitorMIMEType,
+		Meta: mcp.Meta{
+			resourceQueryParamsMetaKey: []resourceQueryParam{
+				{Name: "timeout", Description: "Seconds before the subscription ends if the cluster has not reached a terminal phase (default 3600)"},
+			},
+		},
 	}, func(ctx context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
 		target, err := parseClusterMonitorURI(req.Params.URI)
 		if err != nil {
 			return nil, err
 		}
+		if target.IsFleet() {
+			return nil, errors.New("wildcard cluster monitor URIs support subscriptions only; use k0rdent.mgmt.clusterDeployments.list for a snapshot")
+		}
 		obj, err := session.Clients.Dynamic.Resource(clusters.ClusterDeploymentsGVR).
 			Namespace(target.Namespace).
 			Get(ctx, target.Name, v1.GetOptions{})
@@ -2,18 +2,33 @@ package core
 
 import (
 	"context"
+	"io"
+	"log/slog"
+	"regexp"
 	"testing"
 	"time"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	apiruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
 	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+	clienttesting "k8s.io/client-go/testing"
 
 	"github.com/k0rdent/mcp-k0rdent-server/internal/clusters"
+	"github.com/k0rdent/mcp-k0rdent-server/internal/config"
+	"github.com/k0rdent/mcp-k0rdent-server/internal/kube"
 	clustermonitor "github.com/k0rdent/mcp-k0rdent-server/internal/kube/cluster_monitor"
+	eventsprovider "github.com/k0rdent/mcp-k0rdent-server/internal/kube/events"
 	"github.com/k0rdent/mcp-k0rdent-server/internal/runtime"
 )
 
@@ -33,6 +48,142 @@ func TestParseClusterMonitorURIInvalid(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestParseClusterMonitorURIWildcard(t *testing.T) {
+	target, err := parseClusterMonitorURI("k0rdent://cluster-monitor/*/*")
+	require.NoError(t, err)
+	require.True(t, target.IsFleet())
+	require.Equal(t, "*", target.Namespace)
+
+	target, err = parseClusterMonitorURI("k0rdent://cluster-monitor/team-a/*")
+	require.NoError(t, err)
+	require.True(t, target.IsFleet())
+
+	_, err = parseClusterMonitorURI("k0rdent://cluster-monitor/*/demo-cluster")
+	require.Error(t, err)
+}
+
+func TestClusterMonitorProcessFleetDelta(t *testing.T) {
+	newCD := func(namespace, name string, ready bool) *unstructured.Unstructured {
+		status := "False"
+		if ready {
+			status = "True"
+		}
+		return &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "k0rdent.mirantis.com/v1beta1",
+			"kind":       "ClusterDeployment",
+			"metadata":   map[string]any{"name": name, "namespace": namespace},
+			"status": map[string]any{
+				"conditions": []any{map[string]any{"type": "Ready", "status": status}},
+			},
+		}}
+	}
+
+	manager := NewClusterMonitorManager()
+	manager.session = &runtime.Session{NamespaceFilter: regexp.MustCompile("^team-")}
+	sub := &clusterSubscription{
+		namespace: "*",
+		name:      "*",
+		fleet:     true,
+		phases:    make(map[string]clustermonitor.ProvisioningPhase),
+	}
+
+	require.True(t, manager.processFleetDelta(sub, clusterDelta{Type: watch.Added, Object: newCD("team-a", "one", false)}))
+	require.False(t, manager.processFleetDelta(sub, clusterDelta{Type: watch.Modified, Object: newCD("team-a", "one", false)}), "unchanged phase must not publish")
+	require.True(t, manager.processFleetDelta(sub, clusterDelta{Type: watch.Modified, Object: newCD("team-a", "one", true)}))
+	require.Equal(t, clustermonitor.PhaseReady, sub.phases["team-a/one"])
+
+	require.False(t, manager.processFleetDelta(sub, clusterDelta{Type: watch.Added, Object: newCD("other", "two", false)}), "disallowed namespace must be skipped")
+	require.NotContains(t, sub.phases, "other/two")
+
+	update, publish := manager.fleetUpdate(sub, clusterDelta{Type: watch.Deleted, Object: newCD("team-a", "one", true)})
+	require.True(t, publish)
+	require.Equal(t, clustermonitor.PhaseDeleted, update.Phase)
+	require.False(t, update.Terminal, "a deleted cluster must not end the fleet subscription")
+	require.Equal(t, "team-a", update.RelatedObject.Namespace)
+	require.Empty(t, sub.phases)
+}
+
+func TestWatchAllowedClusterDeploymentsPerNamespace(t *testing.T) {
+	newCD := func(namespace, name string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "k0rdent.mirantis.com/v1beta1",
+			"kind":       "ClusterDeployment",
+			"metadata":   map[string]any{"name": name, "namespace": namespace},
+		}}
+	}
+	newNamespace := func(name string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "v1",
+			"kind":       "Namespace",
+			"metadata":   map[string]any{"name": name},
+		}}
+	}
+	listKinds := map[schema.GroupVersionResource]string{
+		clusters.ClusterDeploymentsGVR:          "ClusterDeploymentList",
+		{Version: "v1", Resource: "namespaces"}: "NamespaceList",
+	}
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(apiruntime.NewScheme(), listKinds, newNamespace("team-a"), newNamespace("other"))
+	var watched []string
+	client.PrependWatchReactor("clusterdeployments", func(action clienttesting.Action) (bool, watch.Interface, error) {
+		watched = append(watched, action.GetNamespace())
+		return false, nil, nil
+	})
+	session := &runtime.Session{
+		NamespaceFilter: regexp.MustCompile("^team-"),
+		Clients:         runtime.Clients{Dynamic: client},
+	}
+
+	watchCtx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	deltas, _, err := watchAllowedClusterDeployments(context.Background(), watchCtx, session, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	require.NoError(t, err)
+	require.Equal(t, []string{"team-a"}, watched, "a filtered session must not open a cluster-wide watch")
+
+	cds := client.Resource(clusters.ClusterDeploymentsGVR)
+	_, err = cds.Namespace("other").Create(context.Background(), newCD("other", "hidden"), metav1.CreateOptions{})
+	require.NoError(t, err)
+	_, err = cds.Namespace("team-a").Create(context.Background(), newCD("team-a", "visible"), metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	select {
+	case delta := <-deltas:
+		require.Equal(t, "team-a", delta.Object.GetNamespace())
+		require.Equal(t, "visible", delta.Object.GetName())
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for watch delta")
+	}
+}
+
+func TestWatchAllowedClusterDeploymentsForbiddenFallback(t *testing.T) {
+	newNamespace := func(name string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "v1",
+			"kind":       "Namespace",
+			"metadata":   map[string]any{"name": name},
+		}}
+	}
+	listKinds := map[schema.GroupVersionResource]string{
+		clusters.ClusterDeploymentsGVR:          "ClusterDeploymentList",
+		{Version: "v1", Resource: "namespaces"}: "NamespaceList",
+	}
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(apiruntime.NewScheme(), listKinds, newNamespace("team-a"), newNamespace("team-b"))
+	var watched []string
+	client.PrependWatchReactor("clusterdeployments", func(action clienttesting.Action) (bool, watch.Interface, error) {
+		watched = append(watched, action.GetNamespace())
+		if action.GetNamespace() == "" {
+			return true, nil, apierrors.NewForbidden(clusters.ClusterDeploymentsGVR.GroupResource(), "", nil)
+		}
+		return false, nil, nil
+	})
+	session := &runtime.Session{Clients: runtime.Clients{Dynamic: client}}
+
+	watchCtx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	_, _, err := watchAllowedClusterDeployments(context.Background(), watchCtx, session, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	require.NoError(t, err)
+	require.Equal(t, []string{"", "team-a", "team-b"}, watched)
+}
+
 func TestClusterMonitorToolState(t *testing.T) {
 	listKinds := map[schema.GroupVersionResource]string{
 		clusters.ClusterDeploymentsGVR: "ClusterDeploymentList",
@@ -73,3 +224,205 @@ func TestClusterMonitorToolState(t *testing.T) {
 	require.Equal(t, clustermonitor.PhaseProvisioning, resp.Update.Phase)
 	require.False(t, resp.Update.Timestamp.IsZero())
 }
+
+func TestClusterMonitorToolStateDefaultNamespace(t *testing.T) {
+	listKinds := map[schema.GroupVersionResource]string{
+		clusters.ClusterDeploymentsGVR: "ClusterDeploymentList",
+	}
+	obj := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "k0rdent.mirantis.com/v1beta1",
+		"kind":       "ClusterDeployment",
+		"metadata":   map[string]any{"name": "demo-cluster", "namespace": "team-a"},
+		"status":     map[string]any{"phase": "Provisioning"},
+	}}
+	fakeClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(apiruntime.NewScheme(), listKinds, obj)
+	factory, err := kube.NewClientFactory(&rest.Config{Host: "https://example.com"}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	require.NoError(t, err)
+	factory.WithConstructors(
+		func(*rest.Config) (kubernetes.Interface, error) { return kubefake.NewSimpleClientset(), nil },
+		func(*rest.Config) (dynamic.Interface, error) { return fakeClient, nil },
+	)
+	rt, err := runtime.New(&config.Settings{
+		AuthMode: config.AuthModeDevAllowAny,
+		Cluster:  config.ClusterSettings{GlobalNamespace: "kcm-system", DefaultNamespace: "team-a"},
+	}, factory, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	require.NoError(t, err)
+	session, err := rt.NewSession(context.Background(), "")
+	require.NoError(t, err)
+
+	tool := &clusterMonitorTool{session: session}
+	req := &mcp.CallToolRequest{Params: &mcp.CallToolParamsRaw{Name: "k0rdent.mgmt.clusterDeployments.getState"}}
+	_, resp, err := tool.state(context.Background(), req, clusterMonitorStateInput{Name: "demo-cluster"})
+	require.NoError(t, err, "a call without a namespace must look in DEFAULT_NAMESPACE, not the global namespace")
+	require.Equal(t, clustermonitor.PhaseProvisioning, resp.Update.Phase)
+}
+
+func TestClusterMonitorRestartEventWatchResumes(t *testing.T) {
+	kubeClient := kubefake.NewSimpleClientset()
+	var watchedVersions []string
+	kubeClient.PrependWatchReactor("events", func(action clienttesting.Action) (bool, watch.Interface, error) {
+		watchedVersions = append(watchedVersions, action.(clienttesting.WatchActionImpl).WatchRestrictions.ResourceVersion)
+		return true, watch.NewFake(), nil
+	})
+	kubeClient.PrependReactor("list", "events", func(clienttesting.Action) (bool, apiruntime.Object, error) {
+		return true, &corev1.EventList{ListMeta: metav1.ListMeta{ResourceVersion: "100"}}, nil
+	})
+	events, err := eventsprovider.NewProvider(context.Background(), kubeClient)
+	require.NoError(t, err)
+
+	manager := NewClusterMonitorManager()
+	manager.session = &runtime.Session{Events: events}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	closedCh := make(chan eventsprovider.Delta)
+	close(closedCh)
+	sub := &clusterSubscription{
+		namespace:            "team-a",
+		watchCtx:             ctx,
+		eventResourceVersion: "42",
+		eventCh:              closedCh,
+	}
+
+	manager.restartEventWatch(sub)
+	require.NotNil(t, sub.eventCh)
+	require.Equal(t, []string{"42"}, watchedVersions, "watch must resume after the last seen event")
+
+	// An expired version is replaced by a fresh list version rather than replayed from scratch.
+	expiredErr := make(chan error, 1)
+	expiredErr <- apierrors.NewResourceExpired("too old resource version: 42")
+	close(expiredErr)
+	sub.eventErr = expiredErr
+	manager.restartEventWatch(sub)
+	require.Equal(t, []string{"42", "100"}, watchedVersions)
+	require.Equal(t, "100", sub.eventResourceVersion)
+	require.False(t, sub.eventWatchExpired)
+
+	sub.eventWatchRestarts = maxEventWatchRestarts
+	manager.restartEventWatch(sub)
+	require.Nil(t, sub.eventCh, "watch must not restart past the restart limit")
+	require.Len(t, watchedVersions, 2)
+}
+
+func TestClusterMonitorRunSubscriptionCompletesOnTerminalPhase(t *testing.T) {
+	manager := NewClusterMonitorManager()
+	manager.session = &runtime.Session{}
+
+	clusterCh := make(chan clusterDelta, 1)
+	clusterCh <- clusterDelta{Type: watch.Modified, Object: &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "k0rdent.mirantis.com/v1beta1",
+		"kind":       "ClusterDeployment",
+		"metadata":   map[string]any{"name": "demo-cluster", "namespace": "team-a"},
+		"status": map[string]any{
+			"conditions": []any{map[string]any{"type": "Ready", "status": "True"}},
+		},
+	}}}
+	_, cancel := context.WithCancel(context.Background())
+	sub := &clusterSubscription{
+		namespace:    "team-a",
+		name:         "demo-cluster",
+		cancel:       cancel,
+		done:         make(chan struct{}),
+		clusterCh:    clusterCh,
+		currentPhase: clustermonitor.PhaseUnknown,
+	}
+
+	require.True(t, acquireClusterMonitorSlot())
+	go manager.runSubscription(sub)
+	select {
+	case <-sub.done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("subscription did not end after terminal phase")
+	}
+	require.Equal(t, "Monitoring complete: Ready", sub.summary, "completion message must be published when the cluster reaches a terminal phase")
+	require.Equal(t, clustermonitor.PhaseReady, sub.currentPhase)
+	transitions := sub.conditions.Transitions()
+	require.Len(t, transitions, 1, "the Ready condition must be recorded in the condition history")
+	require.Equal(t, "Ready", transitions[0].Type)
+	require.Equal(t, "True", transitions[0].Status)
+}
+
+func TestClusterMonitorRunSubscriptionAbortsOnDelete(t *testing.T) {
+	manager := NewClusterMonitorManager()
+	manager.session = &runtime.Session{}
+
+	clusterCh := make(chan clusterDelta, 1)
+	clusterCh <- clusterDelta{Type: watch.Deleted, Object: &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "k0rdent.mirantis.com/v1beta1",
+		"kind":       "ClusterDeployment",
+		"metadata":   map[string]any{"name": "demo-cluster", "namespace": "team-a"},
+		"status": map[string]any{
+			"conditions": []any{map[string]any{"type": "InfrastructureReady", "status": "False"}},
+		},
+	}}}
+	_, cancel := context.WithCancel(context.Background())
+	sub := &clusterSubscription{
+		namespace:    "team-a",
+		name:         "demo-cluster",
+		cancel:       cancel,
+		done:         make(chan struct{}),
+		clusterCh:    clusterCh,
+		currentPhase: clustermonitor.PhaseProvisioning,
+	}
+
+	require.True(t, acquireClusterMonitorSlot())
+	go manager.runSubscription(sub)
+	select {
+	case <-sub.done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("subscription did not end after the cluster was deleted")
+	}
+	require.Contains(t, sub.summary, "Monitoring aborted: cluster demo-cluster was deleted",
+		"a deleted cluster must not be reported as complete")
+}
+
+func TestWatchClusterDeploymentResumesFromBookmark(t *testing.T) {
+	listKinds := map[schema.GroupVersionResource]string{
+		clusters.ClusterDeploymentsGVR: "ClusterDeploymentList",
+	}
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(apiruntime.NewScheme(), listKinds)
+	watchers := make(chan *watch.FakeWatcher, 4)
+	versions := make(chan string, 4)
+	client.PrependWatchReactor("clusterdeployments", func(action clienttesting.Action) (bool, watch.Interface, error) {
+		versions <- action.(clienttesting.WatchActionImpl).WatchRestrictions.ResourceVersion
+		watcher := watch.NewFake()
+		watchers <- watcher
+		return true, watcher, nil
+	})
+	newCD := func(name, resourceVersion string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "k0rdent.mirantis.com/v1beta1",
+			"kind":       "ClusterDeployment",
+			"metadata":   map[string]any{"name": name, "namespace": "team-a", "resourceVersion": resourceVersion},
+		}}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	deltas, errs, err := watchClusterDeployment(ctx, client, "team-a", "")
+	require.NoError(t, err)
+	require.Equal(t, "", <-versions)
+
+	first := <-watchers
+	first.Add(newCD("one", "10"))
+	require.Equal(t, "one", (<-deltas).Object.GetName())
+	first.Action(watch.Bookmark, newCD("", "15"))
+	first.Stop()
+
+	require.Equal(t, "15", <-versions, "the watch must resume from the last bookmark")
+	second := <-watchers
+	second.Modify(newCD("one", "16"))
+	delta := <-deltas
+	require.Equal(t, watch.Modified, delta.Type)
+
+	second.Error(&metav1.Status{Status: metav1.StatusFailure, Code: 410, Reason: metav1.StatusReasonExpired, Message: "too old"})
+	second.Stop()
+	require.Equal(t, "", <-versions, "an expired version restarts from the current state")
+	<-watchers
+
+	select {
+	case err := <-errs:
+		t.Fatalf("unexpected watch error: %v", err)
+	default:
+	}
+}
@@ -0,0 +1,319 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/k0rdent/mcp-k0rdent-server/internal/k0rdent/api"
+	"github.com/k0rdent/mcp-k0rdent-server/internal/metrics"
+)
+
+// serviceApplyEntry is one service to merge into spec.serviceSpec.services.
+type serviceApplyEntry struct {
+	TemplateNamespace string                   `json:"templateNamespace,omitempty" jsonschema:"ServiceTemplate namespace (default: the global namespace)"`
+	TemplateName      string                   `json:"templateName" jsonschema:"ServiceTemplate name"`
+	ServiceName       string                   `json:"serviceName,omitempty" jsonschema:"Service name (default: templateName)"`
+	ServiceNamespace  string                   `json:"serviceNamespace,omitempty" jsonschema:"Namespace the service is installed into on the cluster"`
+	Values            map[string]any           `json:"values,omitempty" jsonschema:"Helm values, deep-merged over the server defaults"`
+	ValuesFrom        []serviceValuesFromInput `json:"valuesFrom,omitempty"`
+	HelmOptions       *serviceHelmOptionsInput `json:"helmOptions,omitempty"`
+	DependsOn         []string                 `json:"dependsOn,omitempty" jsonschema:"Services that must be deployed first; may name other services in the same call"`
+	Priority          *int64                   `json:"priority,omitempty"`
+}
+
+type clusterServicesApplyInput struct {
+	ClusterNamespace string              `json:"clusterNamespace"`
+	ClusterName      string              `json:"clusterName"`
+	Services         []serviceApplyEntry `json:"services" jsonschema:"Services to create or update, applied together"`
+	ProviderConfig   map[string]any      `json:"providerConfig,omitempty"`
+	DryRun           bool                `json:"dryRun,omitempty"`
+}
+
+// clusterServiceApplyStatus reports one service of a batch apply.
+type clusterServiceApplyStatus struct {
+	ServiceName  string           `json:"serviceName"`
+	Service      map[string]any   `json:"service"`
+	Status       map[string]any   `json:"status,omitempty"`
+	UpgradePaths []map[string]any `json:"upgradePaths,omitempty"`
+	// StatusPending is set when status.services has no entry for the service yet
+	StatusPending bool `json:"statusPending,omitempty"`
+}
+
+type clusterServicesApplyResult struct {
+	ClusterName      string                      `json:"clusterName"`
+	ClusterNamespace string                      `json:"clusterNamespace"`
+	DryRun           bool                        `json:"dryRun"`
+	Services         []clusterServiceApplyStatus `json:"services"`
+}
+
+// normalizeServiceEntry trims the entry, fills in defaults, and checks everything that needs
+// no API call. It returns the metrics outcome to record alongside any error.
+func (t *clusterServiceApplyTool) normalizeServiceEntry(entry serviceApplyEntry) (serviceApplyEntry, string, error) {
+	entry.TemplateNamespace = strings.TrimSpace(entry.TemplateNamespace)
+	entry.TemplateName = strings.TrimSpace(entry.TemplateName)
+	entry.ServiceNamespace = strings.TrimSpace(entry.ServiceNamespace)
+	entry.ServiceName = strings.TrimSpace(entry.ServiceName)
+	if entry.TemplateNamespace == "" {
+		entry.TemplateNamespace = t.session.GlobalNamespace()
+	}
+
+	if entry.TemplateName == "" {
+		return entry, metrics.OutcomeError, fmt.Errorf("templateName is required")
+	}
+	if entry.ServiceName == "" {
+		entry.ServiceName = entry.TemplateName
+	}
+	if err := t.ensureNamespaceAllowed("templateNamespace", entry.TemplateNamespace); err != nil {
+		return entry, metrics.OutcomeForbidden, err
+	}
+	if entry.ServiceNamespace != "" {
+		if err := t.ensureNamespaceAllowed("serviceNamespace", entry.ServiceNamespace); err != nil {
+			return entry, metrics.OutcomeForbidden, err
+		}
+	}
+	if entry.Priority != nil && *entry.Priority < 0 {
+		return entry, metrics.OutcomeError, fmt.Errorf("priority must be zero or positive")
+	}
+
+	if len(entry.DependsOn) > 0 {
+		deps := make([]string, len(entry.DependsOn))
+		for i, raw := range entry.DependsOn {
+			dep := strings.TrimSpace(raw)
+			if dep == "" {
+				return entry, metrics.OutcomeError, fmt.Errorf("dependsOn[%d] must not be empty", i)
+			}
+			if dep == entry.ServiceName {
+				return entry, metrics.OutcomeError, fmt.Errorf("dependsOn cannot reference the target service (%s)", entry.ServiceName)
+			}
+			deps[i] = dep
+		}
+		entry.DependsOn = deps
+	}
+	return entry, metrics.OutcomeSuccess, nil
+}
+
+// buildServiceSpec resolves a normalized entry into the spec sent to the API: it checks the
+// ServiceTemplate exists, merges and validates values, and converts the Helm options. It
+// returns the metrics outcome to record alongside any error.
+func (t *clusterServiceApplyTool) buildServiceSpec(ctx context.Context, entry serviceApplyEntry, logger *slog.Logger) (api.ClusterServiceApplySpec, string, error) {
+	var valuesFromPtr *[]api.ClusterServiceValuesFrom
+	if len(entry.ValuesFrom) > 0 {
+		ptr, err := convertValuesFromInputs(entry.ValuesFrom)
+		if err != nil {
+			return api.ClusterServiceApplySpec{}, metrics.OutcomeError, err
+		}
+		valuesFromPtr = ptr
+	}
+
+	helmOpts, err := convertHelmOptionsInput(entry.HelmOptions)
+	if err != nil {
+		return api.ClusterServiceApplySpec{}, metrics.OutcomeError, err
+	}
+
+	values := mergeServiceValues(t.session.ServiceDefaultValues(), entry.Values)
+
+	var serviceValues *string
+	if len(values) > 0 {
+		valuesYAML, err := yaml.Marshal(values)
+		if err != nil {
+			return api.ClusterServiceApplySpec{}, metrics.OutcomeError, fmt.Errorf("encode values: %w", err)
+		}
+		val := string(valuesYAML)
+		serviceValues = &val
+	}
+
+	templateObj, err := t.session.Clients.Dynamic.
+		Resource(api.ServiceTemplateGVR()).
+		Namespace(entry.TemplateNamespace).
+		Get(ctx, entry.TemplateName, metav1.GetOptions{})
+	if err != nil {
+		logger.Error("service template validation failed", "template", entry.TemplateNamespace+"/"+entry.TemplateName, "error", err)
+		return api.ClusterServiceApplySpec{}, classifyMetricsOutcome(err), fmt.Errorf("get service template: %w", err)
+	}
+	logger.Debug("validated service template",
+		"template_namespace", templateObj.GetNamespace(),
+		"template_name", templateObj.GetName(),
+	)
+
+	if len(values) > 0 {
+		if err := t.validateValues(ctx, templateObj, values, logger); err != nil {
+			logger.Warn("service values failed schema validation", "service_name", entry.ServiceName, "error", err)
+			return api.ClusterServiceApplySpec{}, metrics.OutcomeError, err
+		}
+	}
+
+	serviceSpec := api.ClusterServiceApplySpec{
+		TemplateNamespace: entry.TemplateNamespace,
+		TemplateName:      entry.TemplateName,
+		ServiceName:       entry.ServiceName,
+		Values:            serviceValues,
+		ValuesFrom:        valuesFromPtr,
+		HelmOptions:       helmOpts,
+	}
+	if entry.ServiceNamespace != "" {
+		ns := entry.ServiceNamespace
+		serviceSpec.ServiceNamespace = &ns
+	}
+	if len(entry.DependsOn) > 0 {
+		deps := append([]string(nil), entry.DependsOn...)
+		serviceSpec.DependsOn = &deps
+	}
+	if entry.Priority != nil {
+		priority := *entry.Priority
+		serviceSpec.Priority = &priority
+	}
+	return serviceSpec, metrics.OutcomeSuccess, nil
+}
+
+// applyBatch creates or updates several services of a ClusterDeployment with one server-side
+// apply. Every entry is validated, and the combined dependsOn graph checked, before anything
+// is written.
+func (t *clusterServiceApplyTool) applyBatch(ctx context.Context, req *mcp.CallToolRequest, input clusterServicesApplyInput) (*mcp.CallToolResult, clusterServicesApplyResult, error) {
+	name := toolName(req)
+	ctx, logger := toolContext(ctx, t.session, name, "tool.clusters")
+	start := time.Now()
+	outcome := metrics.OutcomeSuccess
+	// Only label with the cluster namespace once it has passed the namespace filter.
+	metricsLabels := metrics.Labels{Tool: name}
+	defer func() {
+		t.session.Metrics().RecordServiceApply(metricsLabels, outcome, time.Since(start))
+	}()
+
+	clusterNamespace := strings.TrimSpace(input.ClusterNamespace)
+	clusterName := strings.TrimSpace(input.ClusterName)
+	if clusterNamespace == "" {
+		outcome = metrics.OutcomeError
+		return nil, clusterServicesApplyResult{}, fmt.Errorf("clusterNamespace is required")
+	}
+	if clusterName == "" {
+		outcome = metrics.OutcomeError
+		return nil, clusterServicesApplyResult{}, fmt.Errorf("clusterName is required")
+	}
+	if len(input.Services) == 0 {
+		outcome = metrics.OutcomeError
+		return nil, clusterServicesApplyResult{}, fmt.Errorf("services must list at least one service")
+	}
+	if err := t.ensureNamespaceAllowed("clusterNamespace", clusterNamespace); err != nil {
+		outcome = metrics.OutcomeForbidden
+		return nil, clusterServicesApplyResult{}, err
+	}
+	metricsLabels.Namespace = clusterNamespace
+
+	entries := make([]serviceApplyEntry, len(input.Services))
+	seen := make(map[string]int, len(input.Services))
+	for i, raw := range input.Services {
+		entry, entryOutcome, err := t.normalizeServiceEntry(raw)
+		if err != nil {
+			outcome = entryOutcome
+			return nil, clusterServicesApplyResult{}, fmt.Errorf("services[%d]: %w", i, err)
+		}
+		if prev, dup := seen[entry.ServiceName]; dup {
+			outcome = metrics.OutcomeError
+			return nil, clusterServicesApplyResult{}, fmt.Errorf("services[%d]: service %q is already listed at services[%d]", i, entry.ServiceName, prev)
+		}
+		seen[entry.ServiceName] = i
+		entries[i] = entry
+	}
+
+	logger.Debug("applying cluster services",
+		"tool", name,
+		"cluster_namespace", clusterNamespace,
+		"cluster_name", clusterName,
+		"services", len(entries),
+		"dry_run", input.DryRun,
+	)
+
+	specs := make([]api.ClusterServiceApplySpec, len(entries))
+	for i, entry := range entries {
+		spec, entryOutcome, err := t.buildServiceSpec(ctx, entry, logger.With("service_name", entry.ServiceName))
+		if err != nil {
+			outcome = entryOutcome
+			return nil, clusterServicesApplyResult{}, fmt.Errorf("services[%d] (%s): %w", i, entry.ServiceName, err)
+		}
+		specs[i] = spec
+	}
+
+	applyOpts := api.ApplyClusterServicesOptions{
+		ClusterNamespace: clusterNamespace,
+		ClusterName:      clusterName,
+		FieldOwner:       t.session.ServiceFieldOwner(),
+		DryRun:           input.DryRun,
+		Services:         specs,
+	}
+	if len(input.ProviderConfig) > 0 {
+		cfgCopy := make(map[string]any, len(input.ProviderConfig))
+		for k, v := range input.ProviderConfig {
+			cfgCopy[k] = v
+		}
+		applyOpts.ProviderConfig = &cfgCopy
+	}
+
+	client := t.session.Clients.Dynamic
+	applyResult, err := api.ApplyClusterServices(ctx, client, applyOpts)
+	if err != nil {
+		outcome = classifyMetricsOutcome(err)
+		if errors.Is(err, api.ErrInvalidServiceDependencies) {
+			outcome = metrics.OutcomeError
+		}
+		logger.Error("failed to apply services", "tool", name, "error", err)
+		return nil, clusterServicesApplyResult{}, err
+	}
+
+	statusSource := applyResult.Cluster
+	if !input.DryRun {
+		refreshed, err := client.
+			Resource(api.ClusterDeploymentGVR()).
+			Namespace(clusterNamespace).
+			Get(ctx, clusterName, metav1.GetOptions{})
+		if err != nil {
+			logger.Warn("failed to refresh cluster after apply", "tool", name, "error", err)
+		} else {
+			statusSource = refreshed
+		}
+	}
+
+	response := clusterServicesApplyResult{
+		ClusterName:      clusterName,
+		ClusterNamespace: clusterNamespace,
+		DryRun:           input.DryRun,
+		Services:         make([]clusterServiceApplyStatus, 0, len(applyResult.Services)),
+	}
+	pending := 0
+	for i, service := range applyResult.Services {
+		serviceName := entries[i].ServiceName
+		if applied, ok := service["name"].(string); ok && applied != "" {
+			serviceName = applied
+		}
+		status := clusterServiceApplyStatus{
+			ServiceName:  serviceName,
+			Service:      service,
+			Status:       extractServiceStatus(statusSource, serviceName),
+			UpgradePaths: extractServiceUpgradePaths(statusSource, serviceName),
+		}
+		if status.Status == nil {
+			status.StatusPending = true
+			pending++
+		}
+		response.Services = append(response.Services, status)
+	}
+
+	logger.Info("cluster services apply completed",
+		"tool", name,
+		"cluster_namespace", clusterNamespace,
+		"cluster_name", clusterName,
+		"services", len(response.Services),
+		"status_pending", pending,
+		"dry_run", input.DryRun,
+		"duration_ms", time.Since(start).Milliseconds(),
+	)
+
+	return nil, response, nil
+}
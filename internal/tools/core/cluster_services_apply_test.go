@@ -0,0 +1,137 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/k0rdent/mcp-k0rdent-server/internal/k0rdent/api"
+	"github.com/k0rdent/mcp-k0rdent-server/internal/runtime"
+	testdynamic "github.com/k0rdent/mcp-k0rdent-server/internal/testutil/dynamic"
+)
+
+func TestClusterServicesApplyBatch(t *testing.T) {
+	client := testdynamic.NewFakeDynamicClient()
+	client.Add(api.ClusterDeploymentGVR(), newClusterObject("tenant-a", "dev-cluster", nil, []map[string]any{
+		{"name": "cert-manager", "state": "Deployed"},
+	}))
+	client.Add(api.ServiceTemplateGVR(), newServiceTemplateObject("kcm-system", "cert-manager-1-0-0"))
+	client.Add(api.ServiceTemplateGVR(), newServiceTemplateObject("kcm-system", "ingress-nginx-4-11-0"))
+
+	tool := &clusterServiceApplyTool{
+		session: &runtime.Session{
+			Clients: runtime.Clients{Dynamic: client},
+		},
+	}
+
+	input := clusterServicesApplyInput{
+		ClusterNamespace: "tenant-a",
+		ClusterName:      "dev-cluster",
+		Services: []serviceApplyEntry{
+			{TemplateName: "ingress-nginx-4-11-0", ServiceName: "ingress", DependsOn: []string{" cert-manager "}},
+			{TemplateNamespace: "kcm-system", TemplateName: "cert-manager-1-0-0", ServiceName: "cert-manager", Values: map[string]any{"installCRDs": true}},
+		},
+	}
+
+	_, result, err := tool.applyBatch(context.Background(), nil, input)
+	if err != nil {
+		t.Fatalf("applyBatch returned error: %v", err)
+	}
+	if len(result.Services) != 2 {
+		t.Fatalf("expected 2 service results, got %#v", result.Services)
+	}
+	ingress, certManager := result.Services[0], result.Services[1]
+	if ingress.ServiceName != "ingress" || !ingress.StatusPending {
+		t.Fatalf("expected pending ingress result, got %#v", ingress)
+	}
+	if certManager.ServiceName != "cert-manager" || certManager.StatusPending || certManager.Status["state"] != "Deployed" {
+		t.Fatalf("expected reported cert-manager status, got %#v", certManager)
+	}
+
+	obj, _ := client.GetObject(api.ClusterDeploymentGVR(), "tenant-a", "dev-cluster")
+	list, _, _ := unstructured.NestedSlice(obj.Object, "spec", "serviceSpec", "services")
+	if len(list) != 2 {
+		t.Fatalf("expected 2 service entries, got %#v", list)
+	}
+	entry, _ := list[0].(map[string]any)
+	if deps, _ := entry["dependsOn"].([]any); len(deps) != 1 || deps[0] != "cert-manager" {
+		t.Fatalf("expected trimmed dependsOn on ingress, got %#v", entry["dependsOn"])
+	}
+}
+
+func TestClusterServicesApplyBatchValidation(t *testing.T) {
+	tests := []struct {
+		name     string
+		services []serviceApplyEntry
+		want     string
+		wantErr  error
+	}{
+		{
+			name:     "empty batch",
+			services: nil,
+			want:     "at least one service",
+		},
+		{
+			name: "duplicate service",
+			services: []serviceApplyEntry{
+				{TemplateName: "minio-1-0-0", ServiceName: "minio"},
+				{TemplateName: "minio-1-0-0", ServiceName: " minio"},
+			},
+			want: `service "minio" is already listed at services[0]`,
+		},
+		{
+			name:     "missing template",
+			services: []serviceApplyEntry{{TemplateName: "absent-1-0-0"}},
+			want:     "services[0] (absent-1-0-0): get service template",
+		},
+		{
+			name:     "unknown dependency",
+			services: []serviceApplyEntry{{TemplateName: "minio-1-0-0", ServiceName: "minio", DependsOn: []string{"storage"}}},
+			want:     "storage (required by minio)",
+			wantErr:  api.ErrInvalidServiceDependencies,
+		},
+		{
+			name: "dependency cycle",
+			services: []serviceApplyEntry{
+				{TemplateName: "minio-1-0-0", ServiceName: "a", DependsOn: []string{"b"}},
+				{TemplateName: "minio-1-0-0", ServiceName: "b", DependsOn: []string{"a"}},
+			},
+			want:    "a -> b -> a",
+			wantErr: api.ErrInvalidServiceDependencies,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := testdynamic.NewFakeDynamicClient()
+			client.Add(api.ClusterDeploymentGVR(), newClusterObject("tenant-a", "dev-cluster", nil, nil))
+			client.Add(api.ServiceTemplateGVR(), newServiceTemplateObject("kcm-system", "minio-1-0-0"))
+
+			tool := &clusterServiceApplyTool{
+				session: &runtime.Session{
+					Clients: runtime.Clients{Dynamic: client},
+				},
+			}
+
+			_, _, err := tool.applyBatch(context.Background(), nil, clusterServicesApplyInput{
+				ClusterNamespace: "tenant-a",
+				ClusterName:      "dev-cluster",
+				Services:         tt.services,
+			})
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("expected error containing %q, got %v", tt.want, err)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+
+			obj, _ := client.GetObject(api.ClusterDeploymentGVR(), "tenant-a", "dev-cluster")
+			if list, _, _ := unstructured.NestedSlice(obj.Object, "spec", "serviceSpec", "services"); len(list) != 0 {
+				t.Fatalf("expected no services written, got %#v", list)
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/k0rdent/mcp-k0rdent-server/internal/k0rdent/api"
+	"github.com/k0rdent/mcp-k0rdent-server/internal/runtime"
+)
+
+// clusterServiceGetTool reports a single service of a ClusterDeployment
+type clusterServiceGetTool struct {
+	session *runtime.Session
+}
+
+type clusterServiceGetInput struct {
+	ClusterNamespace string `json:"clusterNamespace" jsonschema:"ClusterDeployment namespace"`
+	ClusterName      string `json:"clusterName" jsonschema:"ClusterDeployment name"`
+	ServiceName      string `json:"serviceName" jsonschema:"Service name as listed in spec.serviceSpec.services"`
+}
+
+type clusterServiceGetResult struct {
+	ClusterName      string `json:"clusterName"`
+	ClusterNamespace string `json:"clusterNamespace"`
+	ServiceName      string `json:"serviceName"`
+	// Service is the desired spec.serviceSpec.services entry; nil when only status remains,
+	// e.g. while a removed service is being uninstalled
+	Service map[string]any `json:"service,omitempty"`
+	// Status is the observed status.services entry
+	Status       map[string]any   `json:"status,omitempty"`
+	UpgradePaths []map[string]any `json:"upgradePaths,omitempty"`
+	// StatusPending is set when the service is desired but the controller has not reported
+	// status for it yet
+	StatusPending bool `json:"statusPending,omitempty"`
+}
+
+// get returns the desired entry, observed status, and upgrade paths of one cluster service
+func (t *clusterServiceGetTool) get(ctx context.Context, req *mcp.CallToolRequest, input clusterServiceGetInput) (*mcp.CallToolResult, clusterServiceGetResult, error) {
+	name := toolName(req)
+	ctx, logger := toolContext(ctx, t.session, name, "tool.clusters")
+	start := time.Now()
+
+	clusterNamespace := strings.TrimSpace(input.ClusterNamespace)
+	clusterName := strings.TrimSpace(input.ClusterName)
+	serviceName := strings.TrimSpace(input.ServiceName)
+	if clusterName == "" {
+		return nil, clusterServiceGetResult{}, fmt.Errorf("clusterName is required")
+	}
+	if serviceName == "" {
+		return nil, clusterServiceGetResult{}, fmt.Errorf("serviceName is required")
+	}
+	if err := t.ensureNamespaceAllowed("clusterNamespace", clusterNamespace); err != nil {
+		return nil, clusterServiceGetResult{}, err
+	}
+
+	cluster, err := t.session.Clients.Dynamic.
+		Resource(api.ClusterDeploymentGVR()).
+		Namespace(clusterNamespace).
+		Get(ctx, clusterName, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, clusterServiceGetResult{}, fmt.Errorf("cluster deployment %s/%s not found", clusterNamespace, clusterName)
+		}
+		logger.Error("failed to get cluster deployment", "tool", name, "error", err)
+		return nil, clusterServiceGetResult{}, fmt.Errorf("get cluster deployment: %w", err)
+	}
+
+	result := clusterServiceGetResult{
+		ClusterName:      clusterName,
+		ClusterNamespace: clusterNamespace,
+		ServiceName:      serviceName,
+		Service:          extractDesiredService(cluster, serviceName),
+		Status:           extractServiceStatus(cluster, serviceName),
+		UpgradePaths:     extractServiceUpgradePaths(cluster, serviceName),
+	}
+	if result.Service == nil && result.Status == nil {
+		return nil, clusterServiceGetResult{}, fmt.Errorf("service %q not found in cluster deployment %s/%s", serviceName, clusterNamespace, clusterName)
+	}
+	result.StatusPending = result.Service != nil && result.Status == nil
+
+	logger.Info("cluster service retrieved",
+		"tool", name,
+		"cluster_namespace", clusterNamespace,
+		"cluster_name", clusterName,
+		"service_name", serviceName,
+		"status_pending", result.StatusPending,
+		"upgrade_paths", len(result.UpgradePaths),
+		"duration_ms", time.Since(start).Milliseconds(),
+	)
+
+	return nil, result, nil
+}
+
+func (t *clusterServiceGetTool) ensureNamespaceAllowed(field, namespace string) error {
+	if namespace == "" {
+		return fmt.Errorf("%s is required", field)
+	}
+	if t.session == nil || t.session.NamespaceFilter == nil || t.session.IsDevMode() {
+		return nil
+	}
+	if t.session.NamespaceFilter.MatchString(namespace) {
+		return nil
+	}
+	return fmt.Errorf("%s %q not allowed by namespace filter", field, namespace)
+}
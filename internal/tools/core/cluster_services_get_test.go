@@ -0,0 +1,106 @@
+package core
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/k0rdent/mcp-k0rdent-server/internal/k0rdent/api"
+	"github.com/k0rdent/mcp-k0rdent-server/internal/runtime"
+	testdynamic "github.com/k0rdent/mcp-k0rdent-server/internal/testutil/dynamic"
+)
+
+func TestClusterServiceGet(t *testing.T) {
+	client := testdynamic.NewFakeDynamicClient()
+	cluster := newClusterObject("tenant-a", "dev-cluster",
+		[]map[string]any{
+			{"name": "minio", "template": "minio-1-0-0"},
+			{"name": "logging", "template": "logging-1-0-0"},
+		},
+		[]map[string]any{
+			{"name": "minio", "state": "Deployed"},
+			{"name": "removed", "state": "Deleting"},
+		},
+	)
+	_ = unstructured.SetNestedSlice(cluster.Object, []any{
+		map[string]any{"name": "minio", "availableUpgrades": []any{"minio-1-1-0"}},
+		map[string]any{"name": "logging", "availableUpgrades": []any{"logging-2-0-0"}},
+	}, "status", "servicesUpgradePaths")
+	client.Add(api.ClusterDeploymentGVR(), cluster)
+
+	tool := &clusterServiceGetTool{
+		session: &runtime.Session{
+			Clients: runtime.Clients{Dynamic: client},
+		},
+	}
+	get := func(service string) (clusterServiceGetResult, error) {
+		_, result, err := tool.get(context.Background(), nil, clusterServiceGetInput{
+			ClusterNamespace: "tenant-a",
+			ClusterName:      "dev-cluster",
+			ServiceName:      service,
+		})
+		return result, err
+	}
+
+	result, err := get("minio")
+	if err != nil {
+		t.Fatalf("get returned error: %v", err)
+	}
+	if result.Service["template"] != "minio-1-0-0" {
+		t.Errorf("unexpected desired service: %#v", result.Service)
+	}
+	if result.Status["state"] != "Deployed" {
+		t.Errorf("unexpected status: %#v", result.Status)
+	}
+	if len(result.UpgradePaths) != 1 || result.UpgradePaths[0]["name"] != "minio" {
+		t.Errorf("expected only minio upgrade paths, got %#v", result.UpgradePaths)
+	}
+	if result.StatusPending {
+		t.Errorf("statusPending must be false when status is reported")
+	}
+
+	result, err = get("logging")
+	if err != nil {
+		t.Fatalf("get returned error: %v", err)
+	}
+	if !result.StatusPending || result.Status != nil {
+		t.Errorf("expected pending status for logging, got %#v", result)
+	}
+
+	result, err = get("removed")
+	if err != nil {
+		t.Fatalf("get returned error: %v", err)
+	}
+	if result.Service != nil || result.Status["state"] != "Deleting" {
+		t.Errorf("expected status-only result for removed service, got %#v", result)
+	}
+
+	if _, err := get("missing"); err == nil || !strings.Contains(err.Error(), `service "missing" not found`) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+}
+
+func TestClusterServiceGetValidation(t *testing.T) {
+	tool := &clusterServiceGetTool{
+		session: &runtime.Session{
+			Clients:         runtime.Clients{Dynamic: testdynamic.NewFakeDynamicClient()},
+			NamespaceFilter: regexp.MustCompile("^tenant-"),
+		},
+	}
+
+	tests := map[string]clusterServiceGetInput{
+		"clusterName is required":  {ClusterNamespace: "tenant-a", ServiceName: "minio"},
+		"serviceName is required":  {ClusterNamespace: "tenant-a", ClusterName: "dev-cluster"},
+		"not allowed by namespace": {ClusterNamespace: "other", ClusterName: "dev-cluster", ServiceName: "minio"},
+		"not found":                {ClusterNamespace: "tenant-a", ClusterName: "dev-cluster", ServiceName: "minio"},
+	}
+	for want, input := range tests {
+		_, _, err := tool.get(context.Background(), nil, input)
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("input %+v: expected error containing %q, got %v", input, want, err)
+		}
+	}
+}
@@ -2,12 +2,16 @@ package core
 
 import (
 	"context"
+	"reflect"
 	"regexp"
+	"strings"
 	"testing"
 
+	"github.com/modelcontextprotocol/go-sdk/mcp"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/k0rdent/mcp-k0rdent-server/internal/k0rdent/api"
+	"github.com/k0rdent/mcp-k0rdent-server/internal/metrics"
 	"github.com/k0rdent/mcp-k0rdent-server/internal/runtime"
 	testdynamic "github.com/k0rdent/mcp-k0rdent-server/internal/testutil/dynamic"
 )
@@ -59,6 +63,43 @@ func TestClusterServiceApplyAddsService(t *testing.T) {
 	}
 }
 
+func TestClusterServiceApplyStatusPending(t *testing.T) {
+	client := testdynamic.NewFakeDynamicClient()
+	client.Add(api.ClusterDeploymentGVR(), newClusterObject("tenant-a", "dev-cluster", nil, nil))
+	client.Add(api.ServiceTemplateGVR(), newServiceTemplateObject("kcm-system", "minio-1-0-0"))
+
+	tool := &clusterServiceApplyTool{
+		session: &runtime.Session{
+			Clients: runtime.Clients{Dynamic: client},
+		},
+	}
+
+	input := clusterServiceApplyInput{
+		ClusterNamespace:  "tenant-a",
+		ClusterName:       "dev-cluster",
+		TemplateNamespace: "kcm-system",
+		TemplateName:      "minio-1-0-0",
+		ServiceName:       "minio",
+	}
+
+	_, result, err := tool.apply(context.Background(), nil, input)
+	if err != nil {
+		t.Fatalf("apply returned error: %v", err)
+	}
+	if result.Status != nil {
+		t.Fatalf("expected no status before reconciliation, got %#v", result.Status)
+	}
+	if !result.StatusPending {
+		t.Fatalf("expected statusPending when status.services is absent")
+	}
+	if result.DesiredService["template"] != "minio-1-0-0" {
+		t.Fatalf("expected desired service entry, got %#v", result.DesiredService)
+	}
+	if result.Message == "" {
+		t.Fatalf("expected reconciliation message")
+	}
+}
+
 func TestClusterServiceApplyDryRun(t *testing.T) {
 	client := testdynamic.NewFakeDynamicClient()
 	client.Add(api.ClusterDeploymentGVR(), newClusterObject("tenant-a", "dev-cluster", nil, nil))
@@ -90,6 +131,46 @@ func TestClusterServiceApplyDryRun(t *testing.T) {
 	}
 }
 
+func TestClusterServiceApplyRecordsMetricLabels(t *testing.T) {
+	client := testdynamic.NewFakeDynamicClient()
+	client.Add(api.ClusterDeploymentGVR(), newClusterObject("tenant-a", "dev-cluster", nil, nil))
+	client.Add(api.ServiceTemplateGVR(), newServiceTemplateObject("kcm-system", "minio-1-0-0"))
+
+	clusterMetrics := metrics.NewClusterMetrics()
+	tool := &clusterServiceApplyTool{
+		session: &runtime.Session{
+			Clients:        runtime.Clients{Dynamic: client},
+			ClusterMetrics: clusterMetrics,
+		},
+	}
+
+	req := &mcp.CallToolRequest{Params: &mcp.CallToolParamsRaw{Name: "k0rdent.mgmt.clusterDeployments.services.apply"}}
+	input := clusterServiceApplyInput{
+		ClusterNamespace: "tenant-a",
+		ClusterName:      "dev-cluster",
+		TemplateName:     "minio-1-0-0",
+	}
+	if _, _, err := tool.apply(context.Background(), req, input); err != nil {
+		t.Fatalf("apply returned error: %v", err)
+	}
+
+	labels := metrics.Labels{Tool: "k0rdent.mgmt.clusterDeployments.services.apply", Namespace: "tenant-a"}
+	if got := clusterMetrics.GetServiceApplyTotalFor(labels, metrics.OutcomeSuccess); got != 1 {
+		t.Fatalf("expected 1 success for %+v, got %d", labels, got)
+	}
+
+	// Rejected requests must not leak unvalidated namespaces into labels.
+	input.ClusterName = ""
+	input.ClusterNamespace = "user-supplied"
+	if _, _, err := tool.apply(context.Background(), req, input); err == nil {
+		t.Fatal("expected error for missing cluster name")
+	}
+	unlabelled := metrics.Labels{Tool: "k0rdent.mgmt.clusterDeployments.services.apply"}
+	if got := clusterMetrics.GetServiceApplyTotalFor(unlabelled, metrics.OutcomeError); got != 1 {
+		t.Fatalf("expected error recorded without namespace label, got %d", got)
+	}
+}
+
 func TestClusterServiceApplyNamespaceFilter(t *testing.T) {
 	client := testdynamic.NewFakeDynamicClient()
 	client.Add(api.ClusterDeploymentGVR(), newClusterObject("tenant-a", "dev-cluster", nil, nil))
@@ -285,9 +366,14 @@ func TestRemoveServiceTool_NotFound(t *testing.T) {
 		ServiceName:      "nonexistent",
 	}
 
+	if _, _, err := tool.remove(context.Background(), nil, input); err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Fatalf("expected not found error for missing service, got %v", err)
+	}
+
+	input.IgnoreMissing = true
 	_, result, err := tool.remove(context.Background(), nil, input)
 	if err != nil {
-		t.Fatalf("remove returned error for missing service: %v", err)
+		t.Fatalf("remove returned error for missing service with ignoreMissing: %v", err)
 	}
 
 	// Verify idempotent response: removedService is nil
@@ -482,3 +568,99 @@ func findSubstring(s, substr string) bool {
 	}
 	return false
 }
+
+type stubSchemaFetcher struct {
+	chart *api.ChartValues
+	err   error
+}
+
+func (f stubSchemaFetcher) FetchChartValues(context.Context, *unstructured.Unstructured) (*api.ChartValues, error) {
+	return f.chart, f.err
+}
+
+func TestClusterServiceApplyValuesSchema(t *testing.T) {
+	schema := []byte(`{"type":"object","properties":{"replicaCount":{"type":"integer","minimum":1}}}`)
+
+	tests := []struct {
+		name    string
+		fetcher stubSchemaFetcher
+		wantErr bool
+	}{
+		{name: "invalid values rejected", fetcher: stubSchemaFetcher{chart: &api.ChartValues{Schema: schema}}, wantErr: true},
+		{name: "schema unavailable skips validation", fetcher: stubSchemaFetcher{err: api.ErrValuesSchemaUnavailable}},
+		{name: "malformed chart schema skips validation", fetcher: stubSchemaFetcher{chart: &api.ChartValues{Schema: []byte(`{"type": 7}`)}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := testdynamic.NewFakeDynamicClient()
+			client.Add(api.ClusterDeploymentGVR(), newClusterObject("tenant-a", "dev-cluster", nil, nil))
+			client.Add(api.ServiceTemplateGVR(), newServiceTemplateObject("kcm-system", "minio-1-0-0"))
+
+			tool := &clusterServiceApplyTool{
+				session: &runtime.Session{
+					Clients: runtime.Clients{Dynamic: client},
+				},
+				schemaFetcher: tt.fetcher,
+			}
+
+			input := clusterServiceApplyInput{
+				ClusterNamespace: "tenant-a",
+				ClusterName:      "dev-cluster",
+				TemplateName:     "minio-1-0-0",
+				Values:           map[string]any{"replicaCount": 0},
+			}
+
+			_, _, err := tool.apply(context.Background(), nil, input)
+			if tt.wantErr {
+				if err == nil || !contains(err.Error(), "INVALID_INPUT") || !contains(err.Error(), "replicaCount") {
+					t.Fatalf("expected INVALID_INPUT error for replicaCount, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("apply returned error: %v", err)
+			}
+		})
+	}
+}
+
+func TestMergeServiceValues(t *testing.T) {
+	defaults := map[string]any{
+		"resources": map[string]any{
+			"limits": map[string]any{"cpu": "500m", "memory": "256Mi"},
+		},
+		"imagePullSecrets": []any{map[string]any{"name": "registry"}},
+		"replicaCount":     1,
+	}
+	values := map[string]any{
+		"resources":    map[string]any{"limits": map[string]any{"memory": "1Gi"}},
+		"replicaCount": 3,
+		"extra":        true,
+	}
+
+	merged := mergeServiceValues(defaults, values)
+	want := map[string]any{
+		"resources": map[string]any{
+			"limits": map[string]any{"cpu": "500m", "memory": "1Gi"},
+		},
+		"imagePullSecrets": []any{map[string]any{"name": "registry"}},
+		"replicaCount":     3,
+		"extra":            true,
+	}
+	if !reflect.DeepEqual(merged, want) {
+		t.Fatalf("unexpected merge result:\n got: %#v\nwant: %#v", merged, want)
+	}
+
+	limits := defaults["resources"].(map[string]any)["limits"].(map[string]any)
+	if limits["memory"] != "256Mi" {
+		t.Fatalf("defaults must not be mutated, got memory %v", limits["memory"])
+	}
+
+	if got := mergeServiceValues(nil, values); !reflect.DeepEqual(got, values) {
+		t.Fatalf("expected values unchanged without defaults, got %#v", got)
+	}
+	if got := mergeServiceValues(defaults, nil); !reflect.DeepEqual(got, defaults) {
+		t.Fatalf("expected defaults when no values are given, got %#v", got)
+	}
+}
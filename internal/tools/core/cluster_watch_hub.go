@@ -0,0 +1,187 @@
+package core
+
+import (
+	"context"
+	"sync"
+
+	"k8s.io/client-go/dynamic"
+)
+
+// sharedWatchBuffer lets a momentarily busy subscriber fall behind without stalling the others.
+const sharedWatchBuffer = 16
+
+// ClusterWatchHub shares ClusterDeployment watches between cluster-monitor subscriptions,
+// including subscriptions owned by different sessions. One API watch is opened per
+// namespace/name and fanned out to every subscriber; it is stopped when the last
+// subscriber releases it. Callers must authorize access to the cluster with their own
+// client before joining, since the shared watch runs with the first subscriber's client.
+type ClusterWatchHub struct {
+	mu      sync.Mutex
+	watches map[string]*sharedClusterWatch
+}
+
+type sharedClusterWatch struct {
+	key         string
+	cancel      context.CancelFunc
+	subscribers map[*clusterWatchSubscriber]struct{}
+}
+
+type clusterWatchSubscriber struct {
+	out  chan clusterDelta
+	err  chan error
+	done chan struct{}
+
+	// mu serializes deliveries with closing out and err; closed guards the double close
+	// between release and the end of fanOut.
+	mu     sync.Mutex
+	closed bool
+}
+
+// NewClusterWatchHub constructs an empty hub. A single hub should be shared by all sessions.
+func NewClusterWatchHub() *ClusterWatchHub {
+	return &ClusterWatchHub{watches: make(map[string]*sharedClusterWatch)}
+}
+
+// Subscribe joins the shared watch for namespace/name, starting it with client when no
+// other subscriber holds it. The returned release func must be called when the caller
+// stops reading; the channels are closed on release or when the underlying watch ends.
+// The watch is opened outside the hub lock so a slow API server stalls only this caller.
+func (h *ClusterWatchHub) Subscribe(client dynamic.Interface, namespace, name string) (<-chan clusterDelta, <-chan error, func(), error) {
+	key := subscriptionKey(namespace, name)
+
+	h.mu.Lock()
+	shared, ok := h.watches[key]
+	if ok {
+		sub := h.join(shared)
+		h.mu.Unlock()
+		return sub.out, sub.err, h.releaseFunc(shared, sub), nil
+	}
+	h.mu.Unlock()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	deltas, errs, err := watchClusterDeployment(ctx, client, namespace, name)
+	if err != nil {
+		cancel()
+		return nil, nil, nil, err
+	}
+
+	h.mu.Lock()
+	if existing, ok := h.watches[key]; ok {
+		// Another subscriber started the watch while ours was opening; join theirs.
+		cancel()
+		shared = existing
+	} else {
+		shared = &sharedClusterWatch{
+			key:         key,
+			cancel:      cancel,
+			subscribers: make(map[*clusterWatchSubscriber]struct{}),
+		}
+		h.watches[key] = shared
+		go h.fanOut(shared, deltas, errs)
+	}
+	sub := h.join(shared)
+	h.mu.Unlock()
+	return sub.out, sub.err, h.releaseFunc(shared, sub), nil
+}
+
+// join adds a subscriber to shared. The caller holds h.mu.
+func (h *ClusterWatchHub) join(shared *sharedClusterWatch) *clusterWatchSubscriber {
+	sub := &clusterWatchSubscriber{
+		out:  make(chan clusterDelta, sharedWatchBuffer),
+		err:  make(chan error, 1),
+		done: make(chan struct{}),
+	}
+	shared.subscribers[sub] = struct{}{}
+	return sub
+}
+
+func (h *ClusterWatchHub) releaseFunc(shared *sharedClusterWatch, sub *clusterWatchSubscriber) func() {
+	var once sync.Once
+	return func() {
+		once.Do(func() { h.release(shared, sub) })
+	}
+}
+
+// Subscribers returns the number of subscribers attached to the shared watch for namespace/name.
+func (h *ClusterWatchHub) Subscribers(namespace, name string) int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if shared, ok := h.watches[subscriptionKey(namespace, name)]; ok {
+		return len(shared.subscribers)
+	}
+	return 0
+}
+
+func (h *ClusterWatchHub) release(shared *sharedClusterWatch, sub *clusterWatchSubscriber) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	close(sub.done)
+	sub.close(nil)
+	delete(shared.subscribers, sub)
+	if len(shared.subscribers) == 0 {
+		shared.cancel()
+		if h.watches[shared.key] == shared {
+			delete(h.watches, shared.key)
+		}
+	}
+}
+
+// fanOut delivers each delta to every current subscriber and, once the underlying watch
+// ends, forwards its error and closes the subscriber channels.
+func (h *ClusterWatchHub) fanOut(shared *sharedClusterWatch, deltas <-chan clusterDelta, errs <-chan error) {
+	for delta := range deltas {
+		h.mu.Lock()
+		targets := make([]*clusterWatchSubscriber, 0, len(shared.subscribers))
+		for sub := range shared.subscribers {
+			targets = append(targets, sub)
+		}
+		h.mu.Unlock()
+
+		for _, sub := range targets {
+			sub.deliver(clusterDelta{Object: delta.Object.DeepCopy(), Type: delta.Type})
+		}
+	}
+
+	// watchClusterDeployment closes its error channel before the delta channel.
+	err := <-errs
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.watches[shared.key] == shared {
+		delete(h.watches, shared.key)
+	}
+	for sub := range shared.subscribers {
+		sub.close(err)
+		delete(shared.subscribers, sub)
+	}
+	shared.cancel()
+}
+
+// deliver sends delta unless the subscriber was released, waiting while its buffer is full.
+func (s *clusterWatchSubscriber) deliver(delta clusterDelta) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return
+	}
+	select {
+	case s.out <- delta:
+	case <-s.done:
+	}
+}
+
+// close forwards err, if any, and closes the subscriber's channels once.
+func (s *clusterWatchSubscriber) close(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return
+	}
+	s.closed = true
+	if err != nil {
+		s.err <- err
+	}
+	close(s.err)
+	close(s.out)
+}
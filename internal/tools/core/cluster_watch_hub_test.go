@@ -0,0 +1,129 @@
+package core
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	apiruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/k0rdent/mcp-k0rdent-server/internal/clusters"
+	eventsprovider "github.com/k0rdent/mcp-k0rdent-server/internal/kube/events"
+	"github.com/k0rdent/mcp-k0rdent-server/internal/runtime"
+)
+
+func TestClusterWatchHubSharesWatch(t *testing.T) {
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(apiruntime.NewScheme(), map[schema.GroupVersionResource]string{
+		clusters.ClusterDeploymentsGVR: "ClusterDeploymentList",
+	})
+	hub := NewClusterWatchHub()
+
+	firstCh, _, releaseFirst, err := hub.Subscribe(client, "team-a", "demo")
+	require.NoError(t, err)
+	secondCh, _, releaseSecond, err := hub.Subscribe(client, "team-a", "demo")
+	require.NoError(t, err)
+	require.Equal(t, 2, hub.Subscribers("team-a", "demo"))
+
+	obj := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "k0rdent.mirantis.com/v1beta1",
+		"kind":       "ClusterDeployment",
+		"metadata":   map[string]any{"name": "demo", "namespace": "team-a"},
+	}}
+	_, err = client.Resource(clusters.ClusterDeploymentsGVR).Namespace("team-a").Create(context.Background(), obj, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	for _, ch := range []<-chan clusterDelta{firstCh, secondCh} {
+		select {
+		case delta := <-ch:
+			require.Equal(t, "demo", delta.Object.GetName())
+		case <-time.After(2 * time.Second):
+			t.Fatal("expected shared watch to deliver the delta to every subscriber")
+		}
+	}
+
+	releaseFirst()
+	require.Equal(t, 1, hub.Subscribers("team-a", "demo"))
+	releaseSecond()
+	releaseSecond()
+	require.Equal(t, 0, hub.Subscribers("team-a", "demo"))
+
+	// A new subscriber after teardown starts a fresh watch.
+	_, _, releaseThird, err := hub.Subscribe(client, "team-a", "demo")
+	require.NoError(t, err)
+	require.Equal(t, 1, hub.Subscribers("team-a", "demo"))
+	releaseThird()
+}
+
+func TestClusterMonitorUnsubscribeSharedWatch(t *testing.T) {
+	obj := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "k0rdent.mirantis.com/v1beta1",
+		"kind":       "ClusterDeployment",
+		"metadata":   map[string]any{"name": "demo", "namespace": "team-a"},
+		"status":     map[string]any{"phase": "Provisioning"},
+	}}
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(apiruntime.NewScheme(), map[schema.GroupVersionResource]string{
+		clusters.ClusterDeploymentsGVR: "ClusterDeploymentList",
+	}, obj)
+	kubeClient := kubefake.NewSimpleClientset()
+	events, err := eventsprovider.NewProvider(context.Background(), kubeClient)
+	require.NoError(t, err)
+
+	hub := NewClusterWatchHub()
+	manager := NewClusterMonitorManager()
+	manager.Bind(mcp.NewServer(&mcp.Implementation{Name: "test", Version: "v0.0.0"}, nil), &runtime.Session{
+		Events:  events,
+		Clients: runtime.Clients{Kubernetes: kubeClient, Dynamic: client},
+	})
+	manager.UseSharedWatches(hub)
+
+	uri := "k0rdent://cluster-monitor/team-a/demo"
+	require.NoError(t, manager.Subscribe(context.Background(), &mcp.SubscribeRequest{Params: &mcp.SubscribeParams{URI: uri}}))
+	require.Equal(t, 1, hub.Subscribers("team-a", "demo"))
+
+	done := make(chan error, 1)
+	go func() {
+		done <- manager.Unsubscribe(context.Background(), &mcp.UnsubscribeRequest{Params: &mcp.UnsubscribeParams{URI: uri}})
+	}()
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(2 * time.Second):
+		t.Fatal("Unsubscribe did not return after releasing the shared watch")
+	}
+	require.Equal(t, 0, hub.Subscribers("team-a", "demo"))
+}
+
+func TestClusterWatchHubReleaseClosesChannels(t *testing.T) {
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(apiruntime.NewScheme(), map[schema.GroupVersionResource]string{
+		clusters.ClusterDeploymentsGVR: "ClusterDeploymentList",
+	})
+	hub := NewClusterWatchHub()
+
+	deltas, errs, release, err := hub.Subscribe(client, "team-a", "demo")
+	require.NoError(t, err)
+	_, _, releaseOther, err := hub.Subscribe(client, "team-a", "demo")
+	require.NoError(t, err)
+	defer releaseOther()
+
+	release()
+	for _, closed := range []func() bool{
+		func() bool { _, ok := <-deltas; return !ok },
+		func() bool { _, ok := <-errs; return !ok },
+	} {
+		result := make(chan bool, 1)
+		go func() { result <- closed() }()
+		select {
+		case ok := <-result:
+			require.True(t, ok)
+		case <-time.After(2 * time.Second):
+			t.Fatal("released subscriber channels were not closed")
+		}
+	}
+}
@@ -2,9 +2,10 @@ package core
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
-	"sort"
+	"slices"
 	"strings"
 	"time"
 
@@ -12,11 +13,13 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
-	"sigs.k8s.io/yaml"
 
 	"github.com/k0rdent/mcp-k0rdent-server/internal/clusters"
+	clustermonitor "github.com/k0rdent/mcp-k0rdent-server/internal/kube/cluster_monitor"
 	"github.com/k0rdent/mcp-k0rdent-server/internal/k0rdent/api"
+	"github.com/k0rdent/mcp-k0rdent-server/internal/kube"
 	"github.com/k0rdent/mcp-k0rdent-server/internal/metrics"
 	"github.com/k0rdent/mcp-k0rdent-server/internal/runtime"
 )
@@ -28,6 +31,12 @@ const (
 	defaultAWSRootVolumeSize   = 32
 	defaultAzureRootVolumeSize = 30
 	defaultGCPRootVolumeSize   = 30
+
+	defaultVSphereCPUs           = 2
+	defaultVSphereMemoryMiB      = 4096
+	defaultVSphereRootVolumeSize = 30
+
+	defaultOpenStackCloudName = "openstack"
 )
 
 // validateAndDefaultNodeCounts validates and applies defaults to control plane and worker counts
@@ -56,12 +65,19 @@ type clustersListCredentialsTool struct {
 }
 
 type clustersListCredentialsInput struct {
-	Namespace string `json:"namespace,omitempty"`
-	Provider  string `json:"provider,omitempty"`
+	Namespace                string `json:"namespace,omitempty"`
+	Provider                 string `json:"provider,omitempty" jsonschema:"Only return credentials for this provider (aws, azure, gcp, vsphere, openstack)"`
+	IncludeGlobalCredentials *bool  `json:"includeGlobalCredentials,omitempty" jsonschema:"Include credentials from the global namespace (kcm-system) when no namespace is given (default true)"`
+	Limit                    int64  `json:"limit,omitempty" jsonschema:"Maximum number of credentials to list per page (default 0: all). The provider filter applies within the page"`
+	Continue                 string `json:"continue,omitempty" jsonschema:"continue token returned with the previous page; without limit, the previous page size is kept"`
 }
 
 type clustersListCredentialsResult struct {
 	Credentials []clusters.CredentialSummary `json:"credentials"`
+	Failures    []clusters.NamespaceFailure  `json:"failures,omitempty"`
+	Warnings    []string                     `json:"warnings,omitempty"`
+	// Continue fetches the next page; empty on the last page
+	Continue string `json:"continue,omitempty"`
 }
 
 type providersListTool struct {
@@ -81,7 +97,9 @@ type providersListIdentitiesInput struct {
 }
 
 type providersListIdentitiesResult struct {
-	Identities []clusters.IdentitySummary `json:"identities"`
+	Identities []clusters.IdentitySummary  `json:"identities"`
+	Failures   []clusters.NamespaceFailure `json:"failures,omitempty"`
+	Warnings   []string                    `json:"warnings,omitempty"`
 }
 
 type clustersListTemplatesTool struct {
@@ -91,10 +109,32 @@ type clustersListTemplatesTool struct {
 type clustersListTemplatesInput struct {
 	Scope     string `json:"scope"`               // "global", "local", or "all"
 	Namespace string `json:"namespace,omitempty"` // Optional namespace filter
+	// LabelSelector restricts templates to those matching, e.g. "k0rdent.mirantis.com/provider=aws"
+	LabelSelector string `json:"labelSelector,omitempty" jsonschema:"Only list templates matching this Kubernetes label selector"`
+	Limit         int64  `json:"limit,omitempty" jsonschema:"Maximum number of templates to list per page (default 0: all)"`
+	Continue      string `json:"continue,omitempty" jsonschema:"continue token returned with the previous page; without limit, the previous page size is kept"`
 }
 
 type clustersListTemplatesResult struct {
 	Templates []clusters.ClusterTemplateSummary `json:"templates"`
+	Failures  []clusters.NamespaceFailure       `json:"failures,omitempty"`
+	// Continue fetches the next page; empty on the last page
+	Continue string `json:"continue,omitempty"`
+}
+
+type clusterTemplateChainsTool struct {
+	session *runtime.Session
+}
+
+type clusterTemplateChainsInput struct {
+	Scope     string `json:"scope,omitempty" jsonschema:"Template scope: 'global', 'local', or 'all' (default 'all')"`
+	Namespace string `json:"namespace,omitempty" jsonschema:"Only build chains for templates in this namespace"`
+	Provider  string `json:"provider,omitempty" jsonschema:"Only return chains for this provider (e.g. aws, azure, gcp)"`
+}
+
+type clusterTemplateChainsResult struct {
+	Chains   []clusters.TemplateUpgradeChain `json:"chains"`
+	Failures []clusters.NamespaceFailure     `json:"failures,omitempty"`
 }
 
 type clustersDeleteTool struct {
@@ -116,15 +156,25 @@ type clustersListTool struct {
 }
 
 type clustersListInput struct {
-	Namespace string `json:"namespace,omitempty"`
+	Namespace string   `json:"namespace,omitempty"`
+	Phases    []string `json:"phases,omitempty"`
+	// LabelSelector restricts clusters to those matching, e.g. "environment=prod"
+	LabelSelector string `json:"labelSelector,omitempty" jsonschema:"Only list clusters matching this Kubernetes label selector (e.g. environment=prod)"`
+	Limit         int64  `json:"limit,omitempty" jsonschema:"Maximum number of clusters to list per page (default 0: all). The phases filter applies within the page"`
+	Continue      string `json:"continue,omitempty" jsonschema:"continue token returned with the previous page; without limit, the previous page size is kept"`
 }
 
 type clustersListResult struct {
 	Clusters []clusters.ClusterDeploymentSummary `json:"clusters"`
+	Failures []clusters.NamespaceFailure         `json:"failures,omitempty"`
+	// Continue fetches the next page; empty on the last page
+	Continue string `json:"continue,omitempty"`
 }
 
 type clusterServiceApplyTool struct {
 	session *runtime.Session
+	// schemaFetcher resolves chart values schemas; defaults to ChartValuesSchemaFetcher.
+	schemaFetcher api.ValuesSchemaFetcher
 }
 
 type clusterServiceApplyInput struct {
@@ -169,6 +219,11 @@ type clusterServiceApplyResult struct {
 	ClusterName      string           `json:"clusterName"`
 	ClusterNamespace string           `json:"clusterNamespace"`
 	DryRun           bool             `json:"dryRun"`
+	// StatusPending is set when status.services has no entry for the service yet;
+	// DesiredService then echoes the spec.serviceSpec.services entry that was applied.
+	StatusPending  bool           `json:"statusPending,omitempty"`
+	DesiredService map[string]any `json:"desiredService,omitempty"`
+	Message        string         `json:"message,omitempty"`
 }
 
 type removeClusterServiceTool struct {
@@ -180,6 +235,8 @@ type removeClusterServiceInput struct {
 	ClusterName      string `json:"clusterName"`
 	ServiceName      string `json:"serviceName"`
 	DryRun           bool   `json:"dryRun,omitempty"`
+	// IgnoreMissing makes removal of a service that is not present a no-op instead of an error
+	IgnoreMissing bool `json:"ignoreMissing,omitempty"`
 }
 
 type removeClusterServiceResult struct {
@@ -190,18 +247,50 @@ type removeClusterServiceResult struct {
 }
 
 var defaultProviderSummaries = []clusters.ProviderSummary{
-	{Name: "aws", Title: "Amazon Web Services"},
-	{Name: "azure", Title: "Microsoft Azure"},
-	{Name: "gcp", Title: "Google Cloud Platform"},
+	{
+		Name:    "aws",
+		Title:   "Amazon Web Services",
+		Regions: []string{"us-east-1", "us-east-2", "us-west-1", "us-west-2", "eu-west-1", "eu-central-1", "ap-southeast-1", "ap-northeast-1"},
+	},
+	{
+		Name:    "azure",
+		Title:   "Microsoft Azure",
+		Regions: []string{"eastus", "eastus2", "westus2", "westus3", "centralus", "westeurope", "northeurope", "southeastasia"},
+	},
+	{
+		Name:    "gcp",
+		Title:   "Google Cloud Platform",
+		Regions: []string{"us-central1", "us-east1", "us-west1", "europe-west1", "europe-west4", "asia-southeast1", "asia-northeast1"},
+	},
 	{Name: "vsphere", Title: "VMware vSphere"},
+	{Name: "openstack", Title: "OpenStack"},
 }
 
-func registerClusters(server *mcp.Server, session *runtime.Session) error {
+// knownProviderNames returns the names of the supported infrastructure providers.
+func knownProviderNames() []string {
+	names := make([]string, 0, len(defaultProviderSummaries))
+	for _, provider := range defaultProviderSummaries {
+		names = append(names, provider.Name)
+	}
+	return names
+}
+
+// isKnownProvider reports whether name matches a supported provider, ignoring case.
+func isKnownProvider(name string) bool {
+	for _, provider := range defaultProviderSummaries {
+		if strings.EqualFold(provider.Name, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func registerClusters(reg *toolRegistry, session *runtime.Session) error {
 	// Register k0rdent.mgmt.providers.list
 	providersTool := &providersListTool{session: session}
-	mcp.AddTool(server, &mcp.Tool{
+	addTool(reg, &mcp.Tool{
 		Name:        "k0rdent.mgmt.providers.list",
-		Description: "List supported infrastructure providers (e.g., AWS, Azure, Google Cloud, vSphere) available for credential onboarding.",
+		Description: "List supported infrastructure providers (e.g., AWS, Azure, Google Cloud, vSphere) available for credential onboarding. Each provider includes a curated list of common regions and the default Kubernetes version of the newest standalone template in the global namespace.",
 		Meta: mcp.Meta{
 			"plane":    "mgmt",
 			"category": "providers",
@@ -209,11 +298,47 @@ func registerClusters(server *mcp.Server, session *runtime.Session) error {
 		},
 	}, providersTool.list)
 
+	// Register k0rdent.mgmt.providers.usable
+	usableTool := &providersUsableTool{session: session}
+	addTool(reg, &mcp.Tool{
+		Name:        "k0rdent.mgmt.providers.usable",
+		Description: "List infrastructure providers that can be deployed right now: only providers with at least one ready Credential and at least one ClusterTemplate in kcm-system or the namespaces allowed by the current session. Returns the matching credentials and templates for each provider.",
+		Meta: mcp.Meta{
+			"plane":    "mgmt",
+			"category": "providers",
+			"action":   "usable",
+		},
+	}, usableTool.list)
+
+	// Register k0rdent.mgmt.providers.installed
+	installedTool := &providersInstalledTool{session: session}
+	addTool(reg, &mcp.Tool{
+		Name:        "k0rdent.mgmt.providers.installed",
+		Description: "List the CAPI infrastructure providers actually installed on the management cluster, found from controller Deployments labelled cluster.x-k8s.io/provider=infrastructure-<name>. Each provider reports its controller deployment, image version, and whether a replica is ready; notInstalled lists the supported providers (see k0rdent.mgmt.providers.list) that have no controller and cannot be deployed to.",
+		Meta: mcp.Meta{
+			"plane":    "mgmt",
+			"category": "providers",
+			"action":   "installed",
+		},
+	}, installedTool.list)
+
+	// Register k0rdent.mgmt.providers.testCredential
+	testCredentialTool := &providersTestCredentialTool{session: session}
+	addTool(reg, &mcp.Tool{
+		Name:        "k0rdent.mgmt.providers.testCredential",
+		Description: "Check whether a Credential looks usable before deploying with it: the Credential's Ready condition plus, for AWS, Azure, vSphere, and Secret-backed identities, whether the referenced identity exists and its validation status. Returns usable and the problems found. This inspects status only; it does not call the cloud provider.",
+		Meta: mcp.Meta{
+			"plane":    "mgmt",
+			"category": "providers",
+			"action":   "testCredential",
+		},
+	}, testCredentialTool.test)
+
 	// Register k0rdent.mgmt.providers.listCredentials
 	listCredsTool := &clustersListCredentialsTool{session: session}
-	mcp.AddTool(server, &mcp.Tool{
+	addTool(reg, &mcp.Tool{
 		Name:        "k0rdent.mgmt.providers.listCredentials",
-		Description: "List available Credentials for a given provider. Returns credentials from kcm-system (global) plus namespaces allowed by the current session.",
+		Description: "List available Credentials for a given provider. Returns credentials from kcm-system (global) plus namespaces allowed by the current session. An unrecognized provider is rejected with the list of valid providers. Set includeGlobalCredentials=false to skip the global namespace; if it is not readable (Forbidden), session namespaces are listed with a warning instead of failing.",
 		Meta: mcp.Meta{
 			"plane":    "mgmt",
 			"category": "providers",
@@ -223,9 +348,9 @@ func registerClusters(server *mcp.Server, session *runtime.Session) error {
 
 	// Register k0rdent.mgmt.providers.listIdentities
 	identitiesTool := &providersListIdentitiesTool{session: session}
-	mcp.AddTool(server, &mcp.Tool{
+	addTool(reg, &mcp.Tool{
 		Name:        "k0rdent.mgmt.providers.listIdentities",
-		Description: "List ClusterIdentity resources referenced by Credentials, including provider metadata and associated credentials.",
+		Description: "List ClusterIdentity resources referenced by Credentials, including provider metadata and associated credentials. Best-effort across namespaces: namespaces that cannot be read (e.g. forbidden) are skipped and reported in failures and warnings.",
 		Meta: mcp.Meta{
 			"plane":    "mgmt",
 			"category": "providers",
@@ -235,7 +360,7 @@ func registerClusters(server *mcp.Server, session *runtime.Session) error {
 
 	// Register k0rdent.mgmt.clusterTemplates.list
 	listTemplsTool := &clustersListTemplatesTool{session: session}
-	mcp.AddTool(server, &mcp.Tool{
+	addTool(reg, &mcp.Tool{
 		Name:        "k0rdent.mgmt.clusterTemplates.list",
 		Description: "List available ClusterTemplates. Differentiates global (kcm-system) vs local templates, enforcing namespace filters. Input scope: 'global', 'local', or 'all'.",
 		Meta: mcp.Meta{
@@ -245,11 +370,23 @@ func registerClusters(server *mcp.Server, session *runtime.Session) error {
 		},
 	}, listTemplsTool.list)
 
+	// Register k0rdent.mgmt.clusterTemplates.upgradeChains
+	chainsTool := &clusterTemplateChainsTool{session: session}
+	addTool(reg, &mcp.Tool{
+		Name:        "k0rdent.mgmt.clusterTemplates.upgradeChains",
+		Description: "List ClusterTemplates grouped into upgrade chains by provider and template family, ordered oldest to newest. Each template lists the templates it can be upgraded to, taken from ClusterTemplateChain availableUpgrades; templates without a chain show versions only. Optional filters: scope ('global', 'local', 'all'; default 'all'), namespace, provider.",
+		Meta: mcp.Meta{
+			"plane":    "mgmt",
+			"category": "clusterTemplates",
+			"action":   "upgradeChains",
+		},
+	}, chainsTool.upgradeChains)
+
 	// Register k0rdent.mgmt.clusterDeployments.list
 	listClustersTool := &clustersListTool{session: session}
-	mcp.AddTool(server, &mcp.Tool{
+	addTool(reg, &mcp.Tool{
 		Name:        "k0rdent.mgmt.clusterDeployments.list",
-		Description: "List all ClusterDeployments. Returns clusters from allowed namespaces with optional filtering by namespace.",
+		Description: "List all ClusterDeployments. Returns clusters from allowed namespaces with optional filtering by namespace. Set phases (e.g. [\"Failed\", \"Provisioning\"]) to compute each cluster's provisioning phase (Initializing, Provisioning, Bootstrapping, Scaling, Installing, Ready, Failed, Unknown) and return only matching clusters. Set limit to page through results, passing back the returned continue token.",
 		Meta: mcp.Meta{
 			"plane":    "mgmt",
 			"category": "clusterDeployments",
@@ -258,10 +395,13 @@ func registerClusters(server *mcp.Server, session *runtime.Session) error {
 	}, listClustersTool.list)
 
 	// Register k0rdent.mgmt.clusterDeployments.services.apply
-	serviceApplyTool := &clusterServiceApplyTool{session: session}
-	mcp.AddTool(server, &mcp.Tool{
+	serviceApplyTool := &clusterServiceApplyTool{
+		session:       session,
+		schemaFetcher: &api.ChartValuesSchemaFetcher{Client: session.Clients.Dynamic},
+	}
+	addTool(reg, &mcp.Tool{
 		Name:        "k0rdent.mgmt.clusterDeployments.services.apply",
-		Description: "Attach or update a ServiceTemplate entry on a running ClusterDeployment using server-side apply. Supports dry-run previews and returns the service status snapshot.",
+		Description: "Attach or update a ServiceTemplate entry on a running ClusterDeployment using server-side apply. Server-configured default values are deep-merged under the provided values (provided values win). Supports dry-run previews and returns the service status snapshot; when the controller has not reported status yet, statusPending is set and the desired service entry is returned instead.",
 		Meta: mcp.Meta{
 			"plane":    "mgmt",
 			"category": "clusterDeployments",
@@ -269,11 +409,34 @@ func registerClusters(server *mcp.Server, session *runtime.Session) error {
 		},
 	}, serviceApplyTool.apply)
 
+	// Register k0rdent.mgmt.clusterDeployments.services.applyBatch
+	addTool(reg, &mcp.Tool{
+		Name:        "k0rdent.mgmt.clusterDeployments.services.applyBatch",
+		Description: "Create or update several ServiceTemplate entries on a running ClusterDeployment in one server-side apply, so the controller reconciles the final service set once. Each entry takes the same fields as services.apply; dependsOn may name other services in the same call. All entries, and the combined dependsOn graph (unknown references, cycles), are validated before anything is written. Returns per-service status; statusPending is set for services the controller has not reported yet.",
+		Meta: mcp.Meta{
+			"plane":    "mgmt",
+			"category": "clusterDeployments",
+			"action":   "services.applyBatch",
+		},
+	}, serviceApplyTool.applyBatch)
+
+	// Register k0rdent.mgmt.clusterDeployments.services.get
+	serviceGetTool := &clusterServiceGetTool{session: session}
+	addTool(reg, &mcp.Tool{
+		Name:        "k0rdent.mgmt.clusterDeployments.services.get",
+		Description: "Get a single service of a ClusterDeployment: its desired spec.serviceSpec.services entry, observed status, and available upgrade paths. Cheaper than reading the whole cluster when iterating on one service's configuration; statusPending is set when the controller has not reported status for the service yet.",
+		Meta: mcp.Meta{
+			"plane":    "mgmt",
+			"category": "clusterDeployments",
+			"action":   "services.get",
+		},
+	}, serviceGetTool.get)
+
 	// Register k0rdent.mgmt.clusterDeployments.services.remove
 	serviceRemoveTool := &removeClusterServiceTool{session: session}
-	mcp.AddTool(server, &mcp.Tool{
+	addTool(reg, &mcp.Tool{
 		Name:        "k0rdent.mgmt.clusterDeployments.services.remove",
-		Description: "Remove a service from a running ClusterDeployment by deleting its entry from spec.serviceSpec.services[]",
+		Description: "Remove a service from a running ClusterDeployment by deleting its entry from spec.serviceSpec.services[]. Returns the remaining services. Fails when the service is not present (unless ignoreMissing is set) or when other services still list it in dependsOn.",
 		Meta: mcp.Meta{
 			"plane":    "mgmt",
 			"category": "clusterDeployments",
@@ -285,7 +448,7 @@ func registerClusters(server *mcp.Server, session *runtime.Session) error {
 
 	// Register k0rdent.provider.aws.clusterDeployments.deploy
 	awsDeployTool := &awsClusterDeployTool{session: session}
-	mcp.AddTool(server, &mcp.Tool{
+	addTool(reg, &mcp.Tool{
 		Name:        "k0rdent.provider.aws.clusterDeployments.deploy",
 		Description: "Deploy a new AWS Kubernetes cluster. Automatically selects the latest stable AWS template and validates AWS-specific configuration (region, instanceType). Exposes AWS-specific parameters directly in the tool schema for easy agent discovery.",
 		Meta: mcp.Meta{
@@ -298,7 +461,7 @@ func registerClusters(server *mcp.Server, session *runtime.Session) error {
 
 	// Register k0rdent.provider.azure.clusterDeployments.deploy
 	azureDeployTool := &azureClusterDeployTool{session: session}
-	mcp.AddTool(server, &mcp.Tool{
+	addTool(reg, &mcp.Tool{
 		Name:        "k0rdent.provider.azure.clusterDeployments.deploy",
 		Description: "Deploy a new Azure Kubernetes cluster. Automatically selects the latest stable Azure template and validates Azure-specific configuration (location, subscriptionID, vmSize). Exposes Azure-specific parameters directly in the tool schema for easy agent discovery.",
 		Meta: mcp.Meta{
@@ -311,7 +474,7 @@ func registerClusters(server *mcp.Server, session *runtime.Session) error {
 
 	// Register k0rdent.provider.gcp.clusterDeployments.deploy
 	gcpDeployTool := &gcpClusterDeployTool{session: session}
-	mcp.AddTool(server, &mcp.Tool{
+	addTool(reg, &mcp.Tool{
 		Name:        "k0rdent.provider.gcp.clusterDeployments.deploy",
 		Description: "Deploy a new GCP Kubernetes cluster. Automatically selects the latest stable GCP template and validates GCP-specific configuration (project, region, network.name, instanceType). Exposes GCP-specific parameters directly in the tool schema for easy agent discovery.",
 		Meta: mcp.Meta{
@@ -322,9 +485,35 @@ func registerClusters(server *mcp.Server, session *runtime.Session) error {
 		},
 	}, gcpDeployTool.deploy)
 
+	// Register k0rdent.provider.vsphere.clusterDeployments.deploy
+	vsphereDeployTool := &vsphereClusterDeployTool{session: session}
+	addTool(reg, &mcp.Tool{
+		Name:        "k0rdent.provider.vsphere.clusterDeployments.deploy",
+		Description: "Deploy a new vSphere Kubernetes cluster. Automatically selects the latest stable vSphere template and validates vSphere-specific configuration (vsphere.datacenter, vsphere.datastore, vsphere.resourcePool, vmTemplate, network). Exposes vSphere-specific parameters directly in the tool schema for easy agent discovery.",
+		Meta: mcp.Meta{
+			"plane":    "provider",
+			"category": "clusterDeployments",
+			"action":   "deploy",
+			"provider": "vsphere",
+		},
+	}, vsphereDeployTool.deploy)
+
+	// Register k0rdent.provider.openstack.clusterDeployments.deploy
+	openstackDeployTool := &openstackClusterDeployTool{session: session}
+	addTool(reg, &mcp.Tool{
+		Name:        "k0rdent.provider.openstack.clusterDeployments.deploy",
+		Description: "Deploy a new OpenStack Kubernetes cluster. Automatically selects the latest stable OpenStack template and validates OpenStack-specific configuration (authURL, region, externalNetwork, flavor, image). Exposes OpenStack-specific parameters directly in the tool schema for easy agent discovery.",
+		Meta: mcp.Meta{
+			"plane":    "provider",
+			"category": "clusterDeployments",
+			"action":   "deploy",
+			"provider": "openstack",
+		},
+	}, openstackDeployTool.deploy)
+
 	// Register k0rdent.provider.azure.clusterDeployments.detail
 	azureDetailTool := &azureClusterDetailTool{session: session}
-	mcp.AddTool(server, &mcp.Tool{
+	addTool(reg, &mcp.Tool{
 		Name:        "k0rdent.provider.azure.clusterDeployments.detail",
 		Description: "Fetch deep Azure infrastructure inspection for a ClusterDeployment. Returns provider-specific infrastructure details including resource group, subscription ID, location, network topology (VNet, subnets), NAT gateway, load balancers, and security groups. Complements getState by providing detailed infrastructure IDs and topology.",
 		Meta: mcp.Meta{
@@ -337,7 +526,7 @@ func registerClusters(server *mcp.Server, session *runtime.Session) error {
 
 	// Register k0rdent.provider.gcp.clusterDeployments.detail
 	gcpDetailTool := &gcpClusterDetailTool{session: session}
-	mcp.AddTool(server, &mcp.Tool{
+	addTool(reg, &mcp.Tool{
 		Name:        "k0rdent.provider.gcp.clusterDeployments.detail",
 		Description: "Fetch deep GCP infrastructure inspection for a ClusterDeployment. Returns provider-specific infrastructure details including project, region, network topology, subnets, firewall rules, and routers. Complements getState by providing detailed infrastructure IDs and topology.",
 		Meta: mcp.Meta{
@@ -350,7 +539,7 @@ func registerClusters(server *mcp.Server, session *runtime.Session) error {
 
 	// Register k0rdent.provider.aws.clusterDeployments.detail
 	awsDetailTool := &awsClusterDetailTool{session: session}
-	mcp.AddTool(server, &mcp.Tool{
+	addTool(reg, &mcp.Tool{
 		Name:        "k0rdent.provider.aws.clusterDeployments.detail",
 		Description: "Fetch deep AWS infrastructure inspection for a ClusterDeployment. Returns provider-specific infrastructure details including VPC ID, subnet IDs, security groups, load balancers, NAT gateways, internet gateway, and IAM roles. Complements getState by providing detailed infrastructure IDs and topology.",
 		Meta: mcp.Meta{
@@ -361,11 +550,119 @@ func registerClusters(server *mcp.Server, session *runtime.Session) error {
 		},
 	}, awsDetailTool.detail)
 
+	// Register k0rdent.mgmt.clusterDeployments.metrics
+	metricsTool := &clusterMetricsTool{session: session}
+	addTool(reg, &mcp.Tool{
+		Name:        "k0rdent.mgmt.clusterDeployments.metrics",
+		Description: "Summarize CPU and memory usage for a child cluster. Connects to the child cluster using its kubeconfig secret and queries the metrics API (metrics.k8s.io) for per-node and per-namespace usage. Returns a clear error when metrics-server is not installed on the child cluster.",
+		Meta: mcp.Meta{
+			"plane":    "mgmt",
+			"category": "clusterDeployments",
+			"action":   "metrics",
+		},
+	}, metricsTool.metrics)
+
+	// Register k0rdent.mgmt.clusterDeployments.getKubeconfig
+	kubeconfigTool := &clusterKubeconfigTool{session: session}
+	addTool(reg, &mcp.Tool{
+		Name:        "k0rdent.mgmt.clusterDeployments.getKubeconfig",
+		Description: "Fetch the kubeconfig of a provisioned child cluster from its kubeconfig secret (<name>-kubeconfig, data key 'value' or 'kubeconfig'). Returns the kubeconfig base64-encoded together with the API server URL. Fails with a 'kubeconfig not ready' error while the cluster is still provisioning. Set credential='token' to receive instead a kubeconfig that authenticates with a short-lived token for the given child cluster ServiceAccount (TokenRequest API) rather than the stored admin credentials; expiresAt reports when it stops working.",
+		Meta: mcp.Meta{
+			"plane":    "mgmt",
+			"category": "clusterDeployments",
+			"action":   "getKubeconfig",
+		},
+	}, kubeconfigTool.getKubeconfig)
+
+	// Register k0rdent.mgmt.clusterDeployments.get
+	getTool := &clusterGetTool{session: session}
+	addTool(reg, &mcp.Tool{
+		Name:        "k0rdent.mgmt.clusterDeployments.get",
+		Description: "Get a ClusterDeployment regardless of provider: the summary returned by clusterDeployments.list (template and credential refs, phase, conditions, kubeconfig secret reference) plus, when the provider is recognized as AWS, Azure, or GCP, the provider infrastructure detail under aws, azure, or gcp. If provider detail cannot be fetched yet, detailError explains why and the summary is still returned.",
+		Meta: mcp.Meta{
+			"plane":    "mgmt",
+			"category": "clusterDeployments",
+			"action":   "get",
+		},
+	}, getTool.get)
+
+	// Register k0rdent.mgmt.clusterDeployments.relatedResources
+	relatedTool := &clusterRelatedTool{session: session}
+	addTool(reg, &mcp.Tool{
+		Name:        "k0rdent.mgmt.clusterDeployments.relatedResources",
+		Description: "List the Kubernetes objects belonging to a ClusterDeployment as an ownership tree: the CAPI Cluster, infrastructure cluster (AWSCluster, AzureCluster, ...), control plane, MachineDeployments, MachineSets, Machines, and secrets such as the kubeconfig. Objects are found by the cluster.x-k8s.io/cluster-name label and ownerReferences in the deployment's namespace and report their Ready condition and phase. Secret contents are never returned.",
+		Meta: mcp.Meta{
+			"plane":    "mgmt",
+			"category": "clusterDeployments",
+			"action":   "relatedResources",
+		},
+	}, relatedTool.related)
+
+	// Register k0rdent.mgmt.clusterDeployments.endpoint
+	endpointTool := &clusterEndpointTool{session: session}
+	addTool(reg, &mcp.Tool{
+		Name:        "k0rdent.mgmt.clusterDeployments.endpoint",
+		Description: "Resolve a child cluster's API server endpoint (host, port, URL) from its CAPI Cluster and report whether it is reachable right now via a quick TCP dial and TLS handshake (5s timeout). Certificates are not verified; an unassigned or unreachable endpoint is reported with an error message rather than failing the call.",
+		Meta: mcp.Meta{
+			"plane":    "mgmt",
+			"category": "clusterDeployments",
+			"action":   "endpoint",
+		},
+	}, endpointTool.endpoint)
+
+	// Register k0rdent.mgmt.clusterDeployments.compare
+	compareTool := &clusterCompareTool{session: session}
+	addTool(reg, &mcp.Tool{
+		Name:        "k0rdent.mgmt.clusterDeployments.compare",
+		Description: "Compare two ClusterDeployments for troubleshooting (\"why does A work but B doesn't\"). Returns both cluster summaries plus a structured diff: leaf-level spec differences (template, credential, config, services keyed by name), per-service status differences, and condition status/reason differences. Input: left and right, each {name, namespace}.",
+		Meta: mcp.Meta{
+			"plane":    "mgmt",
+			"category": "clusterDeployments",
+			"action":   "compare",
+		},
+	}, compareTool.compare)
+
+	// Register k0rdent.mgmt.clusterDeployments.update
+	updateTool := &clusterUpdateTool{session: session}
+	addTool(reg, &mcp.Tool{
+		Name:        "k0rdent.mgmt.clusterDeployments.update",
+		Description: "Update a ClusterDeployment's spec.config with a JSON merge patch (set a key to null to remove it). Immutable fields such as region, location, project, and clusterIdentity cannot be changed. Returns the list of changed keys with old and new values. Supports dryRun to preview the diff without applying.",
+		Meta: mcp.Meta{
+			"plane":    "mgmt",
+			"category": "clusterDeployments",
+			"action":   "update",
+		},
+	}, updateTool.update)
+
+	// Register k0rdent.mgmt.clusterDeployments.scale
+	scaleTool := &clusterScaleTool{session: session}
+	addTool(reg, &mcp.Tool{
+		Name:        "k0rdent.mgmt.clusterDeployments.scale",
+		Description: "Change the node counts of a running ClusterDeployment by patching spec.config.controlPlaneNumber and/or spec.config.workersNumber. Only the counts provided are changed; the control plane needs at least one node and the worker count may not be negative. Returns the resulting counts and the changed keys.",
+		Meta: mcp.Meta{
+			"plane":    "mgmt",
+			"category": "clusterDeployments",
+			"action":   "scale",
+		},
+	}, scaleTool.scale)
+
+	// Register k0rdent.mgmt.clusterDeployments.reconcile
+	reconcileTool := &clusterReconcileTool{session: session}
+	addTool(reg, &mcp.Tool{
+		Name:        "k0rdent.mgmt.clusterDeployments.reconcile",
+		Description: "Force the controller to re-reconcile a ClusterDeployment by setting the k0rdent.mirantis.com/reconcile annotation to the current timestamp. Use it to recover a stuck reconciliation; the spec is not changed. Returns the annotation value that was set.",
+		Meta: mcp.Meta{
+			"plane":    "mgmt",
+			"category": "clusterDeployments",
+			"action":   "reconcile",
+		},
+	}, reconcileTool.reconcile)
+
 	// Register k0rdent.mgmt.clusterDeployments.delete
 	deleteTool := &clustersDeleteTool{session: session}
-	mcp.AddTool(server, &mcp.Tool{
+	addTool(reg, &mcp.Tool{
 		Name:        "k0rdent.mgmt.clusterDeployments.delete",
-		Description: "Delete a ClusterDeployment. Uses foreground propagation to ensure proper finalizer execution and resource cleanup. By default (wait=false), returns immediately after initiating deletion. Set wait=true to poll until deletion completes. Idempotent (returns success if already deleted).",
+		Description: "Delete a ClusterDeployment. Uses foreground propagation to ensure proper finalizer execution and resource cleanup. By default (wait=false), returns immediately after initiating deletion. Set wait=true to poll until deletion completes. Idempotent (returns success if already deleted); the outcome field reports Deleting, AlreadyAbsent, or NotFound, and initiated is true only when this call started the deletion.",
 		Meta: mcp.Meta{
 			"plane":    "mgmt",
 			"category": "clusterDeployments",
@@ -381,32 +678,71 @@ func (t *clustersListCredentialsTool) list(ctx context.Context, req *mcp.CallToo
 	ctx, logger := toolContext(ctx, t.session, name, "tool.clusters")
 	start := time.Now()
 
-	// TODO: Add metrics tracking (task 2.3, 3.4)
-	// Increment clusters_list_credentials_total counter
-	// Record duration histogram on completion
+	outcome := metrics.OutcomeSuccess
+	metricsLabels := metrics.Labels{Tool: name}
+	defer func() {
+		t.session.Metrics().RecordListCredentials(metricsLabels, outcome)
+	}()
 
 	logger.Debug("listing cluster credentials",
 		"tool", name,
 		"namespace", input.Namespace,
 	)
 
+	providerFilter := strings.ToLower(strings.TrimSpace(input.Provider))
+	if providerFilter != "" && !isKnownProvider(providerFilter) {
+		outcome = metrics.OutcomeError
+		return nil, clustersListCredentialsResult{}, fmt.Errorf("INVALID_INPUT: unknown provider %q; valid providers: %s", input.Provider, strings.Join(knownProviderNames(), ", "))
+	}
+
 	// Resolve target namespaces
 	targetNamespaces, err := t.resolveTargetNamespaces(ctx, input.Namespace, logger)
 	if err != nil {
+		outcome = metrics.OutcomeForbidden
 		logger.Error("failed to resolve target namespaces", "tool", name, "error", err)
 		return nil, clustersListCredentialsResult{}, fmt.Errorf("resolve namespaces: %w", err)
 	}
+	globalNS := t.session.GlobalNamespace()
+	if input.Namespace == "" && input.IncludeGlobalCredentials != nil && !*input.IncludeGlobalCredentials {
+		targetNamespaces = slices.DeleteFunc(targetNamespaces, func(ns string) bool { return ns == globalNS })
+	}
+	metricsLabels.Namespace = metrics.NamespaceLabel(targetNamespaces)
 
 	logger.Debug("resolved target namespaces for credentials", "tool", name, "namespaces", targetNamespaces)
 
 	// List credentials using cluster manager
-	credentials, err := t.session.Clusters.ListCredentials(ctx, targetNamespaces)
-	if err != nil {
+	listCtx, cancel := withListTimeout(ctx, t.session)
+	credentials, next, err := t.session.Clusters.ListCredentialsPage(listCtx, targetNamespaces, clusters.ListOptions{Limit: input.Limit, Continue: input.Continue})
+	cancel()
+	err = listTimeoutError(listCtx, err)
+	failures, partial := namespaceFailures(err)
+	var warnings []string
+	switch {
+	case partial:
+		// A forbidden global namespace degrades to the session's own namespaces
+		var remaining []clusters.NamespaceFailure
+		for _, failure := range failures {
+			if failure.Namespace == globalNS && failure.Reason == string(metav1.StatusReasonForbidden) {
+				logger.Warn("skipping forbidden global namespace for credentials", "tool", name, "namespace", globalNS)
+				warnings = append(warnings, fmt.Sprintf("global namespace %s is not readable; listing credentials from session namespaces only", globalNS))
+				continue
+			}
+			remaining = append(remaining, failure)
+		}
+		failures = remaining
+		if len(failures) > 0 {
+			logger.Warn("credentials listed with namespace failures", "tool", name, "failed_namespaces", len(failures), "error", err)
+		}
+	case err != nil && input.Namespace == "" && apierrors.IsForbidden(err) && slices.Equal(targetNamespaces, []string{globalNS}):
+		logger.Warn("global namespace credentials not readable", "tool", name, "namespace", globalNS, "error", err)
+		warnings = append(warnings, fmt.Sprintf("global namespace %s is not readable and no session namespaces are available", globalNS))
+		credentials = []clusters.CredentialSummary{}
+	case err != nil:
+		outcome = classifyMetricsOutcome(err)
 		logger.Error("failed to list credentials", "tool", name, "error", err)
 		return nil, clustersListCredentialsResult{}, fmt.Errorf("list credentials: %w", err)
 	}
 
-	providerFilter := strings.ToLower(strings.TrimSpace(input.Provider))
 	var filtered []clusters.CredentialSummary
 	if providerFilter == "" {
 		filtered = credentials
@@ -426,7 +762,7 @@ func (t *clustersListCredentialsTool) list(ctx context.Context, req *mcp.CallToo
 		"duration_ms", time.Since(start).Milliseconds(),
 	)
 
-	return nil, clustersListCredentialsResult{Credentials: filtered}, nil
+	return nil, clustersListCredentialsResult{Credentials: filtered, Failures: failures, Warnings: warnings, Continue: next}, nil
 }
 
 func (t *providersListTool) list(ctx context.Context, req *mcp.CallToolRequest, _ any) (*mcp.CallToolResult, providersListResult, error) {
@@ -437,6 +773,21 @@ func (t *providersListTool) list(ctx context.Context, req *mcp.CallToolRequest,
 	providers := make([]clusters.ProviderSummary, len(defaultProviderSummaries))
 	copy(providers, defaultProviderSummaries)
 
+	// Default Kubernetes versions come from the templates installed in the global
+	// namespace; the static list is still returned if they cannot be read.
+	if t.session != nil && t.session.Clusters != nil {
+		templates, err := t.session.Clusters.ListTemplates(ctx, []string{t.session.GlobalNamespace()})
+		if err != nil {
+			logger.Warn("failed to list templates for provider defaults", "tool", name, "error", err)
+		} else {
+			for i := range providers {
+				if latest, ok := t.session.Clusters.LatestStableTemplate(templates, providers[i].Name); ok {
+					providers[i].DefaultK8sVersion = latest.K8sVersion
+				}
+			}
+		}
+	}
+
 	return nil, providersListResult{Providers: providers}, nil
 }
 
@@ -454,29 +805,35 @@ func (t *providersListIdentitiesTool) list(ctx context.Context, req *mcp.CallToo
 
 	logger.Debug("resolved namespaces for identity listing", "tool", name, "namespaces", targetNamespaces)
 
-	identities, err := t.session.Clusters.ListIdentities(ctx, targetNamespaces)
-	if err != nil {
+	listCtx, cancel := withListTimeout(ctx, t.session)
+	identities, err := t.session.Clusters.ListIdentities(listCtx, targetNamespaces)
+	cancel()
+	err = listTimeoutError(listCtx, err)
+	failures, partial := namespaceFailures(err)
+	var warnings []string
+	switch {
+	case partial:
+		logger.Warn("identities listed with namespace failures", "tool", name, "failed_namespaces", len(failures), "error", err)
+		for _, failure := range failures {
+			warnings = append(warnings, fmt.Sprintf("namespace %s skipped: %s", failure.Namespace, failure.Error))
+		}
+	case err != nil && input.Namespace == "" && apierrors.IsForbidden(err):
+		// No namespace could be read; report an empty view rather than failing the call
+		logger.Warn("identities not readable in any namespace", "tool", name, "namespaces", len(targetNamespaces), "error", err)
+		warnings = append(warnings, fmt.Sprintf("no credentials readable in %d namespace(s): %v", len(targetNamespaces), err))
+		identities = []clusters.IdentitySummary{}
+	case err != nil:
 		logger.Error("failed to list identities", "tool", name, "error", err)
 		return nil, providersListIdentitiesResult{}, fmt.Errorf("list identities: %w", err)
 	}
 
-	for i := range identities {
-		sort.Strings(identities[i].Credentials)
-	}
-	sort.Slice(identities, func(i, j int) bool {
-		if identities[i].Namespace == identities[j].Namespace {
-			return identities[i].Name < identities[j].Name
-		}
-		return identities[i].Namespace < identities[j].Namespace
-	})
-
 	logger.Info("cluster identities listed",
 		"tool", name,
 		"count", len(identities),
 		"duration_ms", time.Since(start).Milliseconds(),
 	)
 
-	return nil, providersListIdentitiesResult{Identities: identities}, nil
+	return nil, providersListIdentitiesResult{Identities: identities, Failures: failures, Warnings: warnings}, nil
 }
 
 func (t *clustersListTemplatesTool) list(ctx context.Context, req *mcp.CallToolRequest, input clustersListTemplatesInput) (*mcp.CallToolResult, clustersListTemplatesResult, error) {
@@ -484,33 +841,52 @@ func (t *clustersListTemplatesTool) list(ctx context.Context, req *mcp.CallToolR
 	ctx, logger := toolContext(ctx, t.session, name, "tool.clusters")
 	start := time.Now()
 
-	// TODO: Add metrics tracking (task 2.3, 3.4)
-	// Increment clusters_list_templates_total counter
-	// Record duration histogram on completion
+	outcome := metrics.OutcomeSuccess
+	metricsLabels := metrics.Labels{Tool: name}
+	defer func() {
+		t.session.Metrics().RecordListTemplates(metricsLabels, outcome)
+	}()
 
 	logger.Debug("listing cluster templates",
 		"tool", name,
 		"scope", input.Scope,
 		"namespace", input.Namespace,
+		"label_selector", input.LabelSelector,
 	)
 
 	// Validate scope
 	if input.Scope != "global" && input.Scope != "local" && input.Scope != "all" {
+		outcome = metrics.OutcomeError
 		return nil, clustersListTemplatesResult{}, fmt.Errorf("scope must be 'global', 'local', or 'all'")
 	}
+	labelSelector, err := parseListLabelSelector(input.LabelSelector)
+	if err != nil {
+		outcome = metrics.OutcomeError
+		logger.Error("invalid label selector", "tool", name, "label_selector", input.LabelSelector, "error", err)
+		return nil, clustersListTemplatesResult{}, err
+	}
 
 	// Resolve target namespaces based on scope
 	targetNamespaces, err := t.resolveTargetNamespaces(ctx, input.Scope, input.Namespace, logger)
 	if err != nil {
+		outcome = metrics.OutcomeForbidden
 		logger.Error("failed to resolve target namespaces", "tool", name, "error", err)
 		return nil, clustersListTemplatesResult{}, fmt.Errorf("resolve namespaces: %w", err)
 	}
+	metricsLabels.Namespace = metrics.NamespaceLabel(targetNamespaces)
 
 	logger.Debug("resolved target namespaces for templates", "tool", name, "namespaces", targetNamespaces, "scope", input.Scope)
 
 	// List templates using cluster manager
-	templates, err := t.session.Clusters.ListTemplates(ctx, targetNamespaces)
-	if err != nil {
+	listCtx, cancel := withListTimeout(ctx, t.session)
+	templates, next, err := t.session.Clusters.ListTemplatesPage(listCtx, targetNamespaces, clusters.ListOptions{LabelSelector: labelSelector, Limit: input.Limit, Continue: input.Continue})
+	cancel()
+	err = listTimeoutError(listCtx, err)
+	failures, partial := namespaceFailures(err)
+	if partial {
+		logger.Warn("templates listed with namespace failures", "tool", name, "failed_namespaces", len(failures), "error", err)
+	} else if err != nil {
+		outcome = classifyMetricsOutcome(err)
 		logger.Error("failed to list templates", "tool", name, "error", err)
 		return nil, clustersListTemplatesResult{}, fmt.Errorf("list templates: %w", err)
 	}
@@ -522,7 +898,59 @@ func (t *clustersListTemplatesTool) list(ctx context.Context, req *mcp.CallToolR
 		"duration_ms", time.Since(start).Milliseconds(),
 	)
 
-	return nil, clustersListTemplatesResult{Templates: templates}, nil
+	return nil, clustersListTemplatesResult{Templates: templates, Failures: failures, Continue: next}, nil
+}
+
+func (t *clusterTemplateChainsTool) upgradeChains(ctx context.Context, req *mcp.CallToolRequest, input clusterTemplateChainsInput) (*mcp.CallToolResult, clusterTemplateChainsResult, error) {
+	name := toolName(req)
+	ctx, logger := toolContext(ctx, t.session, name, "tool.clusters")
+	start := time.Now()
+
+	scope := input.Scope
+	if scope == "" {
+		scope = "all"
+	}
+	if scope != "global" && scope != "local" && scope != "all" {
+		return nil, clusterTemplateChainsResult{}, fmt.Errorf("scope must be 'global', 'local', or 'all'")
+	}
+
+	resolver := &clustersListTemplatesTool{session: t.session}
+	targetNamespaces, err := resolver.resolveTargetNamespaces(ctx, scope, input.Namespace, logger)
+	if err != nil {
+		logger.Error("failed to resolve target namespaces", "tool", name, "error", err)
+		return nil, clusterTemplateChainsResult{}, fmt.Errorf("resolve namespaces: %w", err)
+	}
+
+	chains, err := t.session.Clusters.ListTemplateUpgradeChains(ctx, targetNamespaces)
+	failures, partial := namespaceFailures(err)
+	if partial {
+		logger.Warn("upgrade chains built with namespace failures", "tool", name, "failed_namespaces", len(failures), "error", err)
+	} else if err != nil {
+		logger.Error("failed to build upgrade chains", "tool", name, "error", err)
+		return nil, clusterTemplateChainsResult{}, fmt.Errorf("list template upgrade chains: %w", err)
+	}
+
+	if provider := strings.TrimSpace(input.Provider); provider != "" {
+		filtered := make([]clusters.TemplateUpgradeChain, 0, len(chains))
+		for _, chain := range chains {
+			if strings.EqualFold(chain.Provider, provider) {
+				filtered = append(filtered, chain)
+			}
+		}
+		chains = filtered
+	}
+	if chains == nil {
+		chains = []clusters.TemplateUpgradeChain{}
+	}
+
+	logger.Info("cluster template upgrade chains listed",
+		"tool", name,
+		"scope", scope,
+		"count", len(chains),
+		"duration_ms", time.Since(start).Milliseconds(),
+	)
+
+	return nil, clusterTemplateChainsResult{Chains: chains, Failures: failures}, nil
 }
 
 func (t *clustersDeleteTool) delete(ctx context.Context, req *mcp.CallToolRequest, input clustersDeleteInput) (*mcp.CallToolResult, clustersDeleteResult, error) {
@@ -530,9 +958,11 @@ func (t *clustersDeleteTool) delete(ctx context.Context, req *mcp.CallToolReques
 	ctx, logger := toolContext(ctx, t.session, name, "tool.clusters")
 	start := time.Now()
 
-	// TODO: Add metrics tracking (task 2.3, 3.4)
-	// Increment clusters_delete_total counter (label by outcome: success/error)
-	// Record duration histogram on completion
+	outcome := metrics.OutcomeSuccess
+	metricsLabels := metrics.Labels{Tool: name}
+	defer func() {
+		t.session.Metrics().RecordDelete(metricsLabels, outcome, time.Since(start))
+	}()
 
 	logger.Debug("deleting cluster",
 		"tool", name,
@@ -542,54 +972,56 @@ func (t *clustersDeleteTool) delete(ctx context.Context, req *mcp.CallToolReques
 
 	// Validate required fields
 	if input.Name == "" {
+		outcome = metrics.OutcomeError
 		return nil, clustersDeleteResult{}, fmt.Errorf("cluster name is required")
 	}
 
+	// Validate wait parameters up front so a typo is not silently replaced by the default
+	pollInterval, err := parseDurationInput("pollInterval", input.PollInterval, 60*time.Second)
+	if err != nil {
+		outcome = metrics.OutcomeError
+		return nil, clustersDeleteResult{}, err
+	}
+	deletionTimeout, err := parseDurationInput("deletionTimeout", input.DeletionTimeout, 20*time.Minute)
+	if err != nil {
+		outcome = metrics.OutcomeError
+		return nil, clustersDeleteResult{}, err
+	}
+
 	// Resolve target namespace
 	targetNamespace, err := t.resolveDeleteNamespace(ctx, input.Namespace, logger)
 	if err != nil {
+		outcome = metrics.OutcomeForbidden
 		logger.Error("failed to resolve delete namespace", "tool", name, "error", err)
 		return nil, clustersDeleteResult{}, fmt.Errorf("resolve namespace: %w", err)
 	}
+	metricsLabels.Namespace = targetNamespace
 
 	logger.Debug("resolved delete namespace", "tool", name, "namespace", targetNamespace)
 
 	// Delete cluster using cluster manager
 	deleteResult, err := t.session.Clusters.DeleteCluster(ctx, targetNamespace, input.Name)
 	if err != nil {
+		outcome = classifyMetricsOutcome(err)
 		logger.Error("failed to delete cluster", "tool", name, "error", err)
 		return nil, clustersDeleteResult{}, fmt.Errorf("delete cluster: %w", err)
 	}
 
 	result := clustersDeleteResult(deleteResult)
 
-	// If wait=true, wait for deletion to complete
-	if input.Wait {
+	// If wait=true, wait for deletion to complete; there is nothing to wait for once the cluster is gone
+	if input.Wait && result.Outcome == clusters.DeleteOutcomeDeleting {
 		logger.Info("waiting for cluster deletion to complete",
 			"tool", name,
 			"cluster_name", input.Name,
 			"namespace", targetNamespace,
 		)
 
-		// Parse wait parameters with defaults
-		pollInterval := 60 * time.Second
-		if input.PollInterval != "" {
-			if parsed, err := time.ParseDuration(input.PollInterval); err == nil {
-				pollInterval = parsed
-			}
-		}
-
-		deletionTimeout := 20 * time.Minute
-		if input.DeletionTimeout != "" {
-			if parsed, err := time.ParseDuration(input.DeletionTimeout); err == nil {
-				deletionTimeout = parsed
-			}
-		}
-
 		// Wait for deletion to complete using shared helper
 		waitHelper := &clusterWaitHelper{session: t.session}
 		completed, err := waitHelper.waitForDeletion(ctx, targetNamespace, input.Name, pollInterval, deletionTimeout, logger)
 		if err != nil {
+			outcome = metrics.OutcomeError
 			logger.Error("error waiting for deletion", "tool", name, "error", err)
 			return nil, result, fmt.Errorf("wait for deletion: %w", err)
 		}
@@ -615,6 +1047,8 @@ func (t *clustersDeleteTool) delete(ctx context.Context, req *mcp.CallToolReques
 		"cluster_name", input.Name,
 		"namespace", targetNamespace,
 		"status", result.Status,
+		"outcome", result.Outcome,
+		"initiated", result.Initiated,
 		"wait", input.Wait,
 		"duration_ms", time.Since(start).Milliseconds(),
 	)
@@ -634,11 +1068,22 @@ func (t *clustersListTool) list(ctx context.Context, req *mcp.CallToolRequest, i
 	logger.Debug("listing cluster deployments",
 		"tool", name,
 		"namespace", input.Namespace,
+		"phases", input.Phases,
+		"label_selector", input.LabelSelector,
 	)
 
+	phases, err := parsePhaseFilter(input.Phases)
+	if err != nil {
+		return nil, clustersListResult{}, err
+	}
+	labelSelector, err := parseListLabelSelector(input.LabelSelector)
+	if err != nil {
+		logger.Error("invalid label selector", "tool", name, "label_selector", input.LabelSelector, "error", err)
+		return nil, clustersListResult{}, err
+	}
+
 	// Resolve target namespaces
 	var targetNamespaces []string
-	var err error
 
 	if input.Namespace != "" {
 		// Validate the specified namespace
@@ -659,19 +1104,73 @@ func (t *clustersListTool) list(ctx context.Context, req *mcp.CallToolRequest, i
 	logger.Debug("resolved target namespaces for cluster deployments", "tool", name, "namespaces", targetNamespaces)
 
 	// List cluster deployments using cluster manager
-	clusters, err := t.session.Clusters.ListClusters(ctx, targetNamespaces)
-	if err != nil {
+	listOpts := clusters.ListOptions{LabelSelector: labelSelector, Limit: input.Limit, Continue: input.Continue}
+	listCtx, cancel := withListTimeout(ctx, t.session)
+	clusters, next, err := t.session.Clusters.ListClustersPage(listCtx, targetNamespaces, listOpts)
+	cancel()
+	err = listTimeoutError(listCtx, err)
+	failures, partial := namespaceFailures(err)
+	if partial {
+		logger.Warn("cluster deployments listed with namespace failures", "tool", name, "failed_namespaces", len(failures), "error", err)
+	} else if err != nil {
 		logger.Error("failed to list cluster deployments", "tool", name, "error", err)
 		return nil, clustersListResult{}, fmt.Errorf("list cluster deployments: %w", err)
 	}
 
+	if len(phases) > 0 {
+		clusters = filterClustersByPhase(clusters, phases)
+	}
+
 	logger.Info("cluster deployments listed",
 		"tool", name,
 		"count", len(clusters),
 		"duration_ms", time.Since(start).Milliseconds(),
 	)
 
-	return nil, clustersListResult{Clusters: clusters}, nil
+	return nil, clustersListResult{Clusters: clusters, Failures: failures, Continue: next}, nil
+}
+
+// parseListLabelSelector validates a list tool's labelSelector so a malformed selector is
+// reported before any namespace is listed.
+func parseListLabelSelector(raw string) (string, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return "", nil
+	}
+	if _, err := labels.Parse(raw); err != nil {
+		return "", fmt.Errorf("invalid labelSelector %q (expected e.g. \"environment=prod\" or \"tier in (web,api)\"): %w", raw, err)
+	}
+	return raw, nil
+}
+
+// parsePhaseFilter validates requested provisioning phase names.
+func parsePhaseFilter(values []string) (map[clustermonitor.ProvisioningPhase]bool, error) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+	phases := make(map[clustermonitor.ProvisioningPhase]bool, len(values))
+	for _, value := range values {
+		phase, ok := clustermonitor.ParsePhase(value)
+		if !ok {
+			return nil, fmt.Errorf("unknown phase %q (valid: Initializing, Provisioning, Bootstrapping, Scaling, Installing, Ready, Failed, Unknown)", value)
+		}
+		phases[phase] = true
+	}
+	return phases, nil
+}
+
+// filterClustersByPhase infers each cluster's provisioning phase and keeps those in phases.
+func filterClustersByPhase(items []clusters.ClusterDeploymentSummary, phases map[clustermonitor.ProvisioningPhase]bool) []clusters.ClusterDeploymentSummary {
+	filtered := make([]clusters.ClusterDeploymentSummary, 0, len(items))
+	for _, item := range items {
+		phase := clustermonitor.DetectPhaseFromSummary(item, nil)
+		if !phases[phase] {
+			continue
+		}
+		item.ProvisioningPhase = string(phase)
+		filtered = append(filtered, item)
+	}
+	return filtered
 }
 
 func (t *clusterServiceApplyTool) apply(ctx context.Context, req *mcp.CallToolRequest, input clusterServiceApplyInput) (*mcp.CallToolResult, clusterServiceApplyResult, error) {
@@ -679,21 +1178,14 @@ func (t *clusterServiceApplyTool) apply(ctx context.Context, req *mcp.CallToolRe
 	ctx, logger := toolContext(ctx, t.session, name, "tool.clusters")
 	start := time.Now()
 	outcome := metrics.OutcomeSuccess
+	// Only label with the cluster namespace once it has passed the namespace filter.
+	metricsLabels := metrics.Labels{Tool: name}
 	defer func() {
-		if t.session != nil && t.session.ClusterMetrics != nil {
-			t.session.ClusterMetrics.RecordServiceApply(outcome, time.Since(start))
-		}
+		t.session.Metrics().RecordServiceApply(metricsLabels, outcome, time.Since(start))
 	}()
 
 	clusterNamespace := strings.TrimSpace(input.ClusterNamespace)
 	clusterName := strings.TrimSpace(input.ClusterName)
-	templateNamespace := strings.TrimSpace(input.TemplateNamespace)
-	templateName := strings.TrimSpace(input.TemplateName)
-	serviceNamespace := strings.TrimSpace(input.ServiceNamespace)
-	serviceName := strings.TrimSpace(input.ServiceName)
-	if templateNamespace == "" {
-		templateNamespace = t.session.GlobalNamespace()
-	}
 
 	if clusterNamespace == "" {
 		outcome = metrics.OutcomeError
@@ -703,47 +1195,39 @@ func (t *clusterServiceApplyTool) apply(ctx context.Context, req *mcp.CallToolRe
 		outcome = metrics.OutcomeError
 		return nil, clusterServiceApplyResult{}, fmt.Errorf("clusterName is required")
 	}
-	if templateName == "" {
-		outcome = metrics.OutcomeError
-		return nil, clusterServiceApplyResult{}, fmt.Errorf("templateName is required")
-	}
-	if serviceName == "" {
-		serviceName = templateName
-	}
-	if serviceName == "" {
-		outcome = metrics.OutcomeError
-		return nil, clusterServiceApplyResult{}, fmt.Errorf("serviceName could not be derived")
-	}
 	if err := t.ensureNamespaceAllowed("clusterNamespace", clusterNamespace); err != nil {
 		outcome = metrics.OutcomeForbidden
 		return nil, clusterServiceApplyResult{}, err
 	}
-	if err := t.ensureNamespaceAllowed("templateNamespace", templateNamespace); err != nil {
-		outcome = metrics.OutcomeForbidden
+	metricsLabels.Namespace = clusterNamespace
+
+	entry, entryOutcome, err := t.normalizeServiceEntry(serviceApplyEntry{
+		TemplateNamespace: input.TemplateNamespace,
+		TemplateName:      input.TemplateName,
+		ServiceName:       input.ServiceName,
+		ServiceNamespace:  input.ServiceNamespace,
+		Values:            input.Values,
+		ValuesFrom:        input.ValuesFrom,
+		HelmOptions:       input.HelmOptions,
+		DependsOn:         input.DependsOn,
+		Priority:          input.Priority,
+	})
+	if err != nil {
+		outcome = entryOutcome
 		return nil, clusterServiceApplyResult{}, err
 	}
-	if serviceNamespace != "" {
-		if err := t.ensureNamespaceAllowed("serviceNamespace", serviceNamespace); err != nil {
-			outcome = metrics.OutcomeForbidden
-			return nil, clusterServiceApplyResult{}, err
-		}
-	}
+	serviceName := entry.ServiceName
 
 	logger.Debug("applying cluster service",
 		"tool", name,
 		"cluster_namespace", clusterNamespace,
 		"cluster_name", clusterName,
-		"template", fmt.Sprintf("%s/%s", templateNamespace, templateName),
+		"template", fmt.Sprintf("%s/%s", entry.TemplateNamespace, entry.TemplateName),
 		"service_name", serviceName,
-		"service_namespace", serviceNamespace,
+		"service_namespace", entry.ServiceNamespace,
 		"dry_run", input.DryRun,
 	)
 
-	if input.Priority != nil && *input.Priority < 0 {
-		outcome = metrics.OutcomeError
-		return nil, clusterServiceApplyResult{}, fmt.Errorf("priority must be zero or positive")
-	}
-
 	client := t.session.Clients.Dynamic
 
 	clusterObj, err := client.
@@ -756,106 +1240,30 @@ func (t *clusterServiceApplyTool) apply(ctx context.Context, req *mcp.CallToolRe
 		return nil, clusterServiceApplyResult{}, fmt.Errorf("get cluster deployment: %w", err)
 	}
 
+	// Batch applies resolve dependsOn against the services they add; a single apply can only
+	// depend on services already on the cluster.
 	existingServices := collectServiceNames(clusterObj)
-
-	var dependsOnPtr *[]string
-	if len(input.DependsOn) > 0 {
-		deps := make([]string, len(input.DependsOn))
-		var missing []string
-		for i, raw := range input.DependsOn {
-			dep := strings.TrimSpace(raw)
-			if dep == "" {
-				outcome = metrics.OutcomeError
-				return nil, clusterServiceApplyResult{}, fmt.Errorf("dependsOn[%d] must not be empty", i)
-			}
-			if dep == serviceName {
-				outcome = metrics.OutcomeError
-				return nil, clusterServiceApplyResult{}, fmt.Errorf("dependsOn cannot reference the target service (%s)", serviceName)
-			}
-			if _, ok := existingServices[dep]; !ok {
-				missing = append(missing, dep)
-			}
-			deps[i] = dep
-		}
-		if len(missing) > 0 {
-			outcome = metrics.OutcomeError
-			return nil, clusterServiceApplyResult{}, fmt.Errorf("dependsOn references unknown services: %s", strings.Join(missing, ", "))
-		}
-		depsCopy := deps
-		dependsOnPtr = &depsCopy
-	}
-
-	var valuesFromPtr *[]api.ClusterServiceValuesFrom
-	if len(input.ValuesFrom) > 0 {
-		ptr, err := convertValuesFromInputs(input.ValuesFrom)
-		if err != nil {
-			outcome = metrics.OutcomeError
-			return nil, clusterServiceApplyResult{}, err
+	var missing []string
+	for _, dep := range entry.DependsOn {
+		if _, ok := existingServices[dep]; !ok {
+			missing = append(missing, dep)
 		}
-		valuesFromPtr = ptr
 	}
-
-	helmOpts, err := convertHelmOptionsInput(input.HelmOptions)
-	if err != nil {
+	if len(missing) > 0 {
 		outcome = metrics.OutcomeError
-		return nil, clusterServiceApplyResult{}, err
+		return nil, clusterServiceApplyResult{}, fmt.Errorf("dependsOn references unknown services: %s", strings.Join(missing, ", "))
 	}
 
-	var serviceValues *string
-	if len(input.Values) > 0 {
-		valuesYAML, err := yaml.Marshal(input.Values)
-		if err != nil {
-			outcome = metrics.OutcomeError
-			return nil, clusterServiceApplyResult{}, fmt.Errorf("encode values: %w", err)
-		}
-		val := string(valuesYAML)
-		serviceValues = &val
-	}
-
-	templateObj, err := client.
-		Resource(api.ServiceTemplateGVR()).
-		Namespace(templateNamespace).
-		Get(ctx, templateName, metav1.GetOptions{})
+	serviceSpec, specOutcome, err := t.buildServiceSpec(ctx, entry, logger)
 	if err != nil {
-		outcome = classifyMetricsOutcome(err)
-		logger.Error("service template validation failed", "tool", name, "error", err)
-		return nil, clusterServiceApplyResult{}, fmt.Errorf("get service template: %w", err)
-	}
-	logger.Debug("validated service template",
-		"tool", name,
-		"template_namespace", templateObj.GetNamespace(),
-		"template_name", templateObj.GetName(),
-	)
-
-	serviceSpec := api.ClusterServiceApplySpec{
-		TemplateNamespace: templateNamespace,
-		TemplateName:      templateName,
-		ServiceName:       serviceName,
-	}
-	if serviceNamespace != "" {
-		ns := serviceNamespace
-		serviceSpec.ServiceNamespace = &ns
-	}
-	if serviceValues != nil {
-		serviceSpec.Values = serviceValues
-	}
-	if valuesFromPtr != nil {
-		serviceSpec.ValuesFrom = valuesFromPtr
-	}
-	if helmOpts != nil {
-		serviceSpec.HelmOptions = helmOpts
-	}
-	if dependsOnPtr != nil {
-		serviceSpec.DependsOn = dependsOnPtr
-	}
-	if input.Priority != nil {
-		priority := *input.Priority
-		serviceSpec.Priority = &priority
+		outcome = specOutcome
+		return nil, clusterServiceApplyResult{}, err
 	}
 
 	applyOpts := api.ApplyClusterServiceOptions{
 		ClusterNamespace: clusterNamespace,
 		ClusterName:      clusterName,
+		FieldOwner:       t.session.ServiceFieldOwner(),
 		DryRun:           input.DryRun,
 		Service:          serviceSpec,
 	}
@@ -908,6 +1316,11 @@ func (t *clusterServiceApplyTool) apply(ctx context.Context, req *mcp.CallToolRe
 		if state, ok := response.Status["state"].(string); ok {
 			statusState = state
 		}
+	} else {
+		response.StatusPending = true
+		response.DesiredService = extractDesiredService(statusSource, appliedServiceName)
+		response.Message = "service status not reported yet; reconciliation in progress"
+		statusState = "pending"
 	}
 
 	logger.Info("cluster service apply completed",
@@ -967,6 +1380,7 @@ func (t *removeClusterServiceTool) remove(ctx context.Context, req *mcp.CallTool
 		ClusterNamespace: clusterNamespace,
 		ClusterName:      clusterName,
 		ServiceName:      serviceName,
+		FieldOwner:       t.session.ServiceFieldOwner(),
 		DryRun:           input.DryRun,
 	}
 
@@ -976,6 +1390,10 @@ func (t *removeClusterServiceTool) remove(ctx context.Context, req *mcp.CallTool
 		logger.Error("failed to remove service", "tool", name, "error", err)
 		return nil, removeClusterServiceResult{}, err
 	}
+	if removeResult.RemovedService == nil && !input.IgnoreMissing {
+		outcome = metrics.OutcomeError
+		return nil, removeClusterServiceResult{}, fmt.Errorf("service %q not found in cluster deployment %s/%s (set ignoreMissing to treat this as success)", serviceName, clusterNamespace, clusterName)
+	}
 
 	statusSource := removeResult.UpdatedCluster
 	if !input.DryRun {
@@ -1045,6 +1463,37 @@ func extractUpdatedServices(cluster *unstructured.Unstructured) []map[string]any
 	return services
 }
 
+// validateValues checks values against the chart's values.schema.json. Validation is
+// skipped with a warning when the chart publishes no schema, it cannot be fetched, or
+// the chart's schema itself is malformed.
+func (t *clusterServiceApplyTool) validateValues(ctx context.Context, templateObj *unstructured.Unstructured, values map[string]any, logger *slog.Logger) error {
+	fetcher := t.schemaFetcher
+	if fetcher == nil {
+		fetcher = &api.ChartValuesSchemaFetcher{Client: t.session.Clients.Dynamic}
+	}
+
+	chart, err := fetcher.FetchChartValues(ctx, templateObj)
+	if err != nil {
+		logger.Warn("skipping values schema validation",
+			"template_namespace", templateObj.GetNamespace(),
+			"template_name", templateObj.GetName(),
+			"error", err,
+		)
+		return nil
+	}
+
+	err = api.ValidateServiceValues(templateObj.GetNamespace()+"/"+templateObj.GetName(), chart, values)
+	if errors.Is(err, api.ErrInvalidValuesSchema) {
+		logger.Warn("skipping values schema validation",
+			"template_namespace", templateObj.GetNamespace(),
+			"template_name", templateObj.GetName(),
+			"error", err,
+		)
+		return nil
+	}
+	return err
+}
+
 func (t *clusterServiceApplyTool) ensureNamespaceAllowed(field, namespace string) error {
 	if namespace == "" {
 		return fmt.Errorf("%s is required", field)
@@ -1107,14 +1556,25 @@ func convertValuesFromInputs(list []serviceValuesFromInput) (*[]api.ClusterServi
 	return &entries, nil
 }
 
+// parseDurationInput parses an optional duration field, returning def when it is empty.
+// Unparseable or non-positive values are rejected with an INVALID_INPUT error naming the field.
+func parseDurationInput(field, value string, def time.Duration) (time.Duration, error) {
+	if value == "" {
+		return def, nil
+	}
+	parsed, err := time.ParseDuration(value)
+	if err != nil || parsed <= 0 {
+		return 0, fmt.Errorf("INVALID_INPUT: %s must be a positive duration such as \"30s\" or \"20m\", got %q", field, value)
+	}
+	return parsed, nil
+}
+
 func convertHelmOptionsInput(input *serviceHelmOptionsInput) (*api.ClusterServiceHelmOptions, error) {
 	if input == nil {
 		return nil, nil
 	}
-	if input.Timeout != "" {
-		if _, err := time.ParseDuration(input.Timeout); err != nil {
-			return nil, fmt.Errorf("helmOptions.timeout must be a valid duration: %w", err)
-		}
+	if _, err := parseDurationInput("helmOptions.timeout", input.Timeout, 0); err != nil {
+		return nil, err
 	}
 	if input.MaxHistory != nil && *input.MaxHistory < 0 {
 		return nil, fmt.Errorf("helmOptions.maxHistory must be zero or positive")
@@ -1152,6 +1612,27 @@ func extractServiceStatus(cluster *unstructured.Unstructured, serviceName string
 	return nil
 }
 
+// extractDesiredService returns the spec.serviceSpec.services entry for serviceName.
+func extractDesiredService(cluster *unstructured.Unstructured, serviceName string) map[string]any {
+	if cluster == nil || serviceName == "" {
+		return nil
+	}
+	list, found, err := unstructured.NestedSlice(cluster.Object, "spec", "serviceSpec", "services")
+	if err != nil || !found {
+		return nil
+	}
+	for _, entry := range list {
+		m, ok := entry.(map[string]any)
+		if !ok {
+			continue
+		}
+		if name, _ := m["name"].(string); name == serviceName {
+			return deepCopyJSONMap(m)
+		}
+	}
+	return nil
+}
+
 func extractServiceUpgradePaths(cluster *unstructured.Unstructured, serviceName string) []map[string]any {
 	if cluster == nil || serviceName == "" {
 		return nil
@@ -1193,6 +1674,25 @@ func deepCopyJSONMap(value map[string]any) map[string]any {
 	return copy
 }
 
+// mergeServiceValues deep-merges values over defaults. Nested maps are merged key by key;
+// any other value from values, including null, replaces the default.
+func mergeServiceValues(defaults, values map[string]any) map[string]any {
+	if len(defaults) == 0 {
+		return values
+	}
+	merged := deepCopyJSONMap(defaults)
+	for k, v := range values {
+		if override, ok := v.(map[string]any); ok {
+			if base, ok := merged[k].(map[string]any); ok {
+				merged[k] = mergeServiceValues(base, override)
+				continue
+			}
+		}
+		merged[k] = cloneJSONValue(v)
+	}
+	return merged
+}
+
 func cloneJSONValue(val any) any {
 	switch v := val.(type) {
 	case map[string]any:
@@ -1238,7 +1738,7 @@ func (t *clustersListCredentialsTool) resolveTargetNamespaces(ctx context.Contex
 	}
 
 	// Always include global namespace (kcm-system) for credentials
-	globalNS := "kcm-system"
+	globalNS := t.session.GlobalNamespace()
 	hasGlobal := false
 	for _, ns := range namespaces {
 		if ns == globalNS {
@@ -1316,11 +1816,32 @@ func (t *clustersDeleteTool) resolveDeleteNamespace(ctx context.Context, namespa
 		return namespace, nil
 	}
 
-	// DEV_ALLOW_ANY mode: default to kcm-system
+	// DEV_ALLOW_ANY mode: default to DEFAULT_NAMESPACE (kcm-system)
 	// OIDC_REQUIRED mode: require explicit namespace
-	if t.session.NamespaceFilter == nil || t.session.NamespaceFilter.MatchString("kcm-system") {
-		logger.Debug("defaulting to kcm-system namespace (DEV_ALLOW_ANY mode)")
-		return "kcm-system", nil
+	defaultNamespace := t.session.DefaultNamespace()
+	if t.session.NamespaceFilter == nil || t.session.NamespaceFilter.MatchString(defaultNamespace) {
+		logger.Debug("defaulting to configured namespace (DEV_ALLOW_ANY mode)", "namespace", defaultNamespace)
+		return defaultNamespace, nil
+	}
+
+	return "", fmt.Errorf("namespace must be specified in OIDC_REQUIRED mode (use 'namespace' parameter)")
+}
+
+// resolveClusterNamespace determines the namespace of a single ClusterDeployment operation:
+// an explicit namespace must pass the session filter; without one, DEFAULT_NAMESPACE is used
+// when the filter allows it (DEV_ALLOW_ANY), otherwise the caller must name one (OIDC_REQUIRED).
+func resolveClusterNamespace(ctx context.Context, session *runtime.Session, namespace string, logger *slog.Logger) (string, error) {
+	if namespace != "" {
+		if session.NamespaceFilter != nil && !session.NamespaceFilter.MatchString(namespace) {
+			return "", fmt.Errorf("namespace %q not allowed by namespace filter", namespace)
+		}
+		return namespace, nil
+	}
+
+	defaultNamespace := session.DefaultNamespace()
+	if session.NamespaceFilter == nil || session.NamespaceFilter.MatchString(defaultNamespace) {
+		logger.Debug("defaulting to configured namespace (DEV_ALLOW_ANY mode)", "namespace", defaultNamespace)
+		return defaultNamespace, nil
 	}
 
 	return "", fmt.Errorf("namespace must be specified in OIDC_REQUIRED mode (use 'namespace' parameter)")
@@ -1335,8 +1856,27 @@ func (t *clustersListTemplatesTool) getAllowedNamespaces(ctx context.Context, lo
 	return getAllowedNamespacesHelper(ctx, t.session, logger)
 }
 
+// namespaceFailures extracts per-namespace failures from a partial multi-namespace error.
+// It reports false for nil and for errors that aborted the whole operation.
+func namespaceFailures(err error) ([]clusters.NamespaceFailure, bool) {
+	var partial *clusters.PartialError
+	if errors.As(err, &partial) {
+		return partial.Failures, true
+	}
+	return nil, false
+}
+
+// errExplicitNamespaceRequired is returned instead of enumerating namespaces when
+// REQUIRE_EXPLICIT_NAMESPACE is set.
+var errExplicitNamespaceRequired = errors.New("namespace must be specified: namespace enumeration is disabled by REQUIRE_EXPLICIT_NAMESPACE (use 'namespace' parameter)")
+
 // getAllowedNamespacesHelper is a shared helper to get allowed namespaces
 func getAllowedNamespacesHelper(ctx context.Context, session *runtime.Session, logger *slog.Logger) ([]string, error) {
+	if session.RequireExplicitNamespace() {
+		logger.Warn("namespace enumeration disabled; explicit namespace required")
+		return nil, errExplicitNamespaceRequired
+	}
+
 	// List all namespaces from the cluster
 	nsGVR := schema.GroupVersionResource{
 		Group:    "",
@@ -1344,8 +1884,15 @@ func getAllowedNamespacesHelper(ctx context.Context, session *runtime.Session, l
 		Resource: "namespaces",
 	}
 
-	nsList, err := session.Clients.Dynamic.Resource(nsGVR).List(ctx, metav1.ListOptions{})
-	if err != nil {
+	listCtx, cancel := withListTimeout(ctx, session)
+	var nsList *unstructured.UnstructuredList
+	err := kube.RetryRead(listCtx, func(ctx context.Context) error {
+		var listErr error
+		nsList, listErr = session.Clients.Dynamic.Resource(nsGVR).List(ctx, metav1.ListOptions{})
+		return listErr
+	})
+	cancel()
+	if err = listTimeoutError(listCtx, err); err != nil {
 		return nil, fmt.Errorf("list namespaces: %w", err)
 	}
 
@@ -24,8 +24,9 @@ type awsClusterDeployInput struct {
 	Worker             awsNodeConfig     `json:"worker" jsonschema:"Worker node configuration"`
 	ControlPlaneNumber int               `json:"controlPlaneNumber,omitempty" jsonschema:"Number of control plane nodes (default: 3)"`
 	WorkersNumber      int               `json:"workersNumber,omitempty" jsonschema:"Number of worker nodes (default: 2)"`
-	Namespace          string            `json:"namespace,omitempty" jsonschema:"Deployment namespace (default: kcm-system)"`
+	Namespace          string            `json:"namespace,omitempty" jsonschema:"Deployment namespace (default: DEFAULT_NAMESPACE, kcm-system unless set)"`
 	Labels             map[string]string `json:"labels,omitempty" jsonschema:"Labels for the cluster"`
+	Annotations        map[string]string `json:"annotations,omitempty" jsonschema:"Annotations for the cluster"`
 	Wait               bool              `json:"wait,omitempty" jsonschema:"Wait for cluster to be ready before returning"`
 	WaitTimeout        string            `json:"waitTimeout,omitempty" jsonschema:"Maximum time to wait for cluster ready (default: 30m)"`
 }
@@ -69,10 +70,10 @@ func (t *awsClusterDeployTool) deploy(ctx context.Context, req *mcp.CallToolRequ
 		return nil, awsClusterDeployResult{}, fmt.Errorf("worker instance type is required")
 	}
 
-	// Default namespace to kcm-system if not specified
+	// Default namespace to DEFAULT_NAMESPACE (kcm-system) if not specified
 	namespace := input.Namespace
 	if namespace == "" {
-		namespace = "kcm-system"
+		namespace = t.session.DefaultNamespace()
 	}
 
 	// Auto-select latest AWS template
@@ -126,12 +127,13 @@ func (t *awsClusterDeployTool) deploy(ctx context.Context, req *mcp.CallToolRequ
 
 	// Create generic deploy request
 	deployReq := clusters.DeployRequest{
-		Name:       input.Name,
-		Template:   template,
-		Credential: input.Credential,
-		Namespace:  namespace,
-		Labels:     input.Labels,
-		Config:     config,
+		Name:        input.Name,
+		Template:    template,
+		Credential:  input.Credential,
+		Namespace:   namespace,
+		Labels:      input.Labels,
+		Annotations: input.Annotations,
+		Config:      config,
 	}
 
 	// Call existing deploy logic (reuses validation!)
@@ -3,7 +3,6 @@ package core
 import (
 	"context"
 	"fmt"
-	"log/slog"
 	"time"
 
 	"github.com/k0rdent/mcp-k0rdent-server/internal/clusters"
@@ -43,7 +42,7 @@ func (t *awsClusterDetailTool) detail(ctx context.Context, req *mcp.CallToolRequ
 	}
 
 	// Resolve target namespace
-	targetNamespace, err := t.resolveNamespace(ctx, input.Namespace, logger)
+	targetNamespace, err := resolveClusterNamespace(ctx, t.session, input.Namespace, logger)
 	if err != nil {
 		logger.Error("failed to resolve namespace", "tool", name, "error", err)
 		return nil, awsClusterDetailResult{}, fmt.Errorf("resolve namespace: %w", err)
@@ -77,26 +76,3 @@ func (t *awsClusterDetailTool) detail(ctx context.Context, req *mcp.CallToolRequ
 
 	return nil, result, nil
 }
-
-// resolveNamespace determines the target namespace for AWS cluster detail retrieval
-func (t *awsClusterDetailTool) resolveNamespace(ctx context.Context, namespace string, logger *slog.Logger) (string, error) {
-	// If specific namespace provided, validate it
-	if namespace != "" {
-		if t.session.NamespaceFilter != nil && !t.session.NamespaceFilter.MatchString(namespace) {
-			return "", fmt.Errorf("namespace %q not allowed by namespace filter", namespace)
-		}
-		return namespace, nil
-	}
-
-	// No namespace specified - determine default behavior
-	// DEV_ALLOW_ANY mode (no filter or matches all): default to kcm-system
-	// OIDC_REQUIRED mode (restricted filter): require explicit namespace
-	if t.session.NamespaceFilter == nil || t.session.NamespaceFilter.MatchString("kcm-system") {
-		// DEV_ALLOW_ANY mode - default to kcm-system
-		logger.Debug("defaulting to kcm-system namespace (DEV_ALLOW_ANY mode)")
-		return "kcm-system", nil
-	}
-
-	// OIDC_REQUIRED mode - require explicit namespace
-	return "", fmt.Errorf("namespace must be specified in OIDC_REQUIRED mode (use 'namespace' parameter)")
-}
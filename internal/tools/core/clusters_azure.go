@@ -27,8 +27,9 @@ type azureClusterDeployInput struct {
 	Worker             azureNodeConfig   `json:"worker" jsonschema:"Worker node configuration"`
 	ControlPlaneNumber int               `json:"controlPlaneNumber,omitempty" jsonschema:"Number of control plane nodes (default: 3)"`
 	WorkersNumber      int               `json:"workersNumber,omitempty" jsonschema:"Number of worker nodes (default: 2)"`
-	Namespace          string            `json:"namespace,omitempty" jsonschema:"Target namespace for deployment (default: kcm-system)"`
+	Namespace          string            `json:"namespace,omitempty" jsonschema:"Target namespace for deployment (default: DEFAULT_NAMESPACE, kcm-system unless set)"`
 	Labels             map[string]string `json:"labels,omitempty" jsonschema:"Additional labels to apply to the cluster deployment"`
+	Annotations        map[string]string `json:"annotations,omitempty" jsonschema:"Additional annotations to apply to the cluster deployment"`
 	Wait               bool              `json:"wait,omitempty" jsonschema:"Wait for cluster to be ready before returning"`
 	WaitTimeout        string            `json:"waitTimeout,omitempty" jsonschema:"Maximum time to wait for provisioning (default: 30m)"`
 }
@@ -129,12 +130,13 @@ func (t *azureClusterDeployTool) deploy(ctx context.Context, req *mcp.CallToolRe
 
 	// Build deploy request
 	deployReq := clusters.DeployRequest{
-		Name:       input.Name,
-		Template:   template,
-		Credential: input.Credential,
-		Namespace:  targetNamespace,
-		Labels:     input.Labels,
-		Config:     config,
+		Name:        input.Name,
+		Template:    template,
+		Credential:  input.Credential,
+		Namespace:   targetNamespace,
+		Labels:      input.Labels,
+		Annotations: input.Annotations,
+		Config:      config,
 	}
 
 	// Deploy cluster using cluster manager
@@ -217,12 +219,12 @@ func (t *azureClusterDeployTool) resolveDeployNamespace(ctx context.Context, nam
 	}
 
 	// No namespace specified - determine default behavior
-	// DEV_ALLOW_ANY mode (no filter or matches all): default to kcm-system
+	// DEV_ALLOW_ANY mode (no filter or matches the default): default to DEFAULT_NAMESPACE (kcm-system)
 	// OIDC_REQUIRED mode (restricted filter): require explicit namespace
-	if t.session.NamespaceFilter == nil || t.session.NamespaceFilter.MatchString("kcm-system") {
-		// DEV_ALLOW_ANY mode - default to kcm-system
-		logger.Debug("defaulting to kcm-system namespace (DEV_ALLOW_ANY mode)")
-		return "kcm-system", nil
+	defaultNamespace := t.session.DefaultNamespace()
+	if t.session.NamespaceFilter == nil || t.session.NamespaceFilter.MatchString(defaultNamespace) {
+		logger.Debug("defaulting to configured namespace (DEV_ALLOW_ANY mode)", "namespace", defaultNamespace)
+		return defaultNamespace, nil
 	}
 
 	// OIDC_REQUIRED mode - require explicit namespace
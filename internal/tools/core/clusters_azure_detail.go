@@ -3,7 +3,6 @@ package core
 import (
 	"context"
 	"fmt"
-	"log/slog"
 	"time"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
@@ -44,7 +43,7 @@ func (t *azureClusterDetailTool) detail(ctx context.Context, req *mcp.CallToolRe
 	}
 
 	// Resolve target namespace
-	targetNamespace, err := t.resolveNamespace(ctx, input.Namespace, logger)
+	targetNamespace, err := resolveClusterNamespace(ctx, t.session, input.Namespace, logger)
 	if err != nil {
 		logger.Error("failed to resolve namespace", "tool", name, "error", err)
 		return nil, azureClusterDetailResult{}, fmt.Errorf("resolve namespace: %w", err)
@@ -73,25 +72,3 @@ func (t *azureClusterDetailTool) detail(ctx context.Context, req *mcp.CallToolRe
 
 	return nil, result, nil
 }
-
-// resolveNamespace determines the target namespace for Azure cluster detail retrieval
-func (t *azureClusterDetailTool) resolveNamespace(ctx context.Context, namespace string, logger *slog.Logger) (string, error) {
-	// If specific namespace provided, validate it
-	if namespace != "" {
-		if t.session.NamespaceFilter != nil && !t.session.NamespaceFilter.MatchString(namespace) {
-			return "", fmt.Errorf("namespace %q not allowed by namespace filter", namespace)
-		}
-		return namespace, nil
-	}
-
-	// No namespace specified - in OIDC mode, require explicit namespace
-	// In DEV mode, we'll need the user to specify since we can't infer which cluster they mean
-	if t.session.NamespaceFilter == nil || t.session.NamespaceFilter.MatchString("kcm-system") {
-		// DEV_ALLOW_ANY mode - default to kcm-system
-		logger.Debug("defaulting to kcm-system namespace (DEV_ALLOW_ANY mode)")
-		return "kcm-system", nil
-	}
-
-	// OIDC_REQUIRED mode - require explicit namespace
-	return "", fmt.Errorf("namespace must be specified in OIDC_REQUIRED mode (use 'namespace' parameter)")
-}
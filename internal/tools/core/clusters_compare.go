@@ -0,0 +1,69 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/k0rdent/mcp-k0rdent-server/internal/clusters"
+	"github.com/k0rdent/mcp-k0rdent-server/internal/runtime"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// clusterCompareTool diffs two ClusterDeployments
+type clusterCompareTool struct {
+	session *runtime.Session
+}
+
+// clusterRefInput identifies one side of a comparison
+type clusterRefInput struct {
+	Name      string `json:"name" jsonschema:"Cluster deployment name"`
+	Namespace string `json:"namespace,omitempty" jsonschema:"Deployment namespace (optional, follows standard patterns)"`
+}
+
+// clusterCompareInput defines the input schema for cluster comparison
+type clusterCompareInput struct {
+	Left  clusterRefInput `json:"left" jsonschema:"First cluster (reported as 'left' in differences)"`
+	Right clusterRefInput `json:"right" jsonschema:"Second cluster (reported as 'right' in differences)"`
+}
+
+// clusterCompareResult is the result of a cluster comparison
+type clusterCompareResult clusters.ClusterComparison
+
+// compare handles the cluster comparison request
+func (t *clusterCompareTool) compare(ctx context.Context, req *mcp.CallToolRequest, input clusterCompareInput) (*mcp.CallToolResult, clusterCompareResult, error) {
+	name := toolName(req)
+	ctx, logger := toolContext(ctx, t.session, name, "tool.clusters.compare")
+	start := time.Now()
+
+	if input.Left.Name == "" || input.Right.Name == "" {
+		return nil, clusterCompareResult{}, fmt.Errorf("left.name and right.name are required")
+	}
+
+	refs := make([]clusters.ClusterRef, 0, 2)
+	for _, side := range []clusterRefInput{input.Left, input.Right} {
+		namespace, err := resolveClusterNamespace(ctx, t.session, side.Namespace, logger)
+		if err != nil {
+			logger.Error("failed to resolve namespace", "tool", name, "cluster_name", side.Name, "error", err)
+			return nil, clusterCompareResult{}, fmt.Errorf("resolve namespace for %s: %w", side.Name, err)
+		}
+		refs = append(refs, clusters.ClusterRef{Namespace: namespace, Name: side.Name})
+	}
+
+	comparison, err := t.session.Clusters.CompareClusters(ctx, refs[0], refs[1])
+	if err != nil {
+		logger.Error("failed to compare clusters", "tool", name, "error", err)
+		return nil, clusterCompareResult{}, fmt.Errorf("compare clusters: %w", err)
+	}
+
+	logger.Info("clusters compared",
+		"tool", name,
+		"left", refs[0].Namespace+"/"+refs[0].Name,
+		"right", refs[1].Namespace+"/"+refs[1].Name,
+		"identical", comparison.Identical,
+		"spec_differences", len(comparison.SpecDifferences),
+		"duration_ms", time.Since(start).Milliseconds(),
+	)
+
+	return nil, clusterCompareResult(comparison), nil
+}
@@ -0,0 +1,43 @@
+package core
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	runtimepkg "github.com/k0rdent/mcp-k0rdent-server/internal/runtime"
+)
+
+func TestParseDurationInput(t *testing.T) {
+	got, err := parseDurationInput("pollInterval", "", time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, time.Minute, got)
+
+	got, err = parseDurationInput("pollInterval", "15s", time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, 15*time.Second, got)
+
+	for _, bad := range []string{"20min", "-5s", "0s"} {
+		_, err = parseDurationInput("deletionTimeout", bad, time.Minute)
+		require.Error(t, err, bad)
+		assert.Contains(t, err.Error(), "INVALID_INPUT: deletionTimeout")
+	}
+}
+
+func TestClustersDelete_InvalidDurations(t *testing.T) {
+	tool := &clustersDeleteTool{session: &runtimepkg.Session{Logger: slog.Default()}}
+	req := &mcp.CallToolRequest{Params: &mcp.CallToolParamsRaw{Name: "k0rdent.mgmt.clusterDeployments.delete"}}
+
+	_, _, err := tool.delete(context.Background(), req, clustersDeleteInput{Name: "demo", Wait: true, DeletionTimeout: "20min"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "deletionTimeout")
+
+	_, _, err = tool.delete(context.Background(), req, clustersDeleteInput{Name: "demo", PollInterval: "often"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "pollInterval")
+}
@@ -0,0 +1,59 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/k0rdent/mcp-k0rdent-server/internal/clusters"
+	"github.com/k0rdent/mcp-k0rdent-server/internal/runtime"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// clusterEndpointTool resolves a child cluster's API server endpoint
+type clusterEndpointTool struct {
+	session *runtime.Session
+}
+
+// clusterEndpointInput defines the input schema for endpoint resolution
+type clusterEndpointInput struct {
+	Name      string `json:"name" jsonschema:"Cluster deployment name"`
+	Namespace string `json:"namespace,omitempty" jsonschema:"Deployment namespace (optional, follows standard patterns)"`
+}
+
+// clusterEndpointResult is the result of an endpoint resolution
+type clusterEndpointResult clusters.ClusterEndpoint
+
+// endpoint handles the endpoint resolution request
+func (t *clusterEndpointTool) endpoint(ctx context.Context, req *mcp.CallToolRequest, input clusterEndpointInput) (*mcp.CallToolResult, clusterEndpointResult, error) {
+	name := toolName(req)
+	ctx, logger := toolContext(ctx, t.session, name, "tool.clusters.endpoint")
+	start := time.Now()
+
+	if input.Name == "" {
+		return nil, clusterEndpointResult{}, fmt.Errorf("cluster name is required")
+	}
+
+	targetNamespace, err := resolveClusterNamespace(ctx, t.session, input.Namespace, logger)
+	if err != nil {
+		logger.Error("failed to resolve namespace", "tool", name, "error", err)
+		return nil, clusterEndpointResult{}, fmt.Errorf("resolve namespace: %w", err)
+	}
+
+	endpoint, err := t.session.Clusters.GetClusterEndpoint(ctx, targetNamespace, input.Name)
+	if err != nil {
+		logger.Error("failed to resolve cluster endpoint", "tool", name, "error", err)
+		return nil, clusterEndpointResult{}, fmt.Errorf("resolve cluster endpoint: %w", err)
+	}
+
+	logger.Info("cluster endpoint resolved",
+		"tool", name,
+		"cluster_name", input.Name,
+		"namespace", targetNamespace,
+		"url", endpoint.URL,
+		"reachable", endpoint.Reachable,
+		"duration_ms", time.Since(start).Milliseconds(),
+	)
+
+	return nil, clusterEndpointResult(endpoint), nil
+}
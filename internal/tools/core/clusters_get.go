@@ -0,0 +1,59 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/k0rdent/mcp-k0rdent-server/internal/clusters"
+	"github.com/k0rdent/mcp-k0rdent-server/internal/runtime"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// clusterGetTool returns the detail of a ClusterDeployment for any provider
+type clusterGetTool struct {
+	session *runtime.Session
+}
+
+// clusterGetInput defines the input schema for cluster detail retrieval
+type clusterGetInput struct {
+	Name      string `json:"name" jsonschema:"Cluster deployment name"`
+	Namespace string `json:"namespace,omitempty" jsonschema:"Deployment namespace (optional, follows standard patterns)"`
+}
+
+// clusterGetResult is the result of a cluster detail request
+type clusterGetResult clusters.ClusterDetail
+
+// get handles the cluster detail request
+func (t *clusterGetTool) get(ctx context.Context, req *mcp.CallToolRequest, input clusterGetInput) (*mcp.CallToolResult, clusterGetResult, error) {
+	name := toolName(req)
+	ctx, logger := toolContext(ctx, t.session, name, "tool.clusters.get")
+	start := time.Now()
+
+	if input.Name == "" {
+		return nil, clusterGetResult{}, fmt.Errorf("cluster name is required")
+	}
+
+	targetNamespace, err := resolveClusterNamespace(ctx, t.session, input.Namespace, logger)
+	if err != nil {
+		logger.Error("failed to resolve namespace", "tool", name, "error", err)
+		return nil, clusterGetResult{}, fmt.Errorf("resolve namespace: %w", err)
+	}
+
+	detail, err := t.session.Clusters.GetClusterDetail(ctx, targetNamespace, input.Name)
+	if err != nil {
+		logger.Error("failed to get cluster detail", "tool", name, "error", err)
+		return nil, clusterGetResult{}, fmt.Errorf("get cluster detail: %w", err)
+	}
+
+	logger.Info("cluster detail retrieved",
+		"tool", name,
+		"cluster_name", input.Name,
+		"namespace", targetNamespace,
+		"provider", detail.Provider,
+		"provider_detail", detail.DetailError == "" && (detail.AWS != nil || detail.Azure != nil || detail.GCP != nil),
+		"duration_ms", time.Since(start).Milliseconds(),
+	)
+
+	return nil, clusterGetResult(detail), nil
+}
@@ -0,0 +1,80 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/k0rdent/mcp-k0rdent-server/internal/clusters"
+	"github.com/k0rdent/mcp-k0rdent-server/internal/runtime"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// clusterKubeconfigTool returns the kubeconfig of a provisioned child cluster
+type clusterKubeconfigTool struct {
+	session *runtime.Session
+}
+
+// clusterKubeconfigInput defines the input schema for kubeconfig retrieval
+type clusterKubeconfigInput struct {
+	Name                    string `json:"name" jsonschema:"Cluster deployment name"`
+	Namespace               string `json:"namespace,omitempty" jsonschema:"Deployment namespace (optional, follows standard patterns)"`
+	Credential              string `json:"credential,omitempty" jsonschema:"'static' (default) returns the stored admin kubeconfig; 'token' returns a kubeconfig with a short-lived ServiceAccount token"`
+	ServiceAccount          string `json:"serviceAccount,omitempty" jsonschema:"Child cluster ServiceAccount the token is issued for (required for credential 'token')"`
+	ServiceAccountNamespace string `json:"serviceAccountNamespace,omitempty" jsonschema:"Namespace of the ServiceAccount in the child cluster (default: default)"`
+	ExpirationSeconds       int64  `json:"expirationSeconds,omitempty" jsonschema:"Token lifetime in seconds, 600-86400 (default: 3600)"`
+}
+
+// clusterKubeconfigResult is the result of a kubeconfig retrieval
+type clusterKubeconfigResult clusters.ClusterKubeconfig
+
+// getKubeconfig handles the kubeconfig retrieval request. The kubeconfig itself is never logged.
+func (t *clusterKubeconfigTool) getKubeconfig(ctx context.Context, req *mcp.CallToolRequest, input clusterKubeconfigInput) (*mcp.CallToolResult, clusterKubeconfigResult, error) {
+	name := toolName(req)
+	ctx, logger := toolContext(ctx, t.session, name, "tool.clusters.kubeconfig")
+	start := time.Now()
+
+	if input.Name == "" {
+		return nil, clusterKubeconfigResult{}, fmt.Errorf("cluster name is required")
+	}
+
+	targetNamespace, err := resolveClusterNamespace(ctx, t.session, input.Namespace, logger)
+	if err != nil {
+		logger.Error("failed to resolve namespace", "tool", name, "error", err)
+		return nil, clusterKubeconfigResult{}, fmt.Errorf("resolve namespace: %w", err)
+	}
+
+	var result clusters.ClusterKubeconfig
+	switch input.Credential {
+	case "", clusters.KubeconfigCredentialStatic:
+		result, err = t.session.Clusters.GetClusterKubeconfig(ctx, targetNamespace, input.Name)
+	case clusters.KubeconfigCredentialToken:
+		result, err = t.session.Clusters.GetClusterTokenKubeconfig(ctx, targetNamespace, input.Name, clusters.TokenKubeconfigOptions{
+			ServiceAccount:          input.ServiceAccount,
+			ServiceAccountNamespace: input.ServiceAccountNamespace,
+			Expiration:              time.Duration(input.ExpirationSeconds) * time.Second,
+		})
+	default:
+		return nil, clusterKubeconfigResult{}, fmt.Errorf("invalid credential %q: must be %q or %q", input.Credential, clusters.KubeconfigCredentialStatic, clusters.KubeconfigCredentialToken)
+	}
+	if err != nil {
+		if errors.Is(err, clusters.ErrKubeconfigNotReady) {
+			logger.Warn("child kubeconfig not ready", "tool", name, "cluster_name", input.Name, "namespace", targetNamespace)
+		} else {
+			logger.Error("failed to fetch child kubeconfig", "tool", name, "error", err)
+		}
+		return nil, clusterKubeconfigResult{}, fmt.Errorf("get kubeconfig: %w", err)
+	}
+
+	logger.Info("child kubeconfig fetched",
+		"tool", name,
+		"cluster_name", input.Name,
+		"namespace", targetNamespace,
+		"server", result.Server,
+		"credential", result.Credential,
+		"duration_ms", time.Since(start).Milliseconds(),
+	)
+
+	return nil, clusterKubeconfigResult(result), nil
+}
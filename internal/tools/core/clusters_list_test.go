@@ -0,0 +1,87 @@
+package core
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/k0rdent/mcp-k0rdent-server/internal/clusters"
+	clustermonitor "github.com/k0rdent/mcp-k0rdent-server/internal/kube/cluster_monitor"
+	"github.com/k0rdent/mcp-k0rdent-server/internal/runtime"
+)
+
+func TestParsePhaseFilter(t *testing.T) {
+	phases, err := parsePhaseFilter(nil)
+	require.NoError(t, err)
+	assert.Nil(t, phases)
+
+	phases, err = parsePhaseFilter([]string{"failed", "Provisioning"})
+	require.NoError(t, err)
+	assert.True(t, phases[clustermonitor.PhaseFailed])
+	assert.True(t, phases[clustermonitor.PhaseProvisioning])
+
+	_, err = parsePhaseFilter([]string{"Deleting"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Deleting")
+}
+
+func TestParseListLabelSelector(t *testing.T) {
+	selector, err := parseListLabelSelector("  ")
+	require.NoError(t, err)
+	assert.Empty(t, selector)
+
+	selector, err = parseListLabelSelector(" environment=prod,tier in (web) ")
+	require.NoError(t, err)
+	assert.Equal(t, "environment=prod,tier in (web)", selector)
+
+	_, err = parseListLabelSelector("environment in prod")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid labelSelector")
+}
+
+func TestFilterClustersByPhase(t *testing.T) {
+	items := []clusters.ClusterDeploymentSummary{
+		{Name: "ready", Ready: true},
+		{Name: "failed", Phase: "Failed", Conditions: []clusters.ConditionSummary{
+			{Type: "Ready", Status: "False", Reason: "Failed", Message: "Provisioning failed"},
+		}},
+		{Name: "new"},
+	}
+
+	filtered := filterClustersByPhase(items, map[clustermonitor.ProvisioningPhase]bool{
+		clustermonitor.PhaseFailed:       true,
+		clustermonitor.PhaseInitializing: true,
+	})
+
+	require.Len(t, filtered, 2)
+	assert.Equal(t, "failed", filtered[0].Name)
+	assert.Equal(t, "Failed", filtered[0].ProvisioningPhase)
+	assert.Equal(t, "new", filtered[1].Name)
+	assert.Equal(t, "Initializing", filtered[1].ProvisioningPhase)
+	assert.Empty(t, items[0].ProvisioningPhase, "input summaries must not be modified")
+}
+
+func TestResolveClusterNamespace(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	ctx := context.Background()
+
+	namespace, err := resolveClusterNamespace(ctx, &runtime.Session{}, "", logger)
+	require.NoError(t, err)
+	assert.Equal(t, "kcm-system", namespace)
+
+	restricted := &runtime.Session{NamespaceFilter: regexp.MustCompile("^team-")}
+	namespace, err = resolveClusterNamespace(ctx, restricted, "team-a", logger)
+	require.NoError(t, err)
+	assert.Equal(t, "team-a", namespace)
+
+	_, err = resolveClusterNamespace(ctx, restricted, "other", logger)
+	require.ErrorContains(t, err, "not allowed by namespace filter")
+
+	_, err = resolveClusterNamespace(ctx, restricted, "", logger)
+	require.ErrorContains(t, err, "namespace must be specified")
+}
@@ -0,0 +1,71 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/k0rdent/mcp-k0rdent-server/internal/clusters"
+	"github.com/k0rdent/mcp-k0rdent-server/internal/runtime"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// clusterMetricsTool reports child cluster resource usage from metrics.k8s.io
+type clusterMetricsTool struct {
+	session *runtime.Session
+}
+
+// clusterMetricsInput defines the input schema for child cluster metrics retrieval
+type clusterMetricsInput struct {
+	Name      string `json:"name" jsonschema:"Cluster deployment name"`
+	Namespace string `json:"namespace,omitempty" jsonschema:"Deployment namespace (optional, follows standard patterns)"`
+}
+
+// clusterMetricsResult is the result of a child cluster metrics request
+type clusterMetricsResult clusters.ClusterMetricsSummary
+
+// metrics handles the child cluster metrics request
+func (t *clusterMetricsTool) metrics(ctx context.Context, req *mcp.CallToolRequest, input clusterMetricsInput) (*mcp.CallToolResult, clusterMetricsResult, error) {
+	name := toolName(req)
+	ctx, logger := toolContext(ctx, t.session, name, "tool.clusters.metrics")
+	start := time.Now()
+
+	logger.Debug("fetching child cluster metrics",
+		"tool", name,
+		"cluster_name", input.Name,
+		"namespace", input.Namespace,
+	)
+
+	if input.Name == "" {
+		return nil, clusterMetricsResult{}, fmt.Errorf("cluster name is required")
+	}
+
+	targetNamespace, err := resolveClusterNamespace(ctx, t.session, input.Namespace, logger)
+	if err != nil {
+		logger.Error("failed to resolve namespace", "tool", name, "error", err)
+		return nil, clusterMetricsResult{}, fmt.Errorf("resolve namespace: %w", err)
+	}
+
+	summary, err := t.session.Clusters.GetClusterMetrics(ctx, targetNamespace, input.Name)
+	if err != nil {
+		if errors.Is(err, clusters.ErrMetricsUnavailable) {
+			logger.Warn("metrics API unavailable on child cluster", "tool", name, "cluster_name", input.Name, "error", err)
+		} else {
+			logger.Error("failed to fetch child cluster metrics", "tool", name, "error", err)
+		}
+		return nil, clusterMetricsResult{}, fmt.Errorf("fetch cluster metrics: %w", err)
+	}
+
+	logger.Info("child cluster metrics fetched",
+		"tool", name,
+		"cluster_name", input.Name,
+		"namespace", targetNamespace,
+		"node_count", len(summary.Nodes),
+		"cpu_millicores", summary.Total.CPUMillicores,
+		"memory_bytes", summary.Total.MemoryBytes,
+		"duration_ms", time.Since(start).Milliseconds(),
+	)
+
+	return nil, clusterMetricsResult(summary), nil
+}
@@ -0,0 +1,216 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/k0rdent/mcp-k0rdent-server/internal/clusters"
+	"github.com/k0rdent/mcp-k0rdent-server/internal/runtime"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// openstackClusterDeployTool implements OpenStack-specific cluster deployment
+type openstackClusterDeployTool struct {
+	session *runtime.Session
+}
+
+// openstackClusterDeployInput defines the input parameters for OpenStack cluster deployment
+type openstackClusterDeployInput struct {
+	Name               string              `json:"name" jsonschema:"Cluster deployment name"`
+	Credential         string              `json:"credential" jsonschema:"OpenStack credential name"`
+	AuthURL            string              `json:"authURL" jsonschema:"Keystone auth URL (e.g. https://keystone.example.com:5000/v3)"`
+	Region             string              `json:"region" jsonschema:"OpenStack region (e.g. RegionOne)"`
+	CloudName          string              `json:"cloudName,omitempty" jsonschema:"Cloud entry in the credential's clouds.yaml (default: openstack)"`
+	ExternalNetwork    string              `json:"externalNetwork" jsonschema:"External network name used for floating IPs and the API load balancer (e.g. public)"`
+	ControlPlane       openstackNodeConfig `json:"controlPlane" jsonschema:"Control plane node configuration"`
+	Worker             openstackNodeConfig `json:"worker" jsonschema:"Worker node configuration"`
+	ControlPlaneNumber int                 `json:"controlPlaneNumber,omitempty" jsonschema:"Number of control plane nodes (default: 3)"`
+	WorkersNumber      int                 `json:"workersNumber,omitempty" jsonschema:"Number of worker nodes (default: 2)"`
+	Namespace          string              `json:"namespace,omitempty" jsonschema:"Deployment namespace (default: DEFAULT_NAMESPACE, kcm-system unless set)"`
+	Labels             map[string]string   `json:"labels,omitempty" jsonschema:"Labels for the cluster"`
+	Annotations        map[string]string   `json:"annotations,omitempty" jsonschema:"Annotations for the cluster"`
+	Wait               bool                `json:"wait,omitempty" jsonschema:"Wait for cluster to be ready before returning"`
+	WaitTimeout        string              `json:"waitTimeout,omitempty" jsonschema:"Maximum time to wait for cluster ready (default: 30m)"`
+}
+
+// openstackNodeConfig defines OpenStack-specific node configuration
+type openstackNodeConfig struct {
+	Flavor string `json:"flavor" jsonschema:"Nova flavor (e.g. m1.medium, m1.large)"`
+	Image  string `json:"image" jsonschema:"Glance image name (e.g. ubuntu-22.04-x86_64)"`
+}
+
+// openstackClusterDeployResult is the result of an OpenStack cluster deployment
+type openstackClusterDeployResult clusters.DeployResult
+
+// deploy handles the OpenStack cluster deployment
+func (t *openstackClusterDeployTool) deploy(ctx context.Context, req *mcp.CallToolRequest, input openstackClusterDeployInput) (*mcp.CallToolResult, openstackClusterDeployResult, error) {
+	name := toolName(req)
+	ctx, logger := toolContext(ctx, t.session, name, "tool.clusters.openstack")
+	start := time.Now()
+
+	logger.Debug("deploying OpenStack cluster",
+		"tool", name,
+		"cluster_name", input.Name,
+		"region", input.Region,
+		"credential", input.Credential,
+		"namespace", input.Namespace,
+	)
+
+	// Validate required fields
+	if input.Name == "" {
+		return nil, openstackClusterDeployResult{}, fmt.Errorf("cluster name is required")
+	}
+	if input.Credential == "" {
+		return nil, openstackClusterDeployResult{}, fmt.Errorf("credential is required")
+	}
+	if input.AuthURL == "" {
+		return nil, openstackClusterDeployResult{}, fmt.Errorf("authURL is required")
+	}
+	if input.Region == "" {
+		return nil, openstackClusterDeployResult{}, fmt.Errorf("region is required")
+	}
+	if input.ExternalNetwork == "" {
+		return nil, openstackClusterDeployResult{}, fmt.Errorf("externalNetwork is required")
+	}
+	if input.ControlPlane.Flavor == "" {
+		return nil, openstackClusterDeployResult{}, fmt.Errorf("controlPlane.flavor is required")
+	}
+	if input.ControlPlane.Image == "" {
+		return nil, openstackClusterDeployResult{}, fmt.Errorf("controlPlane.image is required")
+	}
+	if input.Worker.Flavor == "" {
+		return nil, openstackClusterDeployResult{}, fmt.Errorf("worker.flavor is required")
+	}
+	if input.Worker.Image == "" {
+		return nil, openstackClusterDeployResult{}, fmt.Errorf("worker.image is required")
+	}
+
+	// Validate and apply defaults for node counts
+	controlPlaneNumber, workersNumber, err := validateAndDefaultNodeCounts(input.ControlPlaneNumber, input.WorkersNumber)
+	if err != nil {
+		return nil, openstackClusterDeployResult{}, err
+	}
+	input.ControlPlaneNumber = controlPlaneNumber
+	input.WorkersNumber = workersNumber
+
+	if input.CloudName == "" {
+		input.CloudName = defaultOpenStackCloudName
+	}
+
+	// Resolve target namespace
+	targetNamespace, err := resolveClusterNamespace(ctx, t.session, input.Namespace, logger)
+	if err != nil {
+		logger.Error("failed to resolve deploy namespace", "tool", name, "error", err)
+		return nil, openstackClusterDeployResult{}, fmt.Errorf("resolve namespace: %w", err)
+	}
+
+	logger.Debug("resolved deploy namespace", "tool", name, "namespace", targetNamespace)
+
+	// Select latest OpenStack template
+	template, err := t.session.Clusters.SelectLatestTemplate(ctx, "openstack", targetNamespace)
+	if err != nil {
+		logger.Error("failed to select OpenStack template", "tool", name, "error", err)
+		return nil, openstackClusterDeployResult{}, fmt.Errorf("select OpenStack template: %w", err)
+	}
+
+	logger.Debug("selected OpenStack template", "tool", name, "template", template, "namespace", targetNamespace)
+
+	deployReq := clusters.DeployRequest{
+		Name:        input.Name,
+		Template:    template,
+		Credential:  input.Credential,
+		Namespace:   targetNamespace,
+		Labels:      input.Labels,
+		Annotations: input.Annotations,
+		Config:      openstackClusterConfig(input),
+	}
+
+	// Deploy cluster using cluster manager
+	deployResult, err := t.session.Clusters.DeployCluster(ctx, targetNamespace, deployReq)
+	if err != nil {
+		logger.Error("failed to deploy OpenStack cluster", "tool", name, "error", err)
+		return nil, openstackClusterDeployResult{}, fmt.Errorf("deploy cluster: %w", err)
+	}
+
+	result := openstackClusterDeployResult(deployResult)
+
+	// If wait is requested, monitor the cluster until ready or timeout
+	if input.Wait {
+		logger.Info("waiting for OpenStack cluster to be ready",
+			"tool", name,
+			"cluster_name", input.Name,
+			"namespace", targetNamespace,
+		)
+
+		// Parse wait timeout with default
+		waitTimeout := 30 * time.Minute
+		if input.WaitTimeout != "" {
+			if d, err := time.ParseDuration(input.WaitTimeout); err == nil {
+				waitTimeout = d
+			} else {
+				logger.Warn("invalid waitTimeout, using default", "input", input.WaitTimeout, "default", waitTimeout)
+			}
+		}
+
+		waitHelper := &clusterWaitHelper{session: t.session}
+		ready, err := waitHelper.waitForClusterReady(ctx, targetNamespace, input.Name, 30*time.Second, waitTimeout, 10*time.Minute, logger)
+		if err != nil {
+			logger.Error("error while waiting for OpenStack cluster", "tool", name, "error", err)
+			return nil, openstackClusterDeployResult{}, fmt.Errorf("wait for cluster ready: %w", err)
+		}
+
+		if !ready {
+			logger.Warn("OpenStack cluster did not become ready within timeout",
+				"tool", name,
+				"cluster_name", input.Name,
+				"timeout", waitTimeout,
+			)
+			return nil, openstackClusterDeployResult{}, fmt.Errorf("cluster %s did not become ready within %v", input.Name, waitTimeout)
+		}
+
+		logger.Info("OpenStack cluster is ready",
+			"tool", name,
+			"cluster_name", input.Name,
+			"namespace", targetNamespace,
+		)
+	}
+
+	logger.Info("OpenStack cluster deployment completed",
+		"tool", name,
+		"cluster_name", input.Name,
+		"namespace", targetNamespace,
+		"template", template,
+		"status", result.Status,
+		"duration_ms", time.Since(start).Milliseconds(),
+	)
+
+	return nil, result, nil
+}
+
+// openstackClusterConfig builds the openstack-standalone-cp config map, expanding the flat
+// tool inputs into the template's identityRef and name filter structures.
+func openstackClusterConfig(input openstackClusterDeployInput) map[string]any {
+	node := func(n openstackNodeConfig) map[string]any {
+		return map[string]any{
+			"flavor": n.Flavor,
+			"image": map[string]any{
+				"filter": map[string]any{"name": n.Image},
+			},
+		}
+	}
+	return map[string]any{
+		"authURL": input.AuthURL,
+		"identityRef": map[string]any{
+			"cloudName": input.CloudName,
+			"region":    input.Region,
+		},
+		"externalNetwork": map[string]any{
+			"filter": map[string]any{"name": input.ExternalNetwork},
+		},
+		"controlPlane":       node(input.ControlPlane),
+		"worker":             node(input.Worker),
+		"controlPlaneNumber": input.ControlPlaneNumber,
+		"workersNumber":      input.WorkersNumber,
+	}
+}
@@ -0,0 +1,45 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/k0rdent/mcp-k0rdent-server/internal/clusters"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOpenStackClusterConfig(t *testing.T) {
+	input := openstackClusterDeployInput{
+		Name:               "test-cluster",
+		Credential:         "openstack-cred",
+		AuthURL:            "https://keystone.example.com:5000/v3",
+		Region:             "RegionOne",
+		CloudName:          "openstack",
+		ExternalNetwork:    "public",
+		ControlPlane:       openstackNodeConfig{Flavor: "m1.medium", Image: "ubuntu-22.04-x86_64"},
+		Worker:             openstackNodeConfig{Flavor: "m1.large", Image: "ubuntu-22.04-x86_64"},
+		ControlPlaneNumber: 3,
+		WorkersNumber:      2,
+	}
+
+	config := openstackClusterConfig(input)
+
+	assert.Equal(t, "https://keystone.example.com:5000/v3", config["authURL"])
+	assert.Equal(t, map[string]any{"cloudName": "openstack", "region": "RegionOne"}, config["identityRef"])
+	assert.Equal(t, map[string]any{"filter": map[string]any{"name": "public"}}, config["externalNetwork"])
+	assert.Equal(t, map[string]any{
+		"flavor": "m1.large",
+		"image":  map[string]any{"filter": map[string]any{"name": "ubuntu-22.04-x86_64"}},
+	}, config["worker"])
+	assert.Equal(t, 3, config["controlPlaneNumber"])
+	assert.Equal(t, 2, config["workersNumber"])
+
+	// The built config must satisfy the OpenStack validation applied by DeployCluster
+	result := clusters.ValidateConfig("openstack-standalone-cp-1-0-10", config)
+	assert.True(t, result.IsValid(), "unexpected validation errors: %+v", result.Errors)
+}
+
+func TestOpenStackIsKnownProvider(t *testing.T) {
+	assert.True(t, isKnownProvider("openstack"))
+	assert.True(t, isKnownProvider("OpenStack"))
+	assert.Contains(t, knownProviderNames(), "openstack")
+}
@@ -0,0 +1,58 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/k0rdent/mcp-k0rdent-server/internal/clusters"
+	"github.com/k0rdent/mcp-k0rdent-server/internal/runtime"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// clusterReconcileTool asks the controller to re-reconcile a ClusterDeployment
+type clusterReconcileTool struct {
+	session *runtime.Session
+}
+
+// clusterReconcileInput defines the input schema for a forced reconciliation
+type clusterReconcileInput struct {
+	Name      string `json:"name" jsonschema:"Cluster deployment name"`
+	Namespace string `json:"namespace,omitempty" jsonschema:"Deployment namespace (optional, follows standard patterns)"`
+}
+
+// clusterReconcileResult is the result of a forced reconciliation request
+type clusterReconcileResult clusters.ReconcileResult
+
+// reconcile handles the forced reconciliation request
+func (t *clusterReconcileTool) reconcile(ctx context.Context, req *mcp.CallToolRequest, input clusterReconcileInput) (*mcp.CallToolResult, clusterReconcileResult, error) {
+	name := toolName(req)
+	ctx, logger := toolContext(ctx, t.session, name, "tool.clusters.reconcile")
+	start := time.Now()
+
+	if input.Name == "" {
+		return nil, clusterReconcileResult{}, fmt.Errorf("cluster name is required")
+	}
+
+	targetNamespace, err := resolveClusterNamespace(ctx, t.session, input.Namespace, logger)
+	if err != nil {
+		logger.Error("failed to resolve namespace", "tool", name, "error", err)
+		return nil, clusterReconcileResult{}, fmt.Errorf("resolve namespace: %w", err)
+	}
+
+	result, err := t.session.Clusters.RequestReconcile(ctx, targetNamespace, input.Name, time.Now())
+	if err != nil {
+		logger.Error("failed to request reconcile", "tool", name, "error", err)
+		return nil, clusterReconcileResult{}, fmt.Errorf("request reconcile: %w", err)
+	}
+
+	logger.Info("cluster reconcile requested",
+		"tool", name,
+		"cluster_name", input.Name,
+		"namespace", targetNamespace,
+		"value", result.Value,
+		"duration_ms", time.Since(start).Milliseconds(),
+	)
+
+	return nil, clusterReconcileResult(result), nil
+}
@@ -0,0 +1,59 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/k0rdent/mcp-k0rdent-server/internal/clusters"
+	"github.com/k0rdent/mcp-k0rdent-server/internal/runtime"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// clusterRelatedTool lists the Kubernetes objects belonging to a ClusterDeployment
+type clusterRelatedTool struct {
+	session *runtime.Session
+}
+
+// clusterRelatedInput defines the input schema for related resource listing
+type clusterRelatedInput struct {
+	Name      string `json:"name" jsonschema:"Cluster deployment name"`
+	Namespace string `json:"namespace,omitempty" jsonschema:"Deployment namespace (optional, follows standard patterns)"`
+}
+
+// clusterRelatedResult is the result of a related resource listing
+type clusterRelatedResult clusters.RelatedResourceTree
+
+// related handles the related resource listing request
+func (t *clusterRelatedTool) related(ctx context.Context, req *mcp.CallToolRequest, input clusterRelatedInput) (*mcp.CallToolResult, clusterRelatedResult, error) {
+	name := toolName(req)
+	ctx, logger := toolContext(ctx, t.session, name, "tool.clusters.related")
+	start := time.Now()
+
+	if input.Name == "" {
+		return nil, clusterRelatedResult{}, fmt.Errorf("cluster name is required")
+	}
+
+	targetNamespace, err := resolveClusterNamespace(ctx, t.session, input.Namespace, logger)
+	if err != nil {
+		logger.Error("failed to resolve namespace", "tool", name, "error", err)
+		return nil, clusterRelatedResult{}, fmt.Errorf("resolve namespace: %w", err)
+	}
+
+	tree, err := t.session.Clusters.GetRelatedResources(ctx, targetNamespace, input.Name)
+	if err != nil {
+		logger.Error("failed to collect related resources", "tool", name, "error", err)
+		return nil, clusterRelatedResult{}, fmt.Errorf("get related resources: %w", err)
+	}
+
+	logger.Info("related resources collected",
+		"tool", name,
+		"cluster_name", input.Name,
+		"namespace", targetNamespace,
+		"count", tree.Count,
+		"warnings", len(tree.Warnings),
+		"duration_ms", time.Since(start).Milliseconds(),
+	)
+
+	return nil, clusterRelatedResult(tree), nil
+}
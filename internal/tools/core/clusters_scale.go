@@ -0,0 +1,65 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/k0rdent/mcp-k0rdent-server/internal/clusters"
+	"github.com/k0rdent/mcp-k0rdent-server/internal/runtime"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// clusterScaleTool changes the node counts of an existing ClusterDeployment
+type clusterScaleTool struct {
+	session *runtime.Session
+}
+
+// clusterScaleInput defines the input schema for cluster scaling
+type clusterScaleInput struct {
+	Name               string `json:"name" jsonschema:"Cluster deployment name"`
+	Namespace          string `json:"namespace,omitempty" jsonschema:"Deployment namespace (optional, follows standard patterns)"`
+	ControlPlaneNumber *int   `json:"controlPlaneNumber,omitempty" jsonschema:"New number of control plane nodes (at least 1; unchanged when omitted)"`
+	WorkersNumber      *int   `json:"workersNumber,omitempty" jsonschema:"New number of worker nodes (not negative; unchanged when omitted)"`
+}
+
+// clusterScaleResult is the result of a cluster scale request
+type clusterScaleResult clusters.ScaleResult
+
+// scale handles the cluster scale request
+func (t *clusterScaleTool) scale(ctx context.Context, req *mcp.CallToolRequest, input clusterScaleInput) (*mcp.CallToolResult, clusterScaleResult, error) {
+	name := toolName(req)
+	ctx, logger := toolContext(ctx, t.session, name, "tool.clusters.scale")
+	start := time.Now()
+
+	if input.Name == "" {
+		return nil, clusterScaleResult{}, fmt.Errorf("cluster name is required")
+	}
+	if input.ControlPlaneNumber == nil && input.WorkersNumber == nil {
+		return nil, clusterScaleResult{}, fmt.Errorf("controlPlaneNumber or workersNumber is required")
+	}
+
+	targetNamespace, err := resolveClusterNamespace(ctx, t.session, input.Namespace, logger)
+	if err != nil {
+		logger.Error("failed to resolve namespace", "tool", name, "error", err)
+		return nil, clusterScaleResult{}, fmt.Errorf("resolve namespace: %w", err)
+	}
+
+	result, err := t.session.Clusters.ScaleCluster(ctx, targetNamespace, input.Name, input.ControlPlaneNumber, input.WorkersNumber)
+	if err != nil {
+		logger.Error("failed to scale cluster", "tool", name, "error", err)
+		return nil, clusterScaleResult{}, fmt.Errorf("scale cluster: %w", err)
+	}
+
+	logger.Info("cluster scaled",
+		"tool", name,
+		"cluster_name", input.Name,
+		"namespace", targetNamespace,
+		"status", result.Status,
+		"control_plane_number", result.ControlPlaneNumber,
+		"workers_number", result.WorkersNumber,
+		"duration_ms", time.Since(start).Milliseconds(),
+	)
+
+	return nil, clusterScaleResult(result), nil
+}
@@ -0,0 +1,71 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/k0rdent/mcp-k0rdent-server/internal/clusters"
+	"github.com/k0rdent/mcp-k0rdent-server/internal/runtime"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// clusterUpdateTool patches spec.config on an existing ClusterDeployment
+type clusterUpdateTool struct {
+	session *runtime.Session
+}
+
+// clusterUpdateInput defines the input schema for cluster config updates
+type clusterUpdateInput struct {
+	Name      string         `json:"name" jsonschema:"Cluster deployment name"`
+	Namespace string         `json:"namespace,omitempty" jsonschema:"Deployment namespace (optional, follows standard patterns)"`
+	Config    map[string]any `json:"config" jsonschema:"JSON merge patch applied to spec.config; null values remove keys"`
+	DryRun    bool           `json:"dryRun,omitempty" jsonschema:"Compute the diff without applying the patch"`
+}
+
+// clusterUpdateResult is the result of a cluster config update
+type clusterUpdateResult clusters.UpdateConfigResult
+
+// update handles the cluster config update request
+func (t *clusterUpdateTool) update(ctx context.Context, req *mcp.CallToolRequest, input clusterUpdateInput) (*mcp.CallToolResult, clusterUpdateResult, error) {
+	name := toolName(req)
+	ctx, logger := toolContext(ctx, t.session, name, "tool.clusters.update")
+	start := time.Now()
+
+	logger.Debug("updating cluster config",
+		"tool", name,
+		"cluster_name", input.Name,
+		"namespace", input.Namespace,
+		"dry_run", input.DryRun,
+	)
+
+	if input.Name == "" {
+		return nil, clusterUpdateResult{}, fmt.Errorf("cluster name is required")
+	}
+	if len(input.Config) == 0 {
+		return nil, clusterUpdateResult{}, fmt.Errorf("config patch is required")
+	}
+
+	targetNamespace, err := resolveClusterNamespace(ctx, t.session, input.Namespace, logger)
+	if err != nil {
+		logger.Error("failed to resolve namespace", "tool", name, "error", err)
+		return nil, clusterUpdateResult{}, fmt.Errorf("resolve namespace: %w", err)
+	}
+
+	result, err := t.session.Clusters.UpdateClusterConfig(ctx, targetNamespace, input.Name, input.Config, input.DryRun)
+	if err != nil {
+		logger.Error("failed to update cluster config", "tool", name, "error", err)
+		return nil, clusterUpdateResult{}, fmt.Errorf("update cluster config: %w", err)
+	}
+
+	logger.Info("cluster config updated",
+		"tool", name,
+		"cluster_name", input.Name,
+		"namespace", targetNamespace,
+		"status", result.Status,
+		"changed_keys", len(result.Changes),
+		"duration_ms", time.Since(start).Milliseconds(),
+	)
+
+	return nil, clusterUpdateResult(result), nil
+}
@@ -0,0 +1,267 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/k0rdent/mcp-k0rdent-server/internal/clusters"
+	"github.com/k0rdent/mcp-k0rdent-server/internal/runtime"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// vsphereClusterDeployTool implements vSphere-specific cluster deployment
+type vsphereClusterDeployTool struct {
+	session *runtime.Session
+}
+
+// vsphereClusterDeployInput defines the input parameters for vSphere cluster deployment
+type vsphereClusterDeployInput struct {
+	Name                   string            `json:"name" jsonschema:"Cluster deployment name"`
+	Credential             string            `json:"credential" jsonschema:"vSphere credential name"`
+	VSphere                vsphereConfig     `json:"vsphere" jsonschema:"vCenter connection and placement"`
+	ControlPlaneEndpointIP string            `json:"controlPlaneEndpointIP" jsonschema:"Static IP for the Kubernetes API endpoint (must be unused on the cluster network)"`
+	ControlPlane           vsphereNodeConfig `json:"controlPlane" jsonschema:"Control plane VM configuration"`
+	Worker                 vsphereNodeConfig `json:"worker" jsonschema:"Worker VM configuration"`
+	ControlPlaneNumber     int               `json:"controlPlaneNumber,omitempty" jsonschema:"Number of control plane nodes (default: 3)"`
+	WorkersNumber          int               `json:"workersNumber,omitempty" jsonschema:"Number of worker nodes (default: 2)"`
+	Namespace              string            `json:"namespace,omitempty" jsonschema:"Deployment namespace (default: DEFAULT_NAMESPACE, kcm-system unless set)"`
+	Labels                 map[string]string `json:"labels,omitempty" jsonschema:"Labels for the cluster"`
+	Annotations            map[string]string `json:"annotations,omitempty" jsonschema:"Annotations for the cluster"`
+	Wait                   bool              `json:"wait,omitempty" jsonschema:"Wait for cluster to be ready before returning"`
+	WaitTimeout            string            `json:"waitTimeout,omitempty" jsonschema:"Maximum time to wait for cluster ready (default: 30m)"`
+}
+
+// vsphereConfig defines where in vCenter the cluster VMs are placed
+type vsphereConfig struct {
+	Server       string `json:"server" jsonschema:"vCenter server address (e.g. vcenter.example.com)"`
+	Thumbprint   string `json:"thumbprint,omitempty" jsonschema:"SHA-1 thumbprint of the vCenter TLS certificate"`
+	Datacenter   string `json:"datacenter" jsonschema:"Datacenter name (e.g. DC1)"`
+	Datastore    string `json:"datastore" jsonschema:"Datastore path (e.g. /DC1/datastore/DS1)"`
+	ResourcePool string `json:"resourcePool" jsonschema:"Resource pool path (e.g. /DC1/host/Cluster1/Resources/Pool1)"`
+	Folder       string `json:"folder,omitempty" jsonschema:"VM folder path (e.g. /DC1/vm/k0rdent)"`
+}
+
+// vsphereNodeConfig defines vSphere-specific VM configuration
+type vsphereNodeConfig struct {
+	VMTemplate     string           `json:"vmTemplate" jsonschema:"Path of the template VM to clone (e.g. /DC1/vm/ubuntu-22.04-template)"`
+	Network        string           `json:"network" jsonschema:"Network path (e.g. /DC1/network/VM Network)"`
+	CPUs           int              `json:"cpus,omitempty" jsonschema:"Number of vCPUs (default: 2)"`
+	Memory         int              `json:"memory,omitempty" jsonschema:"Memory in MiB (default: 4096)"`
+	RootVolumeSize int              `json:"rootVolumeSize,omitempty" jsonschema:"Root volume size in GB (default: 30)"`
+	SSH            vsphereSSHConfig `json:"ssh,omitempty" jsonschema:"SSH access to the VMs"`
+}
+
+// vsphereSSHConfig defines the SSH user and key injected into the VMs
+type vsphereSSHConfig struct {
+	User      string `json:"user,omitempty" jsonschema:"SSH user (e.g. ubuntu)"`
+	PublicKey string `json:"publicKey,omitempty" jsonschema:"SSH public key authorized for the user"`
+}
+
+// vsphereClusterDeployResult is the result of a vSphere cluster deployment
+type vsphereClusterDeployResult clusters.DeployResult
+
+// deploy handles the vSphere cluster deployment
+func (t *vsphereClusterDeployTool) deploy(ctx context.Context, req *mcp.CallToolRequest, input vsphereClusterDeployInput) (*mcp.CallToolResult, vsphereClusterDeployResult, error) {
+	name := toolName(req)
+	ctx, logger := toolContext(ctx, t.session, name, "tool.clusters.vsphere")
+	start := time.Now()
+
+	logger.Debug("deploying vSphere cluster",
+		"tool", name,
+		"cluster_name", input.Name,
+		"server", input.VSphere.Server,
+		"datacenter", input.VSphere.Datacenter,
+		"credential", input.Credential,
+		"namespace", input.Namespace,
+	)
+
+	// Validate required fields
+	if input.Name == "" {
+		return nil, vsphereClusterDeployResult{}, fmt.Errorf("cluster name is required")
+	}
+	if input.Credential == "" {
+		return nil, vsphereClusterDeployResult{}, fmt.Errorf("credential is required")
+	}
+	if err := validateVSphereDeployInput(input); err != nil {
+		return nil, vsphereClusterDeployResult{}, err
+	}
+
+	// Validate and apply defaults for node counts
+	controlPlaneNumber, workersNumber, err := validateAndDefaultNodeCounts(input.ControlPlaneNumber, input.WorkersNumber)
+	if err != nil {
+		return nil, vsphereClusterDeployResult{}, err
+	}
+	input.ControlPlaneNumber = controlPlaneNumber
+	input.WorkersNumber = workersNumber
+
+	// Resolve target namespace
+	targetNamespace, err := resolveClusterNamespace(ctx, t.session, input.Namespace, logger)
+	if err != nil {
+		logger.Error("failed to resolve deploy namespace", "tool", name, "error", err)
+		return nil, vsphereClusterDeployResult{}, fmt.Errorf("resolve namespace: %w", err)
+	}
+
+	logger.Debug("resolved deploy namespace", "tool", name, "namespace", targetNamespace)
+
+	// Select latest vSphere template
+	template, err := t.session.Clusters.SelectLatestTemplate(ctx, "vsphere", targetNamespace)
+	if err != nil {
+		logger.Error("failed to select vSphere template", "tool", name, "error", err)
+		return nil, vsphereClusterDeployResult{}, fmt.Errorf("select vSphere template: %w", err)
+	}
+
+	logger.Debug("selected vSphere template", "tool", name, "template", template, "namespace", targetNamespace)
+
+	deployReq := clusters.DeployRequest{
+		Name:        input.Name,
+		Template:    template,
+		Credential:  input.Credential,
+		Namespace:   targetNamespace,
+		Labels:      input.Labels,
+		Annotations: input.Annotations,
+		Config:      vsphereClusterConfig(input),
+	}
+
+	// Deploy cluster using cluster manager
+	deployResult, err := t.session.Clusters.DeployCluster(ctx, targetNamespace, deployReq)
+	if err != nil {
+		logger.Error("failed to deploy vSphere cluster", "tool", name, "error", err)
+		return nil, vsphereClusterDeployResult{}, fmt.Errorf("deploy cluster: %w", err)
+	}
+
+	result := vsphereClusterDeployResult(deployResult)
+
+	// If wait is requested, monitor the cluster until ready or timeout
+	if input.Wait {
+		logger.Info("waiting for vSphere cluster to be ready",
+			"tool", name,
+			"cluster_name", input.Name,
+			"namespace", targetNamespace,
+		)
+
+		// Parse wait timeout with default
+		waitTimeout := 30 * time.Minute
+		if input.WaitTimeout != "" {
+			if d, err := time.ParseDuration(input.WaitTimeout); err == nil {
+				waitTimeout = d
+			} else {
+				logger.Warn("invalid waitTimeout, using default", "input", input.WaitTimeout, "default", waitTimeout)
+			}
+		}
+
+		waitHelper := &clusterWaitHelper{session: t.session}
+		ready, err := waitHelper.waitForClusterReady(ctx, targetNamespace, input.Name, 30*time.Second, waitTimeout, 10*time.Minute, logger)
+		if err != nil {
+			logger.Error("error while waiting for vSphere cluster", "tool", name, "error", err)
+			return nil, vsphereClusterDeployResult{}, fmt.Errorf("wait for cluster ready: %w", err)
+		}
+
+		if !ready {
+			logger.Warn("vSphere cluster did not become ready within timeout",
+				"tool", name,
+				"cluster_name", input.Name,
+				"timeout", waitTimeout,
+			)
+			return nil, vsphereClusterDeployResult{}, fmt.Errorf("cluster %s did not become ready within %v", input.Name, waitTimeout)
+		}
+
+		logger.Info("vSphere cluster is ready",
+			"tool", name,
+			"cluster_name", input.Name,
+			"namespace", targetNamespace,
+		)
+	}
+
+	logger.Info("vSphere cluster deployment completed",
+		"tool", name,
+		"cluster_name", input.Name,
+		"namespace", targetNamespace,
+		"template", template,
+		"status", result.Status,
+		"duration_ms", time.Since(start).Milliseconds(),
+	)
+
+	return nil, result, nil
+}
+
+// validateVSphereDeployInput checks the vCenter placement and per-pool VM fields the
+// vsphere-standalone-cp template cannot default.
+func validateVSphereDeployInput(input vsphereClusterDeployInput) error {
+	required := []struct {
+		field string
+		value string
+	}{
+		{"vsphere.server", input.VSphere.Server},
+		{"vsphere.datacenter", input.VSphere.Datacenter},
+		{"vsphere.datastore", input.VSphere.Datastore},
+		{"vsphere.resourcePool", input.VSphere.ResourcePool},
+		{"controlPlaneEndpointIP", input.ControlPlaneEndpointIP},
+		{"controlPlane.vmTemplate", input.ControlPlane.VMTemplate},
+		{"controlPlane.network", input.ControlPlane.Network},
+		{"worker.vmTemplate", input.Worker.VMTemplate},
+		{"worker.network", input.Worker.Network},
+	}
+	for _, r := range required {
+		if r.value == "" {
+			return fmt.Errorf("%s is required", r.field)
+		}
+	}
+	return nil
+}
+
+// vsphereClusterConfig builds the vsphere-standalone-cp config map, applying VM sizing
+// defaults and omitting optional fields that were not provided.
+func vsphereClusterConfig(input vsphereClusterDeployInput) map[string]any {
+	placement := map[string]any{
+		"server":       input.VSphere.Server,
+		"datacenter":   input.VSphere.Datacenter,
+		"datastore":    input.VSphere.Datastore,
+		"resourcePool": input.VSphere.ResourcePool,
+	}
+	if input.VSphere.Thumbprint != "" {
+		placement["thumbprint"] = input.VSphere.Thumbprint
+	}
+	if input.VSphere.Folder != "" {
+		placement["folder"] = input.VSphere.Folder
+	}
+
+	return map[string]any{
+		"vsphere":                placement,
+		"controlPlaneEndpointIP": input.ControlPlaneEndpointIP,
+		"controlPlane":           vsphereNodeValues(input.ControlPlane),
+		"worker":                 vsphereNodeValues(input.Worker),
+		"controlPlaneNumber":     input.ControlPlaneNumber,
+		"workersNumber":          input.WorkersNumber,
+	}
+}
+
+func vsphereNodeValues(node vsphereNodeConfig) map[string]any {
+	if node.CPUs == 0 {
+		node.CPUs = defaultVSphereCPUs
+	}
+	if node.Memory == 0 {
+		node.Memory = defaultVSphereMemoryMiB
+	}
+	if node.RootVolumeSize == 0 {
+		node.RootVolumeSize = defaultVSphereRootVolumeSize
+	}
+	values := map[string]any{
+		"vmTemplate":     node.VMTemplate,
+		"network":        node.Network,
+		"cpus":           node.CPUs,
+		"memory":         node.Memory,
+		"rootVolumeSize": node.RootVolumeSize,
+	}
+	if node.SSH.User != "" || node.SSH.PublicKey != "" {
+		ssh := map[string]any{}
+		if node.SSH.User != "" {
+			ssh["user"] = node.SSH.User
+		}
+		if node.SSH.PublicKey != "" {
+			ssh["publicKey"] = node.SSH.PublicKey
+		}
+		values["ssh"] = ssh
+	}
+	return values
+}
@@ -0,0 +1,103 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testVSphereInput() vsphereClusterDeployInput {
+	node := vsphereNodeConfig{
+		VMTemplate: "/DC1/vm/ubuntu-22.04-template",
+		Network:    "/DC1/network/VM Network",
+	}
+	return vsphereClusterDeployInput{
+		Name:       "test-cluster",
+		Credential: "vsphere-cred",
+		VSphere: vsphereConfig{
+			Server:       "vcenter.example.com",
+			Datacenter:   "DC1",
+			Datastore:    "/DC1/datastore/DS1",
+			ResourcePool: "/DC1/host/Cluster1/Resources/Pool1",
+		},
+		ControlPlaneEndpointIP: "10.0.0.100",
+		ControlPlane:           node,
+		Worker:                 node,
+		ControlPlaneNumber:     3,
+		WorkersNumber:          2,
+	}
+}
+
+func TestVSphereClusterConfig_Defaults(t *testing.T) {
+	config := vsphereClusterConfig(testVSphereInput())
+
+	placement := config["vsphere"].(map[string]any)
+	assert.Equal(t, "vcenter.example.com", placement["server"])
+	assert.Equal(t, "/DC1/host/Cluster1/Resources/Pool1", placement["resourcePool"])
+	assert.NotContains(t, placement, "thumbprint")
+	assert.NotContains(t, placement, "folder")
+	assert.Equal(t, "10.0.0.100", config["controlPlaneEndpointIP"])
+	assert.Equal(t, 3, config["controlPlaneNumber"])
+	assert.Equal(t, 2, config["workersNumber"])
+
+	for _, role := range []string{"controlPlane", "worker"} {
+		node := config[role].(map[string]any)
+		assert.Equal(t, "/DC1/vm/ubuntu-22.04-template", node["vmTemplate"], role)
+		assert.Equal(t, "/DC1/network/VM Network", node["network"], role)
+		assert.Equal(t, defaultVSphereCPUs, node["cpus"], role)
+		assert.Equal(t, defaultVSphereMemoryMiB, node["memory"], role)
+		assert.Equal(t, defaultVSphereRootVolumeSize, node["rootVolumeSize"], role)
+		assert.NotContains(t, node, "ssh", role)
+	}
+}
+
+func TestVSphereClusterConfig_CustomValues(t *testing.T) {
+	input := testVSphereInput()
+	input.VSphere.Thumbprint = "AA:BB"
+	input.VSphere.Folder = "/DC1/vm/k0rdent"
+	input.Worker.CPUs = 8
+	input.Worker.Memory = 16384
+	input.Worker.RootVolumeSize = 100
+	input.ControlPlane.SSH = vsphereSSHConfig{User: "ubuntu", PublicKey: "ssh-ed25519 AAAA"}
+
+	config := vsphereClusterConfig(input)
+
+	placement := config["vsphere"].(map[string]any)
+	assert.Equal(t, "AA:BB", placement["thumbprint"])
+	assert.Equal(t, "/DC1/vm/k0rdent", placement["folder"])
+
+	worker := config["worker"].(map[string]any)
+	assert.Equal(t, 8, worker["cpus"])
+	assert.Equal(t, 16384, worker["memory"])
+	assert.Equal(t, 100, worker["rootVolumeSize"])
+
+	controlPlane := config["controlPlane"].(map[string]any)
+	assert.Equal(t, map[string]any{"user": "ubuntu", "publicKey": "ssh-ed25519 AAAA"}, controlPlane["ssh"])
+}
+
+func TestValidateVSphereDeployInput(t *testing.T) {
+	require.NoError(t, validateVSphereDeployInput(testVSphereInput()))
+
+	tests := []struct {
+		field  string
+		mutate func(*vsphereClusterDeployInput)
+	}{
+		{"vsphere.server", func(in *vsphereClusterDeployInput) { in.VSphere.Server = "" }},
+		{"vsphere.datacenter", func(in *vsphereClusterDeployInput) { in.VSphere.Datacenter = "" }},
+		{"vsphere.datastore", func(in *vsphereClusterDeployInput) { in.VSphere.Datastore = "" }},
+		{"vsphere.resourcePool", func(in *vsphereClusterDeployInput) { in.VSphere.ResourcePool = "" }},
+		{"controlPlaneEndpointIP", func(in *vsphereClusterDeployInput) { in.ControlPlaneEndpointIP = "" }},
+		{"controlPlane.vmTemplate", func(in *vsphereClusterDeployInput) { in.ControlPlane.VMTemplate = "" }},
+		{"worker.network", func(in *vsphereClusterDeployInput) { in.Worker.Network = "" }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.field, func(t *testing.T) {
+			input := testVSphereInput()
+			tt.mutate(&input)
+			err := validateVSphereDeployInput(input)
+			require.Error(t, err)
+			assert.Equal(t, tt.field+" is required", err.Error())
+		})
+	}
+}
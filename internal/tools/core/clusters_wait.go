@@ -221,12 +221,12 @@ func resolveDeployNamespace(ctx context.Context, session *runtime.Session, names
 	}
 
 	// No namespace specified - determine default behavior
-	// DEV_ALLOW_ANY mode (no filter or matches all): default to kcm-system
+	// DEV_ALLOW_ANY mode (no filter or matches the default): default to DEFAULT_NAMESPACE (kcm-system)
 	// OIDC_REQUIRED mode (restricted filter): require explicit namespace
-	if session.NamespaceFilter == nil || session.NamespaceFilter.MatchString("kcm-system") {
-		// DEV_ALLOW_ANY mode - default to kcm-system
-		logger.Debug("defaulting to kcm-system namespace (DEV_ALLOW_ANY mode)")
-		return "kcm-system", nil
+	defaultNamespace := session.DefaultNamespace()
+	if session.NamespaceFilter == nil || session.NamespaceFilter.MatchString(defaultNamespace) {
+		logger.Debug("defaulting to configured namespace (DEV_ALLOW_ANY mode)", "namespace", defaultNamespace)
+		return defaultNamespace, nil
 	}
 
 	// OIDC_REQUIRED mode - require explicit namespace
@@ -0,0 +1,307 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"github.com/k0rdent/mcp-k0rdent-server/internal/clusters"
+	"github.com/k0rdent/mcp-k0rdent-server/internal/logging"
+	"github.com/k0rdent/mcp-k0rdent-server/internal/runtime"
+)
+
+const (
+	clusterDeploymentWatchHost        = "cluster-deployments"
+	clusterDeploymentWatchURITemplate = "k0rdent://cluster-deployments/{namespace}"
+	clusterDeploymentWatchMIMEType    = "application/json"
+	// clusterDeploymentWatchAll selects every namespace allowed by the session filter.
+	clusterDeploymentWatchAll = "*"
+)
+
+// ClusterDeploymentWatchManager streams ClusterDeployment add/update/delete deltas so
+// clients can keep a cluster list current without polling k0rdent.mgmt.clusterDeployments.list.
+type ClusterDeploymentWatchManager struct {
+	mu            sync.Mutex
+	server        *mcp.Server
+	session       *runtime.Session
+	subscriptions map[string]*clusterDeploymentWatchSubscription
+}
+
+type clusterDeploymentWatchSubscription struct {
+	namespace string
+	cancel    context.CancelFunc
+	done      chan struct{}
+}
+
+// clusterDeploymentWatchDelta is the payload published for each ClusterDeployment change.
+type clusterDeploymentWatchDelta struct {
+	Action  string                            `json:"action"`
+	Cluster clusters.ClusterDeploymentSummary `json:"cluster"`
+}
+
+// NewClusterDeploymentWatchManager returns a manager ready for binding.
+func NewClusterDeploymentWatchManager() *ClusterDeploymentWatchManager {
+	return &ClusterDeploymentWatchManager{subscriptions: make(map[string]*clusterDeploymentWatchSubscription)}
+}
+
+// Bind associates the underlying runtime dependencies.
+func (m *ClusterDeploymentWatchManager) Bind(server *mcp.Server, session *runtime.Session) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.server = server
+	m.session = session
+}
+
+// Subscribe starts watching ClusterDeployments in the namespace represented by the URI.
+// The watch begins with an ADDED delta for every existing ClusterDeployment.
+func (m *ClusterDeploymentWatchManager) Subscribe(ctx context.Context, req *mcp.SubscribeRequest) error {
+	if m == nil {
+		return errors.New("cluster deployment watch manager not configured")
+	}
+	namespace, err := parseClusterDeploymentWatchURI(req.Params.URI)
+	if err != nil {
+		return err
+	}
+
+	ctx = logging.WithNamespace(ctx, namespace)
+	ctx, logger := toolContext(ctx, m.session, "k0rdent.mgmt.clusterDeployments.watch", "tool.clusters.watch")
+	logger = logger.With("namespace", namespace)
+	logger.Info("subscribing to cluster deployment watch")
+
+	m.mu.Lock()
+	session, server := m.session, m.server
+	if session == nil || session.Clients.Dynamic == nil || server == nil {
+		m.mu.Unlock()
+		logger.Error("cluster deployment watch manager not bound to session")
+		return errors.New("cluster deployment watch manager not bound to session")
+	}
+	if namespace != clusterDeploymentWatchAll && !m.namespaceAllowed(namespace) {
+		m.mu.Unlock()
+		return fmt.Errorf("namespace %q not allowed by namespace filter", namespace)
+	}
+	if _, exists := m.subscriptions[namespace]; exists {
+		m.mu.Unlock()
+		logger.Debug("cluster deployment watch already active")
+		return nil
+	}
+	m.mu.Unlock()
+
+	// Start the watch without holding m.mu; a concurrent subscribe for the same namespace
+	// may win the race below.
+	watchCtx, cancel := context.WithCancel(context.Background())
+	var deltas <-chan clusterDelta
+	var errCh <-chan error
+	if namespace == clusterDeploymentWatchAll {
+		deltas, errCh, err = watchAllowedClusterDeployments(ctx, watchCtx, session, logger)
+	} else {
+		deltas, errCh, err = watchClusterDeployment(watchCtx, session.Clients.Dynamic, namespace, "")
+	}
+	if err != nil {
+		cancel()
+		logger.Error("failed to start cluster deployment watch", "error", err)
+		return fmt.Errorf("watch clusterdeployments: %w", err)
+	}
+
+	sub := &clusterDeploymentWatchSubscription{
+		namespace: namespace,
+		cancel:    cancel,
+		done:      make(chan struct{}),
+	}
+	m.mu.Lock()
+	if _, exists := m.subscriptions[namespace]; exists {
+		m.mu.Unlock()
+		cancel()
+		logger.Debug("cluster deployment watch already active")
+		return nil
+	}
+	m.subscriptions[namespace] = sub
+	m.mu.Unlock()
+	go m.stream(watchCtx, server, sub, deltas, errCh)
+
+	logger.Info("cluster deployment watch started")
+	return nil
+}
+
+// Unsubscribe stops the watch for the namespace represented by the URI.
+func (m *ClusterDeploymentWatchManager) Unsubscribe(ctx context.Context, req *mcp.UnsubscribeRequest) error {
+	if m == nil {
+		return errors.New("cluster deployment watch manager not configured")
+	}
+	namespace, err := parseClusterDeploymentWatchURI(req.Params.URI)
+	if err != nil {
+		return err
+	}
+
+	ctx = logging.WithNamespace(ctx, namespace)
+	_, logger := toolContext(ctx, m.session, "k0rdent.mgmt.clusterDeployments.unwatch", "tool.clusters.watch")
+	logger = logger.With("namespace", namespace)
+
+	m.mu.Lock()
+	sub, ok := m.subscriptions[namespace]
+	if ok {
+		delete(m.subscriptions, namespace)
+	}
+	m.mu.Unlock()
+
+	if !ok {
+		return nil
+	}
+	sub.cancel()
+	<-sub.done
+	logger.Info("cluster deployment watch terminated")
+	return nil
+}
+
+func (m *ClusterDeploymentWatchManager) stream(ctx context.Context, server *mcp.Server, sub *clusterDeploymentWatchSubscription, deltas <-chan clusterDelta, errCh <-chan error) {
+	defer close(sub.done)
+	defer m.forget(sub)
+
+	uri := buildClusterDeploymentWatchURI(sub.namespace)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case err, ok := <-errCh:
+			if ok && err != nil {
+				m.publish(server, uri, map[string]any{
+					"action": string(watch.Error),
+					"error":  err.Error(),
+				})
+			}
+			return
+		case delta, ok := <-deltas:
+			if !ok {
+				return
+			}
+			if payload, publish := m.summarizeDelta(sub, delta); publish {
+				m.publish(server, uri, payload)
+			}
+		}
+	}
+}
+
+// summarizeDelta summarizes a watch delta; publish is false for objects the subscriber may
+// not see.
+func (m *ClusterDeploymentWatchManager) summarizeDelta(sub *clusterDeploymentWatchSubscription, delta clusterDelta) (clusterDeploymentWatchDelta, bool) {
+	if delta.Object == nil {
+		return clusterDeploymentWatchDelta{}, false
+	}
+	if sub.namespace == clusterDeploymentWatchAll && !m.namespaceAllowed(delta.Object.GetNamespace()) {
+		return clusterDeploymentWatchDelta{}, false
+	}
+	return clusterDeploymentWatchDelta{
+		Action:  string(delta.Type),
+		Cluster: clusters.SummarizeClusterDeployment(delta.Object),
+	}, true
+}
+
+func (m *ClusterDeploymentWatchManager) namespaceAllowed(namespace string) bool {
+	filter := m.session.NamespaceFilter
+	return filter == nil || filter.MatchString(namespace)
+}
+
+// forget drops a subscription whose watch ended on its own so it can be re-established.
+func (m *ClusterDeploymentWatchManager) forget(sub *clusterDeploymentWatchSubscription) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if existing, ok := m.subscriptions[sub.namespace]; ok && existing == sub {
+		delete(m.subscriptions, sub.namespace)
+	}
+}
+
+func (m *ClusterDeploymentWatchManager) publish(server *mcp.Server, uri string, payload any) {
+	if server == nil {
+		return
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return
+	}
+	params := &mcp.ResourceUpdatedNotificationParams{
+		URI: uri,
+		Meta: mcp.Meta{
+			"delta": json.RawMessage(data),
+		},
+	}
+	_ = server.ResourceUpdated(context.Background(), params)
+}
+
+func registerClusterDeploymentWatch(reg *toolRegistry, session *runtime.Session, manager *ClusterDeploymentWatchManager) error {
+	if session == nil {
+		return errors.New("session is required")
+	}
+
+	if manager != nil {
+		manager.Bind(reg.server, session)
+	}
+
+	listTool := &clustersListTool{session: session}
+	reg.addResourceTemplate(&mcp.ResourceTemplate{
+		Name:        "k0rdent.mgmt.clusterDeployments.watch",
+		Title:       "ClusterDeployment changes",
+		Description: "Streaming add/update/delete deltas for ClusterDeployments in a namespace, or in every allowed namespace for {namespace}=*. Each delta carries the cluster summary returned by k0rdent.mgmt.clusterDeployments.list; reading the resource returns that list.",
+		URITemplate: clusterDeploymentWatchURITemplate,
+		MIMEType:    clusterDeploymentWatchMIMEType,
+	}, func(ctx context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
+		namespace, err := parseClusterDeploymentWatchURI(req.Params.URI)
+		if err != nil {
+			return nil, err
+		}
+		input := clustersListInput{Namespace: namespace}
+		if namespace == clusterDeploymentWatchAll {
+			input.Namespace = ""
+		}
+		_, result, err := listTool.list(ctx, &mcp.CallToolRequest{Params: &mcp.CallToolParamsRaw{Name: "k0rdent.mgmt.clusterDeployments.list"}}, input)
+		if err != nil {
+			return nil, err
+		}
+		payload, err := json.Marshal(result)
+		if err != nil {
+			return nil, err
+		}
+		return &mcp.ReadResourceResult{
+			Contents: []*mcp.ResourceContents{{
+				URI:      req.Params.URI,
+				MIMEType: clusterDeploymentWatchMIMEType,
+				Blob:     payload,
+			}},
+		}, nil
+	})
+
+	return nil
+}
+
+func parseClusterDeploymentWatchURI(raw string) (string, error) {
+	if raw == "" {
+		return "", errors.New("subscription URI is required")
+	}
+	parsed, err := url.Parse(raw)
+	if err != nil {
+		return "", fmt.Errorf("invalid cluster deployments URI: %w", err)
+	}
+	if parsed.Scheme != eventsScheme {
+		return "", fmt.Errorf("unexpected cluster deployments scheme %q", parsed.Scheme)
+	}
+	if !strings.EqualFold(parsed.Host, clusterDeploymentWatchHost) {
+		return "", fmt.Errorf("unexpected cluster deployments host %q", parsed.Host)
+	}
+	namespace := strings.Trim(parsed.Path, "/")
+	if namespace == "" || strings.Contains(namespace, "/") {
+		return "", errors.New("cluster deployments URI must be in the form k0rdent://cluster-deployments/{namespace}")
+	}
+	return namespace, nil
+}
+
+func buildClusterDeploymentWatchURI(namespace string) string {
+	return fmt.Sprintf("%s://%s/%s", eventsScheme, clusterDeploymentWatchHost, namespace)
+}
@@ -0,0 +1,63 @@
+package core
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"github.com/k0rdent/mcp-k0rdent-server/internal/runtime"
+)
+
+func TestParseClusterDeploymentWatchURI(t *testing.T) {
+	namespace, err := parseClusterDeploymentWatchURI("k0rdent://cluster-deployments/team-a")
+	require.NoError(t, err)
+	require.Equal(t, "team-a", namespace)
+
+	namespace, err = parseClusterDeploymentWatchURI("k0rdent://cluster-deployments/*")
+	require.NoError(t, err)
+	require.Equal(t, clusterDeploymentWatchAll, namespace)
+	require.Equal(t, "k0rdent://cluster-deployments/*", buildClusterDeploymentWatchURI(namespace))
+
+	for _, raw := range []string{
+		"",
+		"k0rdent://cluster-deployments/",
+		"k0rdent://cluster-deployments/team-a/demo",
+		"k0rdent://events/team-a",
+		"http://cluster-deployments/team-a",
+	} {
+		_, err := parseClusterDeploymentWatchURI(raw)
+		require.Error(t, err, raw)
+	}
+}
+
+func TestClusterDeploymentWatchSummarizeDelta(t *testing.T) {
+	newCD := func(namespace, name string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "k0rdent.mirantis.com/v1beta1",
+			"kind":       "ClusterDeployment",
+			"metadata":   map[string]any{"name": name, "namespace": namespace},
+			"spec":       map[string]any{"template": "aws-standalone-cp-1-0-0"},
+		}}
+	}
+
+	manager := NewClusterDeploymentWatchManager()
+	manager.session = &runtime.Session{NamespaceFilter: regexp.MustCompile("^team-")}
+	sub := &clusterDeploymentWatchSubscription{namespace: clusterDeploymentWatchAll}
+
+	payload, ok := manager.summarizeDelta(sub, clusterDelta{Type: watch.Added, Object: newCD("team-a", "demo")})
+	require.True(t, ok)
+	require.Equal(t, "ADDED", payload.Action)
+	require.Equal(t, "demo", payload.Cluster.Name)
+	require.Equal(t, "team-a", payload.Cluster.Namespace)
+	require.Equal(t, "aws-standalone-cp-1-0-0", payload.Cluster.TemplateRef.Name)
+
+	_, ok = manager.summarizeDelta(sub, clusterDelta{Type: watch.Modified, Object: newCD("other", "demo")})
+	require.False(t, ok, "disallowed namespace must be skipped")
+
+	payload, ok = manager.summarizeDelta(sub, clusterDelta{Type: watch.Deleted, Object: newCD("team-b", "gone")})
+	require.True(t, ok)
+	require.Equal(t, "DELETED", payload.Action)
+}
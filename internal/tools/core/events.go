@@ -23,6 +23,11 @@ const (
 	eventsScheme      = "k0rdent"
 	eventsHost        = "events"
 	eventsMIMEType    = "application/json"
+
+	// eventsListToolName is the companion tool subscribers use to fetch suppressed events.
+	eventsListToolName = "k0rdent.mgmt.events.list"
+	// eventsSuppressedAction marks the summary published in place of rate-limited events.
+	eventsSuppressedAction = "SUPPRESSED"
 )
 
 // EventManager coordinates namespace event subscriptions across a session.
@@ -38,6 +43,7 @@ type eventSubscription struct {
 	namespace string
 	cancel    context.CancelFunc
 	done      chan struct{}
+	limiter   *streamLimiter
 }
 
 // NewEventManager creates an EventManager ready to be bound to a session.
@@ -98,6 +104,7 @@ func (m *EventManager) Subscribe(ctx context.Context, req *mcp.SubscribeRequest)
 		namespace: namespace,
 		cancel:    cancel,
 		done:      make(chan struct{}),
+		limiter:   newStreamLimiter(m.session.StreamMaxUpdatesPerSecond()),
 	}
 	m.subscriptions[namespace] = sub
 	server := m.server
@@ -107,7 +114,7 @@ func (m *EventManager) Subscribe(ctx context.Context, req *mcp.SubscribeRequest)
 	go m.streamEvents(watchCtx, server, namespace, deltaCh, errCh, sub)
 
 	// Send an initial snapshot so subscribers have immediate context.
-	go m.sendInitialSnapshot(watchCtx, server, namespace, provider)
+	go m.sendInitialSnapshot(watchCtx, server, namespace, provider, sub)
 
 	logger.Info("event subscription started")
 	return nil
@@ -148,10 +155,15 @@ func (m *EventManager) Unsubscribe(ctx context.Context, req *mcp.UnsubscribeRequ
 func (m *EventManager) streamEvents(ctx context.Context, server *mcp.Server, namespace string, deltaCh <-chan eventsprovider.Delta, errCh <-chan error, sub *eventSubscription) {
 	defer close(sub.done)
 
+	ticks, stop := sub.limiter.ticks()
+	defer stop()
+
 	for {
 		select {
 		case <-ctx.Done():
 			return
+		case <-ticks:
+			m.flushSuppressed(server, namespace, sub)
 		case err, ok := <-errCh:
 			if ok && err != nil {
 				// Surface the error as a synthetic log entry to subscribers.
@@ -162,12 +174,12 @@ func (m *EventManager) streamEvents(ctx context.Context, server *mcp.Server, nam
 			if !ok {
 				return
 			}
-			m.publishEvent(server, namespace, delta.Type, delta.Event)
+			m.publishLimited(server, namespace, sub, delta.Type, delta.Event)
 		}
 	}
 }
 
-func (m *EventManager) sendInitialSnapshot(ctx context.Context, server *mcp.Server, namespace string, provider *eventsprovider.Provider) {
+func (m *EventManager) sendInitialSnapshot(ctx context.Context, server *mcp.Server, namespace string, provider *eventsprovider.Provider, sub *eventSubscription) {
 	events, err := provider.List(ctx, namespace, eventsprovider.ListOptions{})
 	if err != nil {
 		m.publishEvent(server, namespace, watch.Error, eventsprovider.Event{Message: err.Error(), Namespace: namespace})
@@ -178,23 +190,49 @@ func (m *EventManager) sendInitialSnapshot(ctx context.Context, server *mcp.Serv
 		case <-ctx.Done():
 			return
 		default:
-			m.publishEvent(server, namespace, watch.Added, evt)
+			m.publishLimited(server, namespace, sub, watch.Added, evt)
 		}
 	}
 }
 
-func (m *EventManager) publishEvent(server *mcp.Server, namespace string, eventType watch.EventType, event eventsprovider.Event) {
-	if server == nil {
+// publishLimited publishes an event unless the subscription is over its rate cap, reporting
+// earlier suppressed events first.
+func (m *EventManager) publishLimited(server *mcp.Server, namespace string, sub *eventSubscription, eventType watch.EventType, event eventsprovider.Event) {
+	m.flushSuppressed(server, namespace, sub)
+	if !sub.limiter.allow() {
+		return
+	}
+	m.publishEvent(server, namespace, eventType, event)
+}
+
+// flushSuppressed publishes a summary of the events dropped by the rate cap, with a resume
+// marker for fetching them through the events list tool.
+func (m *EventManager) flushSuppressed(server *mcp.Server, namespace string, sub *eventSubscription) {
+	gap, ok := sub.limiter.flush()
+	if !ok {
 		return
 	}
-	payload := struct {
+	m.publishDelta(server, namespace, map[string]any{
+		"action":     eventsSuppressedAction,
+		"suppressed": gap.Suppressed,
+		"resume":     gap.resumeMarker(eventsListToolName, time.Now()),
+	})
+}
+
+func (m *EventManager) publishEvent(server *mcp.Server, namespace string, eventType watch.EventType, event eventsprovider.Event) {
+	m.publishDelta(server, namespace, struct {
 		Action string               `json:"action"`
 		Event  eventsprovider.Event `json:"event"`
 	}{
 		Action: string(eventType),
 		Event:  event,
-	}
+	})
+}
 
+func (m *EventManager) publishDelta(server *mcp.Server, namespace string, payload any) {
+	if server == nil {
+		return
+	}
 	data, err := json.Marshal(payload)
 	if err != nil {
 		return
@@ -227,18 +265,18 @@ type eventsListResult struct {
 	Events []eventsprovider.Event `json:"events"`
 }
 
-func registerEvents(server *mcp.Server, session *runtime.Session, manager *EventManager) error {
+func registerEvents(reg *toolRegistry, session *runtime.Session, manager *EventManager) error {
 	if session == nil || session.Events == nil {
 		return errors.New("session events provider is not configured")
 	}
 
 	if manager != nil {
-		manager.Bind(server, session)
+		manager.Bind(reg.server, session)
 	}
 
 	tool := &eventsTool{session: session}
-	mcp.AddTool(server, &mcp.Tool{
-		Name:        "k0rdent.mgmt.events.list",
+	addTool(reg, &mcp.Tool{
+		Name:        eventsListToolName,
 		Description: "List Kubernetes events for a namespace",
 		Meta: mcp.Meta{
 			"plane":    "mgmt",
@@ -246,7 +284,7 @@ func registerEvents(server *mcp.Server, session *runtime.Session, manager *Event
 			"action":   "list",
 		},
 	}, tool.list)
-	server.AddResourceTemplate(&mcp.ResourceTemplate{
+	reg.addResourceTemplate(&mcp.ResourceTemplate{
 		Name:        "k0rdent.mgmt.events",
 		Title:       "Kubernetes namespace events",
 		Description: "Streaming events scoped to a Kubernetes namespace",
@@ -21,6 +21,28 @@ type serviceTemplatesResult struct {
 	Items []api.ServiceTemplateSummary `json:"items"`
 }
 
+type serviceTemplateConsumersTool struct {
+	session *runtime.Session
+}
+
+type serviceTemplateConsumersInput struct {
+	Name      string `json:"name" jsonschema:"ServiceTemplate name"`
+	Namespace string `json:"namespace,omitempty" jsonschema:"ServiceTemplate namespace (only used to match namespace-qualified references)"`
+}
+
+type serviceTemplateConsumersResult struct {
+	Items []api.ServiceTemplateConsumer `json:"items"`
+}
+
+type serviceTemplateStatusTool struct {
+	session *runtime.Session
+}
+
+type serviceTemplateStatusInput struct {
+	Name      string `json:"name" jsonschema:"ServiceTemplate name"`
+	Namespace string `json:"namespace,omitempty" jsonschema:"ServiceTemplate namespace (defaults to the global namespace)"`
+}
+
 type clusterDeploymentsTool struct {
 	session *runtime.Session
 }
@@ -45,13 +67,13 @@ type multiClusterServicesResult struct {
 	Items []api.MultiClusterServiceSummary `json:"items"`
 }
 
-func registerK0rdentTools(server *mcp.Server, session *runtime.Session) error {
+func registerK0rdentTools(reg *toolRegistry, session *runtime.Session) error {
 	if session == nil {
 		return fmt.Errorf("session is required")
 	}
 
 	stTool := &serviceTemplatesTool{session: session}
-	mcp.AddTool(server, &mcp.Tool{
+	addTool(reg, &mcp.Tool{
 		Name:        "k0rdent.mgmt.serviceTemplates.list",
 		Description: "List K0rdent ServiceTemplates",
 		Meta: mcp.Meta{
@@ -61,8 +83,30 @@ func registerK0rdentTools(server *mcp.Server, session *runtime.Session) error {
 		},
 	}, stTool.list)
 
+	consumersTool := &serviceTemplateConsumersTool{session: session}
+	addTool(reg, &mcp.Tool{
+		Name:        "k0rdent.mgmt.serviceTemplates.consumers",
+		Description: "List ClusterDeployments and MultiClusterServices whose services reference a ServiceTemplate. Use before changing or deleting a template to avoid breaking consumers.",
+		Meta: mcp.Meta{
+			"plane":    "mgmt",
+			"category": "serviceTemplates",
+			"action":   "consumers",
+		},
+	}, consumersTool.consumers)
+
+	statusTool := &serviceTemplateStatusTool{session: session}
+	addTool(reg, &mcp.Tool{
+		Name:        "k0rdent.mgmt.serviceTemplates.getStatus",
+		Description: "Get the controller status of a ServiceTemplate: status.valid, the validation error, and conditions. Check this before attaching a template to a cluster; services referencing an invalid template stall during reconciliation.",
+		Meta: mcp.Meta{
+			"plane":    "mgmt",
+			"category": "serviceTemplates",
+			"action":   "getStatus",
+		},
+	}, statusTool.getStatus)
+
 	cdTool := &clusterDeploymentsTool{session: session}
-	mcp.AddTool(server, &mcp.Tool{
+	addTool(reg, &mcp.Tool{
 		Name:        "k0rdent.mgmt.clusterDeployments.listAll",
 		Description: "List K0rdent ClusterDeployments",
 		Meta: mcp.Meta{
@@ -73,7 +117,7 @@ func registerK0rdentTools(server *mcp.Server, session *runtime.Session) error {
 	}, cdTool.list)
 
 	msTool := &multiClusterServicesTool{session: session}
-	mcp.AddTool(server, &mcp.Tool{
+	addTool(reg, &mcp.Tool{
 		Name:        "k0rdent.mgmt.multiClusterServices.list",
 		Description: "List K0rdent MultiClusterServices",
 		Meta: mcp.Meta{
@@ -96,7 +140,10 @@ func (t *serviceTemplatesTool) list(ctx context.Context, req *mcp.CallToolReques
 		logger.Debug("listing service templates", "tool", name)
 	}
 
-	items, err := api.ListServiceTemplates(ctx, t.session.Clients.Dynamic)
+	listCtx, cancel := withListTimeout(ctx, t.session)
+	items, err := api.ListServiceTemplates(listCtx, t.session.Clients.Dynamic)
+	cancel()
+	err = listTimeoutError(listCtx, err)
 	if err != nil {
 		logger.Error("list service templates failed", "tool", name, "error", err)
 		return nil, serviceTemplatesResult{}, err
@@ -106,6 +153,53 @@ func (t *serviceTemplatesTool) list(ctx context.Context, req *mcp.CallToolReques
 	return nil, serviceTemplatesResult{Items: filtered}, nil
 }
 
+func (t *serviceTemplateConsumersTool) consumers(ctx context.Context, req *mcp.CallToolRequest, input serviceTemplateConsumersInput) (*mcp.CallToolResult, serviceTemplateConsumersResult, error) {
+	name := toolName(req)
+	ctx, logger := toolContext(ctx, t.session, name, "tool.k0rdent")
+	start := time.Now()
+	if input.Name == "" {
+		return nil, serviceTemplateConsumersResult{}, fmt.Errorf("service template name is required")
+	}
+	logger.Debug("listing service template consumers", "tool", name, "template", input.Name, "namespace", input.Namespace)
+
+	listCtx, cancel := withListTimeout(ctx, t.session)
+	items, err := api.ListServiceTemplateConsumers(listCtx, t.session.Clients.Dynamic, input.Namespace, input.Name)
+	cancel()
+	err = listTimeoutError(listCtx, err)
+	if err != nil {
+		logger.Error("list service template consumers failed", "tool", name, "template", input.Name, "error", err)
+		return nil, serviceTemplateConsumersResult{}, err
+	}
+	filtered := filterConsumersByNamespace(items, t.session.NamespaceFilter)
+	logger.Info("service template consumers listed", "tool", name, "template", input.Name, "count", len(filtered), "duration_ms", time.Since(start).Milliseconds())
+	return nil, serviceTemplateConsumersResult{Items: filtered}, nil
+}
+
+func (t *serviceTemplateStatusTool) getStatus(ctx context.Context, req *mcp.CallToolRequest, input serviceTemplateStatusInput) (*mcp.CallToolResult, api.ServiceTemplateStatus, error) {
+	name := toolName(req)
+	ctx, logger := toolContext(ctx, t.session, name, "tool.k0rdent")
+	start := time.Now()
+	if input.Name == "" {
+		return nil, api.ServiceTemplateStatus{}, fmt.Errorf("service template name is required")
+	}
+	namespace := input.Namespace
+	if namespace == "" {
+		namespace = t.session.GlobalNamespace()
+	}
+	if filter := t.session.NamespaceFilter; filter != nil && !filter.MatchString(namespace) {
+		return nil, api.ServiceTemplateStatus{}, fmt.Errorf("namespace %q not allowed by namespace filter", namespace)
+	}
+	logger.Debug("getting service template status", "tool", name, "template", input.Name, "namespace", namespace)
+
+	status, err := api.GetServiceTemplateStatus(ctx, t.session.Clients.Dynamic, namespace, input.Name)
+	if err != nil {
+		logger.Error("get service template status failed", "tool", name, "template", input.Name, "namespace", namespace, "error", err)
+		return nil, api.ServiceTemplateStatus{}, err
+	}
+	logger.Info("service template status retrieved", "tool", name, "template", input.Name, "namespace", namespace, "valid", status.Valid, "duration_ms", time.Since(start).Milliseconds())
+	return nil, status, nil
+}
+
 func (t *clusterDeploymentsTool) list(ctx context.Context, req *mcp.CallToolRequest, input clusterDeploymentsInput) (*mcp.CallToolResult, clusterDeploymentsResult, error) {
 	name := toolName(req)
 	ctx, logger := toolContext(ctx, t.session, name, "tool.k0rdent")
@@ -117,7 +211,10 @@ func (t *clusterDeploymentsTool) list(ctx context.Context, req *mcp.CallToolRequ
 		}
 	}
 	logger.Debug("listing cluster deployments", "tool", name, "selector", input.Selector)
-	items, err := api.ListClusterDeployments(ctx, t.session.Clients.Dynamic, input.Selector)
+	listCtx, cancel := withListTimeout(ctx, t.session)
+	items, err := api.ListClusterDeployments(listCtx, t.session.Clients.Dynamic, input.Selector)
+	cancel()
+	err = listTimeoutError(listCtx, err)
 	if err != nil {
 		logger.Error("list cluster deployments failed", "tool", name, "selector", input.Selector, "error", err)
 		return nil, clusterDeploymentsResult{}, err
@@ -138,7 +235,10 @@ func (t *multiClusterServicesTool) list(ctx context.Context, req *mcp.CallToolRe
 		}
 	}
 	logger.Debug("listing multi-cluster services", "tool", name, "selector", input.Selector)
-	items, err := api.ListMultiClusterServices(ctx, t.session.Clients.Dynamic, input.Selector)
+	listCtx, cancel := withListTimeout(ctx, t.session)
+	items, err := api.ListMultiClusterServices(listCtx, t.session.Clients.Dynamic, input.Selector)
+	cancel()
+	err = listTimeoutError(listCtx, err)
 	if err != nil {
 		logger.Error("list multi-cluster services failed", "tool", name, "selector", input.Selector, "error", err)
 		return nil, multiClusterServicesResult{}, err
@@ -186,3 +286,18 @@ func filterMultiClusterServicesByNamespace(items []api.MultiClusterServiceSummar
 	}
 	return filtered
 }
+
+// filterConsumersByNamespace drops namespaced consumers outside the filter; cluster-scoped
+// consumers (MultiClusterServices) are always kept.
+func filterConsumersByNamespace(items []api.ServiceTemplateConsumer, filter *regexp.Regexp) []api.ServiceTemplateConsumer {
+	if filter == nil {
+		return items
+	}
+	filtered := make([]api.ServiceTemplateConsumer, 0, len(items))
+	for _, item := range items {
+		if item.Namespace == "" || filter.MatchString(item.Namespace) {
+			filtered = append(filtered, item)
+		}
+	}
+	return filtered
+}
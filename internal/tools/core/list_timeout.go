@@ -0,0 +1,33 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/k0rdent/mcp-k0rdent-server/internal/runtime"
+)
+
+// errKubeListTimeout is the cause of a list context whose KUBE_LIST_TIMEOUT elapsed.
+var errKubeListTimeout = errors.New("kubernetes list timed out")
+
+// withListTimeout derives the context for one namespace or resource list, bounded by the
+// session's KUBE_LIST_TIMEOUT so a hung API server fails the tool instead of waiting for the
+// client's request deadline. Call cancel before listTimeoutError.
+func withListTimeout(ctx context.Context, session *runtime.Session) (context.Context, context.CancelFunc) {
+	timeout := session.KubeListTimeout()
+	return context.WithTimeoutCause(ctx, timeout, fmt.Errorf("%w after %s (KUBE_LIST_TIMEOUT)", errKubeListTimeout, timeout))
+}
+
+// listTimeoutError reports err as a list timeout when the list context's KUBE_LIST_TIMEOUT
+// elapsed, discarding any partial namespace results. Other errors, including the request's
+// own deadline, pass through unchanged.
+func listTimeoutError(listCtx context.Context, err error) error {
+	if err == nil {
+		return nil
+	}
+	if cause := context.Cause(listCtx); errors.Is(cause, errKubeListTimeout) {
+		return fmt.Errorf("%w: %v", cause, err)
+	}
+	return err
+}
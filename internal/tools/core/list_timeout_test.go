@@ -0,0 +1,54 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/k0rdent/mcp-k0rdent-server/internal/config"
+)
+
+func TestListTimeoutError(t *testing.T) {
+	listErr := errors.New("list failed")
+
+	listCtx, cancel := withListTimeout(context.Background(), nil)
+	cancel()
+	if err := listTimeoutError(listCtx, listErr); err != listErr {
+		t.Fatalf("expected error unchanged before the timeout, got %v", err)
+	}
+	if err := listTimeoutError(listCtx, nil); err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+
+	parent, cancelParent := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancelParent()
+	<-parent.Done()
+	listCtx, cancel = withListTimeout(parent, nil)
+	cancel()
+	if err := listTimeoutError(listCtx, parent.Err()); errors.Is(err, errKubeListTimeout) {
+		t.Fatalf("expected the request deadline to pass through, got %v", err)
+	}
+
+	listCtx, cancel = context.WithTimeoutCause(context.Background(), time.Millisecond, errKubeListTimeout)
+	<-listCtx.Done()
+	cancel()
+	err := listTimeoutError(listCtx, listCtx.Err())
+	if !errors.Is(err, errKubeListTimeout) || !strings.Contains(err.Error(), context.DeadlineExceeded.Error()) {
+		t.Fatalf("expected list timeout error, got %v", err)
+	}
+}
+
+func TestWithListTimeoutUsesSessionDefault(t *testing.T) {
+	listCtx, cancel := withListTimeout(context.Background(), nil)
+	defer cancel()
+
+	deadline, ok := listCtx.Deadline()
+	if !ok {
+		t.Fatal("expected list context deadline")
+	}
+	if remaining := time.Until(deadline); remaining <= 0 || remaining > config.DefaultKubeListTimeout {
+		t.Fatalf("expected deadline within %s, got %s", config.DefaultKubeListTimeout, remaining)
+	}
+}
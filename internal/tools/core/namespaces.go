@@ -3,12 +3,17 @@ package core
 import (
 	"context"
 	"fmt"
+	"slices"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/k0rdent/mcp-k0rdent-server/internal/k0rdent/api"
+	"github.com/k0rdent/mcp-k0rdent-server/internal/kube"
 	"github.com/k0rdent/mcp-k0rdent-server/internal/runtime"
 )
 
@@ -18,6 +23,43 @@ type namespacesTool struct {
 
 type namespaceListInput struct{}
 
+type namespacesWithResourcesTool struct {
+	session *runtime.Session
+}
+
+type namespacesWithResourcesResult struct {
+	Namespaces []namespaceResourceCounts `json:"namespaces"`
+}
+
+// namespaceResourceCounts counts the namespaced k0rdent resources in a namespace.
+// MultiClusterServices are cluster-scoped, so they are not counted.
+type namespaceResourceCounts struct {
+	Name               string `json:"name"`
+	ClusterDeployments int    `json:"clusterDeployments"`
+	ServiceTemplates   int    `json:"serviceTemplates"`
+}
+
+type namespacesResolveTool struct {
+	session *runtime.Session
+}
+
+type namespacesResolveInput struct {
+	Operation     string `json:"operation" jsonschema:"Operation to preview: install_from_catalog, install_from_manifest, or delete"`
+	Namespace     string `json:"namespace,omitempty" jsonschema:"Namespace the operation would be called with"`
+	AllNamespaces bool   `json:"all_namespaces,omitempty" jsonschema:"Whether the operation would be called with all_namespaces"`
+	IncludeGlobal bool   `json:"includeGlobal,omitempty" jsonschema:"Whether delete would be called with includeGlobal"`
+}
+
+type namespacesResolveResult struct {
+	Operation  string   `json:"operation"`
+	Namespaces []string `json:"namespaces"`
+	Excluded   []string `json:"excluded,omitempty"`
+	Warnings   []string `json:"warnings,omitempty"`
+}
+
+// serviceTemplateOperations are the batch operations whose targets can be previewed.
+var serviceTemplateOperations = []string{"install_from_catalog", "install_from_manifest", "delete"}
+
 type namespaceListResult struct {
 	Namespaces []namespaceInfo `json:"namespaces"`
 }
@@ -28,9 +70,9 @@ type namespaceInfo struct {
 	Status string            `json:"status"`
 }
 
-func registerNamespaces(server *mcp.Server, session *runtime.Session) error {
+func registerNamespaces(reg *toolRegistry, session *runtime.Session) error {
 	tool := &namespacesTool{session: session}
-	mcp.AddTool(server, &mcp.Tool{
+	addTool(reg, &mcp.Tool{
 		Name:        "k0rdent.mgmt.namespaces.list",
 		Description: "List namespaces with their labels and phase status",
 		Meta: mcp.Meta{
@@ -39,6 +81,28 @@ func registerNamespaces(server *mcp.Server, session *runtime.Session) error {
 			"action":   "list",
 		},
 	}, tool.handle)
+
+	withResources := &namespacesWithResourcesTool{session: session}
+	addTool(reg, &mcp.Tool{
+		Name:        "k0rdent.meta.namespaces.withResources",
+		Description: "List allowed namespaces with counts of ClusterDeployments and ServiceTemplates in each",
+		Meta: mcp.Meta{
+			"plane":    "meta",
+			"category": "namespaces",
+			"action":   "list",
+		},
+	}, withResources.handle)
+
+	resolveTool := &namespacesResolveTool{session: session}
+	addTool(reg, &mcp.Tool{
+		Name:        "k0rdent.meta.namespaces.resolveForOperation",
+		Description: "Preview the exact namespaces a ServiceTemplate install_from_catalog, install_from_manifest, or delete call would touch for the given namespace/all_namespaces/includeGlobal flags, applying the namespace filter, dev-mode default, and global-namespace exclusion rules. Nothing is installed or deleted. Returns the namespaces, any excluded namespaces, and the warnings the operation would report.",
+		Meta: mcp.Meta{
+			"plane":    "meta",
+			"category": "namespaces",
+			"action":   "resolveForOperation",
+		},
+	}, resolveTool.resolve)
 	return nil
 }
 
@@ -47,8 +111,7 @@ func (t *namespacesTool) handle(ctx context.Context, req *mcp.CallToolRequest, _
 	ctx, logger := toolContext(ctx, t.session, name, "tool.namespaces")
 	start := time.Now()
 
-	client := t.session.Clients.Kubernetes.CoreV1().Namespaces()
-	list, err := client.List(ctx, metav1.ListOptions{})
+	list, err := listNamespacesWithRetry(ctx, t.session)
 	if err != nil {
 		logger.Error("list namespaces failed", "tool", name, "error", err)
 		return nil, namespaceListResult{}, fmt.Errorf("list namespaces: %w", err)
@@ -92,6 +155,128 @@ func (t *namespacesTool) handle(ctx context.Context, req *mcp.CallToolRequest, _
 	return nil, out, nil
 }
 
+func (t *namespacesWithResourcesTool) handle(ctx context.Context, req *mcp.CallToolRequest, _ namespaceListInput) (*mcp.CallToolResult, namespacesWithResourcesResult, error) {
+	name := toolName(req)
+	ctx, logger := toolContext(ctx, t.session, name, "tool.namespaces.withResources")
+	start := time.Now()
+
+	if t.session.RequireExplicitNamespace() {
+		return nil, namespacesWithResourcesResult{}, errExplicitNamespaceRequired
+	}
+
+	list, err := listNamespacesWithRetry(ctx, t.session)
+	if err != nil {
+		logger.Error("list namespaces failed", "tool", name, "error", err)
+		return nil, namespacesWithResourcesResult{}, fmt.Errorf("list namespaces: %w", err)
+	}
+
+	client := t.session.Clients.Dynamic
+	deployments, err := api.ListClusterDeployments(ctx, client, "")
+	if err != nil {
+		logger.Error("list cluster deployments failed", "tool", name, "error", err)
+		return nil, namespacesWithResourcesResult{}, err
+	}
+	templates, err := api.ListServiceTemplates(ctx, client)
+	if err != nil {
+		logger.Error("list service templates failed", "tool", name, "error", err)
+		return nil, namespacesWithResourcesResult{}, err
+	}
+
+	filter := t.session.NamespaceFilter
+	counts := make(map[string]*namespaceResourceCounts, len(list.Items))
+	for _, item := range list.Items {
+		if filter != nil && !filter.MatchString(item.Name) {
+			continue
+		}
+		counts[item.Name] = &namespaceResourceCounts{Name: item.Name}
+	}
+	for _, cd := range deployments {
+		if entry, ok := counts[cd.Namespace]; ok {
+			entry.ClusterDeployments++
+		}
+	}
+	for _, tpl := range templates {
+		if entry, ok := counts[tpl.Namespace]; ok {
+			entry.ServiceTemplates++
+		}
+	}
+
+	out := namespacesWithResourcesResult{
+		Namespaces: make([]namespaceResourceCounts, 0, len(counts)),
+	}
+	for _, entry := range counts {
+		if entry.ClusterDeployments+entry.ServiceTemplates == 0 {
+			continue
+		}
+		out.Namespaces = append(out.Namespaces, *entry)
+	}
+	sort.Slice(out.Namespaces, func(i, j int) bool {
+		return out.Namespaces[i].Name < out.Namespaces[j].Name
+	})
+
+	logger.Info("namespaces with resources listed",
+		"tool", name,
+		"count", len(out.Namespaces),
+		"duration_ms", time.Since(start).Milliseconds(),
+	)
+
+	return nil, out, nil
+}
+
+func (t *namespacesResolveTool) resolve(ctx context.Context, req *mcp.CallToolRequest, input namespacesResolveInput) (*mcp.CallToolResult, namespacesResolveResult, error) {
+	name := toolName(req)
+	ctx, logger := toolContext(ctx, t.session, name, "tool.namespaces.resolve")
+	start := time.Now()
+
+	operation := strings.TrimSpace(input.Operation)
+	if !slices.Contains(serviceTemplateOperations, operation) {
+		return nil, namespacesResolveResult{}, fmt.Errorf("INVALID_INPUT: operation must be one of %s, got %q", strings.Join(serviceTemplateOperations, ", "), input.Operation)
+	}
+
+	resolved, err := resolveServiceTemplateNamespaces(ctx, t.session, input.Namespace, input.AllNamespaces, logger)
+	if err != nil {
+		return nil, namespacesResolveResult{}, err
+	}
+
+	out := namespacesResolveResult{Operation: operation, Namespaces: resolved}
+	if operation == "delete" {
+		targets, warnings, err := applyGlobalNamespaceProtection(t.session, resolved, input.AllNamespaces, input.IncludeGlobal, logger)
+		if err != nil {
+			return nil, namespacesResolveResult{}, err
+		}
+		out.Namespaces = targets
+		out.Warnings = warnings
+		for _, ns := range resolved {
+			if !slices.Contains(targets, ns) {
+				out.Excluded = append(out.Excluded, ns)
+			}
+		}
+	}
+
+	logger.Info("operation namespaces resolved",
+		"tool", name,
+		"operation", operation,
+		"count", len(out.Namespaces),
+		"excluded", len(out.Excluded),
+		"duration_ms", time.Since(start).Milliseconds(),
+	)
+
+	return nil, out, nil
+}
+
+// listNamespacesWithRetry lists namespaces, retrying transient API failures.
+func listNamespacesWithRetry(ctx context.Context, session *runtime.Session) (*corev1.NamespaceList, error) {
+	listCtx, cancel := withListTimeout(ctx, session)
+	var list *corev1.NamespaceList
+	err := kube.RetryRead(listCtx, func(ctx context.Context) error {
+		var listErr error
+		list, listErr = session.Clients.Kubernetes.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+		return listErr
+	})
+	cancel()
+	return list, listTimeoutError(listCtx, err)
+}
+
 func copyMap(in map[string]string) map[string]string {
 	if len(in) == 0 {
 		return nil
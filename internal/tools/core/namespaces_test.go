@@ -5,6 +5,7 @@ import (
 	"context"
 	"log/slog"
 	"regexp"
+	"slices"
 	"sync"
 	"testing"
 	"time"
@@ -12,8 +13,13 @@ import (
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
 	"k8s.io/client-go/kubernetes/fake"
 
+	"github.com/k0rdent/mcp-k0rdent-server/internal/k0rdent/api"
 	"github.com/k0rdent/mcp-k0rdent-server/internal/logging"
 	"github.com/k0rdent/mcp-k0rdent-server/internal/runtime"
 )
@@ -104,6 +110,146 @@ func TestNamespacesHandleLogs(t *testing.T) {
 	}
 }
 
+func TestNamespacesWithResourcesHandle(t *testing.T) {
+	clientset := fake.NewSimpleClientset(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "kcm-system"}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-alpha"}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-empty"}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "other"}},
+	)
+
+	newObj := func(kind, namespace, name string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "k0rdent.mirantis.com/v1beta1",
+			"kind":       kind,
+			"metadata":   map[string]any{"name": name, "namespace": namespace},
+		}}
+	}
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(k8sruntime.NewScheme(), map[schema.GroupVersionResource]string{
+		api.ClusterDeploymentGVR(): "ClusterDeploymentList",
+		api.ServiceTemplateGVR():   "ServiceTemplateList",
+	},
+		newObj("ClusterDeployment", "team-alpha", "dev"),
+		newObj("ClusterDeployment", "team-alpha", "prod"),
+		newObj("ClusterDeployment", "other", "hidden"),
+		newObj("ServiceTemplate", "kcm-system", "minio-1-0-0"),
+		newObj("ServiceTemplate", "team-alpha", "custom-1-0-0"),
+	)
+
+	tool := &namespacesWithResourcesTool{
+		session: &runtime.Session{
+			NamespaceFilter: regexp.MustCompile("^(team-|kcm-system$)"),
+			Clients: runtime.Clients{
+				Kubernetes: clientset,
+				Dynamic:    dynamicClient,
+			},
+		},
+	}
+
+	_, result, err := tool.handle(context.Background(), nil, namespaceListInput{})
+	if err != nil {
+		t.Fatalf("handle returned error: %v", err)
+	}
+	want := []namespaceResourceCounts{
+		{Name: "kcm-system", ServiceTemplates: 1},
+		{Name: "team-alpha", ClusterDeployments: 2, ServiceTemplates: 1},
+	}
+	if len(result.Namespaces) != len(want) {
+		t.Fatalf("expected %d namespaces, got %+v", len(want), result.Namespaces)
+	}
+	for i := range want {
+		if result.Namespaces[i] != want[i] {
+			t.Fatalf("namespace %d: expected %+v, got %+v", i, want[i], result.Namespaces[i])
+		}
+	}
+}
+
+func TestNamespacesResolveForOperation(t *testing.T) {
+	nsGVR := schema.GroupVersionResource{Version: "v1", Resource: "namespaces"}
+	newNamespace := func(name string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "v1",
+			"kind":       "Namespace",
+			"metadata":   map[string]any{"name": name},
+		}}
+	}
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(k8sruntime.NewScheme(),
+		map[schema.GroupVersionResource]string{nsGVR: "NamespaceList"},
+		newNamespace("kcm-system"), newNamespace("team-alpha"), newNamespace("other"),
+	)
+	tool := &namespacesResolveTool{session: &runtime.Session{
+		NamespaceFilter: regexp.MustCompile("^(team-|kcm-system$)"),
+		Clients:         runtime.Clients{Dynamic: dynamicClient},
+	}}
+
+	tests := []struct {
+		name         string
+		input        namespacesResolveInput
+		wantNS       []string
+		wantExcluded []string
+		wantWarnings int
+		wantErr      string
+	}{
+		{
+			name:   "install all namespaces",
+			input:  namespacesResolveInput{Operation: "install_from_catalog", AllNamespaces: true},
+			wantNS: []string{"kcm-system", "team-alpha"},
+		},
+		{
+			name:         "delete skips global",
+			input:        namespacesResolveInput{Operation: "delete", AllNamespaces: true},
+			wantNS:       []string{"team-alpha"},
+			wantExcluded: []string{"kcm-system"},
+			wantWarnings: 1,
+		},
+		{
+			name:         "delete includes global",
+			input:        namespacesResolveInput{Operation: "delete", AllNamespaces: true, IncludeGlobal: true},
+			wantNS:       []string{"kcm-system", "team-alpha"},
+			wantWarnings: 1,
+		},
+		{
+			name:   "single namespace",
+			input:  namespacesResolveInput{Operation: "install_from_manifest", Namespace: "team-alpha"},
+			wantNS: []string{"team-alpha"},
+		},
+		{
+			name:    "filtered namespace",
+			input:   namespacesResolveInput{Operation: "delete", Namespace: "other"},
+			wantErr: "not allowed by namespace filter",
+		},
+		{
+			name:    "unknown operation",
+			input:   namespacesResolveInput{Operation: "deploy"},
+			wantErr: "INVALID_INPUT",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, result, err := tool.resolve(context.Background(), nil, tt.input)
+			if tt.wantErr != "" {
+				if err == nil || !contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("resolve returned error: %v", err)
+			}
+			if !slices.Equal(result.Namespaces, tt.wantNS) {
+				t.Fatalf("expected namespaces %v, got %v", tt.wantNS, result.Namespaces)
+			}
+			if !slices.Equal(result.Excluded, tt.wantExcluded) {
+				t.Fatalf("expected excluded %v, got %v", tt.wantExcluded, result.Excluded)
+			}
+			if len(result.Warnings) != tt.wantWarnings {
+				t.Fatalf("expected %d warnings, got %v", tt.wantWarnings, result.Warnings)
+			}
+		})
+	}
+}
+
 type recordingSink struct {
 	mu      sync.Mutex
 	entries []logging.Entry
@@ -0,0 +1,165 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/k0rdent/mcp-k0rdent-server/internal/metrics"
+	"github.com/k0rdent/mcp-k0rdent-server/internal/runtime"
+)
+
+// defaultRecentOperations is the number of operations returned when no limit is given.
+const defaultRecentOperations = 50
+
+// mutatingActions lists the tool actions recorded in the operation log.
+var mutatingActions = map[string]struct{}{
+	"deploy":                {},
+	"delete":                {},
+	"update":                {},
+	"scale":                 {},
+	"reconcile":             {},
+	"services.apply":        {},
+	"services.applyBatch":   {},
+	"services.remove":       {},
+	"install_from_catalog":  {},
+	"install_from_manifest": {},
+	"uninstall":             {},
+}
+
+type operationsRecentTool struct {
+	session *runtime.Session
+}
+
+type operationsRecentInput struct {
+	Limit int    `json:"limit,omitempty" jsonschema:"Maximum number of operations to return, newest first (default 50)"`
+	Tool  string `json:"tool,omitempty" jsonschema:"Only return operations of this tool name"`
+}
+
+type operationsRecentResult struct {
+	Operations []runtime.Operation `json:"operations"`
+}
+
+func registerOperations(reg *toolRegistry, session *runtime.Session) error {
+	tool := &operationsRecentTool{session: session}
+	addTool(reg, &mcp.Tool{
+		Name:        "k0rdent.meta.operations.recent",
+		Description: "List recent mutating operations performed by this server process (deploy, delete, update, service apply/remove, ServiceTemplate installs), newest first. Each entry has the tool, target, outcome, timestamp, and the caller's token subject when available. Only operations made by the caller's token subject in namespaces the session may see are returned. The log is in-memory and keeps only the most recent operations of the whole server; it is not an audit log.",
+		Meta: mcp.Meta{
+			"plane":    "meta",
+			"category": "operations",
+			"action":   "recent",
+		},
+	}, tool.recent)
+	return nil
+}
+
+func (t *operationsRecentTool) recent(ctx context.Context, req *mcp.CallToolRequest, input operationsRecentInput) (*mcp.CallToolResult, operationsRecentResult, error) {
+	name := toolName(req)
+	_, logger := toolContext(ctx, t.session, name, "tool.operations")
+	start := time.Now()
+
+	limit := input.Limit
+	if limit <= 0 {
+		limit = defaultRecentOperations
+	}
+
+	operations := t.session.OperationLog.RecentMatching(limit, func(op runtime.Operation) bool {
+		return (input.Tool == "" || op.Tool == input.Tool) && operationVisible(t.session, op)
+	})
+	if operations == nil {
+		operations = []runtime.Operation{}
+	}
+
+	logger.Info("recent operations listed",
+		"tool", name,
+		"count", len(operations),
+		"duration_ms", time.Since(start).Milliseconds(),
+	)
+
+	return nil, operationsRecentResult{Operations: operations}, nil
+}
+
+// operationVisible reports whether op may be shown to session: the log is shared by every
+// session, so only operations made by the same token subject, in namespaces the session
+// filter allows, are returned.
+func operationVisible(session *runtime.Session, op runtime.Operation) bool {
+	if op.Subject != session.Subject() {
+		return false
+	}
+	filter := session.NamespaceFilter
+	if filter == nil || session.IsDevMode() || op.Namespace == "" || op.Namespace == "*" {
+		return true
+	}
+	return filter.MatchString(op.Namespace)
+}
+
+// isMutatingTool reports whether calls to tool should be recorded in the operation log.
+func isMutatingTool(tool *mcp.Tool) bool {
+	action, _ := tool.Meta["action"].(string)
+	_, ok := mutatingActions[action]
+	return ok
+}
+
+// recordOperation wraps handler so each call is appended to the session's operation log.
+func recordOperation[In, Out any](session *runtime.Session, toolName string, handler mcp.ToolHandlerFor[In, Out]) mcp.ToolHandlerFor[In, Out] {
+	return func(ctx context.Context, req *mcp.CallToolRequest, input In) (*mcp.CallToolResult, Out, error) {
+		start := time.Now()
+		result, out, err := handler(ctx, req, input)
+
+		op := runtime.Operation{
+			Time:       start.UTC(),
+			Tool:       toolName,
+			Outcome:    metrics.OutcomeSuccess,
+			Subject:    session.Subject(),
+			DurationMS: time.Since(start).Milliseconds(),
+		}
+		op.Namespace, op.Target, op.DryRun = operationTarget(input)
+		switch {
+		case err != nil:
+			op.Outcome = classifyMetricsOutcome(err)
+			op.Error = err.Error()
+		case result != nil && result.IsError:
+			op.Outcome = metrics.OutcomeError
+		}
+		session.OperationLog.Record(op)
+
+		return result, out, err
+	}
+}
+
+// operationTarget extracts the namespace, target name, and dry-run flag from a tool input
+// using the field names shared by the mutating tools.
+func operationTarget(input any) (string, string, bool) {
+	raw, err := json.Marshal(input)
+	if err != nil {
+		return "", "", false
+	}
+	var fields map[string]any
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return "", "", false
+	}
+
+	str := func(keys ...string) string {
+		for _, key := range keys {
+			if value, ok := fields[key].(string); ok && strings.TrimSpace(value) != "" {
+				return strings.TrimSpace(value)
+			}
+		}
+		return ""
+	}
+
+	namespace := str("clusterNamespace", "namespace")
+	if all, _ := fields["all_namespaces"].(bool); all && namespace == "" {
+		namespace = "*"
+	}
+	target := str("clusterName", "name", "app")
+	if service := str("serviceName"); service != "" {
+		target = target + "/" + service
+	}
+	dryRun, _ := fields["dryRun"].(bool)
+	return namespace, target, dryRun
+}
@@ -0,0 +1,103 @@
+package core
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/k0rdent/mcp-k0rdent-server/internal/metrics"
+	"github.com/k0rdent/mcp-k0rdent-server/internal/runtime"
+)
+
+func TestRecordOperation(t *testing.T) {
+	session := &runtime.Session{OperationLog: runtime.NewOperationLog(10)}
+
+	apply := recordOperation(session, "k0rdent.mgmt.clusterDeployments.services.apply",
+		func(context.Context, *mcp.CallToolRequest, clusterServiceApplyInput) (*mcp.CallToolResult, clusterServiceApplyResult, error) {
+			return nil, clusterServiceApplyResult{}, nil
+		})
+	_, _, err := apply(context.Background(), nil, clusterServiceApplyInput{
+		ClusterNamespace: "team-a",
+		ClusterName:      "demo",
+		ServiceName:      "ingress",
+		DryRun:           true,
+	})
+	require.NoError(t, err)
+
+	remove := recordOperation(session, "k0rdent.mgmt.clusterDeployments.delete",
+		func(context.Context, *mcp.CallToolRequest, clustersDeleteInput) (*mcp.CallToolResult, clustersDeleteResult, error) {
+			return nil, clustersDeleteResult{}, errors.New("boom")
+		})
+	_, _, err = remove(context.Background(), nil, clustersDeleteInput{Name: "demo", Namespace: "team-a"})
+	require.Error(t, err)
+
+	tool := &operationsRecentTool{session: session}
+	req := &mcp.CallToolRequest{Params: &mcp.CallToolParamsRaw{Name: "k0rdent.meta.operations.recent"}}
+	_, result, err := tool.recent(context.Background(), req, operationsRecentInput{})
+	require.NoError(t, err)
+	require.Len(t, result.Operations, 2)
+
+	latest := result.Operations[0]
+	assert.Equal(t, "k0rdent.mgmt.clusterDeployments.delete", latest.Tool)
+	assert.Equal(t, "team-a", latest.Namespace)
+	assert.Equal(t, "demo", latest.Target)
+	assert.Equal(t, metrics.OutcomeError, latest.Outcome)
+	assert.Equal(t, "boom", latest.Error)
+
+	applied := result.Operations[1]
+	assert.Equal(t, "demo/ingress", applied.Target)
+	assert.Equal(t, metrics.OutcomeSuccess, applied.Outcome)
+	assert.True(t, applied.DryRun)
+
+	_, filtered, err := tool.recent(context.Background(), req, operationsRecentInput{Tool: "k0rdent.mgmt.clusterDeployments.services.apply"})
+	require.NoError(t, err)
+	require.Len(t, filtered.Operations, 1)
+}
+
+func TestOperationsRecentScopedToCaller(t *testing.T) {
+	token := func(subject string) string {
+		return "eyJhbGciOiJub25lIn0." + base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"`+subject+`"}`)) + "."
+	}
+	log := runtime.NewOperationLog(10)
+	for _, op := range []runtime.Operation{
+		{Tool: "deploy", Namespace: "team-a", Target: "mine", Subject: "alice"},
+		{Tool: "deploy", Namespace: "team-a", Target: "theirs", Subject: "bob"},
+		{Tool: "deploy", Namespace: "other", Target: "outside", Subject: "alice"},
+		{Tool: "install_from_catalog", Namespace: "*", Target: "app", Subject: "alice"},
+		{Tool: "deploy", Namespace: "team-a", Target: "anonymous"},
+	} {
+		log.Record(op)
+	}
+	session := &runtime.Session{
+		Token:           token("alice"),
+		NamespaceFilter: regexp.MustCompile("^team-"),
+		OperationLog:    log,
+	}
+
+	tool := &operationsRecentTool{session: session}
+	req := &mcp.CallToolRequest{Params: &mcp.CallToolParamsRaw{Name: "k0rdent.meta.operations.recent"}}
+	_, result, err := tool.recent(context.Background(), req, operationsRecentInput{})
+	require.NoError(t, err)
+	targets := make([]string, 0, len(result.Operations))
+	for _, op := range result.Operations {
+		targets = append(targets, op.Target)
+	}
+	assert.Equal(t, []string{"app", "mine"}, targets)
+
+	session.Token = token("carol")
+	_, result, err = tool.recent(context.Background(), req, operationsRecentInput{})
+	require.NoError(t, err)
+	assert.Empty(t, result.Operations)
+}
+
+func TestIsMutatingTool(t *testing.T) {
+	assert.True(t, isMutatingTool(&mcp.Tool{Meta: mcp.Meta{"action": "deploy"}}))
+	assert.False(t, isMutatingTool(&mcp.Tool{Meta: mcp.Meta{"action": "list"}}))
+	assert.False(t, isMutatingTool(&mcp.Tool{}))
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"log/slog"
 	"net/url"
+	"regexp"
 	"strconv"
 	"strings"
 	"sync"
@@ -24,6 +25,13 @@ const (
 	podLogsHost        = "podlogs"
 	podLogsURITemplate = "k0rdent://podlogs/{namespace}/{pod}/{container}"
 	podLogsMIMEType    = "text/plain"
+
+	// logGrepParam is the subscription URI query parameter holding a regular expression;
+	// only log lines matching it are published.
+	logGrepParam = "grep"
+
+	// podLogsGetToolName is the companion tool subscribers use to fetch suppressed lines.
+	podLogsGetToolName = "k0rdent.mgmt.podLogs.get"
 )
 
 type podLogKey struct {
@@ -33,6 +41,7 @@ type podLogKey struct {
 	Previous     bool
 	TailLines    *int64
 	SinceSeconds *int64
+	Grep         string
 }
 
 // PodLogManager manages streaming pod log subscriptions.
@@ -45,9 +54,12 @@ type PodLogManager struct {
 
 type logSubscription struct {
 	key    podLogKey
+	grep   *regexp.Regexp
 	cancel context.CancelFunc
 	done   chan struct{}
 	seq    int64
+	// limiter caps published lines; suppressed lines still consume a sequence number
+	limiter *streamLimiter
 }
 
 // NewPodLogManager returns a manager ready for binding.
@@ -157,6 +169,11 @@ func (m *PodLogManager) ensureStream(uri string, key podLogKey) (*logSubscriptio
 		return existing, nil
 	}
 
+	grep, err := compileLogGrep(key.Grep)
+	if err != nil {
+		return nil, err
+	}
+
 	streamCtx, cancel := context.WithCancel(context.Background())
 	lines, errCh, err := m.session.Logs.Stream(streamCtx, key.Namespace, key.Pod, logsprovider.StreamOptions{
 		Options: logsprovider.Options{
@@ -172,9 +189,11 @@ func (m *PodLogManager) ensureStream(uri string, key podLogKey) (*logSubscriptio
 	}
 
 	sub := &logSubscription{
-		key:    key,
-		cancel: cancel,
-		done:   make(chan struct{}),
+		key:     key,
+		grep:    grep,
+		cancel:  cancel,
+		done:    make(chan struct{}),
+		limiter: newStreamLimiter(m.session.StreamMaxUpdatesPerSecond()),
 	}
 	m.streams[uri] = sub
 	server := m.server
@@ -187,10 +206,15 @@ func (m *PodLogManager) ensureStream(uri string, key podLogKey) (*logSubscriptio
 func (m *PodLogManager) consumeLogs(ctx context.Context, server *mcp.Server, uri string, sub *logSubscription, lines <-chan string, errCh <-chan error) {
 	defer close(sub.done)
 
+	ticks, stop := sub.limiter.ticks()
+	defer stop()
+
 	for {
 		select {
 		case <-ctx.Done():
 			return
+		case <-ticks:
+			m.flushSuppressed(server, uri, sub)
 		case err, ok := <-errCh:
 			if ok && err != nil {
 				m.publish(server, uri, map[string]any{
@@ -203,7 +227,14 @@ func (m *PodLogManager) consumeLogs(ctx context.Context, server *mcp.Server, uri
 			if !ok {
 				return
 			}
+			if sub.grep != nil && !sub.grep.MatchString(line) {
+				continue
+			}
+			m.flushSuppressed(server, uri, sub)
 			sub.seq++
+			if !sub.limiter.allow() {
+				continue
+			}
 			m.publish(server, uri, map[string]any{
 				"type":      "line",
 				"sequence":  sub.seq,
@@ -214,6 +245,22 @@ func (m *PodLogManager) consumeLogs(ctx context.Context, server *mcp.Server, uri
 	}
 }
 
+// flushSuppressed publishes a summary of the lines dropped by the rate cap: their sequence
+// range and a resume marker for fetching them through the pod logs tool.
+func (m *PodLogManager) flushSuppressed(server *mcp.Server, uri string, sub *logSubscription) {
+	gap, ok := sub.limiter.flush()
+	if !ok {
+		return
+	}
+	m.publish(server, uri, map[string]any{
+		"type":         "suppressed",
+		"suppressed":   gap.Suppressed,
+		"fromSequence": sub.seq - int64(gap.Suppressed) + 1,
+		"toSequence":   sub.seq,
+		"resume":       gap.resumeMarker(podLogsGetToolName, time.Now()),
+	})
+}
+
 func (m *PodLogManager) publish(server *mcp.Server, uri string, payload map[string]any) {
 	if server == nil {
 		return
@@ -252,18 +299,18 @@ type podLogsResult struct {
 	Following bool   `json:"following"`
 }
 
-func registerPodLogs(server *mcp.Server, session *runtime.Session, manager *PodLogManager) error {
+func registerPodLogs(reg *toolRegistry, session *runtime.Session, manager *PodLogManager) error {
 	if session == nil || session.Logs == nil {
 		return errors.New("session log provider is not configured")
 	}
 
 	if manager != nil {
-		manager.Bind(server, session)
+		manager.Bind(reg.server, session)
 	}
 
 	tool := &podLogsTool{session: session, manager: manager}
-	mcp.AddTool(server, &mcp.Tool{
-		Name:        "k0rdent.mgmt.podLogs.get",
+	addTool(reg, &mcp.Tool{
+		Name:        podLogsGetToolName,
 		Description: "Get Kubernetes pod logs",
 		Meta: mcp.Meta{
 			"plane":    "mgmt",
@@ -272,12 +319,20 @@ func registerPodLogs(server *mcp.Server, session *runtime.Session, manager *PodL
 		},
 	}, tool.get)
 
-	server.AddResourceTemplate(&mcp.ResourceTemplate{
+	reg.addResourceTemplate(&mcp.ResourceTemplate{
 		Name:        "k0rdent.mgmt.podLogs",
 		Title:       "Kubernetes pod logs",
 		Description: "Streaming pod logs for troubleshooting",
 		URITemplate: podLogsURITemplate,
 		MIMEType:    podLogsMIMEType,
+		Meta: mcp.Meta{
+			resourceQueryParamsMetaKey: []resourceQueryParam{
+				{Name: "previous", Description: "Read logs of the previous container instance (true/false)"},
+				{Name: "tailLines", Description: "Number of lines from the end of the log to return"},
+				{Name: "sinceSeconds", Description: "Only return lines newer than this many seconds"},
+				{Name: logGrepParam, Description: "Regular expression; subscriptions only publish matching lines"},
+			},
+		},
 	}, func(ctx context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
 		key, uri, err := parsePodLogURI(req.Params.URI)
 		if err != nil {
@@ -427,10 +482,29 @@ func parsePodLogURI(raw string) (podLogKey, string, error) {
 		}
 		key.SinceSeconds = &v
 	}
+	if grep := query.Get(logGrepParam); grep != "" {
+		if _, err := compileLogGrep(grep); err != nil {
+			return podLogKey{}, "", err
+		}
+		key.Grep = grep
+	}
 
 	return key, buildURIFromKey(key), nil
 }
 
+// compileLogGrep compiles a log line filter from a subscription URI; an empty pattern
+// disables filtering.
+func compileLogGrep(pattern string) (*regexp.Regexp, error) {
+	if pattern == "" {
+		return nil, nil
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s regular expression %q: %w", logGrepParam, pattern, err)
+	}
+	return re, nil
+}
+
 func buildURIFromKey(key podLogKey) string {
 	path := fmt.Sprintf("%s://%s/%s/%s", podLogsScheme, podLogsHost, key.Namespace, key.Pod)
 	if key.Container != "" {
@@ -446,6 +520,9 @@ func buildURIFromKey(key podLogKey) string {
 	if key.SinceSeconds != nil {
 		params.Set("sinceSeconds", strconv.FormatInt(*key.SinceSeconds, 10))
 	}
+	if key.Grep != "" {
+		params.Set(logGrepParam, key.Grep)
+	}
 	if encoded := params.Encode(); encoded != "" {
 		path = fmt.Sprintf("%s?%s", path, encoded)
 	}
@@ -0,0 +1,50 @@
+package core
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePodLogURIGrep(t *testing.T) {
+	key, uri, err := parsePodLogURI("k0rdent://podlogs/kcm-system/kcm-controller/manager?grep=level%3Derror&tailLines=10")
+	require.NoError(t, err)
+	require.Equal(t, "level=error", key.Grep)
+	require.Equal(t, "k0rdent://podlogs/kcm-system/kcm-controller/manager?grep=level%3Derror&tailLines=10", uri)
+
+	_, _, err = parsePodLogURI("k0rdent://podlogs/kcm-system/kcm-controller?grep=%5Bbad")
+	require.ErrorContains(t, err, "invalid grep regular expression")
+}
+
+func TestPodLogConsumeAppliesGrep(t *testing.T) {
+	m := NewPodLogManager()
+	sub := &logSubscription{grep: regexp.MustCompile("error"), done: make(chan struct{})}
+	lines := make(chan string, 3)
+	lines <- "info: starting"
+	lines <- "error: boom"
+	lines <- "info: done"
+	close(lines)
+
+	m.consumeLogs(context.Background(), nil, "k0rdent://podlogs/ns/pod", sub, lines, make(chan error))
+	require.Equal(t, int64(1), sub.seq)
+}
+
+func TestPodLogConsumeAppliesRateLimit(t *testing.T) {
+	m := NewPodLogManager()
+	limiter := newStreamLimiter(2)
+	clock := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	limiter.now = func() time.Time { return clock }
+	sub := &logSubscription{limiter: limiter, done: make(chan struct{})}
+	lines := make(chan string, 5)
+	for i := 0; i < 5; i++ {
+		lines <- "line"
+	}
+	close(lines)
+
+	m.consumeLogs(context.Background(), nil, "k0rdent://podlogs/ns/pod", sub, lines, make(chan error))
+	require.Equal(t, int64(5), sub.seq, "suppressed lines still consume sequence numbers")
+	require.Equal(t, 3, limiter.suppressed)
+}
@@ -0,0 +1,59 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/k0rdent/mcp-k0rdent-server/internal/clusters"
+	"github.com/k0rdent/mcp-k0rdent-server/internal/runtime"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// providersTestCredentialTool checks whether a Credential is usable before deploying
+type providersTestCredentialTool struct {
+	session *runtime.Session
+}
+
+// providersTestCredentialInput defines the input schema for credential checks
+type providersTestCredentialInput struct {
+	Name      string `json:"name" jsonschema:"Credential name"`
+	Namespace string `json:"namespace,omitempty" jsonschema:"Credential namespace (optional, follows standard patterns)"`
+}
+
+// providersTestCredentialResult is the result of a credential check
+type providersTestCredentialResult clusters.CredentialCheck
+
+// test handles the credential check request
+func (t *providersTestCredentialTool) test(ctx context.Context, req *mcp.CallToolRequest, input providersTestCredentialInput) (*mcp.CallToolResult, providersTestCredentialResult, error) {
+	name := toolName(req)
+	ctx, logger := toolContext(ctx, t.session, name, "tool.providers.testCredential")
+	start := time.Now()
+
+	if input.Name == "" {
+		return nil, providersTestCredentialResult{}, fmt.Errorf("credential name is required")
+	}
+
+	targetNamespace, err := resolveClusterNamespace(ctx, t.session, input.Namespace, logger)
+	if err != nil {
+		logger.Error("failed to resolve namespace", "tool", name, "error", err)
+		return nil, providersTestCredentialResult{}, fmt.Errorf("resolve namespace: %w", err)
+	}
+
+	check, err := t.session.Clusters.CheckCredential(ctx, targetNamespace, input.Name)
+	if err != nil {
+		logger.Error("failed to check credential", "tool", name, "credential", input.Name, "error", err)
+		return nil, providersTestCredentialResult{}, fmt.Errorf("check credential: %w", err)
+	}
+
+	logger.Info("credential checked",
+		"tool", name,
+		"credential", input.Name,
+		"namespace", targetNamespace,
+		"usable", check.Usable,
+		"problems", len(check.Problems),
+		"duration_ms", time.Since(start).Milliseconds(),
+	)
+
+	return nil, providersTestCredentialResult(check), nil
+}
@@ -0,0 +1,83 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/k0rdent/mcp-k0rdent-server/internal/clusters"
+	"github.com/k0rdent/mcp-k0rdent-server/internal/runtime"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// providersInstalledTool lists the infrastructure providers installed on the management cluster
+type providersInstalledTool struct {
+	session *runtime.Session
+}
+
+// installedProvider is an installed provider annotated with its catalog title
+type installedProvider struct {
+	clusters.InstalledProvider
+	Title string `json:"title,omitempty"`
+	// Supported is true when the server knows how to deploy to the provider
+	Supported bool `json:"supported"`
+}
+
+// providersInstalledResult is the result of an installed provider listing
+type providersInstalledResult struct {
+	Providers []installedProvider `json:"providers"`
+	// NotInstalled lists supported providers without a controller on the management cluster
+	NotInstalled []string `json:"notInstalled"`
+}
+
+// list reports the CAPI infrastructure providers whose controllers are deployed
+func (t *providersInstalledTool) list(ctx context.Context, req *mcp.CallToolRequest, _ any) (*mcp.CallToolResult, providersInstalledResult, error) {
+	name := toolName(req)
+	ctx, logger := toolContext(ctx, t.session, name, "tool.clusters")
+	start := time.Now()
+
+	installed, err := t.session.Clusters.ListInstalledProviders(ctx)
+	if err != nil {
+		logger.Error("failed to list installed providers", "tool", name, "error", err)
+		return nil, providersInstalledResult{}, fmt.Errorf("list installed providers: %w", err)
+	}
+
+	result := installedProviders(defaultProviderSummaries, installed)
+
+	logger.Info("installed providers listed",
+		"tool", name,
+		"count", len(result.Providers),
+		"not_installed", len(result.NotInstalled),
+		"duration_ms", time.Since(start).Milliseconds(),
+	)
+
+	return nil, result, nil
+}
+
+// installedProviders annotates installed providers with the supported provider summaries and
+// lists the supported providers that are not installed.
+func installedProviders(supported []clusters.ProviderSummary, installed []clusters.InstalledProvider) providersInstalledResult {
+	result := providersInstalledResult{
+		Providers:    make([]installedProvider, 0, len(installed)),
+		NotInstalled: []string{},
+	}
+	found := make(map[string]bool, len(installed))
+	for _, provider := range installed {
+		entry := installedProvider{InstalledProvider: provider}
+		for _, summary := range supported {
+			if strings.EqualFold(summary.Name, provider.Name) {
+				entry.Title = summary.Title
+				entry.Supported = true
+				found[summary.Name] = true
+			}
+		}
+		result.Providers = append(result.Providers, entry)
+	}
+	for _, summary := range supported {
+		if !found[summary.Name] {
+			result.NotInstalled = append(result.NotInstalled, summary.Name)
+		}
+	}
+	return result
+}
@@ -0,0 +1,57 @@
+package core
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	"github.com/k0rdent/mcp-k0rdent-server/internal/clusters"
+	runtimepkg "github.com/k0rdent/mcp-k0rdent-server/internal/runtime"
+)
+
+func newInstalledTestDeployment(name, component string, readyReplicas int64) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]any{
+			"name":      name,
+			"namespace": "kcm-system",
+			"labels":    map[string]any{"cluster.x-k8s.io/provider": component},
+		},
+		"status": map[string]any{"readyReplicas": readyReplicas},
+	}}
+}
+
+func TestProvidersInstalled(t *testing.T) {
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{clusters.DeploymentsGVR: "DeploymentList"},
+		newInstalledTestDeployment("capa-controller-manager", "infrastructure-aws", 1),
+		newInstalledTestDeployment("k0smotron-controller-manager-infrastructure", "infrastructure-k0sproject-k0smotron", 1),
+		newInstalledTestDeployment("capi-controller-manager", "cluster-api", 1),
+	)
+
+	mgr, err := clusters.NewManager(clusters.Options{DynamicClient: client, Logger: slog.Default()})
+	require.NoError(t, err)
+
+	tool := &providersInstalledTool{session: &runtimepkg.Session{Logger: slog.Default(), Clusters: mgr}}
+	req := &mcp.CallToolRequest{Params: &mcp.CallToolParamsRaw{Name: "k0rdent.mgmt.providers.installed"}}
+	_, result, err := tool.list(context.Background(), req, nil)
+	require.NoError(t, err)
+
+	require.Len(t, result.Providers, 2)
+	assert.Equal(t, "aws", result.Providers[0].Name)
+	assert.Equal(t, "Amazon Web Services", result.Providers[0].Title)
+	assert.True(t, result.Providers[0].Supported)
+	assert.True(t, result.Providers[0].Ready)
+	assert.Equal(t, "k0sproject-k0smotron", result.Providers[1].Name)
+	assert.False(t, result.Providers[1].Supported)
+	assert.Equal(t, []string{"azure", "gcp", "vsphere", "openstack"}, result.NotInstalled)
+}
@@ -0,0 +1,123 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/k0rdent/mcp-k0rdent-server/internal/clusters"
+	"github.com/k0rdent/mcp-k0rdent-server/internal/runtime"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// providersUsableTool lists providers that can be deployed right now
+type providersUsableTool struct {
+	session *runtime.Session
+}
+
+// providersUsableInput defines the input schema for usable provider listing
+type providersUsableInput struct {
+	Namespace string `json:"namespace,omitempty" jsonschema:"Limit the check to a single namespace (optional, defaults to all allowed namespaces plus kcm-system)"`
+}
+
+// usableProvider is a provider with at least one ready credential and one template
+type usableProvider struct {
+	clusters.ProviderSummary
+	Credentials []string `json:"credentials"`
+	Templates   []string `json:"templates"`
+}
+
+// providersUsableResult is the result of a usable provider listing
+type providersUsableResult struct {
+	Providers []usableProvider            `json:"providers"`
+	Failures  []clusters.NamespaceFailure `json:"failures,omitempty"`
+}
+
+// list cross-references credentials and cluster templates to find deployable providers
+func (t *providersUsableTool) list(ctx context.Context, req *mcp.CallToolRequest, input providersUsableInput) (*mcp.CallToolResult, providersUsableResult, error) {
+	name := toolName(req)
+	ctx, logger := toolContext(ctx, t.session, name, "tool.clusters")
+	start := time.Now()
+
+	credsHelper := &clustersListCredentialsTool{session: t.session}
+	targetNamespaces, err := credsHelper.resolveTargetNamespaces(ctx, input.Namespace, logger)
+	if err != nil {
+		logger.Error("failed to resolve target namespaces", "tool", name, "error", err)
+		return nil, providersUsableResult{}, fmt.Errorf("resolve namespaces: %w", err)
+	}
+
+	listCtx, cancel := withListTimeout(ctx, t.session)
+	credentials, err := t.session.Clusters.ListCredentials(listCtx, targetNamespaces)
+	cancel()
+	err = listTimeoutError(listCtx, err)
+	credFailures, partial := namespaceFailures(err)
+	if partial {
+		logger.Warn("credentials listed with namespace failures", "tool", name, "failed_namespaces", len(credFailures), "error", err)
+	} else if err != nil {
+		logger.Error("failed to list credentials", "tool", name, "error", err)
+		return nil, providersUsableResult{}, fmt.Errorf("list credentials: %w", err)
+	}
+
+	listCtx, cancel = withListTimeout(ctx, t.session)
+	templates, err := t.session.Clusters.ListTemplates(listCtx, targetNamespaces)
+	cancel()
+	err = listTimeoutError(listCtx, err)
+	templateFailures, partial := namespaceFailures(err)
+	if partial {
+		logger.Warn("templates listed with namespace failures", "tool", name, "failed_namespaces", len(templateFailures), "error", err)
+	} else if err != nil {
+		logger.Error("failed to list templates", "tool", name, "error", err)
+		return nil, providersUsableResult{}, fmt.Errorf("list templates: %w", err)
+	}
+
+	providers := usableProviders(defaultProviderSummaries, credentials, templates)
+
+	logger.Info("usable providers listed",
+		"tool", name,
+		"count", len(providers),
+		"namespaces", len(targetNamespaces),
+		"duration_ms", time.Since(start).Milliseconds(),
+	)
+
+	return nil, providersUsableResult{
+		Providers: providers,
+		Failures:  append(credFailures, templateFailures...),
+	}, nil
+}
+
+// usableProviders returns the providers that have both a ready credential and a cluster
+// template. Credentials and templates are reported as namespace/name references.
+func usableProviders(candidates []clusters.ProviderSummary, credentials []clusters.CredentialSummary, templates []clusters.ClusterTemplateSummary) []usableProvider {
+	providers := []usableProvider{}
+	for _, candidate := range candidates {
+		entry := usableProvider{ProviderSummary: candidate}
+		for _, cred := range credentials {
+			if cred.Ready && strings.EqualFold(cred.Provider, candidate.Name) {
+				entry.Credentials = append(entry.Credentials, cred.Namespace+"/"+cred.Name)
+			}
+		}
+		for _, tmpl := range templates {
+			if templateMatchesProvider(tmpl, candidate.Name) {
+				entry.Templates = append(entry.Templates, tmpl.Namespace+"/"+tmpl.Name)
+			}
+		}
+		if len(entry.Credentials) == 0 || len(entry.Templates) == 0 {
+			continue
+		}
+		sort.Strings(entry.Credentials)
+		sort.Strings(entry.Templates)
+		providers = append(providers, entry)
+	}
+	return providers
+}
+
+// templateMatchesProvider reports whether a cluster template deploys to the given provider,
+// using the provider/cloud labels and falling back to the "<provider>-" name prefix.
+func templateMatchesProvider(tmpl clusters.ClusterTemplateSummary, provider string) bool {
+	if strings.EqualFold(tmpl.Provider, provider) || strings.EqualFold(tmpl.Cloud, provider) {
+		return true
+	}
+	return strings.HasPrefix(strings.ToLower(tmpl.Name), strings.ToLower(provider)+"-")
+}
@@ -0,0 +1,253 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	clienttesting "k8s.io/client-go/testing"
+
+	"github.com/k0rdent/mcp-k0rdent-server/internal/clusters"
+	runtimepkg "github.com/k0rdent/mcp-k0rdent-server/internal/runtime"
+)
+
+func newUsableTestCredential(name, identityKind string, ready bool) *unstructured.Unstructured {
+	status := "False"
+	if ready {
+		status = "True"
+	}
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "k0rdent.mirantis.com/v1beta1",
+		"kind":       "Credential",
+		"metadata":   map[string]any{"name": name, "namespace": "kcm-system"},
+		"spec": map[string]any{
+			"identityRef": map[string]any{"kind": identityKind, "name": name + "-identity"},
+		},
+		"status": map[string]any{
+			"conditions": []any{map[string]any{"type": "Ready", "status": status}},
+		},
+	}}
+}
+
+func newUsableTestTemplate(name string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "k0rdent.mirantis.com/v1beta1",
+		"kind":       "ClusterTemplate",
+		"metadata":   map[string]any{"name": name, "namespace": "kcm-system"},
+	}}
+}
+
+func TestProvidersUsable(t *testing.T) {
+	client := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(),
+		newUsableTestCredential("aws-cred", "AWSClusterStaticIdentity", true),
+		newUsableTestCredential("azure-cred", "AzureClusterIdentity", false),
+		newUsableTestCredential("gcp-cred", "GCPClusterIdentity", true),
+		newUsableTestTemplate("aws-standalone-cp-1-0-0"),
+		newUsableTestTemplate("azure-standalone-cp-1-0-0"),
+	)
+
+	mgr, err := clusters.NewManager(clusters.Options{
+		DynamicClient:   client,
+		GlobalNamespace: "kcm-system",
+		Logger:          slog.Default(),
+	})
+	require.NoError(t, err)
+
+	tool := &providersUsableTool{session: &runtimepkg.Session{
+		Logger:   slog.Default(),
+		Clusters: mgr,
+		Clients:  runtimepkg.Clients{Dynamic: client},
+	}}
+
+	req := &mcp.CallToolRequest{Params: &mcp.CallToolParamsRaw{Name: "k0rdent.mgmt.providers.usable"}}
+	_, result, err := tool.list(context.Background(), req, providersUsableInput{Namespace: "kcm-system"})
+	require.NoError(t, err)
+
+	// azure has a template but no ready credential; gcp has a credential but no template
+	require.Len(t, result.Providers, 1)
+	assert.Equal(t, "aws", result.Providers[0].Name)
+	assert.Equal(t, []string{"kcm-system/aws-cred"}, result.Providers[0].Credentials)
+	assert.Equal(t, []string{"kcm-system/aws-standalone-cp-1-0-0"}, result.Providers[0].Templates)
+}
+
+func TestTemplateMatchesProvider(t *testing.T) {
+	assert.True(t, templateMatchesProvider(clusters.ClusterTemplateSummary{Name: "AWS-eks-1-0-0"}, "aws"))
+	assert.True(t, templateMatchesProvider(clusters.ClusterTemplateSummary{Name: "custom", Provider: "azure"}, "azure"))
+	assert.True(t, templateMatchesProvider(clusters.ClusterTemplateSummary{Name: "custom", Cloud: "gcp"}, "gcp"))
+	assert.False(t, templateMatchesProvider(clusters.ClusterTemplateSummary{Name: "awsome-template"}, "aws"))
+}
+
+func TestProvidersList_Metadata(t *testing.T) {
+	template := newUsableTestTemplate("aws-standalone-cp-1-0-15")
+	template.Object["spec"] = map[string]any{"version": "1.0.15"}
+	template.Object["status"] = map[string]any{"k8sVersion": "v1.32.1+k0s.0"}
+	client := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), template)
+
+	mgr, err := clusters.NewManager(clusters.Options{
+		DynamicClient:   client,
+		GlobalNamespace: "kcm-system",
+		Logger:          slog.Default(),
+	})
+	require.NoError(t, err)
+
+	tool := &providersListTool{session: &runtimepkg.Session{Logger: slog.Default(), Clusters: mgr}}
+	req := &mcp.CallToolRequest{Params: &mcp.CallToolParamsRaw{Name: "k0rdent.mgmt.providers.list"}}
+	_, result, err := tool.list(context.Background(), req, nil)
+	require.NoError(t, err)
+
+	byName := map[string]clusters.ProviderSummary{}
+	for _, provider := range result.Providers {
+		byName[provider.Name] = provider
+	}
+	assert.Equal(t, "v1.32.1+k0s.0", byName["aws"].DefaultK8sVersion)
+	assert.NotEmpty(t, byName["aws"].Regions)
+	assert.Empty(t, byName["azure"].DefaultK8sVersion)
+	assert.Empty(t, defaultProviderSummaries[0].DefaultK8sVersion, "static provider list must not be mutated")
+}
+
+func TestProvidersListIdentities_SkipsForbiddenNamespace(t *testing.T) {
+	namespace := func(name string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "v1",
+			"kind":       "Namespace",
+			"metadata":   map[string]any{"name": name},
+		}}
+	}
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		{Version: "v1", Resource: "namespaces"}: "NamespaceList",
+		clusters.CredentialsGVR:                 "CredentialList",
+	},
+		namespace("kcm-system"),
+		namespace("team-b"),
+		newUsableTestCredential("aws-cred", "AWSClusterStaticIdentity", true),
+	)
+	client.PrependReactor("list", "credentials", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		if action.GetNamespace() == "team-b" {
+			return true, nil, apierrors.NewForbidden(clusters.CredentialsGVR.GroupResource(), "", errors.New("no access"))
+		}
+		return false, nil, nil
+	})
+
+	mgr, err := clusters.NewManager(clusters.Options{
+		DynamicClient:   client,
+		GlobalNamespace: "kcm-system",
+		Logger:          slog.Default(),
+	})
+	require.NoError(t, err)
+
+	tool := &providersListIdentitiesTool{session: &runtimepkg.Session{
+		Logger:   slog.Default(),
+		Clusters: mgr,
+		Clients:  runtimepkg.Clients{Dynamic: client},
+	}}
+
+	req := &mcp.CallToolRequest{Params: &mcp.CallToolParamsRaw{Name: "k0rdent.mgmt.providers.listIdentities"}}
+	_, result, err := tool.list(context.Background(), req, providersListIdentitiesInput{})
+	require.NoError(t, err)
+
+	require.Len(t, result.Identities, 1)
+	assert.Equal(t, "aws-cred-identity", result.Identities[0].Name)
+	require.Len(t, result.Failures, 1)
+	assert.Equal(t, "team-b", result.Failures[0].Namespace)
+	require.Len(t, result.Warnings, 1)
+	assert.Contains(t, result.Warnings[0], "namespace team-b skipped")
+}
+
+func TestProvidersListCredentials_ValidatesProvider(t *testing.T) {
+	client := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(),
+		newUsableTestCredential("aws-cred", "AWSClusterStaticIdentity", true),
+		newUsableTestCredential("gcp-cred", "GCPClusterIdentity", true),
+	)
+
+	mgr, err := clusters.NewManager(clusters.Options{
+		DynamicClient:   client,
+		GlobalNamespace: "kcm-system",
+		Logger:          slog.Default(),
+	})
+	require.NoError(t, err)
+
+	tool := &clustersListCredentialsTool{session: &runtimepkg.Session{
+		Logger:   slog.Default(),
+		Clusters: mgr,
+		Clients:  runtimepkg.Clients{Dynamic: client},
+	}}
+	req := &mcp.CallToolRequest{Params: &mcp.CallToolParamsRaw{Name: "k0rdent.mgmt.providers.listCredentials"}}
+
+	_, result, err := tool.list(context.Background(), req, clustersListCredentialsInput{Namespace: "kcm-system", Provider: "AWS"})
+	require.NoError(t, err)
+	require.Len(t, result.Credentials, 1)
+	assert.Equal(t, "aws-cred", result.Credentials[0].Name)
+
+	_, _, err = tool.list(context.Background(), req, clustersListCredentialsInput{Namespace: "kcm-system", Provider: "awz"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "INVALID_INPUT")
+	assert.Contains(t, err.Error(), "aws, azure, gcp, vsphere, openstack")
+}
+
+func TestProvidersListCredentials_ForbiddenGlobalNamespace(t *testing.T) {
+	namespace := func(name string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "v1",
+			"kind":       "Namespace",
+			"metadata":   map[string]any{"name": name},
+		}}
+	}
+	teamCred := newUsableTestCredential("team-cred", "AWSClusterStaticIdentity", true)
+	teamCred.SetNamespace("team-b")
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		{Version: "v1", Resource: "namespaces"}: "NamespaceList",
+		clusters.CredentialsGVR:                 "CredentialList",
+	},
+		namespace("kcm-system"),
+		namespace("team-b"),
+		newUsableTestCredential("aws-cred", "AWSClusterStaticIdentity", true),
+		teamCred,
+	)
+	var globalLists int
+	client.PrependReactor("list", "credentials", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		if action.GetNamespace() == "kcm-system" {
+			globalLists++
+			return true, nil, apierrors.NewForbidden(clusters.CredentialsGVR.GroupResource(), "", errors.New("no access"))
+		}
+		return false, nil, nil
+	})
+
+	mgr, err := clusters.NewManager(clusters.Options{
+		DynamicClient:   client,
+		GlobalNamespace: "kcm-system",
+		Logger:          slog.Default(),
+	})
+	require.NoError(t, err)
+
+	tool := &clustersListCredentialsTool{session: &runtimepkg.Session{
+		Logger:   slog.Default(),
+		Clusters: mgr,
+		Clients:  runtimepkg.Clients{Dynamic: client},
+	}}
+	req := &mcp.CallToolRequest{Params: &mcp.CallToolParamsRaw{Name: "k0rdent.mgmt.providers.listCredentials"}}
+
+	_, result, err := tool.list(context.Background(), req, clustersListCredentialsInput{})
+	require.NoError(t, err)
+	require.Len(t, result.Credentials, 1)
+	assert.Equal(t, "team-cred", result.Credentials[0].Name)
+	assert.Empty(t, result.Failures)
+	require.Len(t, result.Warnings, 1)
+	assert.Contains(t, result.Warnings[0], "global namespace kcm-system is not readable")
+
+	globalLists = 0
+	include := false
+	_, result, err = tool.list(context.Background(), req, clustersListCredentialsInput{IncludeGlobalCredentials: &include})
+	require.NoError(t, err)
+	require.Len(t, result.Credentials, 1)
+	assert.Empty(t, result.Warnings)
+	assert.Zero(t, globalLists, "global namespace should not be listed when excluded")
+}
@@ -2,6 +2,8 @@ package core
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 
@@ -14,6 +16,8 @@ const (
 	ContextKeyEventManager          = "core:eventManager"
 	ContextKeyPodLogManager         = "core:podLogManager"
 	ContextKeyClusterMonitorManager = "core:clusterMonitorManager"
+	ContextKeyClusterLogManager     = "core:clusterLogManager"
+	ContextKeyClusterWatchManager   = "core:clusterWatchManager"
 )
 
 // Options control which tool groups are registered for a session.
@@ -21,6 +25,8 @@ type Options struct {
 	EventManager          *EventManager
 	PodLogManager         *PodLogManager
 	ClusterMonitorManager *ClusterMonitorManager
+	ClusterLogManager     *ClusterLogManager
+	ClusterWatchManager   *ClusterDeploymentWatchManager
 	CatalogManager        *catalog.Manager
 }
 
@@ -33,33 +39,96 @@ func Register(server *mcp.Server, session *runtime.Session, opts Options) error
 		return errors.New("session is required")
 	}
 
-	if err := registerNamespaces(server, session); err != nil {
+	reg := newToolRegistry(server)
+	reg.session = session
+
+	if err := registerNamespaces(reg, session); err != nil {
+		return err
+	}
+
+	if err := registerOperations(reg, session); err != nil {
+		return err
+	}
+
+	if err := registerResourcesMeta(reg, session); err != nil {
+		return err
+	}
+
+	if err := registerEvents(reg, session, opts.EventManager); err != nil {
+		return err
+	}
+
+	if err := registerClusterMonitor(reg, session, opts.ClusterMonitorManager); err != nil {
 		return err
 	}
 
-	if err := registerEvents(server, session, opts.EventManager); err != nil {
+	if err := registerPodLogs(reg, session, opts.PodLogManager); err != nil {
 		return err
 	}
 
-	if err := registerClusterMonitor(server, session, opts.ClusterMonitorManager); err != nil {
+	if err := registerClusterLogs(reg, session, opts.ClusterLogManager); err != nil {
 		return err
 	}
 
-	if err := registerPodLogs(server, session, opts.PodLogManager); err != nil {
+	if err := registerClusterDeploymentWatch(reg, session, opts.ClusterWatchManager); err != nil {
 		return err
 	}
 
-	if err := registerK0rdentTools(server, session); err != nil {
+	if err := registerK0rdentTools(reg, session); err != nil {
 		return err
 	}
 
-	if err := registerCatalog(server, session, opts.CatalogManager); err != nil {
+	if err := registerCatalog(reg, session, opts.CatalogManager); err != nil {
 		return err
 	}
 
-	if err := registerClusters(server, session); err != nil {
+	if err := registerClusters(reg, session); err != nil {
 		return err
 	}
 
-	return nil
+	return reg.err()
+}
+
+// toolRegistry tracks tool names added to a server so that duplicate registrations,
+// which mcp.AddTool would otherwise resolve by silently replacing the earlier tool,
+// fail startup instead. When a session is set, mutating tools are recorded in its
+// operation log. Resource templates are recorded so they can be described by
+// k0rdent.meta.resources.list.
+type toolRegistry struct {
+	server     *mcp.Server
+	session    *runtime.Session
+	names      map[string]struct{}
+	duplicates []string
+	resources  []*mcp.ResourceTemplate
+}
+
+func newToolRegistry(server *mcp.Server) *toolRegistry {
+	return &toolRegistry{server: server, names: make(map[string]struct{})}
+}
+
+// addTool registers a typed tool handler unless a tool with the same name was already added.
+func addTool[In, Out any](reg *toolRegistry, tool *mcp.Tool, handler mcp.ToolHandlerFor[In, Out]) {
+	if _, exists := reg.names[tool.Name]; exists {
+		reg.duplicates = append(reg.duplicates, tool.Name)
+		return
+	}
+	reg.names[tool.Name] = struct{}{}
+	if reg.session != nil && isMutatingTool(tool) {
+		handler = recordOperation(reg.session, tool.Name, handler)
+	}
+	mcp.AddTool(reg.server, tool, handler)
+}
+
+// addResourceTemplate registers a resource template and records it for discovery.
+func (r *toolRegistry) addResourceTemplate(tmpl *mcp.ResourceTemplate, handler mcp.ResourceHandler) {
+	r.resources = append(r.resources, tmpl)
+	r.server.AddResourceTemplate(tmpl, handler)
+}
+
+// err reports duplicate tool names encountered during registration.
+func (r *toolRegistry) err() error {
+	if len(r.duplicates) == 0 {
+		return nil
+	}
+	return fmt.Errorf("duplicate tool registration: %s", strings.Join(r.duplicates, ", "))
 }
@@ -0,0 +1,91 @@
+package core
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	eventsprovider "github.com/k0rdent/mcp-k0rdent-server/internal/kube/events"
+	logsprovider "github.com/k0rdent/mcp-k0rdent-server/internal/kube/logs"
+	"github.com/k0rdent/mcp-k0rdent-server/internal/runtime"
+	testdynamic "github.com/k0rdent/mcp-k0rdent-server/internal/testutil/dynamic"
+)
+
+// TestRegister_UniqueToolNames registers the full tool suite and verifies that every
+// tool exposed by the server has a distinct name.
+func TestRegister_UniqueToolNames(t *testing.T) {
+	ts, catalogManager := createTestCatalogManager(t)
+	defer ts.Close()
+
+	kubeClient := kubefake.NewSimpleClientset()
+	logs, err := logsprovider.NewProvider(kubeClient)
+	if err != nil {
+		t.Fatalf("create logs provider: %v", err)
+	}
+	session := &runtime.Session{
+		Events:  &eventsprovider.Provider{},
+		Logs:    logs,
+		Clients: runtime.Clients{Kubernetes: kubeClient, Dynamic: testdynamic.NewFakeDynamicClient()},
+	}
+
+	server := mcp.NewServer(&mcp.Implementation{Name: "test", Version: "v0.0.0"}, nil)
+	if err := Register(server, session, Options{CatalogManager: catalogManager}); err != nil {
+		t.Fatalf("Register failed: %v", err)
+	}
+
+	ctx := context.Background()
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	serverSession, err := server.Connect(ctx, serverTransport, nil)
+	if err != nil {
+		t.Fatalf("server connect: %v", err)
+	}
+	defer serverSession.Close()
+
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client", Version: "v0.0.0"}, nil)
+	clientSession, err := client.Connect(ctx, clientTransport, nil)
+	if err != nil {
+		t.Fatalf("client connect: %v", err)
+	}
+	defer clientSession.Close()
+
+	result, err := clientSession.ListTools(ctx, nil)
+	if err != nil {
+		t.Fatalf("list tools: %v", err)
+	}
+	if len(result.Tools) == 0 {
+		t.Fatal("expected registered tools")
+	}
+
+	seen := make(map[string]bool, len(result.Tools))
+	for _, tool := range result.Tools {
+		if seen[tool.Name] {
+			t.Errorf("tool %q registered more than once", tool.Name)
+		}
+		seen[tool.Name] = true
+	}
+}
+
+// TestToolRegistry_Duplicate verifies that registering a tool name twice is reported
+func TestToolRegistry_Duplicate(t *testing.T) {
+	reg := newToolRegistry(mcp.NewServer(&mcp.Implementation{Name: "test", Version: "v0.0.0"}, nil))
+	handler := func(ctx context.Context, req *mcp.CallToolRequest, input struct{}) (*mcp.CallToolResult, struct{}, error) {
+		return nil, struct{}{}, nil
+	}
+
+	addTool(reg, &mcp.Tool{Name: "k0rdent.test.tool"}, handler)
+	if err := reg.err(); err != nil {
+		t.Fatalf("unexpected error after first registration: %v", err)
+	}
+
+	addTool(reg, &mcp.Tool{Name: "k0rdent.test.tool"}, handler)
+	err := reg.err()
+	if err == nil {
+		t.Fatal("expected duplicate registration error")
+	}
+	if !strings.Contains(err.Error(), "k0rdent.test.tool") {
+		t.Errorf("expected error to name the duplicate tool, got %v", err)
+	}
+}
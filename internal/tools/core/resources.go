@@ -0,0 +1,89 @@
+package core
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/k0rdent/mcp-k0rdent-server/internal/runtime"
+)
+
+// resourceQueryParamsMetaKey is the resource template _meta key listing the URI query
+// parameters the template's handler understands.
+const resourceQueryParamsMetaKey = "queryParams"
+
+// resourceQueryParam describes an optional URI query parameter of a resource template.
+type resourceQueryParam struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type resourcesListTool struct {
+	session   *runtime.Session
+	templates func() []*mcp.ResourceTemplate
+}
+
+type resourcesListInput struct{}
+
+type resourcesListResult struct {
+	Templates []resourceTemplateInfo `json:"templates"`
+}
+
+type resourceTemplateInfo struct {
+	Name        string               `json:"name"`
+	Title       string               `json:"title,omitempty"`
+	Description string               `json:"description,omitempty"`
+	URITemplate string               `json:"uriTemplate"`
+	MIMEType    string               `json:"mimeType,omitempty"`
+	QueryParams []resourceQueryParam `json:"queryParams,omitempty"`
+}
+
+func registerResourcesMeta(reg *toolRegistry, session *runtime.Session) error {
+	tool := &resourcesListTool{
+		session:   session,
+		templates: func() []*mcp.ResourceTemplate { return reg.resources },
+	}
+	addTool(reg, &mcp.Tool{
+		Name:        "k0rdent.meta.resources.list",
+		Description: "List the MCP resource templates registered by this server with their URI templates, MIME types, and supported query parameters. Use it to discover which streams (cluster monitor, cluster logs, events, pod logs) can be read or subscribed to and how to build their URIs.",
+		Meta: mcp.Meta{
+			"plane":    "meta",
+			"category": "resources",
+			"action":   "list",
+		},
+	}, tool.list)
+	return nil
+}
+
+func (t *resourcesListTool) list(ctx context.Context, req *mcp.CallToolRequest, _ resourcesListInput) (*mcp.CallToolResult, resourcesListResult, error) {
+	name := toolName(req)
+	_, logger := toolContext(ctx, t.session, name, "tool.resources")
+	start := time.Now()
+
+	templates := t.templates()
+	infos := make([]resourceTemplateInfo, 0, len(templates))
+	for _, tmpl := range templates {
+		info := resourceTemplateInfo{
+			Name:        tmpl.Name,
+			Title:       tmpl.Title,
+			Description: tmpl.Description,
+			URITemplate: tmpl.URITemplate,
+			MIMEType:    tmpl.MIMEType,
+		}
+		if params, ok := tmpl.Meta[resourceQueryParamsMetaKey].([]resourceQueryParam); ok {
+			info.QueryParams = append([]resourceQueryParam(nil), params...)
+		}
+		infos = append(infos, info)
+	}
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Name < infos[j].Name })
+
+	logger.Info("resource templates listed",
+		"tool", name,
+		"count", len(infos),
+		"duration_ms", time.Since(start).Milliseconds(),
+	)
+
+	return nil, resourcesListResult{Templates: infos}, nil
+}
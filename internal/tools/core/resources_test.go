@@ -0,0 +1,46 @@
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func TestResourcesList_DescribesRegisteredTemplates(t *testing.T) {
+	reg := newToolRegistry(mcp.NewServer(&mcp.Implementation{Name: "test", Version: "v0.0.0"}, nil))
+	handler := func(ctx context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
+		return nil, nil
+	}
+	reg.addResourceTemplate(&mcp.ResourceTemplate{
+		Name:        "k0rdent.test.stream",
+		URITemplate: "k0rdent://stream/{namespace}",
+		MIMEType:    "application/json",
+		Meta: mcp.Meta{
+			resourceQueryParamsMetaKey: []resourceQueryParam{{Name: "timeout", Description: "Seconds"}},
+		},
+	}, handler)
+	reg.addResourceTemplate(&mcp.ResourceTemplate{
+		Name:        "k0rdent.test.alpha",
+		URITemplate: "k0rdent://alpha/{name}",
+	}, handler)
+
+	tool := &resourcesListTool{templates: func() []*mcp.ResourceTemplate { return reg.resources }}
+	_, result, err := tool.list(context.Background(), nil, resourcesListInput{})
+	if err != nil {
+		t.Fatalf("list returned error: %v", err)
+	}
+	if len(result.Templates) != 2 {
+		t.Fatalf("expected 2 templates, got %d", len(result.Templates))
+	}
+	if result.Templates[0].Name != "k0rdent.test.alpha" {
+		t.Fatalf("expected templates sorted by name, got %q first", result.Templates[0].Name)
+	}
+	stream := result.Templates[1]
+	if stream.URITemplate != "k0rdent://stream/{namespace}" || stream.MIMEType != "application/json" {
+		t.Fatalf("unexpected template info: %+v", stream)
+	}
+	if len(stream.QueryParams) != 1 || stream.QueryParams[0].Name != "timeout" {
+		t.Fatalf("expected timeout query param, got %+v", stream.QueryParams)
+	}
+}
@@ -0,0 +1,114 @@
+package core
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// streamLimitWindow is the interval the per-subscription update cap applies to.
+const streamLimitWindow = time.Second
+
+// streamLimiter caps the updates a subscription publishes per window. Updates over the cap
+// are dropped and counted; once the window closes the drop is reported as a streamGap so the
+// subscriber can fetch what it missed from the stream's list tool. A nil limiter allows
+// everything.
+type streamLimiter struct {
+	mu          sync.Mutex
+	max         int
+	now         func() time.Time
+	windowStart time.Time
+	sent        int
+	suppressed  int
+	gapStart    time.Time
+}
+
+// streamGap describes updates suppressed by a streamLimiter.
+type streamGap struct {
+	Suppressed int
+	// Since is when the first suppressed update arrived
+	Since time.Time
+}
+
+// streamResumeMarker tells a subscriber how to fetch suppressed updates.
+type streamResumeMarker struct {
+	// Tool is the companion tool that lists the stream's updates
+	Tool string `json:"tool"`
+	// Since is when the first suppressed update arrived (RFC3339)
+	Since string `json:"since"`
+	// SinceSeconds is the sinceSeconds argument for Tool that covers the gap
+	SinceSeconds int64 `json:"sinceSeconds"`
+}
+
+// newStreamLimiter returns a limiter allowing max updates per second, or nil when max is not
+// positive.
+func newStreamLimiter(max int) *streamLimiter {
+	if max <= 0 {
+		return nil
+	}
+	return &streamLimiter{max: max, now: time.Now}
+}
+
+// allow reports whether an update may be published, counting it as suppressed otherwise.
+func (l *streamLimiter) allow() bool {
+	if l == nil {
+		return true
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	if now.Sub(l.windowStart) >= streamLimitWindow {
+		l.windowStart = now
+		l.sent = 0
+	}
+	if l.sent < l.max {
+		l.sent++
+		return true
+	}
+	if l.suppressed == 0 {
+		l.gapStart = now
+	}
+	l.suppressed++
+	return false
+}
+
+// flush returns the updates suppressed so far once the window they were dropped in has
+// closed; ok is false when there is nothing to report yet.
+func (l *streamLimiter) flush() (gap streamGap, ok bool) {
+	if l == nil {
+		return streamGap{}, false
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.suppressed == 0 || l.now().Sub(l.windowStart) < streamLimitWindow {
+		return streamGap{}, false
+	}
+	gap = streamGap{Suppressed: l.suppressed, Since: l.gapStart}
+	l.suppressed = 0
+	return gap, true
+}
+
+// ticks returns a channel that fires once per window so a quiet stream still reports the
+// tail of a burst, and a func releasing it. A nil limiter returns a nil channel.
+func (l *streamLimiter) ticks() (<-chan time.Time, func()) {
+	if l == nil {
+		return nil, func() {}
+	}
+	ticker := time.NewTicker(streamLimitWindow)
+	return ticker.C, ticker.Stop
+}
+
+// resumeMarker builds the marker pointing subscribers at tool for the gap.
+func (g streamGap) resumeMarker(tool string, now time.Time) streamResumeMarker {
+	seconds := int64(math.Ceil(now.Sub(g.Since).Seconds()))
+	if seconds < 1 {
+		seconds = 1
+	}
+	return streamResumeMarker{
+		Tool:         tool,
+		Since:        g.Since.UTC().Format(time.RFC3339Nano),
+		SinceSeconds: seconds,
+	}
+}
@@ -0,0 +1,58 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamLimiterSuppressesOverCap(t *testing.T) {
+	clock := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	limiter := newStreamLimiter(2)
+	limiter.now = func() time.Time { return clock }
+
+	require.True(t, limiter.allow())
+	require.True(t, limiter.allow())
+	clock = clock.Add(100 * time.Millisecond)
+	require.False(t, limiter.allow())
+	require.False(t, limiter.allow())
+
+	_, ok := limiter.flush()
+	require.False(t, ok, "gap must not be reported before the window closes")
+
+	clock = clock.Add(time.Second)
+	gap, ok := limiter.flush()
+	require.True(t, ok)
+	require.Equal(t, 2, gap.Suppressed)
+	require.Equal(t, time.Date(2025, 1, 1, 12, 0, 0, int(100*time.Millisecond), time.UTC), gap.Since)
+
+	_, ok = limiter.flush()
+	require.False(t, ok, "gap is reported once")
+	require.True(t, limiter.allow(), "a new window allows updates again")
+}
+
+func TestStreamLimiterDisabled(t *testing.T) {
+	limiter := newStreamLimiter(0)
+	require.Nil(t, limiter)
+	require.True(t, limiter.allow())
+	_, ok := limiter.flush()
+	require.False(t, ok)
+	ticks, stop := limiter.ticks()
+	require.Nil(t, ticks)
+	stop()
+}
+
+func TestStreamGapResumeMarker(t *testing.T) {
+	since := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	gap := streamGap{Suppressed: 3, Since: since}
+
+	marker := gap.resumeMarker(eventsListToolName, since.Add(2500*time.Millisecond))
+	require.Equal(t, streamResumeMarker{
+		Tool:         eventsListToolName,
+		Since:        "2025-01-01T12:00:00Z",
+		SinceSeconds: 3,
+	}, marker)
+
+	require.Equal(t, int64(1), gap.resumeMarker(eventsListToolName, since).SinceSeconds)
+}